@@ -15,6 +15,7 @@ func GetQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(
 		GetCmdQueryInterchainAccount(),
+		GetCmdQueryInterchainAccounts(),
 		GetCmdParams(),
 	)
 
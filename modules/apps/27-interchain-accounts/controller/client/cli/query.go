@@ -11,24 +11,36 @@ import (
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
 )
 
+const (
+	flagAccountLabel = "account-label"
+	flagConnectionID = "connection-id"
+	flagOwner        = "owner"
+)
+
 // GetCmdQueryInterchainAccount returns the command handler for the controller submodule parameter querying.
 func GetCmdQueryInterchainAccount() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "interchain-account [owner] [connection-id]",
 		Short:   "Query the interchain account address for a given owner on a particular connection",
-		Long:    "Query the controller submodule for the interchain account address for a given owner on a particular connection",
+		Long:    "Query the controller submodule for the interchain account address for a given owner on a particular connection. An account label may be provided to select one of multiple interchain accounts registered by the owner.",
 		Args:    cobra.ExactArgs(2),
-		Example: fmt.Sprintf("%s query interchain-accounts controller interchain-account cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs connection-0", version.AppName),
+		Example: fmt.Sprintf("%s query interchain-accounts controller interchain-account cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs connection-0 --%s=staking", version.AppName, flagAccountLabel),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
 
+			label, err := cmd.Flags().GetString(flagAccountLabel)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 			req := &types.QueryInterchainAccountRequest{
 				Owner:        args[0],
 				ConnectionId: args[1],
+				AccountLabel: label,
 			}
 
 			res, err := queryClient.InterchainAccount(cmd.Context(), req)
@@ -40,7 +52,61 @@ func GetCmdQueryInterchainAccount() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagAccountLabel, "", "label of the interchain account, if registered with one")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryInterchainAccounts returns the command handler for querying all interchain accounts registered by the controller chain.
+func GetCmdQueryInterchainAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "interchain-accounts",
+		Short:   "Query all interchain accounts registered by the controller chain",
+		Long:    "Query all interchain accounts registered by the controller chain, optionally filtered by connection and owner",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query interchain-accounts controller interchain-accounts --%s=connection-0 --%s=cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName, flagConnectionID, flagOwner),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			connectionID, err := cmd.Flags().GetString(flagConnectionID)
+			if err != nil {
+				return err
+			}
+
+			owner, err := cmd.Flags().GetString(flagOwner)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryInterchainAccountsRequest{
+				ConnectionId: connectionID,
+				Owner:        owner,
+				Pagination:   pageReq,
+			}
+
+			res, err := queryClient.InterchainAccounts(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagConnectionID, "", "connection identifier used to filter the interchain accounts")
+	cmd.Flags().String(flagOwner, "", "owner address used to filter the interchain accounts")
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "interchain accounts")
 
 	return cmd
 }
@@ -16,7 +16,14 @@ import (
 // identifier is already in use. Gaining access to interchain accounts whose channels
 // have closed cannot be done with this function. A regular MsgChanOpenInit must be used.
 func (k Keeper) RegisterInterchainAccount(ctx sdk.Context, connectionID, owner string) error {
-	portID, err := icatypes.NewControllerPortID(owner)
+	return k.RegisterInterchainAccountWithLabel(ctx, connectionID, owner, "")
+}
+
+// RegisterInterchainAccountWithLabel registers an interchain account for the provided owner, identified by the provided
+// account label. The label is appended to the generated controller port identifier, allowing a single owner to register
+// multiple interchain accounts on the same connection. An empty label is equivalent to calling RegisterInterchainAccount.
+func (k Keeper) RegisterInterchainAccountWithLabel(ctx sdk.Context, connectionID, owner, label string) error {
+	portID, err := icatypes.NewControllerPortIDWithLabel(owner, label)
 	if err != nil {
 		return err
 	}
@@ -24,7 +31,7 @@ func (k Keeper) RegisterInterchainAccount(ctx sdk.Context, connectionID, owner s
 	// if there is an active channel for this portID / connectionID return an error
 	activeChannelID, found := k.GetOpenActiveChannel(ctx, connectionID, portID)
 	if found {
-		return sdkerrors.Wrapf(icatypes.ErrActiveChannelAlreadySet, "existing active channel %s for portID %s on connection %s for owner %s", activeChannelID, portID, connectionID, owner)
+		return sdkerrors.Wrapf(icatypes.ErrActiveChannelAlreadySet, "existing active channel %s for portID %s on connection %s for owner %s with label %s", activeChannelID, portID, connectionID, owner, label)
 	}
 
 	switch {
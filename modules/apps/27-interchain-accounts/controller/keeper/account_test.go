@@ -10,6 +10,7 @@ import (
 func (suite *KeeperTestSuite) TestRegisterInterchainAccount() {
 	var (
 		owner string
+		label string
 		path  *ibctesting.Path
 		err   error
 	)
@@ -31,6 +32,13 @@ func (suite *KeeperTestSuite) TestRegisterInterchainAccount() {
 			},
 			false,
 		},
+		{
+			"success with account label",
+			func() {
+				label = "staking"
+			},
+			true,
+		},
 		{
 			"fails to generate port-id",
 			func() {
@@ -38,6 +46,13 @@ func (suite *KeeperTestSuite) TestRegisterInterchainAccount() {
 			},
 			false,
 		},
+		{
+			"fails to generate port-id, invalid account label",
+			func() {
+				label = "staking/reserves"
+			},
+			false,
+		},
 		{
 			"MsgChanOpenInit fails - channel is already active & in state OPEN",
 			func() {
@@ -66,13 +81,14 @@ func (suite *KeeperTestSuite) TestRegisterInterchainAccount() {
 			suite.SetupTest()
 
 			owner = TestOwnerAddress // must be explicitly changed
+			label = ""
 
 			path = NewICAPath(suite.chainA, suite.chainB)
 			suite.coordinator.SetupConnections(path)
 
 			tc.malleate() // malleate mutates test data
 
-			err = suite.chainA.GetSimApp().ICAControllerKeeper.RegisterInterchainAccount(suite.chainA.GetContext(), path.EndpointA.ConnectionID, owner)
+			err = suite.chainA.GetSimApp().ICAControllerKeeper.RegisterInterchainAccountWithLabel(suite.chainA.GetContext(), path.EndpointA.ConnectionID, owner, label)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
@@ -100,3 +116,42 @@ func (suite *KeeperTestSuite) TestRegisterSameOwnerMultipleConnections() {
 	err = suite.chainA.GetSimApp().ICAControllerKeeper.RegisterInterchainAccount(suite.chainA.GetContext(), path2.EndpointA.ConnectionID, owner)
 	suite.Require().NoError(err)
 }
+
+func (suite *KeeperTestSuite) TestRegisterSameOwnerMultipleAccounts() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	// open a second interchain account for the same owner on the same connection
+	labelledPath := NewICAPath(suite.chainA, suite.chainB)
+	labelledPath.EndpointA.ClientID = path.EndpointA.ClientID
+	labelledPath.EndpointB.ClientID = path.EndpointB.ClientID
+	labelledPath.EndpointA.ConnectionID = path.EndpointA.ConnectionID
+	labelledPath.EndpointB.ConnectionID = path.EndpointB.ConnectionID
+
+	err = SetupICAPathWithLabel(labelledPath, TestOwnerAddress, "reserves")
+	suite.Require().NoError(err)
+
+	labelledPortID, err := icatypes.NewControllerPortIDWithLabel(TestOwnerAddress, "reserves")
+	suite.Require().NoError(err)
+	suite.Require().Equal(labelledPortID, labelledPath.EndpointA.ChannelConfig.PortID)
+	suite.Require().NotEqual(TestPortID, labelledPortID)
+
+	addr, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), path.EndpointA.ConnectionID, TestPortID)
+	suite.Require().True(found)
+
+	labelledAddr, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), path.EndpointA.ConnectionID, labelledPortID)
+	suite.Require().True(found)
+	suite.Require().NotEqual(addr, labelledAddr)
+
+	interchainAccounts := suite.chainA.GetSimApp().ICAControllerKeeper.GetAllInterchainAccounts(suite.chainA.GetContext())
+	suite.Require().Len(interchainAccounts, 2)
+
+	// registering the same label again fails as the account already has an active channel
+	err = suite.chainA.GetSimApp().ICAControllerKeeper.RegisterInterchainAccountWithLabel(suite.chainA.GetContext(), path.EndpointA.ConnectionID, TestOwnerAddress, "reserves")
+	suite.Require().Error(err)
+}
@@ -2,13 +2,17 @@ package keeper
 
 import (
 	"context"
+	"strings"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -19,9 +23,9 @@ func (k Keeper) InterchainAccount(goCtx context.Context, req *types.QueryInterch
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	portID, err := icatypes.NewControllerPortID(req.Owner)
+	portID, err := icatypes.NewControllerPortIDWithLabel(req.Owner, req.AccountLabel)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "failed to generate portID from owner address: %s", err)
+		return nil, status.Errorf(codes.InvalidArgument, "failed to generate portID from owner address and account label: %s", err)
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -35,6 +39,70 @@ func (k Keeper) InterchainAccount(goCtx context.Context, req *types.QueryInterch
 	}, nil
 }
 
+// InterchainAccounts implements the Query/InterchainAccounts gRPC method
+func (k Keeper) InterchainAccounts(goCtx context.Context, req *types.QueryInterchainAccountsRequest) (*types.QueryInterchainAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConnectionId != "" {
+		if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	var ownerPortID string
+	if req.Owner != "" {
+		portID, err := icatypes.NewControllerPortID(req.Owner)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to generate portID from owner address: %s", err)
+		}
+
+		ownerPortID = portID
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	interchainAccounts := []types.RegisteredInterchainAccount{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(icatypes.OwnerKeyPrefix+"/"))
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		// keys are of the format {portID}/{connectionID}
+		keySplit := strings.Split(string(key), "/")
+		if len(keySplit) != 2 {
+			return false, status.Errorf(codes.Internal, "invalid interchain account key %s", key)
+		}
+
+		portID, connectionID := keySplit[0], keySplit[1]
+		if req.ConnectionId != "" && req.ConnectionId != connectionID {
+			return false, nil
+		}
+
+		// match both the default and any labelled controller ports of the owner
+		if ownerPortID != "" && portID != ownerPortID && !strings.HasPrefix(portID, ownerPortID+icatypes.AccountLabelSeparator) {
+			return false, nil
+		}
+
+		if accumulate {
+			interchainAccounts = append(interchainAccounts, types.RegisteredInterchainAccount{
+				ConnectionId:   connectionID,
+				PortId:         portID,
+				AccountAddress: string(value),
+			})
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryInterchainAccountsResponse{
+		InterchainAccounts: interchainAccounts,
+		Pagination:         pageRes,
+	}, nil
+}
+
 // Params implements the Query/Params gRPC method
 func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
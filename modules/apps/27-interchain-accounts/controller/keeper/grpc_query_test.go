@@ -2,13 +2,18 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
 
 func (suite *KeeperTestSuite) TestQueryInterchainAccount() {
-	var req *types.QueryInterchainAccountRequest
+	var (
+		req   *types.QueryInterchainAccountRequest
+		label string
+	)
 
 	testCases := []struct {
 		name     string
@@ -20,6 +25,14 @@ func (suite *KeeperTestSuite) TestQueryInterchainAccount() {
 			func() {},
 			true,
 		},
+		{
+			"success with account label",
+			func() {
+				label = "operations"
+				req.AccountLabel = label
+			},
+			true,
+		},
 		{
 			"empty request",
 			func() {
@@ -41,18 +54,24 @@ func (suite *KeeperTestSuite) TestQueryInterchainAccount() {
 			},
 			false,
 		},
+		{
+			"invalid account label",
+			func() {
+				req.AccountLabel = "operations.staking"
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
 			suite.SetupTest()
 
+			label = ""
+
 			path := NewICAPath(suite.chainA, suite.chainB)
 			suite.coordinator.SetupConnections(path)
 
-			err := SetupICAPath(path, ibctesting.TestAccAddress)
-			suite.Require().NoError(err)
-
 			req = &types.QueryInterchainAccountRequest{
 				ConnectionId: ibctesting.FirstConnectionID,
 				Owner:        ibctesting.TestAccAddress,
@@ -60,6 +79,9 @@ func (suite *KeeperTestSuite) TestQueryInterchainAccount() {
 
 			tc.malleate()
 
+			err := SetupICAPathWithLabel(path, ibctesting.TestAccAddress, label)
+			suite.Require().NoError(err)
+
 			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.InterchainAccount(sdk.WrapSDKContext(suite.chainA.GetContext()), req)
 
 			if tc.expPass {
@@ -75,6 +97,105 @@ func (suite *KeeperTestSuite) TestQueryInterchainAccount() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryInterchainAccounts() {
+	var (
+		req         *types.QueryInterchainAccountsRequest
+		expAccounts []types.RegisteredInterchainAccount
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success with owner filter, including labelled accounts",
+			func() {
+				req.Owner = TestOwnerAddress
+				expAccounts = expAccounts[:2]
+			},
+			true,
+		},
+		{
+			"success with connection filter",
+			func() {
+				req.ConnectionId = "connection-1"
+				expAccounts = expAccounts[2:]
+			},
+			true,
+		},
+		{
+			"success with pagination",
+			func() {
+				req.Pagination = &query.PageRequest{Limit: 1, CountTotal: true}
+				expAccounts = expAccounts[:1]
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid connection identifier",
+			func() {
+				req.ConnectionId = "invalid connection"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			address, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), path.EndpointA.ConnectionID, TestPortID)
+			suite.Require().True(found)
+
+			labelledPortID, err := icatypes.NewControllerPortIDWithLabel(TestOwnerAddress, "reserves")
+			suite.Require().NoError(err)
+
+			// set a labelled account of the same owner and an account of a different owner on a different connection
+			suite.chainA.GetSimApp().ICAControllerKeeper.SetInterchainAccountAddress(suite.chainA.GetContext(), path.EndpointA.ConnectionID, labelledPortID, "labelled-address")
+			suite.chainA.GetSimApp().ICAControllerKeeper.SetInterchainAccountAddress(suite.chainA.GetContext(), "connection-1", "icacontroller-owner", "other-address")
+
+			// NOTE: accounts are ordered by their store keys, {portID}/{connectionID}
+			expAccounts = []types.RegisteredInterchainAccount{
+				{ConnectionId: path.EndpointA.ConnectionID, PortId: labelledPortID, AccountAddress: "labelled-address"},
+				{ConnectionId: path.EndpointA.ConnectionID, PortId: TestPortID, AccountAddress: address},
+				{ConnectionId: "connection-1", PortId: "icacontroller-owner", AccountAddress: "other-address"},
+			}
+
+			req = &types.QueryInterchainAccountsRequest{}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.InterchainAccounts(sdk.WrapSDKContext(suite.chainA.GetContext()), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expAccounts, res.InterchainAccounts)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryParams() {
 	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
 	expParams := types.DefaultParams()
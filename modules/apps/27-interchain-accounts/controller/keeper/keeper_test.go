@@ -59,7 +59,12 @@ func NewICAPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
 
 // SetupICAPath invokes the InterchainAccounts entrypoint and subsequent channel handshake handlers
 func SetupICAPath(path *ibctesting.Path, owner string) error {
-	if err := RegisterInterchainAccount(path.EndpointA, owner); err != nil {
+	return SetupICAPathWithLabel(path, owner, "")
+}
+
+// SetupICAPathWithLabel invokes the InterchainAccounts entrypoint using the provided account label and subsequent channel handshake handlers
+func SetupICAPathWithLabel(path *ibctesting.Path, owner, label string) error {
+	if err := RegisterInterchainAccountWithLabel(path.EndpointA, owner, label); err != nil {
 		return err
 	}
 
@@ -80,14 +85,19 @@ func SetupICAPath(path *ibctesting.Path, owner string) error {
 
 // RegisterInterchainAccount is a helper function for starting the channel handshake
 func RegisterInterchainAccount(endpoint *ibctesting.Endpoint, owner string) error {
-	portID, err := icatypes.NewControllerPortID(owner)
+	return RegisterInterchainAccountWithLabel(endpoint, owner, "")
+}
+
+// RegisterInterchainAccountWithLabel is a helper function for starting the channel handshake of a labelled interchain account
+func RegisterInterchainAccountWithLabel(endpoint *ibctesting.Endpoint, owner, label string) error {
+	portID, err := icatypes.NewControllerPortIDWithLabel(owner, label)
 	if err != nil {
 		return err
 	}
 
 	channelSequence := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(endpoint.Chain.GetContext())
 
-	if err := endpoint.Chain.GetSimApp().ICAControllerKeeper.RegisterInterchainAccount(endpoint.Chain.GetContext(), endpoint.ConnectionID, owner); err != nil {
+	if err := endpoint.Chain.GetSimApp().ICAControllerKeeper.RegisterInterchainAccountWithLabel(endpoint.Chain.GetContext(), endpoint.ConnectionID, owner, label); err != nil {
 		return err
 	}
 
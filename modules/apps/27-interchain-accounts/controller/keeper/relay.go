@@ -16,6 +16,17 @@ import (
 // absolute timeoutTimestamp must be provided. If the packet is timed out, the channel will be closed.
 // In the case of channel closure, a new channel may be reopened to reconnect to the host chain.
 func (k Keeper) SendTx(ctx sdk.Context, chanCap *capabilitytypes.Capability, connectionID, portID string, icaPacketData icatypes.InterchainAccountPacketData, timeoutTimestamp uint64) (uint64, error) {
+	return k.sendTx(ctx, chanCap, connectionID, portID, icaPacketData, timeoutTimestamp, false)
+}
+
+// SendTxWithRelativeTimeout sends the packet data like SendTx, but the timeout timestamp is relative
+// to the latest consensus state of the client of the active channel. It is resolved into an absolute
+// timeout timestamp through the ICS4Wrapper.
+func (k Keeper) SendTxWithRelativeTimeout(ctx sdk.Context, chanCap *capabilitytypes.Capability, connectionID, portID string, icaPacketData icatypes.InterchainAccountPacketData, relativeTimeoutTimestamp uint64) (uint64, error) {
+	return k.sendTx(ctx, chanCap, connectionID, portID, icaPacketData, relativeTimeoutTimestamp, true)
+}
+
+func (k Keeper) sendTx(ctx sdk.Context, chanCap *capabilitytypes.Capability, connectionID, portID string, icaPacketData icatypes.InterchainAccountPacketData, timeoutTimestamp uint64, relativeTimeout bool) (uint64, error) {
 	activeChannelID, found := k.GetOpenActiveChannel(ctx, connectionID, portID)
 	if !found {
 		return 0, sdkerrors.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel on connection %s for port %s", connectionID, portID)
@@ -29,6 +40,18 @@ func (k Keeper) SendTx(ctx sdk.Context, chanCap *capabilitytypes.Capability, con
 	destinationPort := sourceChannelEnd.GetCounterparty().GetPortID()
 	destinationChannel := sourceChannelEnd.GetCounterparty().GetChannelID()
 
+	if relativeTimeout {
+		if timeoutTimestamp == 0 {
+			return 0, sdkerrors.Wrap(icatypes.ErrInvalidTimeoutTimestamp, "relative timeout timestamp cannot be 0")
+		}
+
+		var err error
+		_, timeoutTimestamp, err = k.ics4Wrapper.ResolveRelativeTimeout(ctx, portID, activeChannelID, clienttypes.ZeroHeight(), timeoutTimestamp)
+		if err != nil {
+			return 0, err
+		}
+	}
+
 	if uint64(ctx.BlockTime().UnixNano()) >= timeoutTimestamp {
 		return 0, icatypes.ErrInvalidTimeoutTimestamp
 	}
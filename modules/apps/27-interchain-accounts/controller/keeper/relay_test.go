@@ -1,6 +1,9 @@
 package keeper_test
 
 import (
+	"math"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
@@ -182,6 +185,103 @@ func (suite *KeeperTestSuite) TestSendTx() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSendTxWithRelativeTimeout() {
+	var (
+		path                     *ibctesting.Path
+		relativeTimeoutTimestamp uint64
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"relative timeout timestamp is 0",
+			func() {
+				relativeTimeoutTimestamp = 0
+			},
+			false,
+		},
+		{
+			"relative timeout timestamp overflows",
+			func() {
+				relativeTimeoutTimestamp = math.MaxUint64
+			},
+			false,
+		},
+		{
+			"active channel not found",
+			func() {
+				path.EndpointA.ChannelConfig.PortID = "invalid-port-id"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			relativeTimeoutTimestamp = uint64(time.Hour.Nanoseconds())
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			chanCap, ok := suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(path.EndpointA.Chain.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+			suite.Require().True(ok)
+
+			interchainAccountAddr, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			msg := &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), []sdk.Msg{msg})
+			suite.Require().NoError(err)
+
+			packetData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			tc.malleate() // malleate mutates test data
+
+			ctx := suite.chainA.GetContext()
+			sequence, err := suite.chainA.GetSimApp().ICAControllerKeeper.SendTxWithRelativeTimeout(ctx, chanCap, ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, packetData, relativeTimeoutTimestamp)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				// the timeout timestamp is resolved against the client of the channel
+				_, timeoutTimestamp, err := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.ResolveRelativeTimeout(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, clienttypes.ZeroHeight(), relativeTimeoutTimestamp)
+				suite.Require().NoError(err)
+
+				packet := channeltypes.NewPacket(
+					packetData.GetBytes(), sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+					path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), timeoutTimestamp,
+				)
+				commitment := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetPacketCommitment(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence)
+				suite.Require().Equal(channeltypes.CommitPacket(suite.chainA.GetSimApp().AppCodec(), packet), commitment)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 	var path *ibctesting.Path
 
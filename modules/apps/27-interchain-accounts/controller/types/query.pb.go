@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -33,6 +34,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type QueryInterchainAccountRequest struct {
 	Owner        string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// optional label of the interchain account, as used when registering the account
+	AccountLabel string `protobuf:"bytes,3,opt,name=account_label,json=accountLabel,proto3" json:"account_label,omitempty" yaml:"account_label"`
 }
 
 func (m *QueryInterchainAccountRequest) Reset()         { *m = QueryInterchainAccountRequest{} }
@@ -82,6 +85,13 @@ func (m *QueryInterchainAccountRequest) GetConnectionId() string {
 	return ""
 }
 
+func (m *QueryInterchainAccountRequest) GetAccountLabel() string {
+	if m != nil {
+		return m.AccountLabel
+	}
+	return ""
+}
+
 // QueryInterchainAccountResponse the response type for the Query/InterchainAccount RPC method.
 type QueryInterchainAccountResponse struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
@@ -127,6 +137,186 @@ func (m *QueryInterchainAccountResponse) GetAddress() string {
 	return ""
 }
 
+// RegisteredInterchainAccount contains an interchain account address and its associated connection and controller port identifiers
+type RegisteredInterchainAccount struct {
+	ConnectionId   string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	PortId         string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	AccountAddress string `protobuf:"bytes,3,opt,name=account_address,json=accountAddress,proto3" json:"account_address,omitempty" yaml:"account_address"`
+}
+
+func (m *RegisteredInterchainAccount) Reset()         { *m = RegisteredInterchainAccount{} }
+func (m *RegisteredInterchainAccount) String() string { return proto.CompactTextString(m) }
+func (*RegisteredInterchainAccount) ProtoMessage()    {}
+func (*RegisteredInterchainAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{2}
+}
+func (m *RegisteredInterchainAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisteredInterchainAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisteredInterchainAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegisteredInterchainAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisteredInterchainAccount.Merge(m, src)
+}
+func (m *RegisteredInterchainAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisteredInterchainAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisteredInterchainAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisteredInterchainAccount proto.InternalMessageInfo
+
+func (m *RegisteredInterchainAccount) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *RegisteredInterchainAccount) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *RegisteredInterchainAccount) GetAccountAddress() string {
+	if m != nil {
+		return m.AccountAddress
+	}
+	return ""
+}
+
+// QueryInterchainAccountsRequest is the request type for the Query/InterchainAccounts RPC method.
+type QueryInterchainAccountsRequest struct {
+	// optional connection identifier used to filter the interchain accounts
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// optional owner address used to filter the interchain accounts, including all labelled accounts of the owner
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// pagination request
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInterchainAccountsRequest) Reset()         { *m = QueryInterchainAccountsRequest{} }
+func (m *QueryInterchainAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInterchainAccountsRequest) ProtoMessage()    {}
+func (*QueryInterchainAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{3}
+}
+func (m *QueryInterchainAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterchainAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterchainAccountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInterchainAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterchainAccountsRequest.Merge(m, src)
+}
+func (m *QueryInterchainAccountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterchainAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterchainAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInterchainAccountsRequest proto.InternalMessageInfo
+
+func (m *QueryInterchainAccountsRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *QueryInterchainAccountsRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryInterchainAccountsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryInterchainAccountsResponse is the response type for the Query/InterchainAccounts RPC method.
+type QueryInterchainAccountsResponse struct {
+	// list of registered interchain accounts
+	InterchainAccounts []RegisteredInterchainAccount `protobuf:"bytes,1,rep,name=interchain_accounts,json=interchainAccounts,proto3" json:"interchain_accounts" yaml:"interchain_accounts"`
+	// pagination response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInterchainAccountsResponse) Reset()         { *m = QueryInterchainAccountsResponse{} }
+func (m *QueryInterchainAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInterchainAccountsResponse) ProtoMessage()    {}
+func (*QueryInterchainAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df0d8b259d72854e, []int{4}
+}
+func (m *QueryInterchainAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterchainAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterchainAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInterchainAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterchainAccountsResponse.Merge(m, src)
+}
+func (m *QueryInterchainAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterchainAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterchainAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInterchainAccountsResponse proto.InternalMessageInfo
+
+func (m *QueryInterchainAccountsResponse) GetInterchainAccounts() []RegisteredInterchainAccount {
+	if m != nil {
+		return m.InterchainAccounts
+	}
+	return nil
+}
+
+func (m *QueryInterchainAccountsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -135,7 +325,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df0d8b259d72854e, []int{2}
+	return fileDescriptor_df0d8b259d72854e, []int{5}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -174,7 +364,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df0d8b259d72854e, []int{3}
+	return fileDescriptor_df0d8b259d72854e, []int{6}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -213,6 +403,9 @@ func (m *QueryParamsResponse) GetParams() *Params {
 func init() {
 	proto.RegisterType((*QueryInterchainAccountRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryInterchainAccountRequest")
 	proto.RegisterType((*QueryInterchainAccountResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryInterchainAccountResponse")
+	proto.RegisterType((*RegisteredInterchainAccount)(nil), "ibc.applications.interchain_accounts.controller.v1.RegisteredInterchainAccount")
+	proto.RegisterType((*QueryInterchainAccountsRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryInterchainAccountsRequest")
+	proto.RegisterType((*QueryInterchainAccountsResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryInterchainAccountsResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.QueryParamsResponse")
 }
@@ -222,36 +415,52 @@ func init() {
 }
 
 var fileDescriptor_df0d8b259d72854e = []byte{
-	// 465 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x41, 0x6b, 0x14, 0x31,
-	0x14, 0xc7, 0x77, 0x56, 0xba, 0x62, 0xd4, 0x83, 0x71, 0x0f, 0xcb, 0xa2, 0xa3, 0xcc, 0xc9, 0xcb,
-	0x26, 0x74, 0x2a, 0x08, 0x0b, 0x0a, 0x56, 0x50, 0x7a, 0x6b, 0xe7, 0x20, 0xe2, 0xc1, 0x92, 0xcd,
-	0x84, 0x69, 0x64, 0x26, 0x6f, 0x9a, 0x64, 0x56, 0x96, 0xd2, 0x8b, 0x9f, 0x40, 0xf0, 0xe6, 0x27,
-	0xf2, 0x58, 0x10, 0xc1, 0x93, 0xc8, 0xae, 0x9f, 0xc0, 0xb3, 0x07, 0x99, 0x4c, 0x74, 0x3b, 0x58,
-	0xc5, 0x5d, 0x7b, 0x9a, 0xbc, 0xf7, 0x78, 0xff, 0xdf, 0x7b, 0xf9, 0x67, 0xd0, 0x03, 0x39, 0xe1,
-	0x94, 0x95, 0x65, 0x2e, 0x39, 0xb3, 0x12, 0x94, 0xa1, 0x52, 0x59, 0xa1, 0xf9, 0x01, 0x93, 0x6a,
-	0x9f, 0x71, 0x0e, 0x95, 0xb2, 0x86, 0x72, 0x50, 0x56, 0x43, 0x9e, 0x0b, 0x4d, 0xa7, 0x9b, 0xf4,
-	0xb0, 0x12, 0x7a, 0x46, 0x4a, 0x0d, 0x16, 0x70, 0x2c, 0x27, 0x9c, 0x9c, 0xee, 0x27, 0x67, 0xf4,
-	0x93, 0x65, 0x3f, 0x99, 0x6e, 0x0e, 0x1f, 0xad, 0xc1, 0x3c, 0xa5, 0xe0, 0xc0, 0xc3, 0x7e, 0x06,
-	0x19, 0xb8, 0x23, 0xad, 0x4f, 0x3e, 0x7b, 0x23, 0x03, 0xc8, 0x72, 0x41, 0x59, 0x29, 0x29, 0x53,
-	0x0a, 0xac, 0x1f, 0xca, 0x55, 0x23, 0x8b, 0x6e, 0xee, 0xd5, 0xb3, 0xef, 0xfc, 0xc2, 0x3d, 0x6c,
-	0x68, 0x89, 0x38, 0xac, 0x84, 0xb1, 0xb8, 0x8f, 0x36, 0xe0, 0x95, 0x12, 0x7a, 0x10, 0xdc, 0x0e,
-	0xee, 0x5c, 0x4a, 0x9a, 0x00, 0xdf, 0x47, 0x57, 0x39, 0x28, 0x25, 0x78, 0xad, 0xb5, 0x2f, 0xd3,
-	0x41, 0xb7, 0xae, 0x6e, 0x0f, 0xbe, 0x7d, 0xbe, 0xd5, 0x9f, 0xb1, 0x22, 0x1f, 0x47, 0xad, 0x72,
-	0x94, 0x5c, 0x59, 0xc6, 0x3b, 0x69, 0x34, 0x46, 0xe1, 0x9f, 0xa8, 0xa6, 0x04, 0x65, 0x04, 0x1e,
-	0xa0, 0x8b, 0x2c, 0x4d, 0xb5, 0x30, 0xc6, 0x83, 0x7f, 0x86, 0x51, 0x1f, 0x61, 0xd7, 0xbb, 0xcb,
-	0x34, 0x2b, 0x8c, 0x1f, 0x33, 0x92, 0xe8, 0x7a, 0x2b, 0xeb, 0x65, 0x12, 0xd4, 0x2b, 0x5d, 0xc6,
-	0xa9, 0x5c, 0x8e, 0xc7, 0x64, 0x75, 0x73, 0x88, 0xd7, 0xf4, 0x4a, 0xf1, 0xf7, 0x0b, 0x68, 0xc3,
-	0xb1, 0xf0, 0xbb, 0x2e, 0xba, 0xf6, 0xdb, 0x0a, 0x78, 0x6f, 0x1d, 0xc6, 0x5f, 0x4d, 0x18, 0x26,
-	0xe7, 0x29, 0xd9, 0x5c, 0x4d, 0xf4, 0xe2, 0xf5, 0x87, 0xaf, 0x6f, 0xbb, 0xcf, 0xf0, 0x53, 0xea,
-	0xdf, 0xde, 0xbf, 0xbc, 0x39, 0xe7, 0xbe, 0xa1, 0x47, 0xee, 0x7b, 0x4c, 0x97, 0xa6, 0x1a, 0x7a,
-	0xd4, 0x72, 0xfc, 0x18, 0x7f, 0x0c, 0x50, 0xaf, 0xb9, 0x39, 0xfc, 0x78, 0xed, 0xf1, 0x5b, 0x26,
-	0x0f, 0x9f, 0xfc, 0xb7, 0x8e, 0xdf, 0x7d, 0xec, 0x76, 0xbf, 0x8b, 0xe3, 0x55, 0x76, 0x6f, 0xec,
-	0xdf, 0x7e, 0xf9, 0x7e, 0x1e, 0x06, 0x27, 0xf3, 0x30, 0xf8, 0x32, 0x0f, 0x83, 0x37, 0x8b, 0xb0,
-	0x73, 0xb2, 0x08, 0x3b, 0x9f, 0x16, 0x61, 0xe7, 0xf9, 0x6e, 0x26, 0xed, 0x41, 0x35, 0x21, 0x1c,
-	0x0a, 0xca, 0xc1, 0x14, 0x60, 0x6a, 0xf9, 0x51, 0x06, 0x74, 0xba, 0x45, 0x0b, 0x48, 0xab, 0x5c,
-	0x98, 0x06, 0x16, 0xdf, 0x1b, 0x2d, 0x79, 0xa3, 0xb3, 0x78, 0x76, 0x56, 0x0a, 0x33, 0xe9, 0xb9,
-	0x9f, 0x74, 0xeb, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0d, 0xa8, 0xc5, 0xaa, 0x93, 0x04, 0x00,
+	// 721 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x6b, 0x13, 0x4d,
+	0x18, 0xce, 0xa6, 0x34, 0xe5, 0x9b, 0xf6, 0xeb, 0xc7, 0x37, 0x0d, 0x12, 0xa2, 0x6e, 0xca, 0x1e,
+	0xb4, 0x28, 0xdd, 0x21, 0x5b, 0x41, 0x08, 0xa8, 0xb4, 0x85, 0x96, 0x82, 0x60, 0xbb, 0x82, 0x88,
+	0x07, 0xcb, 0xec, 0xee, 0xb0, 0x1d, 0xd9, 0xec, 0x6c, 0x77, 0x36, 0x91, 0x52, 0x7a, 0x11, 0xf4,
+	0xac, 0x78, 0xf3, 0xec, 0x7f, 0xe0, 0xd9, 0x7b, 0x4f, 0x52, 0x10, 0xc1, 0x53, 0x90, 0xd6, 0xbf,
+	0x20, 0x7f, 0x81, 0xec, 0xcc, 0x6c, 0x93, 0x35, 0x69, 0x35, 0x69, 0x4f, 0x3b, 0xbf, 0xde, 0xf7,
+	0x7d, 0x9e, 0x67, 0x9e, 0x77, 0x16, 0xdc, 0xa7, 0x8e, 0x8b, 0x70, 0x14, 0x05, 0xd4, 0xc5, 0x09,
+	0x65, 0x21, 0x47, 0x34, 0x4c, 0x48, 0xec, 0xee, 0x60, 0x1a, 0x6e, 0x63, 0xd7, 0x65, 0xad, 0x30,
+	0xe1, 0xc8, 0x65, 0x61, 0x12, 0xb3, 0x20, 0x20, 0x31, 0x6a, 0xd7, 0xd1, 0x6e, 0x8b, 0xc4, 0x7b,
+	0x66, 0x14, 0xb3, 0x84, 0x41, 0x8b, 0x3a, 0xae, 0xd9, 0x1f, 0x6f, 0x0e, 0x89, 0x37, 0x7b, 0xf1,
+	0x66, 0xbb, 0x5e, 0x5d, 0x1d, 0xa3, 0x66, 0x5f, 0x06, 0x51, 0xb8, 0x5a, 0xf6, 0x99, 0xcf, 0xc4,
+	0x10, 0xa5, 0x23, 0xb5, 0x7a, 0xcd, 0x67, 0xcc, 0x0f, 0x08, 0xc2, 0x11, 0x45, 0x38, 0x0c, 0x59,
+	0xa2, 0x40, 0xc9, 0xdd, 0x5b, 0x2e, 0xe3, 0x4d, 0xc6, 0x91, 0x83, 0x39, 0x91, 0x2c, 0x50, 0xbb,
+	0xee, 0x90, 0x04, 0xd7, 0x51, 0x84, 0x7d, 0x1a, 0x8a, 0xc3, 0xf2, 0xac, 0xf1, 0x49, 0x03, 0xd7,
+	0xb7, 0xd2, 0x23, 0x1b, 0xa7, 0xd8, 0x96, 0x25, 0x34, 0x9b, 0xec, 0xb6, 0x08, 0x4f, 0x60, 0x19,
+	0x4c, 0xb2, 0x97, 0x21, 0x89, 0x2b, 0xda, 0xbc, 0xb6, 0xf0, 0x8f, 0x2d, 0x27, 0xf0, 0x1e, 0xf8,
+	0xd7, 0x65, 0x61, 0x48, 0xdc, 0x34, 0xd7, 0x36, 0xf5, 0x2a, 0xc5, 0x74, 0x77, 0xa5, 0xd2, 0xed,
+	0xd4, 0xca, 0x7b, 0xb8, 0x19, 0x34, 0x8c, 0xdc, 0xb6, 0x61, 0xcf, 0xf4, 0xe6, 0x1b, 0x5e, 0x1a,
+	0xae, 0x14, 0xd8, 0x0e, 0xb0, 0x43, 0x82, 0xca, 0xc4, 0xef, 0xe1, 0xb9, 0x6d, 0xc3, 0x9e, 0x51,
+	0xf3, 0x87, 0x62, 0xda, 0x00, 0xfa, 0x59, 0xa0, 0x79, 0xc4, 0x42, 0x4e, 0x60, 0x05, 0x4c, 0x61,
+	0xcf, 0x8b, 0x09, 0xe7, 0x0a, 0x77, 0x36, 0x35, 0xbe, 0x68, 0xe0, 0xaa, 0x4d, 0x7c, 0xca, 0x13,
+	0x12, 0x13, 0x6f, 0x20, 0xc3, 0x20, 0x33, 0x6d, 0x24, 0x66, 0xb7, 0xc1, 0x54, 0xc4, 0xe2, 0xa4,
+	0x27, 0x09, 0xec, 0x76, 0x6a, 0xb3, 0x32, 0x50, 0x6d, 0x18, 0x76, 0x29, 0x1d, 0x6d, 0x78, 0x70,
+	0x15, 0xfc, 0x97, 0xf1, 0xcc, 0xd0, 0x4a, 0x21, 0xaa, 0xdd, 0x4e, 0xed, 0x4a, 0x5e, 0x88, 0x0c,
+	0xbf, 0x3d, 0xab, 0x56, 0x96, 0xd5, 0xc2, 0x67, 0xed, 0x2c, 0x35, 0x78, 0x76, 0x87, 0x17, 0xe4,
+	0x74, 0x6a, 0x81, 0x62, 0xbf, 0x05, 0xd6, 0x00, 0xe8, 0xd9, 0x49, 0xe0, 0x9e, 0xb6, 0x6e, 0x98,
+	0xd2, 0x7b, 0x66, 0xea, 0x3d, 0x53, 0x76, 0x90, 0xf2, 0x9e, 0xb9, 0x89, 0x7d, 0xa2, 0x00, 0xd9,
+	0x7d, 0x91, 0xc6, 0xbb, 0x22, 0xa8, 0x9d, 0x89, 0x5f, 0x5d, 0xe7, 0x47, 0x0d, 0xcc, 0x0d, 0xe9,
+	0x9e, 0x8a, 0x36, 0x3f, 0xb1, 0x30, 0x6d, 0x3d, 0x32, 0x47, 0x6f, 0x4f, 0xf3, 0x1c, 0x0f, 0xac,
+	0x18, 0x87, 0x9d, 0x5a, 0xa1, 0xdb, 0xa9, 0x55, 0xa5, 0x38, 0x43, 0x92, 0x19, 0x36, 0xa4, 0x03,
+	0x70, 0xe1, 0x7a, 0x4e, 0x92, 0xa2, 0x90, 0xe4, 0xe6, 0x1f, 0x25, 0x91, 0x1c, 0x73, 0x9a, 0x94,
+	0x01, 0x14, 0x92, 0x6c, 0xe2, 0x18, 0x37, 0xb3, 0x6b, 0x34, 0x28, 0x98, 0xcb, 0xad, 0x2a, 0x71,
+	0x6c, 0x50, 0x8a, 0xc4, 0x8a, 0xb8, 0xd6, 0x69, 0xab, 0x31, 0x8e, 0x1c, 0x2a, 0xa7, 0xca, 0x64,
+	0xbd, 0x2e, 0x81, 0x49, 0x51, 0x0b, 0x7e, 0x28, 0x82, 0xff, 0x07, 0xbb, 0x64, 0x6b, 0x9c, 0x1a,
+	0xe7, 0x3e, 0x34, 0x55, 0xfb, 0x32, 0x53, 0x4a, 0x69, 0x8c, 0xe7, 0xaf, 0xbe, 0xfe, 0x7c, 0x5f,
+	0x7c, 0x0a, 0x9f, 0x20, 0xf5, 0x18, 0xff, 0xcd, 0x23, 0x2c, 0xec, 0xcd, 0xd1, 0xbe, 0xf8, 0x1e,
+	0xa0, 0x5e, 0x2b, 0x70, 0xb4, 0x9f, 0xeb, 0x93, 0x03, 0xf8, 0xa6, 0x08, 0xe0, 0xa0, 0x6d, 0xe1,
+	0x25, 0x52, 0xc9, 0x2e, 0xbf, 0xfa, 0xf8, 0x52, 0x73, 0x2a, 0x7d, 0xd6, 0x85, 0x3e, 0xcb, 0xf0,
+	0xc1, 0x28, 0xfa, 0x0c, 0x39, 0x01, 0xbf, 0x69, 0xa0, 0x24, 0x2d, 0x04, 0xd7, 0xc6, 0x06, 0x9a,
+	0x73, 0x7b, 0x75, 0xfd, 0xc2, 0x79, 0x14, 0xc9, 0x86, 0x20, 0x79, 0x07, 0x5a, 0xa3, 0x90, 0x94,
+	0x7d, 0xb0, 0xf2, 0xe2, 0xf0, 0x58, 0xd7, 0x8e, 0x8e, 0x75, 0xed, 0xc7, 0xb1, 0xae, 0xbd, 0x3d,
+	0xd1, 0x0b, 0x47, 0x27, 0x7a, 0xe1, 0xfb, 0x89, 0x5e, 0x78, 0xb6, 0xe9, 0xd3, 0x64, 0xa7, 0xe5,
+	0x98, 0x2e, 0x6b, 0x22, 0xf5, 0xc3, 0xa5, 0x8e, 0xbb, 0xe8, 0x33, 0xd4, 0x5e, 0x42, 0x4d, 0xe6,
+	0xb5, 0x02, 0xc2, 0x65, 0x31, 0xeb, 0xee, 0x62, 0xaf, 0xde, 0xe2, 0xb0, 0x7a, 0xc9, 0x5e, 0x44,
+	0xb8, 0x53, 0x12, 0xbf, 0xe4, 0xa5, 0x5f, 0x03, 0x00, 0x6e, 0xf9, 0x06, 0xaa, 0xad, 0x08, 0x00,
 	0x00,
 }
 
@@ -267,8 +476,12 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
-	// InterchainAccount returns the interchain account address for a given owner address on a given connection
+	// InterchainAccount returns the interchain account address for a given owner address on a given connection.
+	// An optional account label may be provided to select one of multiple interchain accounts registered by the owner.
 	InterchainAccount(ctx context.Context, in *QueryInterchainAccountRequest, opts ...grpc.CallOption) (*QueryInterchainAccountResponse, error)
+	// InterchainAccounts returns all interchain accounts registered by the controller chain, optionally filtered by
+	// connection and owner.
+	InterchainAccounts(ctx context.Context, in *QueryInterchainAccountsRequest, opts ...grpc.CallOption) (*QueryInterchainAccountsResponse, error)
 	// Params queries all parameters of the ICA controller submodule.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -290,6 +503,15 @@ func (c *queryClient) InterchainAccount(ctx context.Context, in *QueryInterchain
 	return out, nil
 }
 
+func (c *queryClient) InterchainAccounts(ctx context.Context, in *QueryInterchainAccountsRequest, opts ...grpc.CallOption) (*QueryInterchainAccountsResponse, error) {
+	out := new(QueryInterchainAccountsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Query/InterchainAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Query/Params", in, out, opts...)
@@ -301,8 +523,12 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 
 // QueryServer is the server API for Query service.
 type QueryServer interface {
-	// InterchainAccount returns the interchain account address for a given owner address on a given connection
+	// InterchainAccount returns the interchain account address for a given owner address on a given connection.
+	// An optional account label may be provided to select one of multiple interchain accounts registered by the owner.
 	InterchainAccount(context.Context, *QueryInterchainAccountRequest) (*QueryInterchainAccountResponse, error)
+	// InterchainAccounts returns all interchain accounts registered by the controller chain, optionally filtered by
+	// connection and owner.
+	InterchainAccounts(context.Context, *QueryInterchainAccountsRequest) (*QueryInterchainAccountsResponse, error)
 	// Params queries all parameters of the ICA controller submodule.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -314,6 +540,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) InterchainAccount(ctx context.Context, req *QueryInterchainAccountRequest) (*QueryInterchainAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InterchainAccount not implemented")
 }
+func (*UnimplementedQueryServer) InterchainAccounts(ctx context.Context, req *QueryInterchainAccountsRequest) (*QueryInterchainAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InterchainAccounts not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -340,6 +569,24 @@ func _Query_InterchainAccount_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_InterchainAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInterchainAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InterchainAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Query/InterchainAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InterchainAccounts(ctx, req.(*QueryInterchainAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -366,6 +613,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InterchainAccount",
 			Handler:    _Query_InterchainAccount_Handler,
 		},
+		{
+			MethodName: "InterchainAccounts",
+			Handler:    _Query_InterchainAccounts_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -395,6 +646,13 @@ func (m *QueryInterchainAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.AccountLabel) > 0 {
+		i -= len(m.AccountLabel)
+		copy(dAtA[i:], m.AccountLabel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AccountLabel)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.ConnectionId) > 0 {
 		i -= len(m.ConnectionId)
 		copy(dAtA[i:], m.ConnectionId)
@@ -442,6 +700,148 @@ func (m *QueryInterchainAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *RegisteredInterchainAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisteredInterchainAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisteredInterchainAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccountAddress) > 0 {
+		i -= len(m.AccountAddress)
+		copy(dAtA[i:], m.AccountAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AccountAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterchainAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterchainAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterchainAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterchainAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterchainAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterchainAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InterchainAccounts) > 0 {
+		for iNdEx := len(m.InterchainAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InterchainAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -525,6 +925,10 @@ func (m *QueryInterchainAccountRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.AccountLabel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -541,16 +945,77 @@ func (m *QueryInterchainAccountResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
+func (m *RegisteredInterchainAccount) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	return n
-}
-
-func (m *QueryParamsResponse) Size() (n int) {
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AccountAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterchainAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterchainAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.InterchainAccounts) > 0 {
+		for _, e := range m.InterchainAccounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -662,6 +1127,38 @@ func (m *QueryInterchainAccountRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountLabel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountLabel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -765,6 +1262,422 @@ func (m *QueryInterchainAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RegisteredInterchainAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisteredInterchainAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisteredInterchainAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterchainAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterchainAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterchainAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InterchainAccounts = append(m.InterchainAccounts, RegisteredInterchainAccount{})
+			if err := m.InterchainAccounts[len(m.InterchainAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
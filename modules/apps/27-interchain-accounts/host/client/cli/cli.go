@@ -16,6 +16,8 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdParams(),
 		GetCmdPacketEvents(),
+		GetCmdInterchainAccounts(),
+		GetCmdActiveChannels(),
 	)
 
 	return queryCmd
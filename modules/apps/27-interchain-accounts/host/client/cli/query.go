@@ -18,6 +18,10 @@ import (
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
+const (
+	flagConnectionID = "connection-id"
+)
+
 // GetCmdParams returns the command handler for the host submodule parameter querying.
 func GetCmdParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -95,3 +99,95 @@ func GetCmdPacketEvents() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdInterchainAccounts returns the command handler for querying all interchain accounts registered on the host chain.
+func GetCmdInterchainAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "interchain-accounts",
+		Short:   "Query all interchain accounts registered on the host chain",
+		Long:    "Query all interchain accounts registered on the host chain, optionally filtered by connection",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query interchain-accounts host interchain-accounts --%s=connection-0", version.AppName, flagConnectionID),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			connectionID, err := cmd.Flags().GetString(flagConnectionID)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryInterchainAccountsRequest{
+				ConnectionId: connectionID,
+				Pagination:   pageReq,
+			}
+
+			res, err := queryClient.InterchainAccounts(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagConnectionID, "", "connection identifier used to filter the interchain accounts")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "interchain accounts")
+
+	return cmd
+}
+
+// GetCmdActiveChannels returns the command handler for querying all active interchain accounts host channels.
+func GetCmdActiveChannels() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "active-channels",
+		Short:   "Query all active interchain accounts host channels",
+		Long:    "Query all active interchain accounts host channels and their associated controller ports, optionally filtered by connection",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query interchain-accounts host active-channels --%s=connection-0", version.AppName, flagConnectionID),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			connectionID, err := cmd.Flags().GetString(flagConnectionID)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryActiveChannelsRequest{
+				ConnectionId: connectionID,
+				Pagination:   pageReq,
+			}
+
+			res, err := queryClient.ActiveChannels(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagConnectionID, "", "connection identifier used to filter the active channels")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "active channels")
+
+	return cmd
+}
@@ -109,19 +109,34 @@ func (im IBCModule) OnRecvPacket(
 		return types.NewErrorAcknowledgement(types.ErrHostSubModuleDisabled)
 	}
 
+	gasBefore := ctx.GasMeter().GasConsumed()
+
 	txResponse, err := im.keeper.OnRecvPacket(ctx, packet)
 	ack := channeltypes.NewResultAcknowledgement(txResponse)
 	if err != nil {
 		ack = types.NewErrorAcknowledgement(err)
 	}
 
-	// Emit an event indicating a successful or failed acknowledgement.
-	keeper.EmitAcknowledgementEvent(ctx, packet, ack, err)
+	// Emit an event indicating a successful or failed acknowledgement and the gas consumed.
+	keeper.EmitAcknowledgementEvent(ctx, packet, ack, ctx.GasMeter().GasConsumed()-gasBefore, err)
 
 	// NOTE: acknowledgement will be written synchronously during IBC handler execution.
 	return ack
 }
 
+// ChargeRecvPacket implements the RecvPacketCharger interface
+func (im IBCModule) ChargeRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	_ sdk.AccAddress,
+) error {
+	if !im.keeper.IsHostEnabled(ctx) {
+		return nil
+	}
+
+	return im.keeper.ChargeFee(ctx, packet)
+}
+
 // OnAcknowledgementPacket implements the IBCModule interface
 func (im IBCModule) OnAcknowledgementPacket(
 	ctx sdk.Context,
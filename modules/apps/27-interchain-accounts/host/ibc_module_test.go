@@ -502,6 +502,114 @@ func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 	}
 }
 
+// TestOnRecvPacketFee tests that the fee charged to an interchain account for executing a
+// packet is kept by core IBC regardless of the outcome of the execution.
+func (suite *InterchainAccountsTestSuite) TestOnRecvPacketFee() {
+	var (
+		params types.Params
+		amount sdk.Coins
+	)
+
+	startingBal := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000)))
+	fee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)))
+
+	testCases := []struct {
+		msg        string
+		malleate   func()
+		expBalance sdk.Coins
+		expAckPass bool
+	}{
+		{
+			"success: fee is charged and the tx is executed",
+			func() {},
+			startingBal.Sub(fee).Sub(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))),
+			true,
+		},
+		{
+			"failure: fee is charged although the tx fails",
+			func() {
+				amount = startingBal
+			},
+			startingBal.Sub(fee),
+			false,
+		},
+		{
+			"failure: tx is not executed if the interchain account cannot pay the fee",
+			func() {
+				params.GasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(1)))
+			},
+			startingBal,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, startingBal)
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			amount = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+			params = types.NewParamsWithGasConfig(true, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, 1_000_000, sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(1, 3))))
+
+			tc.malleate() // malleate mutates test data
+
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			msg := &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+				Amount:      amount,
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			chanCap, ok := suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(path.EndpointA.Chain.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+			suite.Require().True(ok)
+
+			_, err = suite.chainA.GetSimApp().ICAControllerKeeper.SendTx(suite.chainA.GetContext(), chanCap, ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, icaPacketData, ^uint64(0))
+			suite.Require().NoError(err)
+
+			suite.coordinator.CommitBlock(suite.chainA)
+			err = path.EndpointB.UpdateClient()
+			suite.Require().NoError(err)
+
+			packet := channeltypes.NewPacket(icaPacketData.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), ^uint64(0))
+			res, err := path.EndpointB.RecvPacketWithResult(packet)
+			suite.Require().NoError(err)
+
+			ackBz, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+
+			var ack channeltypes.Acknowledgement
+			err = channeltypes.SubModuleCdc.UnmarshalJSON(ackBz, &ack)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expAckPass, ack.Success())
+
+			icaAddr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+			suite.Require().NoError(err)
+
+			suite.assertBalance(icaAddr, tc.expBalance)
+		})
+	}
+}
+
 func (suite *InterchainAccountsTestSuite) TestOnAcknowledgementPacket() {
 	testCases := []struct {
 		name     string
@@ -10,8 +10,8 @@ import (
 )
 
 // EmitAcknowledgementEvent emits an event signalling a successful or failed acknowledgement and including the error
-// details if any.
-func EmitAcknowledgementEvent(ctx sdk.Context, packet exported.PacketI, ack exported.Acknowledgement, err error) {
+// details if any, as well as the gas consumed handling the packet.
+func EmitAcknowledgementEvent(ctx sdk.Context, packet exported.PacketI, ack exported.Acknowledgement, gasUsed uint64, err error) {
 	var errorMsg string
 	if err != nil {
 		errorMsg = err.Error()
@@ -24,6 +24,7 @@ func EmitAcknowledgementEvent(ctx sdk.Context, packet exported.PacketI, ack expo
 			sdk.NewAttribute(icatypes.AttributeKeyAckError, errorMsg),
 			sdk.NewAttribute(icatypes.AttributeKeyHostChannelID, packet.GetDestChannel()),
 			sdk.NewAttribute(icatypes.AttributeKeyAckSuccess, fmt.Sprintf("%t", ack.Success())),
+			sdk.NewAttribute(icatypes.AttributeKeyGasUsed, fmt.Sprintf("%d", gasUsed)),
 		),
 	)
 }
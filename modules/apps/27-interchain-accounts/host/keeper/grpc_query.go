@@ -2,10 +2,17 @@ package keeper
 
 import (
 	"context"
+	"strings"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -19,3 +26,99 @@ func (q Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 		Params: &params,
 	}, nil
 }
+
+// InterchainAccounts implements the Query/InterchainAccounts gRPC method
+func (q Keeper) InterchainAccounts(c context.Context, req *types.QueryInterchainAccountsRequest) (*types.QueryInterchainAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConnectionId != "" {
+		if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	interchainAccounts := []types.RegisteredInterchainAccount{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), []byte(icatypes.OwnerKeyPrefix+"/"))
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		// keys are of the format {portID}/{connectionID}
+		keySplit := strings.Split(string(key), "/")
+		if len(keySplit) != 2 {
+			return false, status.Errorf(codes.Internal, "invalid interchain account key %s", key)
+		}
+
+		if req.ConnectionId != "" && req.ConnectionId != keySplit[1] {
+			return false, nil
+		}
+
+		if accumulate {
+			interchainAccounts = append(interchainAccounts, types.RegisteredInterchainAccount{
+				ConnectionId:   keySplit[1],
+				PortId:         keySplit[0],
+				AccountAddress: string(value),
+			})
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryInterchainAccountsResponse{
+		InterchainAccounts: interchainAccounts,
+		Pagination:         pageRes,
+	}, nil
+}
+
+// ActiveChannels implements the Query/ActiveChannels gRPC method
+func (q Keeper) ActiveChannels(c context.Context, req *types.QueryActiveChannelsRequest) (*types.QueryActiveChannelsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConnectionId != "" {
+		if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	activeChannels := []types.ActiveChannel{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), []byte(icatypes.ActiveChannelKeyPrefix+"/"))
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		// keys are of the format {portID}/{connectionID}
+		keySplit := strings.Split(string(key), "/")
+		if len(keySplit) != 2 {
+			return false, status.Errorf(codes.Internal, "invalid active channel key %s", key)
+		}
+
+		if req.ConnectionId != "" && req.ConnectionId != keySplit[1] {
+			return false, nil
+		}
+
+		if accumulate {
+			activeChannels = append(activeChannels, types.ActiveChannel{
+				ConnectionId: keySplit[1],
+				PortId:       keySplit[0],
+				ChannelId:    string(value),
+			})
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryActiveChannelsResponse{
+		ActiveChannels: activeChannels,
+		Pagination:     pageRes,
+	}, nil
+}
@@ -2,8 +2,10 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
 
 func (suite *KeeperTestSuite) TestQueryParams() {
@@ -12,3 +14,181 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	res, _ := suite.chainA.GetSimApp().ICAHostKeeper.Params(ctx, &types.QueryParamsRequest{})
 	suite.Require().Equal(&expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestQueryInterchainAccounts() {
+	var (
+		req         *types.QueryInterchainAccountsRequest
+		expAccounts []types.RegisteredInterchainAccount
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success with connection filter",
+			func() {
+				req.ConnectionId = ibctesting.FirstConnectionID
+			},
+			true,
+		},
+		{
+			"success with pagination",
+			func() {
+				req.Pagination = &query.PageRequest{Limit: 1, CountTotal: true}
+				expAccounts = expAccounts[:1]
+			},
+			true,
+		},
+		{
+			"success with unknown connection",
+			func() {
+				req.ConnectionId = "connection-100"
+				expAccounts = []types.RegisteredInterchainAccount{}
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid connection identifier",
+			func() {
+				req.ConnectionId = "invalid connection"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			address, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			// register an additional account on a different connection
+			suite.chainB.GetSimApp().ICAHostKeeper.SetInterchainAccountAddress(suite.chainB.GetContext(), "connection-1", "icacontroller-test", TestOwnerAddress)
+
+			expAccounts = []types.RegisteredInterchainAccount{
+				{ConnectionId: path.EndpointB.ConnectionID, PortId: path.EndpointA.ChannelConfig.PortID, AccountAddress: address},
+				{ConnectionId: "connection-1", PortId: "icacontroller-test", AccountAddress: TestOwnerAddress},
+			}
+
+			req = &types.QueryInterchainAccountsRequest{}
+
+			tc.malleate()
+
+			if req != nil && req.ConnectionId == ibctesting.FirstConnectionID {
+				expAccounts = expAccounts[:1]
+			}
+
+			res, err := suite.chainB.GetSimApp().ICAHostKeeper.InterchainAccounts(sdk.WrapSDKContext(suite.chainB.GetContext()), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expAccounts, res.InterchainAccounts)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryActiveChannels() {
+	var (
+		req         *types.QueryActiveChannelsRequest
+		expChannels []types.ActiveChannel
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success with connection filter",
+			func() {
+				req.ConnectionId = "connection-1"
+				expChannels = expChannels[1:]
+			},
+			true,
+		},
+		{
+			"success with pagination",
+			func() {
+				req.Pagination = &query.PageRequest{Limit: 1, CountTotal: true}
+				expChannels = expChannels[:1]
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid connection identifier",
+			func() {
+				req.ConnectionId = "invalid connection"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			// set an additional active channel on a different connection
+			suite.chainB.GetSimApp().ICAHostKeeper.SetActiveChannelID(suite.chainB.GetContext(), "connection-1", "icacontroller-test", "channel-100")
+
+			expChannels = []types.ActiveChannel{
+				{ConnectionId: path.EndpointB.ConnectionID, PortId: path.EndpointA.ChannelConfig.PortID, ChannelId: path.EndpointB.ChannelID},
+				{ConnectionId: "connection-1", PortId: "icacontroller-test", ChannelId: "channel-100"},
+			}
+
+			req = &types.QueryActiveChannelsRequest{}
+
+			tc.malleate()
+
+			res, err := suite.chainB.GetSimApp().ICAHostKeeper.ActiveChannels(sdk.WrapSDKContext(suite.chainB.GetContext()), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expChannels, res.ActiveChannels)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
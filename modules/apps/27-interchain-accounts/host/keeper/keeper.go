@@ -27,6 +27,7 @@ type Keeper struct {
 	channelKeeper icatypes.ChannelKeeper
 	portKeeper    icatypes.PortKeeper
 	accountKeeper icatypes.AccountKeeper
+	bankKeeper    icatypes.BankKeeper
 
 	scopedKeeper capabilitykeeper.ScopedKeeper
 
@@ -37,7 +38,8 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
 	channelKeeper icatypes.ChannelKeeper, portKeeper icatypes.PortKeeper,
-	accountKeeper icatypes.AccountKeeper, scopedKeeper capabilitykeeper.ScopedKeeper, msgRouter *baseapp.MsgServiceRouter,
+	accountKeeper icatypes.AccountKeeper, bankKeeper icatypes.BankKeeper, scopedKeeper capabilitykeeper.ScopedKeeper,
+	msgRouter *baseapp.MsgServiceRouter,
 ) Keeper {
 	// ensure ibc interchain accounts module account is set
 	if addr := accountKeeper.GetModuleAddress(icatypes.ModuleName); addr == nil {
@@ -56,6 +58,7 @@ func NewKeeper(
 		channelKeeper: channelKeeper,
 		portKeeper:    portKeeper,
 		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
 		scopedKeeper:  scopedKeeper,
 		msgRouter:     msgRouter,
 	}
@@ -21,9 +21,24 @@ func (k Keeper) GetAllowMessages(ctx sdk.Context) []string {
 	return res
 }
 
+// GetMaxGasPerPacket retrieves the maximum gas which may be consumed executing a single interchain accounts packet
+// from the paramstore. Zero is returned if no limit is set.
+func (k Keeper) GetMaxGasPerPacket(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.GetIfExists(ctx, types.KeyMaxGasPerPacket, &res)
+	return res
+}
+
+// GetGasPrices retrieves the gas prices charged to interchain accounts for executing transactions from the paramstore
+func (k Keeper) GetGasPrices(ctx sdk.Context) sdk.DecCoins {
+	var res sdk.DecCoins
+	k.paramSpace.GetIfExists(ctx, types.KeyGasPrices, &res)
+	return res
+}
+
 // GetParams returns the total set of the host submodule parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(k.IsHostEnabled(ctx), k.GetAllowMessages(ctx))
+	return types.NewParamsWithGasConfig(k.IsHostEnabled(ctx), k.GetAllowMessages(ctx), k.GetMaxGasPerPacket(ctx), k.GetGasPrices(ctx))
 }
 
 // SetParams sets the total set of the host submodule parameters.
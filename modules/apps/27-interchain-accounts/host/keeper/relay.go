@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/gogo/protobuf/proto"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
@@ -42,7 +43,11 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byt
 // If authentication succeeds, it does basic validation of the messages before attempting to deliver each message
 // into state. The state changes will only be committed if all messages in the transaction succeed. Thus the
 // execution of the transaction is atomic, all state changes are reverted if a single message fails.
-func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel string, msgs []sdk.Msg) ([]byte, error) {
+//
+// Messages are executed using a child gas meter limited by the MaxGasPerPacket host param. Running out of gas
+// results in a deterministic out of gas error, whilst the gas consumed is always charged to the gas meter of the
+// provided context. The fee for the execution is charged beforehand by ChargeFee.
+func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel string, msgs []sdk.Msg) (txResponse []byte, err error) {
 	channel, found := k.channelKeeper.GetChannel(ctx, destPort, destChannel)
 	if !found {
 		return nil, channeltypes.ErrChannelNotFound
@@ -56,9 +61,30 @@ func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel str
 		Data: make([]*sdk.MsgData, len(msgs)),
 	}
 
+	maxGas := k.GetMaxGasPerPacket(ctx)
+	gasMeter := sdk.NewInfiniteGasMeter()
+	if maxGas != 0 {
+		gasMeter = sdk.NewGasMeter(maxGas)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+
+			txResponse, err = nil, sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %s; max gas per packet: %d", outOfGas.Descriptor, maxGas)
+		}
+
+		// charge the gas consumed by the child gas meter to the gas meter of the provided context
+		ctx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "interchain account host tx execution")
+	}()
+
 	// CacheContext returns a new context with the multi-store branched into a cached storage object
 	// writeCache is called only if all msgs succeed, performing state transitions atomically
 	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter)
 	for i, msg := range msgs {
 		if err := msg.ValidateBasic(); err != nil {
 			return nil, err
@@ -80,7 +106,7 @@ func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel str
 	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
 	writeCache()
 
-	txResponse, err := proto.Marshal(txMsgData)
+	txResponse, err = proto.Marshal(txMsgData)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "failed to marshal tx data")
 	}
@@ -88,6 +114,56 @@ func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel str
 	return txResponse, nil
 }
 
+// ChargeFee charges the fee for executing the provided packet to the interchain account it is sent to. The fee is
+// the MaxGasPerPacket host param multiplied by the GasPrices host param, rounded up, and is sent to the fee collector.
+// Like the fee of a transaction it is charged on the gas limit before execution, and core IBC keeps it when the
+// execution fails. No fee is charged if GasPrices is empty or if the packet is not sent to an interchain account.
+func (k Keeper) ChargeFee(ctx sdk.Context, packet channeltypes.Packet) error {
+	gasPrices := k.GetGasPrices(ctx)
+	if gasPrices.IsZero() {
+		return nil
+	}
+
+	maxGas := k.GetMaxGasPerPacket(ctx)
+	if maxGas == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInsufficientFee, "cannot charge interchain account fee without a max gas per packet")
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, packet.DestinationPort, packet.DestinationChannel)
+	if !found {
+		return channeltypes.ErrChannelNotFound
+	}
+
+	// packets which are not sent to an interchain account fail during execution without being charged
+	interchainAccountAddr, found := k.GetInterchainAccountAddress(ctx, channel.ConnectionHops[0], packet.SourcePort)
+	if !found {
+		return nil
+	}
+
+	gas := sdk.NewDecFromInt(sdk.NewIntFromUint64(maxGas))
+	fees := make([]sdk.Coin, len(gasPrices))
+	for i, gasPrice := range gasPrices {
+		fees[i] = sdk.NewCoin(gasPrice.Denom, gasPrice.Amount.Mul(gas).Ceil().RoundInt())
+	}
+
+	// NewCoins removes any zero value fees
+	feeCoins := sdk.NewCoins(fees...)
+	if feeCoins.IsZero() {
+		return nil
+	}
+
+	accAddress, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+	if err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, accAddress, authtypes.FeeCollectorName, feeCoins); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "failed to charge interchain account fee of %s: %s", feeCoins, err)
+	}
+
+	return nil
+}
+
 // authenticateTx ensures the provided msgs contain the correct interchain account signer address retrieved
 // from state using the provided controller port identifier
 func (k Keeper) authenticateTx(ctx sdk.Context, msgs []sdk.Msg, connectionID, portID string) error {
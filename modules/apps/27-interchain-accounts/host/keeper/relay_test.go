@@ -5,6 +5,8 @@ import (
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -476,6 +478,226 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestOnRecvPacketGasLimit() {
+	var (
+		path   *ibctesting.Path
+		params types.Params
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: no gas limit",
+			func() {},
+			nil,
+		},
+		{
+			"success: gas limit not exceeded",
+			func() {
+				params.MaxGasPerPacket = 1_000_000
+			},
+			nil,
+		},
+		{
+			"failure: gas limit exceeded",
+			func() {
+				params.MaxGasPerPacket = 1000
+			},
+			sdkerrors.ErrOutOfGas,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			msg := &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			params = types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+
+			tc.malleate() // malleate mutates test data
+
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			ctx := suite.chainB.GetContext()
+			gasBefore := ctx.GasMeter().GasConsumed()
+
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(txResponse)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(txResponse)
+			}
+
+			// gas consumed executing the tx is always charged to the provided context
+			gasUsed := ctx.GasMeter().GasConsumed() - gasBefore
+			suite.Require().NotZero(gasUsed)
+			if params.MaxGasPerPacket != 0 && tc.expErr != nil {
+				suite.Require().GreaterOrEqual(gasUsed, params.MaxGasPerPacket)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestChargeFee() {
+	var (
+		path   *ibctesting.Path
+		packet channeltypes.Packet
+		params types.Params
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expFee   sdk.Int
+		expErr   error
+	}{
+		{
+			"success: fee is charged on the gas limit",
+			func() {},
+			sdk.NewInt(1000),
+			nil,
+		},
+		{
+			"success: no gas prices",
+			func() {
+				params.GasPrices = nil
+			},
+			sdk.ZeroInt(),
+			nil,
+		},
+		{
+			"success: packet is not sent to an interchain account",
+			func() {
+				packet.SourcePort = "invalid-port"
+			},
+			sdk.ZeroInt(),
+			nil,
+		},
+		{
+			"failure: no gas limit",
+			func() {
+				params.MaxGasPerPacket = 0
+			},
+			sdk.ZeroInt(),
+			sdkerrors.ErrInsufficientFee,
+		},
+		{
+			"failure: interchain account cannot pay the fee",
+			func() {
+				params.GasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(1)))
+			},
+			sdk.ZeroInt(),
+			sdkerrors.ErrInsufficientFee,
+		},
+		{
+			"failure: channel not found",
+			func() {
+				packet.DestinationChannel = "channel-100"
+			},
+			sdk.ZeroInt(),
+			channeltypes.ErrChannelNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			params = types.NewParamsWithGasConfig(true, []string{"*"}, 1_000_000, sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(1, 3))))
+
+			packet = channeltypes.NewPacket(
+				[]byte("data"),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			tc.malleate() // malleate mutates test data
+
+			ctx := suite.chainB.GetContext()
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(ctx, params)
+
+			icaAddr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+			suite.Require().NoError(err)
+
+			balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, icaAddr, sdk.DefaultBondDenom)
+			feeCollectorAddr := suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+			feeCollectorBalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, feeCollectorAddr, sdk.DefaultBondDenom)
+
+			err = suite.chainB.GetSimApp().ICAHostKeeper.ChargeFee(ctx, packet)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+
+			suite.Require().Equal(balance.SubAmount(tc.expFee), suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, icaAddr, sdk.DefaultBondDenom))
+			suite.Require().Equal(feeCollectorBalance.AddAmount(tc.expFee), suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, feeCollectorAddr, sdk.DefaultBondDenom))
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) fundICAWallet(ctx sdk.Context, portID string, amount sdk.Coins) {
 	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(ctx, ibctesting.FirstConnectionID, portID)
 	suite.Require().True(found)
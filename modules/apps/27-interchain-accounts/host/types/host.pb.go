@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -30,6 +32,12 @@ type Params struct {
 	HostEnabled bool `protobuf:"varint,1,opt,name=host_enabled,json=hostEnabled,proto3" json:"host_enabled,omitempty" yaml:"host_enabled"`
 	// allow_messages defines a list of sdk message typeURLs allowed to be executed on a host chain.
 	AllowMessages []string `protobuf:"bytes,2,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty" yaml:"allow_messages"`
+	// max_gas_per_packet defines the maximum amount of gas which may be consumed executing the messages of a single
+	// interchain accounts packet. A value of zero disables the limit.
+	MaxGasPerPacket uint64 `protobuf:"varint,3,opt,name=max_gas_per_packet,json=maxGasPerPacket,proto3" json:"max_gas_per_packet,omitempty" yaml:"max_gas_per_packet"`
+	// gas_prices defines the fee per unit of gas which an interchain account pays to the fee collector for the gas
+	// consumed executing its messages. An empty list disables the fee.
+	GasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=gas_prices,json=gasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"gas_prices" yaml:"gas_prices"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -79,6 +87,20 @@ func (m *Params) GetAllowMessages() []string {
 	return nil
 }
 
+func (m *Params) GetMaxGasPerPacket() uint64 {
+	if m != nil {
+		return m.MaxGasPerPacket
+	}
+	return 0
+}
+
+func (m *Params) GetGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.GasPrices
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ibc.applications.interchain_accounts.host.v1.Params")
 }
@@ -88,26 +110,34 @@ func init() {
 }
 
 var fileDescriptor_48e202774f13d08e = []byte{
-	// 290 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xcf, 0x4c, 0x4a, 0xd6,
-	0x4f, 0x2c, 0x28, 0xc8, 0xc9, 0x4c, 0x4e, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0xd6, 0xcf, 0xcc, 0x2b,
-	0x49, 0x2d, 0x4a, 0xce, 0x48, 0xcc, 0xcc, 0x8b, 0x4f, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x29,
-	0xd6, 0xcf, 0xc8, 0x2f, 0x2e, 0xd1, 0x2f, 0x33, 0x04, 0xd3, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9,
-	0x42, 0x3a, 0x99, 0x49, 0xc9, 0x7a, 0xc8, 0x1a, 0xf5, 0xb0, 0x68, 0xd4, 0x03, 0x6b, 0x28, 0x33,
-	0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x6b, 0xd4, 0x07, 0xb1, 0x20, 0x66, 0x28, 0xb5, 0x31,
-	0x72, 0xb1, 0x05, 0x24, 0x16, 0x25, 0xe6, 0x16, 0x0b, 0x59, 0x71, 0xf1, 0x80, 0xd4, 0xc6, 0xa7,
-	0xe6, 0x25, 0x26, 0xe5, 0xa4, 0xa6, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x38, 0x89, 0x7f, 0xba,
-	0x27, 0x2f, 0x5c, 0x99, 0x98, 0x9b, 0x63, 0xa5, 0x84, 0x2c, 0xab, 0x14, 0xc4, 0x0d, 0xe2, 0xba,
-	0x42, 0x78, 0x42, 0x0e, 0x5c, 0x7c, 0x89, 0x39, 0x39, 0xf9, 0xe5, 0xf1, 0xb9, 0xa9, 0xc5, 0xc5,
-	0x89, 0xe9, 0xa9, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0x9c, 0x4e, 0x92, 0x9f, 0xee, 0xc9, 0x8b,
-	0x42, 0x74, 0xa3, 0xca, 0x2b, 0x05, 0xf1, 0x82, 0x05, 0x7c, 0xa1, 0x7c, 0xa7, 0x94, 0x13, 0x8f,
-	0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b,
-	0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xf2, 0x4a, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2,
-	0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0xce, 0x2f, 0xce, 0xcd, 0x2f, 0xd6, 0xcf, 0x4c, 0x4a, 0xd6, 0x4d,
-	0xcf, 0xd7, 0x2f, 0x33, 0xd6, 0xcf, 0xcd, 0x4f, 0x29, 0xcd, 0x49, 0x2d, 0x06, 0x85, 0x5f, 0xb1,
-	0xbe, 0x91, 0xb9, 0x2e, 0x22, 0x04, 0x74, 0x51, 0x83, 0xae, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89,
-	0x0d, 0xec, 0x6b, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf0, 0xc9, 0x05, 0x61, 0x74, 0x01,
-	0x00, 0x00,
+	// 425 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcd, 0x6a, 0xdb, 0x40,
+	0x10, 0xc7, 0xad, 0x38, 0x84, 0x46, 0xe9, 0x07, 0x55, 0x5b, 0xea, 0x84, 0x56, 0x32, 0x3a, 0x19,
+	0x5a, 0xef, 0xe2, 0xe4, 0x10, 0xc8, 0xa9, 0xa8, 0x2d, 0x2d, 0x81, 0x82, 0xf1, 0xb1, 0x17, 0x31,
+	0xbb, 0x5e, 0xe4, 0x25, 0x5a, 0xad, 0xd0, 0xac, 0xd5, 0xe4, 0x01, 0x7a, 0xef, 0x73, 0xf4, 0x49,
+	0x72, 0xcc, 0xb1, 0xf4, 0xa0, 0x16, 0xfb, 0x0d, 0xf4, 0x04, 0x45, 0xbb, 0x2e, 0x49, 0x48, 0x4e,
+	0xbb, 0xf3, 0xf1, 0xfb, 0x0f, 0xfc, 0x67, 0xfc, 0x63, 0xc9, 0x38, 0x85, 0xb2, 0xcc, 0x25, 0x07,
+	0x23, 0x75, 0x81, 0x54, 0x16, 0x46, 0x54, 0x7c, 0x01, 0xb2, 0x48, 0x81, 0x73, 0xbd, 0x2c, 0x0c,
+	0xd2, 0x85, 0x46, 0x43, 0xeb, 0x89, 0x7d, 0x49, 0x59, 0x69, 0xa3, 0x83, 0xb7, 0x92, 0x71, 0x72,
+	0x13, 0x24, 0xf7, 0x80, 0xc4, 0x02, 0xf5, 0xe4, 0xe0, 0x79, 0xa6, 0x33, 0x6d, 0x41, 0xda, 0xfd,
+	0x9c, 0xc6, 0x41, 0xc8, 0x35, 0x2a, 0x8d, 0x94, 0x01, 0x0a, 0x5a, 0x4f, 0x98, 0x30, 0x30, 0xa1,
+	0x5c, 0xcb, 0xc2, 0xd5, 0xe3, 0xdf, 0x5b, 0xfe, 0xce, 0x14, 0x2a, 0x50, 0x18, 0x9c, 0xf8, 0x0f,
+	0x3b, 0xad, 0x54, 0x14, 0xc0, 0x72, 0x31, 0x1f, 0x78, 0x43, 0x6f, 0xf4, 0x20, 0x79, 0xd9, 0x36,
+	0xd1, 0xb3, 0x0b, 0x50, 0xf9, 0x49, 0x7c, 0xb3, 0x1a, 0xcf, 0xf6, 0xba, 0xf0, 0xa3, 0x8b, 0x82,
+	0x77, 0xfe, 0x63, 0xc8, 0x73, 0xfd, 0x2d, 0x55, 0x02, 0x11, 0x32, 0x81, 0x83, 0xad, 0x61, 0x7f,
+	0xb4, 0x9b, 0xec, 0xb7, 0x4d, 0xf4, 0xc2, 0xd1, 0xb7, 0xeb, 0xf1, 0xec, 0x91, 0x4d, 0x7c, 0xd9,
+	0xc4, 0xc1, 0xa9, 0x1f, 0x28, 0x38, 0x4f, 0x33, 0xc0, 0xb4, 0x14, 0x55, 0x5a, 0x02, 0x3f, 0x13,
+	0x66, 0xd0, 0x1f, 0x7a, 0xa3, 0xed, 0xe4, 0x75, 0xdb, 0x44, 0xfb, 0x4e, 0xe5, 0x6e, 0x4f, 0x3c,
+	0x7b, 0xa2, 0xe0, 0xfc, 0x13, 0xe0, 0x54, 0x54, 0x53, 0x9b, 0x09, 0xbe, 0x7b, 0xbe, 0x6f, 0x9b,
+	0x2a, 0xc9, 0x05, 0x0e, 0xb6, 0x87, 0xfd, 0xd1, 0xde, 0xe1, 0x2b, 0xe2, 0xac, 0x20, 0x9d, 0x15,
+	0x64, 0x63, 0x05, 0xf9, 0x20, 0xf8, 0x7b, 0x2d, 0x8b, 0xe4, 0xf3, 0x65, 0x13, 0xf5, 0xda, 0x26,
+	0x7a, 0xea, 0xc6, 0x5c, 0xd3, 0xf1, 0xcf, 0x3f, 0xd1, 0x9b, 0x4c, 0x9a, 0xc5, 0x92, 0x11, 0xae,
+	0x15, 0xdd, 0xf8, 0xe9, 0x9e, 0x31, 0xce, 0xcf, 0xa8, 0xb9, 0x28, 0x05, 0xfe, 0x17, 0xc2, 0xd9,
+	0x6e, 0x06, 0x38, 0xb5, 0x68, 0x32, 0xbf, 0x5c, 0x85, 0xde, 0xd5, 0x2a, 0xf4, 0xfe, 0xae, 0x42,
+	0xef, 0xc7, 0x3a, 0xec, 0x5d, 0xad, 0xc3, 0xde, 0xaf, 0x75, 0xd8, 0xfb, 0x7a, 0x7a, 0x57, 0x51,
+	0x32, 0x3e, 0xce, 0x34, 0xad, 0x8f, 0xa8, 0xd2, 0xf3, 0x65, 0x2e, 0xb0, 0xbb, 0x19, 0xa4, 0x87,
+	0xc7, 0xe3, 0xeb, 0xad, 0x8f, 0x6f, 0x9f, 0x8b, 0x9d, 0xcc, 0x76, 0xec, 0x26, 0x8f, 0xfe, 0x0d,
+	0x00, 0x18, 0xc3, 0x81, 0x29, 0x68, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -130,6 +160,25 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GasPrices) > 0 {
+		for iNdEx := len(m.GasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MaxGasPerPacket != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.MaxGasPerPacket))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.AllowMessages) > 0 {
 		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowMessages[iNdEx])
@@ -178,6 +227,15 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovHost(uint64(l))
 		}
 	}
+	if m.MaxGasPerPacket != 0 {
+		n += 1 + sovHost(uint64(m.MaxGasPerPacket))
+	}
+	if len(m.GasPrices) > 0 {
+		for _, e := range m.GasPrices {
+			l = e.Size()
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -268,6 +326,59 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerPacket", wireType)
+			}
+			m.MaxGasPerPacket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerPacket |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasPrices = append(m.GasPrices, types.DecCoin{})
+			if err := m.GasPrices[len(m.GasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHost(dAtA[iNdEx:])
@@ -4,12 +4,16 @@ import (
 	"fmt"
 	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 const (
 	// DefaultHostEnabled is the default value for the host param (set to true)
 	DefaultHostEnabled = true
+
+	// DefaultMaxGasPerPacket is the default value for the max gas per packet param (set to 0, no limit)
+	DefaultMaxGasPerPacket = 0
 )
 
 var (
@@ -17,6 +21,10 @@ var (
 	KeyHostEnabled = []byte("HostEnabled")
 	// KeyAllowMessages is the store key for the AllowMessages Params
 	KeyAllowMessages = []byte("AllowMessages")
+	// KeyMaxGasPerPacket is the store key for the MaxGasPerPacket Params
+	KeyMaxGasPerPacket = []byte("MaxGasPerPacket")
+	// KeyGasPrices is the store key for the GasPrices Params
+	KeyGasPrices = []byte("GasPrices")
 )
 
 // ParamKeyTable type declaration for parameters
@@ -27,11 +35,22 @@ func ParamKeyTable() paramtypes.KeyTable {
 // NewParams creates a new parameter configuration for the host submodule
 func NewParams(enableHost bool, allowMsgs []string) Params {
 	return Params{
-		HostEnabled:   enableHost,
-		AllowMessages: allowMsgs,
+		HostEnabled:     enableHost,
+		AllowMessages:   allowMsgs,
+		MaxGasPerPacket: DefaultMaxGasPerPacket,
 	}
 }
 
+// NewParamsWithGasConfig creates a new parameter configuration for the host submodule,
+// including the gas limit and gas prices applied when executing interchain account transactions
+func NewParamsWithGasConfig(enableHost bool, allowMsgs []string, maxGasPerPacket uint64, gasPrices sdk.DecCoins) Params {
+	params := NewParams(enableHost, allowMsgs)
+	params.MaxGasPerPacket = maxGasPerPacket
+	params.GasPrices = gasPrices
+
+	return params
+}
+
 // DefaultParams is the default parameter configuration for the host submodule
 func DefaultParams() Params {
 	return NewParams(DefaultHostEnabled, nil)
@@ -47,6 +66,18 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateMaxGasPerPacket(p.MaxGasPerPacket); err != nil {
+		return err
+	}
+
+	if err := validateGasPrices(p.GasPrices); err != nil {
+		return err
+	}
+
+	if !p.GasPrices.IsZero() && p.MaxGasPerPacket == 0 {
+		return fmt.Errorf("max gas per packet must be set to charge gas prices %s", p.GasPrices)
+	}
+
 	return nil
 }
 
@@ -55,6 +86,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyHostEnabled, p.HostEnabled, validateEnabled),
 		paramtypes.NewParamSetPair(KeyAllowMessages, p.AllowMessages, validateAllowlist),
+		paramtypes.NewParamSetPair(KeyMaxGasPerPacket, p.MaxGasPerPacket, validateMaxGasPerPacket),
+		paramtypes.NewParamSetPair(KeyGasPrices, p.GasPrices, validateGasPrices),
 	}
 }
 
@@ -81,3 +114,25 @@ func validateAllowlist(i interface{}) error {
 
 	return nil
 }
+
+func validateMaxGasPerPacket(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateGasPrices(i interface{}) error {
+	gasPrices, ok := i.(sdk.DecCoins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := gasPrices.Validate(); err != nil {
+		return fmt.Errorf("invalid gas prices %s: %w", gasPrices, err)
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
@@ -11,4 +12,7 @@ import (
 func TestValidateParams(t *testing.T) {
 	require.NoError(t, types.DefaultParams().Validate())
 	require.NoError(t, types.NewParams(false, []string{}).Validate())
+	require.NoError(t, types.NewParamsWithGasConfig(true, []string{"*"}, 1_000_000, sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(25, 3)))).Validate())
+	require.Error(t, types.NewParamsWithGasConfig(true, []string{"*"}, 0, sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(25, 3)))).Validate())
+	require.Error(t, types.NewParamsWithGasConfig(true, []string{"*"}, 0, sdk.DecCoins{sdk.DecCoin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(-1)}}).Validate())
 }
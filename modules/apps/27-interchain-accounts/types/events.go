@@ -7,4 +7,5 @@ const (
 	AttributeKeyAckError      = "error"
 	AttributeKeyHostChannelID = "host_channel_id"
 	AttributeKeyAckSuccess    = "success"
+	AttributeKeyGasUsed       = "gas_used"
 )
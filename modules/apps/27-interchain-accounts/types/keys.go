@@ -14,6 +14,9 @@ const (
 	// PortPrefix is the default port prefix that the interchain accounts controller submodule binds to
 	PortPrefix = "icacontroller-"
 
+	// AccountLabelSeparator is the separator placed between the owner and the account label of a labelled controller port
+	AccountLabelSeparator = "."
+
 	// Version defines the current version for interchain accounts
 	Version = "ics27-1"
 
@@ -1,9 +1,19 @@
 package types
 
 import (
+	"encoding/json"
+	"strings"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+var (
+	_ ibcexported.PacketData         = (*InterchainAccountPacketData)(nil)
+	_ ibcexported.PacketDataProvider = (*InterchainAccountPacketData)(nil)
 )
 
 // MaxMemoCharLength defines the maximum length for the InterchainAccountPacketData memo field
@@ -32,6 +42,38 @@ func (iapd InterchainAccountPacketData) GetBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&iapd))
 }
 
+// GetPacketSender returns the owner of the interchain account, which is encoded in the
+// controller port identifier. An empty string is returned if the source port is not a
+// controller port.
+func (iapd InterchainAccountPacketData) GetPacketSender(sourcePortID string) string {
+	if !strings.HasPrefix(sourcePortID, PortPrefix) {
+		return ""
+	}
+
+	owner := strings.TrimPrefix(sourcePortID, PortPrefix)
+	if i := strings.Index(owner, AccountLabelSeparator); i >= 0 {
+		owner = owner[:i]
+	}
+
+	return owner
+}
+
+// GetCustomPacketData interprets the memo field of the packet data as a JSON object
+// and returns the value held under the provided key. Nil is returned if the memo is
+// not a JSON object or the key is not present.
+func (iapd InterchainAccountPacketData) GetCustomPacketData(key string) interface{} {
+	if len(iapd.Memo) == 0 {
+		return nil
+	}
+
+	jsonObject := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(iapd.Memo), &jsonObject); err != nil {
+		return nil
+	}
+
+	return jsonObject[key]
+}
+
 // GetBytes returns the JSON marshalled interchain account CosmosTx.
 func (ct CosmosTx) GetBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&ct))
@@ -82,3 +82,46 @@ func (suite *TypesTestSuite) TestValidateBasic() {
 		})
 	}
 }
+
+func (suite *TypesTestSuite) TestGetPacketSender() {
+	testCases := []struct {
+		name      string
+		portID    string
+		expSender string
+	}{
+		{"controller port", types.PortPrefix + "owner", "owner"},
+		{"labelled controller port", types.PortPrefix + "owner" + types.AccountLabelSeparator + "label", "owner"},
+		{"host port", types.PortID, ""},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			packetData := types.InterchainAccountPacketData{}
+			suite.Require().Equal(tc.expSender, packetData.GetPacketSender(tc.portID))
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestGetCustomPacketData() {
+	testCases := []struct {
+		name    string
+		memo    string
+		expData interface{}
+	}{
+		{"memo with key", `{"callback": {"address": "addr"}}`, map[string]interface{}{"address": "addr"}},
+		{"memo without key", `{"forward": {"receiver": "addr"}}`, nil},
+		{"memo is not a JSON object", "memo", nil},
+		{"empty memo", "", nil},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			packetData := types.InterchainAccountPacketData{Memo: tc.memo}
+			suite.Require().Equal(tc.expData, packetData.GetCustomPacketData("callback"))
+		})
+	}
+}
@@ -2,16 +2,73 @@ package types
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
-// NewControllerPortID creates and returns a new prefixed controller port identifier using the provided owner string
+// DefaultMaxAccountLabelLength defines the default maximum character length of an interchain account label
+const DefaultMaxAccountLabelLength = 32
+
+// isValidAccountLabel defines a regular expression to check if the provided string consists of
+// strictly alphanumeric characters, underscores or dashes and is non empty.
+var isValidAccountLabel = regexp.MustCompile("^[a-zA-Z0-9_-]+$").MatchString
+
+// NewControllerPortID creates and returns a new prefixed controller port identifier using the provided owner string.
+// The owner must not contain the AccountLabelSeparator, such that the owner and account label can be unambiguously
+// recovered from a labelled controller port identifier.
 func NewControllerPortID(owner string) (string, error) {
 	if strings.TrimSpace(owner) == "" {
 		return "", sdkerrors.Wrap(ErrInvalidAccountAddress, "owner address cannot be empty")
 	}
 
+	if strings.Contains(owner, AccountLabelSeparator) {
+		return "", sdkerrors.Wrapf(ErrInvalidAccountAddress, "owner address cannot contain the account label separator %s", AccountLabelSeparator)
+	}
+
 	return fmt.Sprint(PortPrefix, owner), nil
 }
+
+// NewControllerPortIDWithLabel creates and returns a new prefixed controller port identifier using the provided owner string
+// and account label, in the format icacontroller-{owner}.{label}. Distinct labels yield distinct controller ports, allowing
+// a single owner to register multiple interchain accounts on the same connection. An empty label returns the default
+// controller port identifier for the owner, as created by NewControllerPortID.
+func NewControllerPortIDWithLabel(owner, label string) (string, error) {
+	portID, err := NewControllerPortID(owner)
+	if err != nil {
+		return "", err
+	}
+
+	if label == "" {
+		return portID, nil
+	}
+
+	if err := ValidateAccountLabel(label); err != nil {
+		return "", err
+	}
+
+	portID = fmt.Sprint(portID, AccountLabelSeparator, label)
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return "", sdkerrors.Wrapf(ErrInvalidAccountLabel, "controller port identifier %s is invalid: %s", portID, err)
+	}
+
+	return portID, nil
+}
+
+// ValidateAccountLabel performs basic validation of interchain account labels, enforcing the following:
+// - must be non-empty
+// - must consist only of alphanumeric characters, underscores or dashes
+// - must not exceed a maximum length of DefaultMaxAccountLabelLength
+func ValidateAccountLabel(label string) error {
+	if !isValidAccountLabel(label) || len(label) > DefaultMaxAccountLabelLength {
+		return sdkerrors.Wrapf(
+			ErrInvalidAccountLabel,
+			"account label must contain only alphanumeric characters, underscores or dashes and be non-empty, with a maximum of %d characters", DefaultMaxAccountLabelLength,
+		)
+	}
+
+	return nil
+}
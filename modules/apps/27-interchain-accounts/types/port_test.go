@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
@@ -33,6 +34,14 @@ func (suite *TypesTestSuite) TestNewControllerPortID() {
 			"",
 			false,
 		},
+		{
+			"invalid owner address, contains separator",
+			func() {
+				owner = "owner.staking"
+			},
+			"",
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -57,3 +66,94 @@ func (suite *TypesTestSuite) TestNewControllerPortID() {
 		})
 	}
 }
+
+func (suite *TypesTestSuite) TestNewControllerPortIDWithLabel() {
+	var (
+		owner string
+		label string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expValue string
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			fmt.Sprint(types.PortPrefix, TestOwnerAddress, types.AccountLabelSeparator, "staking"),
+			true,
+		},
+		{
+			"success with empty label",
+			func() {
+				label = ""
+			},
+			fmt.Sprint(types.PortPrefix, TestOwnerAddress),
+			true,
+		},
+		{
+			"invalid owner address",
+			func() {
+				owner = "    "
+			},
+			"",
+			false,
+		},
+		{
+			"invalid label, contains separator",
+			func() {
+				label = "staking.reserves"
+			},
+			"",
+			false,
+		},
+		{
+			"invalid label, exceeds max length",
+			func() {
+				label = strings.Repeat("a", types.DefaultMaxAccountLabelLength+1)
+			},
+			"",
+			false,
+		},
+		{
+			"invalid owner address, contains separator",
+			func() {
+				// would collide with the port of owner TestOwnerAddress and label "staking"
+				owner = fmt.Sprint(TestOwnerAddress, types.AccountLabelSeparator, "staking")
+				label = ""
+			},
+			"",
+			false,
+		},
+		{
+			"invalid port identifier, exceeds max length",
+			func() {
+				owner = strings.Repeat("a", 110)
+			},
+			"",
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			owner = TestOwnerAddress
+			label = "staking"
+
+			tc.malleate() // malleate mutates test data
+
+			portID, err := types.NewControllerPortIDWithLabel(owner, label)
+
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Equal(tc.expValue, portID)
+			} else {
+				suite.Require().Error(err, tc.name)
+				suite.Require().Empty(portID)
+			}
+		})
+	}
+}
@@ -0,0 +1,346 @@
+package callbacks
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/callbacks/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+var (
+	_ porttypes.Middleware            = IBCMiddleware{}
+	_ porttypes.PacketDataUnmarshaler = IBCMiddleware{}
+	_ porttypes.RecvPacketCharger     = IBCMiddleware{}
+)
+
+// IBCMiddleware implements the ICS26 and ICS4 interfaces for the callbacks middleware.
+// It notifies the callback handlers selected in the packet data of the lifecycle of
+// the packets sent and received by the underlying application.
+type IBCMiddleware struct {
+	app         porttypes.IBCModule
+	unmarshaler porttypes.PacketDataUnmarshaler
+	ics4Wrapper porttypes.ICS4Wrapper
+
+	router         *types.Router
+	maxCallbackGas uint64
+}
+
+// NewIBCMiddleware creates a new callbacks IBCMiddleware given the underlying application,
+// the ICS4Wrapper used to send packets and acknowledgements, the router of the callback
+// handlers and the maximum gas of a single callback execution. The underlying application
+// must implement the PacketDataUnmarshaler interface.
+func NewIBCMiddleware(
+	app porttypes.IBCModule,
+	ics4Wrapper porttypes.ICS4Wrapper,
+	router *types.Router,
+	maxCallbackGas uint64,
+) IBCMiddleware {
+	unmarshaler, ok := app.(porttypes.PacketDataUnmarshaler)
+	if !ok {
+		panic(fmt.Sprintf("underlying application does not implement %T", (*porttypes.PacketDataUnmarshaler)(nil)))
+	}
+
+	if maxCallbackGas == 0 {
+		panic("maximum callback gas cannot be zero")
+	}
+
+	return IBCMiddleware{
+		app:            app,
+		unmarshaler:    unmarshaler,
+		ics4Wrapper:    ics4Wrapper,
+		router:         router,
+		maxCallbackGas: maxCallbackGas,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) error {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (im IBCMiddleware) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (im IBCMiddleware) OnChanCloseInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (im IBCMiddleware) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCModule interface. The destination callback is
+// executed once the underlying application returns a synchronous acknowledgement.
+// For asynchronous acknowledgements the callback is executed in WriteAcknowledgement.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	ack := im.app.OnRecvPacket(ctx, packet, relayer)
+	if ack == nil {
+		return nil
+	}
+
+	callbackData, err := types.GetDestCallbackData(im.unmarshaler, packet, im.maxCallbackGas)
+	im.processCallback(ctx, types.CallbackTypeReceivePacket, packet, callbackData, err, func(cachedCtx sdk.Context, handler types.CallbackHandler) error {
+		return handler.IBCReceivePacketCallback(cachedCtx, packet, ack, callbackData.CallbackAddress)
+	})
+
+	return ack
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. The source callback is
+// executed after the underlying application successfully processed the acknowledgement.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	callbackData, err := types.GetSourceCallbackData(im.unmarshaler, packet, im.maxCallbackGas)
+	im.processCallback(ctx, types.CallbackTypeAcknowledgementPacket, packet, callbackData, err, func(cachedCtx sdk.Context, handler types.CallbackHandler) error {
+		return handler.IBCOnAcknowledgementPacketCallback(cachedCtx, packet, acknowledgement, relayer, callbackData.CallbackAddress, callbackData.SenderAddress)
+	})
+
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface. The source callback is executed
+// after the underlying application successfully processed the timeout.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	callbackData, err := types.GetSourceCallbackData(im.unmarshaler, packet, im.maxCallbackGas)
+	im.processCallback(ctx, types.CallbackTypeTimeoutPacket, packet, callbackData, err, func(cachedCtx sdk.Context, handler types.CallbackHandler) error {
+		return handler.IBCOnTimeoutPacketCallback(cachedCtx, packet, relayer, callbackData.CallbackAddress, callbackData.SenderAddress)
+	})
+
+	return nil
+}
+
+// SendPacket implements the ICS4Wrapper interface. The source callback is executed
+// after the packet has been sent.
+func (im IBCMiddleware) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet ibcexported.PacketI,
+) error {
+	if err := im.ics4Wrapper.SendPacket(ctx, chanCap, packet); err != nil {
+		return err
+	}
+
+	callbackData, err := types.GetSourceCallbackData(im.unmarshaler, packet, im.maxCallbackGas)
+	im.processCallback(ctx, types.CallbackTypeSendPacket, packet, callbackData, err, func(cachedCtx sdk.Context, handler types.CallbackHandler) error {
+		return handler.IBCSendPacketCallback(cachedCtx, packet, callbackData.CallbackAddress, callbackData.SenderAddress)
+	})
+
+	return nil
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface. The destination callback
+// is executed after an asynchronous acknowledgement has been written.
+func (im IBCMiddleware) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet ibcexported.PacketI,
+	ack ibcexported.Acknowledgement,
+) error {
+	if err := im.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack); err != nil {
+		return err
+	}
+
+	callbackData, err := types.GetDestCallbackData(im.unmarshaler, packet, im.maxCallbackGas)
+	im.processCallback(ctx, types.CallbackTypeReceivePacket, packet, callbackData, err, func(cachedCtx sdk.Context, handler types.CallbackHandler) error {
+		return handler.IBCReceivePacketCallback(cachedCtx, packet, ack, callbackData.CallbackAddress)
+	})
+
+	return nil
+}
+
+// ResolveRelativeTimeout implements the ICS4Wrapper interface
+func (im IBCMiddleware) ResolveRelativeTimeout(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) (clienttypes.Height, uint64, error) {
+	return im.ics4Wrapper.ResolveRelativeTimeout(ctx, portID, channelID, timeoutHeight, timeoutTimestamp)
+}
+
+// UnmarshalPacketData implements the PacketDataUnmarshaler interface by deferring
+// to the underlying application, allowing further middleware to wrap the stack.
+func (im IBCMiddleware) UnmarshalPacketData(bz []byte) (interface{}, error) {
+	return im.unmarshaler.UnmarshalPacketData(bz)
+}
+
+// ChargeRecvPacket implements the RecvPacketCharger interface by deferring to the
+// underlying application. Nothing is charged if the underlying application does not
+// implement the RecvPacketCharger interface.
+func (im IBCMiddleware) ChargeRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	charger, ok := im.app.(porttypes.RecvPacketCharger)
+	if !ok {
+		return nil
+	}
+
+	return charger.ChargeRecvPacket(ctx, packet, relayer)
+}
+
+// processCallback executes the callback of a packet, if any. Packets which do not
+// select a callback are ignored. The callback is executed on a cached context with
+// its own gas meter, limited by the callback gas limit and the gas remaining in the
+// transaction. The gas used is consumed from the transaction gas meter. State changes
+// are only written if the callback succeeds and failures never abort the packet flow,
+// they are recorded in the emitted callback event instead. The only exception is a
+// callback running out of gas because the transaction provided less gas than the
+// callback gas limit, in which case the transaction runs out of gas as well. This
+// prevents relayers from skipping callbacks by providing insufficient gas.
+func (im IBCMiddleware) processCallback(
+	ctx sdk.Context,
+	callbackType types.CallbackType,
+	packet ibcexported.PacketI,
+	callbackData types.CallbackData,
+	err error,
+	callback func(cachedCtx sdk.Context, handler types.CallbackHandler) error,
+) {
+	if errors.Is(err, types.ErrNotPacketDataProvider) || errors.Is(err, types.ErrCallbackKeyNotFound) {
+		return
+	}
+
+	if err == nil {
+		err = im.executeCallback(ctx, callbackType, callbackData, callback)
+	}
+
+	if err != nil {
+		ctx.Logger().Error("ibc callback failed", "module", types.ModuleName, "type", callbackType, "address", callbackData.CallbackAddress, "error", err.Error())
+	}
+
+	types.EmitCallbackEvent(ctx, packet, callbackType, callbackData, err)
+}
+
+// executeCallback executes the callback using the handler registered for the callback address.
+func (im IBCMiddleware) executeCallback(
+	ctx sdk.Context,
+	callbackType types.CallbackType,
+	callbackData types.CallbackData,
+	callback func(cachedCtx sdk.Context, handler types.CallbackHandler) error,
+) (err error) {
+	handler, found := im.router.GetRoute(callbackData.CallbackAddress)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrCallbackRouteNotFound, "callback address %s", callbackData.CallbackAddress)
+	}
+
+	// a callback may never consume more gas than remaining in the transaction
+	gasLimit := callbackData.GasLimit
+	gasLimitedByTx := false
+	if limit := ctx.GasMeter().Limit(); limit != 0 {
+		if remaining := limit - ctx.GasMeter().GasConsumedToLimit(); remaining < gasLimit {
+			gasLimit = remaining
+			gasLimitedByTx = true
+		}
+	}
+
+	cachedCtx, writeFn := ctx.CacheContext()
+	cachedCtx = cachedCtx.WithGasMeter(sdk.NewGasMeter(gasLimit))
+
+	defer func() {
+		r := recover()
+
+		ctx.GasMeter().ConsumeGas(cachedCtx.GasMeter().GasConsumedToLimit(), fmt.Sprintf("ibc %s callback", callbackType))
+
+		if r == nil {
+			return
+		}
+
+		outOfGas, ok := r.(sdk.ErrorOutOfGas)
+		switch {
+		case ok && gasLimitedByTx:
+			// the transaction did not provide the gas requested by the callback, the
+			// transaction is aborted rather than allowing the callback to be skipped
+			panic(r)
+		case ok:
+			err = sdkerrors.Wrapf(types.ErrCallbackOutOfGas, "%s callback with gas limit %d: %s", callbackType, gasLimit, outOfGas.Descriptor)
+		default:
+			err = sdkerrors.Wrapf(types.ErrCallbackPanic, "%s callback: %v", callbackType, r)
+		}
+	}()
+
+	if err := callback(cachedCtx, handler); err != nil {
+		return err
+	}
+
+	writeFn()
+	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
+	ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
+
+	return nil
+}
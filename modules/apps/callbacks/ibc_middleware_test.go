@@ -0,0 +1,274 @@
+package callbacks_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/callbacks"
+	"github.com/cosmos/ibc-go/v3/modules/apps/callbacks/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	ibcmock "github.com/cosmos/ibc-go/v3/testing/mock"
+	"github.com/cosmos/ibc-go/v3/testing/simapp"
+)
+
+type CallbacksTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+// SetupTest creates a coordinator with 2 test chains and a transfer channel between them.
+func (suite *CallbacksTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	suite.path.EndpointB.ChannelConfig.Version = transfertypes.Version
+	suite.coordinator.Setup(suite.path)
+}
+
+func TestCallbacksTestSuite(t *testing.T) {
+	suite.Run(t, new(CallbacksTestSuite))
+}
+
+// transfer sends a transfer packet with the provided memo from chainA to chainB.
+func (suite *CallbacksTestSuite) transfer(memo string, timeoutHeight clienttypes.Height) channeltypes.Packet {
+	msg := transfertypes.NewMsgTransfer(
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)),
+		suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+		timeoutHeight, 0,
+	)
+	msg.Memo = memo
+
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	return packet
+}
+
+// callbackExecuted returns true if the mock callback of the provided type was executed
+// and its state changes were written.
+func (suite *CallbacksTestSuite) callbackExecuted(chain *ibctesting.TestChain, callbackType types.CallbackType, packet channeltypes.Packet) bool {
+	_, found := chain.GetSimApp().ScopedIBCMockKeeper.GetCapability(chain.GetContext(), ibcmock.GetMockCallbackCanaryCapabilityName(callbackType, packet))
+	return found
+}
+
+// voucherBalance returns the balance of the transferred vouchers of the receiver on chainB.
+func (suite *CallbacksTestSuite) voucherBalance() sdk.Int {
+	denomTrace := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, sdk.DefaultBondDenom))
+	return suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), denomTrace.IBCDenom()).Amount
+}
+
+var callbackTestCases = []struct {
+	name            string
+	callbackAddress string
+	gasLimit        string
+	expSuccess      bool
+}{
+	{"success", ibcmock.MockCallbackAddress, "", true},
+	{"success: gas limit below maximum", ibcmock.MockCallbackAddress, "200000", true},
+	{"failure: callback returns error", ibcmock.MockFailureCallbackAddress, "", false},
+	{"failure: callback panics", ibcmock.MockPanicCallbackAddress, "", false},
+	{"failure: callback runs out of gas", ibcmock.MockOutOfGasCallbackAddress, "", false},
+	{"failure: gas limit too low", ibcmock.MockCallbackAddress, "1", false},
+	{"failure: callback address not registered", "unknowncallback", "", false},
+}
+
+// callbackMemo returns a transfer memo selecting a callback under the provided key.
+func callbackMemo(callbackKey, callbackAddress, gasLimit string) string {
+	if gasLimit == "" {
+		return fmt.Sprintf(`{"%s": {"address": "%s"}}`, callbackKey, callbackAddress)
+	}
+
+	return fmt.Sprintf(`{"%s": {"address": "%s", "gas_limit": "%s"}}`, callbackKey, callbackAddress, gasLimit)
+}
+
+func (suite *CallbacksTestSuite) TestSourceCallbacks() {
+	for _, tc := range callbackTestCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			packet := suite.transfer(callbackMemo(types.SourceCallbackKey, tc.callbackAddress, tc.gasLimit), clienttypes.NewHeight(0, 110))
+			suite.Require().Equal(tc.expSuccess, suite.callbackExecuted(suite.chainA, types.CallbackTypeSendPacket, packet))
+
+			err := suite.path.RelayPacket(packet)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expSuccess, suite.callbackExecuted(suite.chainA, types.CallbackTypeAcknowledgementPacket, packet))
+
+			// the packet flow is never affected by the callbacks
+			suite.Require().Equal(sdk.NewInt(100), suite.voucherBalance())
+			suite.Require().False(suite.chainA.App.GetIBCKeeper().ChannelKeeper.HasPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+
+			// the destination callback is not executed
+			suite.Require().False(suite.callbackExecuted(suite.chainB, types.CallbackTypeReceivePacket, packet))
+		})
+	}
+}
+
+func (suite *CallbacksTestSuite) TestDestinationCallbacks() {
+	for _, tc := range callbackTestCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			packet := suite.transfer(callbackMemo(types.DestinationCallbackKey, tc.callbackAddress, tc.gasLimit), clienttypes.NewHeight(0, 110))
+
+			err := suite.path.RelayPacket(packet)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expSuccess, suite.callbackExecuted(suite.chainB, types.CallbackTypeReceivePacket, packet))
+
+			// the packet flow is never affected by the callbacks
+			suite.Require().Equal(sdk.NewInt(100), suite.voucherBalance())
+
+			// the source callbacks are not executed
+			suite.Require().False(suite.callbackExecuted(suite.chainA, types.CallbackTypeSendPacket, packet))
+			suite.Require().False(suite.callbackExecuted(suite.chainA, types.CallbackTypeAcknowledgementPacket, packet))
+		})
+	}
+}
+
+func (suite *CallbacksTestSuite) TestTimeoutCallbacks() {
+	for _, tc := range callbackTestCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext()).Increment().(clienttypes.Height)
+			packet := suite.transfer(callbackMemo(types.SourceCallbackKey, tc.callbackAddress, tc.gasLimit), timeoutHeight)
+
+			balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+			suite.coordinator.CommitNBlocks(suite.chainB, 2)
+			err := suite.path.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+
+			err = suite.path.EndpointA.TimeoutPacket(packet)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expSuccess, suite.callbackExecuted(suite.chainA, types.CallbackTypeTimeoutPacket, packet))
+
+			// the sender is refunded regardless of the callback outcome
+			refunded := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+			suite.Require().Equal(balance.Amount.AddRaw(100), refunded.Amount)
+		})
+	}
+}
+
+// TestCallbackGasLimitedByTransaction verifies that a callback running out of gas
+// because the transaction provided less gas than the callback gas limit aborts the
+// transaction instead of skipping the callback.
+func (suite *CallbacksTestSuite) TestCallbackGasLimitedByTransaction() {
+	msg := transfertypes.NewMsgTransfer(
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)),
+		suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+		clienttypes.NewHeight(0, 110), 0,
+	)
+	msg.Memo = callbackMemo(types.SourceCallbackKey, ibcmock.MockCallbackAddress, "")
+
+	// measure the gas consumed by the transfer including the callback
+	ctx, _ := suite.chainA.GetContext().CacheContext()
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(simapp.DefaultMaxCallbackGas * 10))
+	_, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().NoError(err)
+	gasConsumed := ctx.GasMeter().GasConsumed()
+
+	ctx, _ = suite.chainA.GetContext().CacheContext()
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(gasConsumed - 1))
+	defer func() {
+		r := recover()
+		suite.Require().IsType(sdk.ErrorOutOfGas{}, r)
+	}()
+
+	_, _ = suite.chainA.GetSimApp().TransferKeeper.Transfer(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().Fail("transfer did not run out of gas")
+}
+
+func (suite *CallbacksTestSuite) TestPacketsWithoutCallbacks() {
+	testCases := []struct {
+		name string
+		memo string
+	}{
+		{"empty memo", ""},
+		{"memo is not a JSON object", "memo"},
+		{"memo without callback keys", `{"forward": {"receiver": "cosmos1"}}`},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			packet := suite.transfer(tc.memo, clienttypes.NewHeight(0, 110))
+
+			err := suite.path.RelayPacket(packet)
+			suite.Require().NoError(err)
+			suite.Require().Equal(sdk.NewInt(100), suite.voucherBalance())
+
+			for _, callbackType := range []types.CallbackType{types.CallbackTypeSendPacket, types.CallbackTypeAcknowledgementPacket} {
+				suite.Require().False(suite.callbackExecuted(suite.chainA, callbackType, packet))
+			}
+			suite.Require().False(suite.callbackExecuted(suite.chainB, types.CallbackTypeReceivePacket, packet))
+		})
+	}
+}
+
+func (suite *CallbacksTestSuite) TestNewIBCMiddleware() {
+	transferStack, ok := suite.chainA.App.GetIBCKeeper().Router.GetRoute(transfertypes.ModuleName)
+	suite.Require().True(ok)
+
+	mockModule := ibcmock.NewAppModule(&suite.chainA.GetSimApp().IBCKeeper.PortKeeper)
+	mockApp := ibcmock.NewIBCModule(&mockModule, ibcmock.NewMockIBCApp(ibcmock.ModuleName, suite.chainA.GetSimApp().ScopedIBCMockKeeper))
+
+	testCases := []struct {
+		name           string
+		app            porttypes.IBCModule
+		maxCallbackGas uint64
+		expPass        bool
+	}{
+		{"success", transferStack, simapp.DefaultMaxCallbackGas, true},
+		{"underlying application is not a PacketDataUnmarshaler", mockApp, simapp.DefaultMaxCallbackGas, false},
+		{"maximum callback gas is zero", transferStack, 0, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			newMiddleware := func() {
+				callbacks.NewIBCMiddleware(tc.app, suite.chainA.App.GetIBCKeeper().ChannelKeeper, types.NewRouter(), tc.maxCallbackGas)
+			}
+
+			if tc.expPass {
+				suite.Require().NotPanics(newMiddleware)
+			} else {
+				suite.Require().Panics(newMiddleware)
+			}
+		})
+	}
+}
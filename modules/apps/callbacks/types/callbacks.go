@@ -0,0 +1,161 @@
+package types
+
+import (
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// CallbackHandler defines the callbacks a module must implement in order to be
+// notified by the callbacks middleware of the lifecycle of the packets selecting
+// it as callback target. A callback is executed with the gas limit requested in
+// the packet data, capped by the maximum callback gas of the middleware. Any state
+// changes are discarded if the callback returns an error, panics or runs out of
+// gas, in which case the packet flow continues unaffected.
+type CallbackHandler interface {
+	// IBCSendPacketCallback is executed on the source chain after a packet has
+	// been sent. The packet sender is provided as reported by the packet data.
+	IBCSendPacketCallback(
+		ctx sdk.Context,
+		packet ibcexported.PacketI,
+		callbackAddress,
+		packetSenderAddress string,
+	) error
+
+	// IBCOnAcknowledgementPacketCallback is executed on the source chain after the
+	// acknowledgement of a packet has been processed by the underlying application.
+	IBCOnAcknowledgementPacketCallback(
+		ctx sdk.Context,
+		packet channeltypes.Packet,
+		acknowledgement []byte,
+		relayer sdk.AccAddress,
+		callbackAddress,
+		packetSenderAddress string,
+	) error
+
+	// IBCOnTimeoutPacketCallback is executed on the source chain after the timeout
+	// of a packet has been processed by the underlying application.
+	IBCOnTimeoutPacketCallback(
+		ctx sdk.Context,
+		packet channeltypes.Packet,
+		relayer sdk.AccAddress,
+		callbackAddress,
+		packetSenderAddress string,
+	) error
+
+	// IBCReceivePacketCallback is executed on the destination chain once the
+	// acknowledgement of a received packet is available, either when it is
+	// returned by the underlying application or when it is written asynchronously.
+	IBCReceivePacketCallback(
+		ctx sdk.Context,
+		packet ibcexported.PacketI,
+		ack ibcexported.Acknowledgement,
+		callbackAddress string,
+	) error
+}
+
+// CallbackData defines the callback to be executed for a packet.
+type CallbackData struct {
+	// CallbackAddress is the address under which the callback handler is registered.
+	CallbackAddress string
+	// SenderAddress is the address of the packet sender. It is empty for destination callbacks.
+	SenderAddress string
+	// GasLimit is the gas limit of the callback execution.
+	GasLimit uint64
+}
+
+// GetSourceCallbackData returns the callback to be executed on the source chain for
+// the provided packet. The packet data must implement PacketDataProvider and hold the
+// callback under the SourceCallbackKey.
+func GetSourceCallbackData(
+	packetDataUnmarshaler porttypes.PacketDataUnmarshaler,
+	packet ibcexported.PacketI,
+	maxGas uint64,
+) (CallbackData, error) {
+	packetData, err := packetDataUnmarshaler.UnmarshalPacketData(packet.GetData())
+	if err != nil {
+		return CallbackData{}, sdkerrors.Wrap(ErrNotPacketDataProvider, err.Error())
+	}
+
+	callbackData, err := getCallbackData(packetData, SourceCallbackKey, maxGas)
+	if err != nil {
+		return CallbackData{}, err
+	}
+
+	if packetDataSender, ok := packetData.(ibcexported.PacketData); ok {
+		callbackData.SenderAddress = packetDataSender.GetPacketSender(packet.GetSourcePort())
+	}
+
+	return callbackData, nil
+}
+
+// GetDestCallbackData returns the callback to be executed on the destination chain
+// for the provided packet. The packet data must implement PacketDataProvider and hold
+// the callback under the DestinationCallbackKey.
+func GetDestCallbackData(
+	packetDataUnmarshaler porttypes.PacketDataUnmarshaler,
+	packet ibcexported.PacketI,
+	maxGas uint64,
+) (CallbackData, error) {
+	packetData, err := packetDataUnmarshaler.UnmarshalPacketData(packet.GetData())
+	if err != nil {
+		return CallbackData{}, sdkerrors.Wrap(ErrNotPacketDataProvider, err.Error())
+	}
+
+	return getCallbackData(packetData, DestinationCallbackKey, maxGas)
+}
+
+// getCallbackData parses the callback held under the provided key of the packet data.
+// The callback is a JSON object holding the callback address and an optional gas
+// limit, which defaults to and is capped by the maximum gas:
+//
+//	{"address": "{address}", "gas_limit": "{gas}"}
+func getCallbackData(packetData interface{}, callbackKey string, maxGas uint64) (CallbackData, error) {
+	packetDataProvider, ok := packetData.(ibcexported.PacketDataProvider)
+	if !ok {
+		return CallbackData{}, ErrNotPacketDataProvider
+	}
+
+	callbackValue := packetDataProvider.GetCustomPacketData(callbackKey)
+	if callbackValue == nil {
+		return CallbackData{}, sdkerrors.Wrapf(ErrCallbackKeyNotFound, "key %s", callbackKey)
+	}
+
+	callback, ok := callbackValue.(map[string]interface{})
+	if !ok {
+		return CallbackData{}, sdkerrors.Wrapf(ErrInvalidCallbackData, "%s must be a JSON object", callbackKey)
+	}
+
+	callbackAddress, ok := callback[CallbackAddressKey].(string)
+	if !ok || strings.TrimSpace(callbackAddress) == "" {
+		return CallbackData{}, sdkerrors.Wrapf(ErrInvalidCallbackData, "%s must hold a non-empty %s", callbackKey, CallbackAddressKey)
+	}
+
+	gasLimit := maxGas
+	if gasLimitValue, found := callback[CallbackGasLimitKey]; found {
+		gasLimitString, ok := gasLimitValue.(string)
+		if !ok {
+			return CallbackData{}, sdkerrors.Wrapf(ErrInvalidCallbackData, "%s must be a string", CallbackGasLimitKey)
+		}
+
+		userGasLimit, err := strconv.ParseUint(gasLimitString, 10, 64)
+		if err != nil {
+			return CallbackData{}, sdkerrors.Wrapf(ErrInvalidCallbackData, "invalid %s: %s", CallbackGasLimitKey, err)
+		}
+
+		if userGasLimit != 0 && userGasLimit < maxGas {
+			gasLimit = userGasLimit
+		}
+	}
+
+	return CallbackData{
+		CallbackAddress: callbackAddress,
+		GasLimit:        gasLimit,
+	}, nil
+}
@@ -0,0 +1,138 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/callbacks/types"
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+const (
+	maxGas = uint64(1000000)
+	sender = "sender"
+)
+
+func newTransferPacket(memo string) channeltypes.Packet {
+	packetData := transfertypes.NewFungibleTokenPacketData("denom", "100", sender, "receiver")
+	packetData.Memo = memo
+
+	return channeltypes.NewPacket(packetData.GetBytes(), 1, transfertypes.PortID, "channel-0", transfertypes.PortID, "channel-1", clienttypes.NewHeight(0, 100), 0)
+}
+
+func TestGetCallbackData(t *testing.T) {
+	testCases := []struct {
+		name            string
+		callback        string
+		expCallbackData types.CallbackData
+		expErr          error
+	}{
+		{
+			"success",
+			`{"address": "callback"}`,
+			types.CallbackData{CallbackAddress: "callback", GasLimit: maxGas},
+			nil,
+		},
+		{
+			"success: gas limit below maximum",
+			`{"address": "callback", "gas_limit": "100"}`,
+			types.CallbackData{CallbackAddress: "callback", GasLimit: 100},
+			nil,
+		},
+		{
+			"success: gas limit above maximum",
+			fmt.Sprintf(`{"address": "callback", "gas_limit": "%d"}`, maxGas+1),
+			types.CallbackData{CallbackAddress: "callback", GasLimit: maxGas},
+			nil,
+		},
+		{
+			"success: zero gas limit",
+			`{"address": "callback", "gas_limit": "0"}`,
+			types.CallbackData{CallbackAddress: "callback", GasLimit: maxGas},
+			nil,
+		},
+		{
+			"callback key not found",
+			"",
+			types.CallbackData{},
+			types.ErrCallbackKeyNotFound,
+		},
+		{
+			"callback is not a JSON object",
+			`"callback"`,
+			types.CallbackData{},
+			types.ErrInvalidCallbackData,
+		},
+		{
+			"missing address",
+			`{"gas_limit": "100"}`,
+			types.CallbackData{},
+			types.ErrInvalidCallbackData,
+		},
+		{
+			"empty address",
+			`{"address": " "}`,
+			types.CallbackData{},
+			types.ErrInvalidCallbackData,
+		},
+		{
+			"gas limit is not a string",
+			`{"address": "callback", "gas_limit": 100}`,
+			types.CallbackData{},
+			types.ErrInvalidCallbackData,
+		},
+		{
+			"invalid gas limit",
+			`{"address": "callback", "gas_limit": "-1"}`,
+			types.CallbackData{},
+			types.ErrInvalidCallbackData,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			for _, callbackKey := range []string{types.SourceCallbackKey, types.DestinationCallbackKey} {
+				memo := ""
+				if tc.callback != "" {
+					memo = fmt.Sprintf(`{"%s": %s}`, callbackKey, tc.callback)
+				}
+				packet := newTransferPacket(memo)
+
+				var (
+					callbackData types.CallbackData
+					err          error
+				)
+				expCallbackData := tc.expCallbackData
+				if callbackKey == types.SourceCallbackKey {
+					callbackData, err = types.GetSourceCallbackData(transfer.IBCModule{}, packet, maxGas)
+					if tc.expErr == nil {
+						expCallbackData.SenderAddress = sender
+					}
+				} else {
+					callbackData, err = types.GetDestCallbackData(transfer.IBCModule{}, packet, maxGas)
+				}
+
+				require.ErrorIs(t, err, tc.expErr, callbackKey)
+				require.Equal(t, expCallbackData, callbackData, callbackKey)
+			}
+		})
+	}
+}
+
+func TestGetCallbackDataInvalidPacketData(t *testing.T) {
+	packet := newTransferPacket("")
+	packet.Data = []byte("invalid packet data")
+
+	_, err := types.GetSourceCallbackData(transfer.IBCModule{}, packet, maxGas)
+	require.ErrorIs(t, err, types.ErrNotPacketDataProvider)
+
+	_, err = types.GetDestCallbackData(transfer.IBCModule{}, packet, maxGas)
+	require.ErrorIs(t, err, types.ErrNotPacketDataProvider)
+}
@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// IBC callbacks sentinel errors
+var (
+	ErrNotPacketDataProvider = sdkerrors.Register(ModuleName, 2, "packet data does not implement the PacketDataProvider interface")
+	ErrCallbackKeyNotFound   = sdkerrors.Register(ModuleName, 3, "callback key not found in packet data")
+	ErrInvalidCallbackData   = sdkerrors.Register(ModuleName, 4, "invalid callback data")
+	ErrCallbackRouteNotFound = sdkerrors.Register(ModuleName, 5, "callback handler not found")
+	ErrCallbackOutOfGas      = sdkerrors.Register(ModuleName, 6, "callback out of gas")
+	ErrCallbackPanic         = sdkerrors.Register(ModuleName, 7, "callback panicked")
+)
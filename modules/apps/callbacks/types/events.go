@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// IBC callbacks events
+const (
+	EventTypeSourceCallback      = "ibc_src_callback"
+	EventTypeDestinationCallback = "ibc_dest_callback"
+
+	AttributeKeyCallbackType      = "callback_type"
+	AttributeKeyCallbackAddress   = "callback_address"
+	AttributeKeyCallbackGasLimit  = "callback_gas_limit"
+	AttributeKeyCallbackResult    = "callback_result"
+	AttributeKeyCallbackError     = "callback_error"
+	AttributeKeyPacketSequence    = "packet_sequence"
+	AttributeKeyPacketSrcPort     = "packet_src_port"
+	AttributeKeyPacketSrcChannel  = "packet_src_channel"
+	AttributeKeyPacketDestPort    = "packet_dest_port"
+	AttributeKeyPacketDestChannel = "packet_dest_channel"
+	AttributeValueCallbackSuccess = "success"
+	AttributeValueCallbackFailure = "failure"
+)
+
+// EmitCallbackEvent emits an event recording the outcome of a callback execution.
+// Callbacks of the receive packet type are recorded as destination callbacks, all
+// other callbacks as source callbacks.
+func EmitCallbackEvent(
+	ctx sdk.Context,
+	packet ibcexported.PacketI,
+	callbackType CallbackType,
+	callbackData CallbackData,
+	err error,
+) {
+	eventType := EventTypeSourceCallback
+	if callbackType == CallbackTypeReceivePacket {
+		eventType = EventTypeDestinationCallback
+	}
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(AttributeKeyCallbackType, string(callbackType)),
+		sdk.NewAttribute(AttributeKeyCallbackAddress, callbackData.CallbackAddress),
+		sdk.NewAttribute(AttributeKeyCallbackGasLimit, fmt.Sprintf("%d", callbackData.GasLimit)),
+		sdk.NewAttribute(AttributeKeyPacketSequence, fmt.Sprintf("%d", packet.GetSequence())),
+		sdk.NewAttribute(AttributeKeyPacketSrcPort, packet.GetSourcePort()),
+		sdk.NewAttribute(AttributeKeyPacketSrcChannel, packet.GetSourceChannel()),
+		sdk.NewAttribute(AttributeKeyPacketDestPort, packet.GetDestPort()),
+		sdk.NewAttribute(AttributeKeyPacketDestChannel, packet.GetDestChannel()),
+	}
+
+	if err == nil {
+		attributes = append(attributes, sdk.NewAttribute(AttributeKeyCallbackResult, AttributeValueCallbackSuccess))
+	} else {
+		attributes = append(attributes,
+			sdk.NewAttribute(AttributeKeyCallbackResult, AttributeValueCallbackFailure),
+			sdk.NewAttribute(AttributeKeyCallbackError, err.Error()),
+		)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(eventType, attributes...),
+	)
+}
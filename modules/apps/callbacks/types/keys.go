@@ -0,0 +1,32 @@
+package types
+
+const (
+	// ModuleName defines the IBC callbacks middleware name
+	ModuleName = "ibccallbacks"
+
+	// SourceCallbackKey is the key of the packet data holding the callback to be
+	// executed on the source chain.
+	SourceCallbackKey = "src_callback"
+
+	// DestinationCallbackKey is the key of the packet data holding the callback to
+	// be executed on the destination chain.
+	DestinationCallbackKey = "dest_callback"
+
+	// CallbackAddressKey is the key of the callback data holding the address of the
+	// callback handler.
+	CallbackAddressKey = "address"
+
+	// CallbackGasLimitKey is the key of the callback data holding the gas limit of
+	// the callback execution.
+	CallbackGasLimitKey = "gas_limit"
+)
+
+// CallbackType defines the packet lifecycle event upon which a callback is executed.
+type CallbackType string
+
+const (
+	CallbackTypeSendPacket            CallbackType = "send_packet"
+	CallbackTypeReceivePacket         CallbackType = "receive_packet"
+	CallbackTypeAcknowledgementPacket CallbackType = "acknowledgement_packet"
+	CallbackTypeTimeoutPacket         CallbackType = "timeout_packet"
+)
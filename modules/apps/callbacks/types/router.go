@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The router is a map from callback address to the CallbackHandler
+// which is notified of the packets selecting the address as callback target
+type Router struct {
+	routes map[string]CallbackHandler
+	sealed bool
+}
+
+// NewRouter returns an empty callbacks router.
+func NewRouter() *Router {
+	return &Router{
+		routes: make(map[string]CallbackHandler),
+	}
+}
+
+// Seal prevents the Router from any subsequent route handlers to be registered.
+// Seal will panic if called more than once.
+func (rtr *Router) Seal() {
+	if rtr.sealed {
+		panic("router already sealed")
+	}
+	rtr.sealed = true
+}
+
+// Sealed returns a boolean signifying if the Router is sealed or not.
+func (rtr Router) Sealed() bool {
+	return rtr.sealed
+}
+
+// AddRoute adds a CallbackHandler for a given callback address. It returns the Router
+// so AddRoute calls can be linked. It will panic if the Router is sealed.
+func (rtr *Router) AddRoute(callbackAddress string, handler CallbackHandler) *Router {
+	if rtr.sealed {
+		panic(fmt.Sprintf("router sealed; cannot register %s callback handler", callbackAddress))
+	}
+	if !sdk.IsAlphaNumeric(callbackAddress) {
+		panic("callback addresses can only contain alphanumeric characters")
+	}
+	if rtr.HasRoute(callbackAddress) {
+		panic(fmt.Sprintf("callback handler %s has already been registered", callbackAddress))
+	}
+
+	rtr.routes[callbackAddress] = handler
+	return rtr
+}
+
+// HasRoute returns true if the Router has a handler registered for the callback address.
+func (rtr *Router) HasRoute(callbackAddress string) bool {
+	_, ok := rtr.routes[callbackAddress]
+	return ok
+}
+
+// GetRoute returns the CallbackHandler registered for a given callback address.
+func (rtr *Router) GetRoute(callbackAddress string) (CallbackHandler, bool) {
+	if !rtr.HasRoute(callbackAddress) {
+		return nil, false
+	}
+	return rtr.routes[callbackAddress], true
+}
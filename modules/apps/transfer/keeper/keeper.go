@@ -58,6 +58,13 @@ func NewKeeper(
 	}
 }
 
+// SetICS4Wrapper sets the ICS4Wrapper used to send packets. It allows a middleware
+// wrapping the transfer application, which requires the transfer keeper in order
+// to be created, to be set as the ICS4Wrapper of the keeper afterwards.
+func (k *Keeper) SetICS4Wrapper(ics4Wrapper types.ICS4Wrapper) {
+	k.ics4Wrapper = ics4Wrapper
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+host.ModuleName+"-"+types.ModuleName)
@@ -19,8 +19,16 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 		return nil, err
 	}
 
+	timeoutHeight, timeoutTimestamp := msg.TimeoutHeight, msg.TimeoutTimestamp
+	if msg.RelativeTimeouts {
+		timeoutHeight, timeoutTimestamp, err = k.ics4Wrapper.ResolveRelativeTimeout(ctx, msg.SourcePort, msg.SourceChannel, timeoutHeight, timeoutTimestamp)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	sequence, err := k.sendTransfer(
-		ctx, msg.SourcePort, msg.SourceChannel, msg.Token, sender, msg.Receiver, msg.TimeoutHeight, msg.TimeoutTimestamp,
+		ctx, msg.SourcePort, msg.SourceChannel, msg.Token, sender, msg.Receiver, timeoutHeight, timeoutTimestamp,
 		msg.Memo)
 	if err != nil {
 		return nil, err
@@ -1,10 +1,13 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 )
 
 func (suite *KeeperTestSuite) TestMsgTransfer() {
@@ -31,6 +34,23 @@ func (suite *KeeperTestSuite) TestMsgTransfer() {
 			},
 			true,
 		},
+		{
+			"success with relative timeouts",
+			func() {
+				msg.TimeoutHeight = clienttypes.NewHeight(0, 100)
+				msg.TimeoutTimestamp = uint64(time.Hour.Nanoseconds())
+				msg.RelativeTimeouts = true
+			},
+			true,
+		},
+		{
+			"success with relative timeout height only",
+			func() {
+				msg.TimeoutHeight = clienttypes.NewHeight(0, 1)
+				msg.RelativeTimeouts = true
+			},
+			true,
+		},
 		{
 			"invalid sender",
 			func() {
@@ -63,6 +83,14 @@ func (suite *KeeperTestSuite) TestMsgTransfer() {
 			},
 			false,
 		},
+		{
+			"channel does not exist with relative timeouts",
+			func() {
+				msg.SourceChannel = "channel-100"
+				msg.RelativeTimeouts = true
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -40,3 +40,27 @@ func TestFungibleTokenPacketDataValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+// TestFungibleTokenPacketDataGetCustomPacketData tests GetCustomPacketData for FungibleTokenPacketData
+func TestFungibleTokenPacketDataGetCustomPacketData(t *testing.T) {
+	testCases := []struct {
+		name    string
+		memo    string
+		expData interface{}
+	}{
+		{"memo with key", `{"callback": {"address": "addr"}}`, map[string]interface{}{"address": "addr"}},
+		{"memo with string value", `{"callback": "addr"}`, "addr"},
+		{"memo without key", `{"forward": {"receiver": "addr"}}`, nil},
+		{"memo is not a JSON object", "memo", nil},
+		{"memo is a JSON array", `["callback"]`, nil},
+		{"empty memo", "", nil},
+	}
+
+	for _, tc := range testCases {
+		packetData := NewFungibleTokenPacketData(denom, amount, addr1, addr2)
+		packetData.Memo = tc.memo
+
+		require.Equal(t, tc.expData, packetData.GetCustomPacketData("callback"), tc.name)
+		require.Equal(t, addr1, packetData.GetPacketSender(PortID), tc.name)
+	}
+}
@@ -44,14 +44,20 @@ type MsgTransfer struct {
 	Sender string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
 	// the recipient address on the destination chain
 	Receiver string `protobuf:"bytes,5,opt,name=receiver,proto3" json:"receiver,omitempty"`
-	// Timeout height relative to the current block height.
+	// Timeout height on the destination chain, relative to the latest height of the
+	// counterparty client if relative_timeouts is set.
 	// The timeout is disabled when set to 0.
 	TimeoutHeight types1.Height `protobuf:"bytes,6,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height" yaml:"timeout_height"`
-	// Timeout timestamp in absolute nanoseconds since unix epoch.
+	// Timeout timestamp in absolute nanoseconds since unix epoch, or in nanoseconds
+	// relative to the latest consensus state of the counterparty client if
+	// relative_timeouts is set.
 	// The timeout is disabled when set to 0.
 	TimeoutTimestamp uint64 `protobuf:"varint,7,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty" yaml:"timeout_timestamp"`
 	// optional memo
 	Memo string `protobuf:"bytes,8,opt,name=memo,proto3" json:"memo,omitempty"`
+	// if set, the timeouts are resolved on-chain against the latest consensus state
+	// of the counterparty client
+	RelativeTimeouts bool `protobuf:"varint,9,opt,name=relative_timeouts,json=relativeTimeouts,proto3" json:"relative_timeouts,omitempty" yaml:"relative_timeouts"`
 }
 
 func (m *MsgTransfer) Reset()         { *m = MsgTransfer{} }
@@ -143,40 +149,41 @@ func init() {
 }
 
 var fileDescriptor_7401ed9bed2f8e09 = []byte{
-	// 516 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xb5, 0x49, 0x1a, 0xc2, 0x46, 0xad, 0x60, 0x81, 0xca, 0x8d, 0x8a, 0x1d, 0x59, 0x42, 0x0a,
-	0x07, 0x76, 0xe5, 0x56, 0xa8, 0x52, 0x4f, 0x28, 0xbd, 0xc0, 0xa1, 0x12, 0x58, 0x3d, 0x71, 0x29,
-	0xf6, 0x76, 0x70, 0x56, 0xc4, 0x1e, 0xe3, 0xdd, 0x58, 0xf4, 0x0f, 0x38, 0xf2, 0x09, 0xfd, 0x12,
-	0xce, 0x3d, 0xf6, 0xc8, 0x29, 0x42, 0xc9, 0x85, 0x73, 0xbe, 0x00, 0xad, 0xed, 0x84, 0xe4, 0x82,
-	0x38, 0x79, 0x66, 0xde, 0x1b, 0xbf, 0x7d, 0x3b, 0xb3, 0xe4, 0xb9, 0x8c, 0x05, 0x8f, 0xf2, 0x7c,
-	0x22, 0x45, 0xa4, 0x25, 0x66, 0x8a, 0xeb, 0x22, 0xca, 0xd4, 0x27, 0x28, 0x78, 0x19, 0x70, 0xfd,
-	0x95, 0xe5, 0x05, 0x6a, 0xa4, 0x87, 0x32, 0x16, 0x6c, 0x93, 0xc6, 0x56, 0x34, 0x56, 0x06, 0xfd,
-	0x27, 0x09, 0x26, 0x58, 0x11, 0xb9, 0x89, 0xea, 0x9e, 0xbe, 0x2b, 0x50, 0xa5, 0xa8, 0x78, 0x1c,
-	0x29, 0xe0, 0x65, 0x10, 0x83, 0x8e, 0x02, 0x2e, 0x50, 0x66, 0x0d, 0xee, 0x19, 0x69, 0x81, 0x05,
-	0x70, 0x31, 0x91, 0x90, 0x69, 0x23, 0x58, 0x47, 0x35, 0xc1, 0xff, 0xd1, 0x22, 0xbd, 0x73, 0x95,
-	0x5c, 0x34, 0x4a, 0xf4, 0x84, 0xf4, 0x14, 0x4e, 0x0b, 0x01, 0x97, 0x39, 0x16, 0xda, 0xb1, 0x07,
-	0xf6, 0xf0, 0xc1, 0x68, 0x7f, 0x39, 0xf3, 0xe8, 0x75, 0x94, 0x4e, 0x4e, 0xfd, 0x0d, 0xd0, 0x0f,
-	0x49, 0x9d, 0xbd, 0xc3, 0x42, 0xd3, 0xd7, 0x64, 0xaf, 0xc1, 0xc4, 0x38, 0xca, 0x32, 0x98, 0x38,
-	0xf7, 0xaa, 0xde, 0x83, 0xe5, 0xcc, 0x7b, 0xba, 0xd5, 0xdb, 0xe0, 0x7e, 0xb8, 0x5b, 0x17, 0xce,
-	0xea, 0x9c, 0xbe, 0x22, 0x3b, 0x1a, 0x3f, 0x43, 0xe6, 0xb4, 0x06, 0xf6, 0xb0, 0x77, 0x74, 0xc0,
-	0x6a, 0x6f, 0xcc, 0x78, 0x63, 0x8d, 0x37, 0x76, 0x86, 0x32, 0x1b, 0xb5, 0x6f, 0x67, 0x9e, 0x15,
-	0xd6, 0x6c, 0xba, 0x4f, 0x3a, 0x0a, 0xb2, 0x2b, 0x28, 0x9c, 0xb6, 0x11, 0x0c, 0x9b, 0x8c, 0xf6,
-	0x49, 0xb7, 0x00, 0x01, 0xb2, 0x84, 0xc2, 0xd9, 0xa9, 0x90, 0x75, 0x4e, 0x3f, 0x92, 0x3d, 0x2d,
-	0x53, 0xc0, 0xa9, 0xbe, 0x1c, 0x83, 0x4c, 0xc6, 0xda, 0xe9, 0x54, 0x9a, 0x7d, 0x66, 0x66, 0x60,
-	0xee, 0x8b, 0x35, 0xb7, 0x54, 0x06, 0xec, 0x4d, 0xc5, 0x18, 0x3d, 0x33, 0xa2, 0x7f, 0xcd, 0x6c,
-	0xf7, 0xfb, 0xe1, 0x6e, 0x53, 0xa8, 0xd9, 0xf4, 0x2d, 0x79, 0xb4, 0x62, 0x98, 0xaf, 0xd2, 0x51,
-	0x9a, 0x3b, 0xf7, 0x07, 0xf6, 0xb0, 0x3d, 0x3a, 0x5c, 0xce, 0x3c, 0x67, 0xfb, 0x27, 0x6b, 0x8a,
-	0x1f, 0x3e, 0x6c, 0x6a, 0x17, 0xab, 0x12, 0xa5, 0xa4, 0x9d, 0x42, 0x8a, 0x4e, 0xb7, 0x32, 0x51,
-	0xc5, 0xa7, 0xdd, 0x6f, 0x37, 0x9e, 0xf5, 0xfb, 0xc6, 0xb3, 0xfc, 0x80, 0x3c, 0xde, 0x98, 0x5f,
-	0x08, 0x2a, 0xc7, 0x4c, 0x81, 0x71, 0xaf, 0xe0, 0xcb, 0x14, 0x32, 0x01, 0xd5, 0x10, 0xdb, 0xe1,
-	0x3a, 0x3f, 0x42, 0xd2, 0x3a, 0x57, 0x09, 0x1d, 0x93, 0xee, 0x7a, 0xec, 0x2f, 0xd8, 0xbf, 0x96,
-	0x8f, 0x6d, 0x28, 0xf4, 0x83, 0xff, 0xa6, 0xae, 0x0e, 0x33, 0x7a, 0x7f, 0x3b, 0x77, 0xed, 0xbb,
-	0xb9, 0x6b, 0xff, 0x9a, 0xbb, 0xf6, 0xf7, 0x85, 0x6b, 0xdd, 0x2d, 0x5c, 0xeb, 0xe7, 0xc2, 0xb5,
-	0x3e, 0x9c, 0x24, 0x52, 0x8f, 0xa7, 0x31, 0x13, 0x98, 0xf2, 0x66, 0x95, 0x65, 0x2c, 0x5e, 0x26,
-	0xc8, 0xcb, 0x63, 0x9e, 0xe2, 0xd5, 0x74, 0x02, 0xca, 0x3c, 0x9d, 0x8d, 0x27, 0xa3, 0xaf, 0x73,
-	0x50, 0x71, 0xa7, 0x5a, 0xdf, 0xe3, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x61, 0x6b, 0x91, 0x78,
-	0x5c, 0x03, 0x00, 0x00,
+	// 541 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0xb6, 0xff, 0xba, 0xfd, 0xd3, 0xad, 0x5a, 0x95, 0x05, 0x2a, 0x37, 0x2a, 0x76, 0x64, 0x09,
+	0x29, 0x1c, 0xd8, 0x95, 0x5b, 0xa1, 0x4a, 0x3d, 0xa1, 0xf4, 0x02, 0x87, 0x4a, 0x60, 0xf5, 0xc4,
+	0xa5, 0xd8, 0xdb, 0xc1, 0x59, 0x61, 0x7b, 0x8d, 0x77, 0x63, 0xd1, 0x37, 0x80, 0x1b, 0x8f, 0xd0,
+	0xc7, 0xe9, 0xb1, 0x47, 0x4e, 0x11, 0x4a, 0x2e, 0x9c, 0xf3, 0x04, 0x68, 0xed, 0x4d, 0x70, 0x84,
+	0x84, 0x38, 0x79, 0xe6, 0x9b, 0x6f, 0xe6, 0xf3, 0xcc, 0xce, 0xa0, 0xa7, 0x3c, 0x61, 0x34, 0x2e,
+	0xcb, 0x8c, 0xb3, 0x58, 0x71, 0x51, 0x48, 0xaa, 0xaa, 0xb8, 0x90, 0x1f, 0xa0, 0xa2, 0x75, 0x48,
+	0xd5, 0x67, 0x52, 0x56, 0x42, 0x09, 0x7c, 0xc4, 0x13, 0x46, 0xba, 0x34, 0xb2, 0xa4, 0x91, 0x3a,
+	0xec, 0x3f, 0x4a, 0x45, 0x2a, 0x1a, 0x22, 0xd5, 0x56, 0x9b, 0xd3, 0xf7, 0x98, 0x90, 0xb9, 0x90,
+	0x34, 0x89, 0x25, 0xd0, 0x3a, 0x4c, 0x40, 0xc5, 0x21, 0x65, 0x82, 0x17, 0x26, 0xee, 0x6b, 0x69,
+	0x26, 0x2a, 0xa0, 0x2c, 0xe3, 0x50, 0x28, 0x2d, 0xd8, 0x5a, 0x2d, 0x21, 0xf8, 0xea, 0xa0, 0x9d,
+	0x0b, 0x99, 0x5e, 0x1a, 0x25, 0x7c, 0x8a, 0x76, 0xa4, 0x98, 0x54, 0x0c, 0xae, 0x4a, 0x51, 0x29,
+	0xd7, 0x1e, 0xd8, 0xc3, 0xed, 0xd1, 0xc1, 0x62, 0xea, 0xe3, 0x9b, 0x38, 0xcf, 0xce, 0x82, 0x4e,
+	0x30, 0x88, 0x50, 0xeb, 0xbd, 0x11, 0x95, 0xc2, 0x2f, 0xd1, 0x9e, 0x89, 0xb1, 0x71, 0x5c, 0x14,
+	0x90, 0xb9, 0xff, 0x35, 0xb9, 0x87, 0x8b, 0xa9, 0xff, 0x78, 0x2d, 0xd7, 0xc4, 0x83, 0x68, 0xb7,
+	0x05, 0xce, 0x5b, 0x1f, 0xbf, 0x40, 0x9b, 0x4a, 0x7c, 0x84, 0xc2, 0xdd, 0x18, 0xd8, 0xc3, 0x9d,
+	0xe3, 0x43, 0xd2, 0xf6, 0x46, 0x74, 0x6f, 0xc4, 0xf4, 0x46, 0xce, 0x05, 0x2f, 0x46, 0xce, 0xdd,
+	0xd4, 0xb7, 0xa2, 0x96, 0x8d, 0x0f, 0xd0, 0x96, 0x84, 0xe2, 0x1a, 0x2a, 0xd7, 0xd1, 0x82, 0x91,
+	0xf1, 0x70, 0x1f, 0xf5, 0x2a, 0x60, 0xc0, 0x6b, 0xa8, 0xdc, 0xcd, 0x26, 0xb2, 0xf2, 0xf1, 0x7b,
+	0xb4, 0xa7, 0x78, 0x0e, 0x62, 0xa2, 0xae, 0xc6, 0xc0, 0xd3, 0xb1, 0x72, 0xb7, 0x1a, 0xcd, 0x3e,
+	0xd1, 0x6f, 0xa0, 0xe7, 0x45, 0xcc, 0x94, 0xea, 0x90, 0xbc, 0x6a, 0x18, 0xa3, 0x27, 0x5a, 0xf4,
+	0x77, 0x33, 0xeb, 0xf9, 0x41, 0xb4, 0x6b, 0x80, 0x96, 0x8d, 0x5f, 0xa3, 0x07, 0x4b, 0x86, 0xfe,
+	0x4a, 0x15, 0xe7, 0xa5, 0xfb, 0xff, 0xc0, 0x1e, 0x3a, 0xa3, 0xa3, 0xc5, 0xd4, 0x77, 0xd7, 0x8b,
+	0xac, 0x28, 0x41, 0xb4, 0x6f, 0xb0, 0xcb, 0x25, 0x84, 0x31, 0x72, 0x72, 0xc8, 0x85, 0xdb, 0x6b,
+	0x9a, 0x68, 0x6c, 0x5d, 0xbe, 0x82, 0x2c, 0x56, 0xbc, 0x86, 0x2b, 0x93, 0x20, 0xdd, 0xed, 0x81,
+	0x3d, 0xec, 0x75, 0xcb, 0xff, 0x41, 0x09, 0xa2, 0xfd, 0x25, 0x76, 0x69, 0xa0, 0xb3, 0xde, 0x97,
+	0x5b, 0xdf, 0xfa, 0x79, 0xeb, 0x5b, 0x41, 0x88, 0x1e, 0x76, 0x56, 0x21, 0x02, 0x59, 0x8a, 0x42,
+	0x82, 0x1e, 0xa4, 0x84, 0x4f, 0x13, 0x28, 0x18, 0x34, 0xfb, 0xe0, 0x44, 0x2b, 0xff, 0x58, 0xa0,
+	0x8d, 0x0b, 0x99, 0xe2, 0x31, 0xea, 0xad, 0x36, 0xe8, 0x19, 0xf9, 0xdb, 0x1e, 0x93, 0x8e, 0x42,
+	0x3f, 0xfc, 0x67, 0xea, 0xf2, 0x67, 0x46, 0x6f, 0xef, 0x66, 0x9e, 0x7d, 0x3f, 0xf3, 0xec, 0x1f,
+	0x33, 0xcf, 0xfe, 0x36, 0xf7, 0xac, 0xfb, 0xb9, 0x67, 0x7d, 0x9f, 0x7b, 0xd6, 0xbb, 0xd3, 0x94,
+	0xab, 0xf1, 0x24, 0x21, 0x4c, 0xe4, 0xd4, 0x5c, 0x05, 0x4f, 0xd8, 0xf3, 0x54, 0xd0, 0xfa, 0x84,
+	0xe6, 0xe2, 0x7a, 0x92, 0x81, 0xd4, 0x57, 0xd8, 0xb9, 0x3e, 0x75, 0x53, 0x82, 0x4c, 0xb6, 0x9a,
+	0x4b, 0x38, 0xf9, 0x35, 0x00, 0x71, 0x85, 0x59, 0x48, 0xa7, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -281,6 +288,16 @@ func (m *MsgTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RelativeTimeouts {
+		i--
+		if m.RelativeTimeouts {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.Memo) > 0 {
 		i -= len(m.Memo)
 		copy(dAtA[i:], m.Memo)
@@ -416,6 +433,9 @@ func (m *MsgTransfer) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.RelativeTimeouts {
+		n += 2
+	}
 	return n
 }
 
@@ -711,6 +731,26 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 			}
 			m.Memo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelativeTimeouts", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RelativeTimeouts = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
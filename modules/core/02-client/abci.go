@@ -8,7 +8,9 @@ import (
 	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
 )
 
-// BeginBlocker updates an existing localhost client with the latest block height.
+// BeginBlocker sets the upgraded consensus state at the last height before an upgrade and
+// updates an existing localhost client with the latest block height. The localhost client
+// is created once it is allowed if it was not created in genesis.
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	plan, found := k.GetUpgradePlan(ctx)
 	if found {
@@ -30,13 +32,11 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 		}
 	}
 
-	_, found = k.GetClientState(ctx, exported.Localhost)
-	if !found {
-		return
-	}
-
-	// update the localhost client with the latest block height
-	if err := k.UpdateClient(ctx, exported.Localhost, nil); err != nil {
-		panic(err)
+	// update the localhost client with the latest block height, or create it if it has been
+	// allowed since genesis
+	if !k.UpdateLocalhostClient(ctx) && k.GetParams(ctx).IsAllowedClient(exported.Localhost) {
+		if err := k.CreateLocalhostClient(ctx); err != nil {
+			k.Logger(ctx).Error("failed to create localhost client", "error", err.Error())
+		}
 	}
 }
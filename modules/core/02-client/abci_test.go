@@ -63,6 +63,25 @@ func (suite *ClientTestSuite) TestBeginBlocker() {
 	}
 }
 
+func (suite *ClientTestSuite) TestBeginBlockerCreateLocalhost() {
+	clientKeeper := suite.chainB.App.GetIBCKeeper().ClientKeeper
+	ctx := suite.chainB.GetContext()
+
+	// the localhost client is not allowed
+	client.BeginBlocker(ctx, clientKeeper)
+	_, found := clientKeeper.GetClientState(ctx, exported.Localhost)
+	suite.Require().False(found)
+
+	params := clientKeeper.GetParams(ctx)
+	params.AllowedClients = append(params.AllowedClients, exported.Localhost)
+	clientKeeper.SetParams(ctx, params)
+
+	client.BeginBlocker(ctx, clientKeeper)
+	localHostClient, found := clientKeeper.GetClientState(ctx, exported.Localhost)
+	suite.Require().True(found)
+	suite.Require().Equal(types.GetSelfHeight(ctx), localHostClient.GetLatestHeight())
+}
+
 func (suite *ClientTestSuite) TestBeginBlockerConsensusState() {
 	plan := &upgradetypes.Plan{
 		Name:   "test",
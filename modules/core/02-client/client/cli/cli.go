@@ -7,8 +7,9 @@ import (
 	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 )
 
-// GetQueryCmd returns the query commands for IBC clients
-func GetQueryCmd() *cobra.Command {
+// GetQueryCmd returns the query commands for IBC clients, including the given commands of
+// the registered light clients.
+func GetQueryCmd(lightClientCmds ...*cobra.Command) *cobra.Command {
 	queryCmd := &cobra.Command{
 		Use:                        types.SubModuleName,
 		Short:                      "IBC client query subcommands",
@@ -28,12 +29,14 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdSelfConsensusState(),
 		GetCmdParams(),
 	)
+	queryCmd.AddCommand(lightClientCmds...)
 
 	return queryCmd
 }
 
-// NewTxCmd returns the command to create and handle IBC clients
-func NewTxCmd() *cobra.Command {
+// NewTxCmd returns the command to create and handle IBC clients, including the given
+// commands of the registered light clients.
+func NewTxCmd(lightClientCmds ...*cobra.Command) *cobra.Command {
 	txCmd := &cobra.Command{
 		Use:                        types.SubModuleName,
 		Short:                      "IBC client transaction subcommands",
@@ -48,6 +51,7 @@ func NewTxCmd() *cobra.Command {
 		NewSubmitMisbehaviourCmd(),
 		NewUpgradeClientCmd(),
 	)
+	txCmd.AddCommand(lightClientCmds...)
 
 	return txCmd
 }
@@ -294,6 +294,64 @@ func NewCmdSubmitUpdateClientProposal() *cobra.Command {
 	return cmd
 }
 
+// NewCmdSubmitClientMigrationProposal implements a command handler for submitting a client migration proposal transaction.
+func NewCmdSubmitClientMigrationProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-client [client-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal migrating a tendermint IBC client to the dymint client type",
+		Long: "Submit a proposal migrating a tendermint IBC client to the dymint client type along with an initial deposit.\n" +
+			"Please specify the identifier of the 07-tendermint client you want to migrate. The client identifier,\n" +
+			"and the connections and channels built on it, are kept.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewClientMigrationProposal(title, description, args[0])
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
 // NewCmdSubmitUpgradeProposal implements a command handler for submitting an upgrade IBC client proposal transaction.
 func NewCmdSubmitUpgradeProposal() *cobra.Command {
 	cmd := &cobra.Command{
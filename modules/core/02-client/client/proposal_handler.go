@@ -12,8 +12,9 @@ import (
 )
 
 var (
-	UpdateClientProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitUpdateClientProposal, emptyRestHandler)
-	UpgradeProposalHandler      = govclient.NewProposalHandler(cli.NewCmdSubmitUpgradeProposal, emptyRestHandler)
+	UpdateClientProposalHandler    = govclient.NewProposalHandler(cli.NewCmdSubmitUpdateClientProposal, emptyRestHandler)
+	UpgradeProposalHandler         = govclient.NewProposalHandler(cli.NewCmdSubmitUpgradeProposal, emptyRestHandler)
+	ClientMigrationProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitClientMigrationProposal, emptyRestHandler)
 )
 
 func emptyRestHandler(client.Context) govrest.ProposalRESTHandler {
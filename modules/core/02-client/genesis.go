@@ -13,6 +13,10 @@ import (
 // InitGenesis initializes the ibc client submodule's state from a provided genesis
 // state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
+	if err := k.GetLightClientRegistry().ValidateGenesis(gs); err != nil {
+		panic(err)
+	}
+
 	k.SetParams(ctx, gs.Params)
 
 	// Set all client metadata first. This will allow client keeper to overwrite client and consensus state keys
@@ -47,8 +51,12 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 
 	k.SetNextClientSequence(ctx, gs.NextClientSequence)
 
-	// NOTE: localhost creation is specifically disallowed for the time being.
-	// Issue: https://github.com/cosmos/cosmos-sdk/issues/7871
+	// the localhost client may have been exported along with the other clients
+	if _, found := k.GetClientState(ctx, exported.Localhost); gs.CreateLocalhost && !found {
+		if err := k.CreateLocalhostClient(ctx); err != nil {
+			panic(fmt.Sprintf("failed to create localhost client: %v", err))
+		}
+	}
 }
 
 // ExportGenesis returns the ibc client submodule's exported genesis.
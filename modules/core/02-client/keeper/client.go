@@ -31,6 +31,13 @@ func (k Keeper) CreateClient(
 		)
 	}
 
+	if !k.lightClients.HasClientType(clientState.ClientType()) {
+		return "", sdkerrors.Wrapf(
+			types.ErrInvalidClientType,
+			"light client module for client state type %s is not registered", clientState.ClientType(),
+		)
+	}
+
 	clientID := k.GenerateClientIdentifier(ctx, clientState.ClientType())
 
 	k.SetClientState(ctx, clientID, clientState)
@@ -38,9 +45,11 @@ func (k Keeper) CreateClient(
 
 	// verifies initial consensus state against client state and initializes client store with any client-specific metadata
 	// e.g. set ProcessedTime in Tendermint clients
-	if err := clientState.Initialize(ctx, k.cdc, k.ClientStore(ctx, clientID), consensusState); err != nil {
+	clientStore := k.trackingClientStore(ctx, clientID)
+	if err := clientState.Initialize(ctx, k.cdc, k.lightClientStore(clientID, clientStore), consensusState); err != nil {
 		return "", err
 	}
+	k.logClientStoreWrites(ctx, clientID, "create", clientStore)
 
 	// check if consensus state is nil in case the created client is Localhost
 	if consensusState != nil {
@@ -75,18 +84,18 @@ func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, header exported.H
 		return sdkerrors.Wrapf(types.ErrClientNotFound, "cannot update client with ID %s", clientID)
 	}
 
-	clientStore := k.ClientStore(ctx, clientID)
-
-	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
+	if status := clientState.Status(ctx, k.ReadOnlyClientStore(ctx, clientID), k.cdc); status != exported.Active {
 		return sdkerrors.Wrapf(types.ErrClientNotActive, "cannot update client (%s) with status %s", clientID, status)
 	}
 
 	// Any writes made in CheckHeaderAndUpdateState are persisted on both valid updates and misbehaviour updates.
 	// Light client implementations are responsible for writing the correct metadata (if any) in either case.
-	newClientState, newConsensusState, err := clientState.CheckHeaderAndUpdateState(ctx, k.cdc, clientStore, header)
+	clientStore := k.trackingClientStore(ctx, clientID)
+	newClientState, newConsensusState, err := clientState.CheckHeaderAndUpdateState(ctx, k.cdc, k.lightClientStore(clientID, clientStore), header)
 	if err != nil {
 		return sdkerrors.Wrapf(err, "cannot update client with ID %s", clientID)
 	}
+	k.logClientStoreWrites(ctx, clientID, "update", clientStore)
 
 	// emit the full header in events
 	var (
@@ -108,7 +117,7 @@ func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, header exported.H
 	// If client state is not frozen after clientState CheckHeaderAndUpdateState,
 	// then update was valid. Write the update state changes, and set new consensus state.
 	// Else the update was proof of misbehaviour and we must emit appropriate misbehaviour events.
-	if status := newClientState.Status(ctx, clientStore, k.cdc); status != exported.Frozen {
+	if status := newClientState.Status(ctx, k.ReadOnlyClientStore(ctx, clientID), k.cdc); status != exported.Frozen {
 		// if update is not misbehaviour then update the consensus state
 		// we don't set consensus state for localhost client
 		if header != nil && clientID != exported.Localhost {
@@ -170,17 +179,17 @@ func (k Keeper) UpgradeClient(ctx sdk.Context, clientID string, upgradedClient e
 		return sdkerrors.Wrapf(types.ErrClientNotFound, "cannot update client with ID %s", clientID)
 	}
 
-	clientStore := k.ClientStore(ctx, clientID)
-
-	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
+	if status := clientState.Status(ctx, k.ReadOnlyClientStore(ctx, clientID), k.cdc); status != exported.Active {
 		return sdkerrors.Wrapf(types.ErrClientNotActive, "cannot upgrade client (%s) with status %s", clientID, status)
 	}
 
-	updatedClientState, updatedConsState, err := clientState.VerifyUpgradeAndUpdateState(ctx, k.cdc, clientStore,
+	clientStore := k.trackingClientStore(ctx, clientID)
+	updatedClientState, updatedConsState, err := clientState.VerifyUpgradeAndUpdateState(ctx, k.cdc, k.lightClientStore(clientID, clientStore),
 		upgradedClient, upgradedConsState, proofUpgradeClient, proofUpgradeConsState)
 	if err != nil {
 		return sdkerrors.Wrapf(err, "cannot upgrade client with ID %s", clientID)
 	}
+	k.logClientStoreWrites(ctx, clientID, "upgrade", clientStore)
 
 	k.SetClientState(ctx, clientID, updatedClientState)
 	k.SetClientConsensusState(ctx, clientID, updatedClientState.GetLatestHeight(), updatedConsState)
@@ -218,9 +227,7 @@ func (k Keeper) CheckMisbehaviourAndUpdateState(ctx sdk.Context, misbehaviour ex
 		return sdkerrors.Wrapf(types.ErrClientNotFound, "cannot check misbehaviour for client with ID %s", misbehaviour.GetClientID())
 	}
 
-	clientStore := k.ClientStore(ctx, misbehaviour.GetClientID())
-
-	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
+	if status := clientState.Status(ctx, k.ReadOnlyClientStore(ctx, misbehaviour.GetClientID()), k.cdc); status != exported.Active {
 		return sdkerrors.Wrapf(types.ErrClientNotActive, "cannot process misbehaviour for client (%s) with status %s", misbehaviour.GetClientID(), status)
 	}
 
@@ -228,10 +235,12 @@ func (k Keeper) CheckMisbehaviourAndUpdateState(ctx sdk.Context, misbehaviour ex
 		return err
 	}
 
-	clientState, err := clientState.CheckMisbehaviourAndUpdateState(ctx, k.cdc, clientStore, misbehaviour)
+	clientStore := k.trackingClientStore(ctx, misbehaviour.GetClientID())
+	clientState, err := clientState.CheckMisbehaviourAndUpdateState(ctx, k.cdc, k.lightClientStore(misbehaviour.GetClientID(), clientStore), misbehaviour)
 	if err != nil {
 		return err
 	}
+	k.logClientStoreWrites(ctx, misbehaviour.GetClientID(), "misbehaviour", clientStore)
 
 	k.SetClientState(ctx, misbehaviour.GetClientID(), clientState)
 	k.Logger(ctx).Info("client frozen due to misbehaviour", "client-id", misbehaviour.GetClientID())
@@ -3,16 +3,24 @@ package keeper_test
 import (
 	"encoding/hex"
 	"fmt"
+	"strings"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	tmtypes "github.com/tendermint/tendermint/types"
 
+	ibcclient "github.com/cosmos/ibc-go/v3/modules/core/02-client"
+	"github.com/cosmos/ibc-go/v3/modules/core/02-client/keeper"
 	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	solomachinelc "github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine"
+	tendermint "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint"
 	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+	localhost "github.com/cosmos/ibc-go/v3/modules/light-clients/09-localhost"
 	localhosttypes "github.com/cosmos/ibc-go/v3/modules/light-clients/09-localhost/types"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 	ibctestingmock "github.com/cosmos/ibc-go/v3/testing/mock"
@@ -41,6 +49,46 @@ func (suite *KeeperTestSuite) TestCreateClient() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestCreateClientLightClientRegistry() {
+	app := suite.chainA.GetSimApp()
+	ctx := suite.chainA.GetContext()
+
+	// keeper without the solo machine light client registered
+	clientKeeper := keeper.NewKeeper(
+		app.AppCodec(), app.GetKey(host.StoreKey), app.GetSubspace(host.ModuleName), app.StakingKeeper, app.UpgradeKeeper,
+		types.NewLightClientRegistry(tendermint.LightClientModule{}, localhost.LightClientModule{}), ibctmtypes.NewSelfClient(), nil,
+	)
+
+	solomachine := ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, "solomachine", "", 1)
+	suite.Require().True(clientKeeper.GetParams(ctx).IsAllowedClient(exported.Solomachine))
+
+	clientID, err := clientKeeper.CreateClient(ctx, solomachine.ClientState(), solomachine.ConsensusState())
+	suite.Require().ErrorIs(err, types.ErrInvalidClientType)
+	suite.Require().Empty(clientID)
+
+	clientKeeper.RegisterLightClientModule(solomachinelc.LightClientModule{})
+
+	clientID, err = clientKeeper.CreateClient(ctx, solomachine.ClientState(), solomachine.ConsensusState())
+	suite.Require().NoError(err)
+
+	_, found := app.IBCKeeper.ClientKeeper.GetClientState(ctx, clientID)
+	suite.Require().True(found)
+
+	// the genesis of the registered light clients is valid
+	genesis := ibcclient.ExportGenesis(ctx, clientKeeper)
+	suite.Require().NoError(clientKeeper.GetLightClientRegistry().ValidateGenesis(genesis))
+
+	// importing clients of unregistered types fails
+	otherKeeper := keeper.NewKeeper(
+		app.AppCodec(), app.GetKey(host.StoreKey), app.GetSubspace(host.ModuleName), app.StakingKeeper, app.UpgradeKeeper,
+		types.NewLightClientRegistry(tendermint.LightClientModule{}, localhost.LightClientModule{}), ibctmtypes.NewSelfClient(), nil,
+	)
+	suite.Require().Error(otherKeeper.GetLightClientRegistry().ValidateGenesis(genesis))
+	suite.Require().Panics(func() {
+		ibcclient.InitGenesis(suite.chainB.GetContext(), otherKeeper, genesis)
+	})
+}
+
 func (suite *KeeperTestSuite) TestUpdateClientTendermint() {
 	var (
 		path         *ibctesting.Path
@@ -254,19 +302,154 @@ func (suite *KeeperTestSuite) TestUpdateClientTendermint() {
 	}
 }
 
+// TestUpdateClientStoreWrites verifies that the writes of the in-tree clients on update
+// stay under the client prefix of the updated client.
+func (suite *KeeperTestSuite) TestUpdateClientStoreWrites() {
+	var (
+		clientID string
+		header   exported.Header
+	)
+
+	// updateFromChainB sets up a client on chainA tracking chainB and constructs an update of it.
+	updateFromChainB := func() {
+		path := ibctesting.NewPath(suite.chainA, suite.chainB)
+		suite.coordinator.SetupClients(path)
+		clientID = path.EndpointA.ClientID
+
+		suite.coordinator.CommitBlock(suite.chainB)
+
+		var err error
+		header, err = suite.chainA.ConstructUpdateClientHeader(suite.chainB, clientID)
+		suite.Require().NoError(err)
+	}
+
+	testCases := []struct {
+		msg   string
+		setup func()
+	}{
+		{
+			exported.Tendermint, updateFromChainB,
+		},
+		{
+			exported.Dymint, func() {
+				suite.coordinator = ibctesting.NewCoordinatorWithConsensusType(suite.T(), []string{exported.Tendermint, exported.Dymint})
+				suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+				suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+				updateFromChainB()
+			},
+		},
+		{
+			exported.Solomachine, func() {
+				solomachine := ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, "solo machine", "", 1)
+
+				var err error
+				clientID, err = suite.chainA.App.GetIBCKeeper().ClientKeeper.CreateClient(suite.chainA.GetContext(), solomachine.ClientState(), solomachine.ConsensusState())
+				suite.Require().NoError(err)
+
+				header = solomachine.CreateHeader()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			tc.setup()
+
+			ctx := suite.chainA.GetContext()
+			before := suite.ibcStoreSnapshot(ctx)
+
+			err := suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(ctx, clientID, header)
+			suite.Require().NoError(err)
+
+			clientState, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientState(ctx, clientID)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.msg, clientState.ClientType())
+
+			writtenKeys := changedKeys(before, suite.ibcStoreSnapshot(ctx))
+			suite.Require().NotEmpty(writtenKeys)
+			for _, key := range writtenKeys {
+				suite.Require().True(strings.HasPrefix(key, string(host.FullClientKey(clientID, nil))), "key %s written outside of the client store", key)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestUpdateClientLocalhost() {
 	revision := types.ParseChainID(suite.chainA.ChainID)
 	var localhostClient exported.ClientState = localhosttypes.NewClientState(suite.chainA.ChainID, types.NewHeight(revision, uint64(suite.chainA.GetContext().BlockHeight())))
 
 	ctx := suite.chainA.GetContext().WithBlockHeight(suite.chainA.GetContext().BlockHeight() + 1)
 	err := suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(ctx, exported.Localhost, nil)
-	suite.Require().NoError(err)
+	suite.Require().Error(err, "localhost client must only be updated on BeginBlock")
+
+	found := suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateLocalhostClient(ctx)
+	suite.Require().True(found)
 
 	clientState, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientState(ctx, exported.Localhost)
 	suite.Require().True(found)
 	suite.Require().Equal(localhostClient.GetLatestHeight().(types.Height).Increment(), clientState.GetLatestHeight())
 }
 
+func (suite *KeeperTestSuite) TestCreateLocalhostClient() {
+	var ctx sdk.Context
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {
+				params := types.NewParams(exported.Tendermint, exported.Localhost)
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(ctx, params)
+			}, true,
+		},
+		{
+			"localhost client is not allowed", func() {}, false,
+		},
+		{
+			"localhost client already exists", func() {
+				params := types.NewParams(exported.Tendermint, exported.Localhost)
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(ctx, params)
+
+				err := suite.chainA.App.GetIBCKeeper().ClientKeeper.CreateLocalhostClient(ctx)
+				suite.Require().NoError(err)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			ctx = suite.chainA.GetContext()
+
+			// remove the localhost client set in SetupTest
+			ctx.KVStore(suite.chainA.GetSimApp().GetKey(host.StoreKey)).Delete(host.FullClientStateKey(exported.Localhost))
+
+			tc.malleate()
+
+			err := suite.chainA.App.GetIBCKeeper().ClientKeeper.CreateLocalhostClient(ctx)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				clientState, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientState(ctx, exported.Localhost)
+				suite.Require().True(found)
+				suite.Require().Equal(types.GetSelfHeight(ctx), clientState.GetLatestHeight())
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestUpgradeClient() {
 	var (
 		path                                        *ibctesting.Path
@@ -70,6 +70,18 @@ func EmitUpdateClientProposalEvent(ctx sdk.Context, clientID string, clientState
 	)
 }
 
+// EmitMigrateClientProposalEvent emits a migrate client proposal event
+func EmitMigrateClientProposalEvent(ctx sdk.Context, clientID string, clientState exported.ClientState) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMigrateClientProposal,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyClientType, clientState.ClientType()),
+			sdk.NewAttribute(types.AttributeKeyConsensusHeight, clientState.GetLatestHeight().String()),
+		),
+	)
+}
+
 // EmitUpgradeClientProposalEvent emits an upgrade client proposal event
 func EmitUpgradeClientProposalEvent(ctx sdk.Context, title string, height int64) {
 	ctx.EventManager().EmitEvent(
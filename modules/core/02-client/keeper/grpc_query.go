@@ -244,7 +244,7 @@ func (q Keeper) ClientStatus(c context.Context, req *types.QueryClientStatusRequ
 		)
 	}
 
-	clientStore := q.ClientStore(ctx, req.ClientId)
+	clientStore := q.ReadOnlyClientStore(ctx, req.ClientId)
 	status := clientState.Status(ctx, clientStore, q.cdc)
 
 	return &types.QueryClientStatusResponse{
@@ -328,3 +328,62 @@ func (q Keeper) UpgradedConsensusState(c context.Context, req *types.QueryUpgrad
 		UpgradedConsensusState: any,
 	}, nil
 }
+
+// VerifyProof implements the Query/VerifyProof gRPC method. It runs the membership
+// verification of the proof if a value is provided and the non-membership verification
+// otherwise. Verification is run on a cached context which is discarded, thus no state
+// changes are persisted. A failed verification is returned in the response along with
+// the registered codespace and code of the error.
+func (q Keeper) VerifyProof(c context.Context, req *types.QueryVerifyProofRequest) (*types.QueryVerifyProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if len(req.Proof) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proof cannot be empty")
+	}
+
+	if req.ProofHeight.IsZero() {
+		return nil, status.Error(codes.InvalidArgument, "proof height must be non-zero")
+	}
+
+	if req.MerklePath.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "merkle path cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, found := q.GetClientState(ctx, req.ClientId); !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrap(types.ErrClientNotFound, req.ClientId).Error(),
+		)
+	}
+
+	// verification must not write state, the cached context is never written back
+	cacheCtx, _ := ctx.CacheContext()
+
+	var err error
+	if len(req.Value) != 0 {
+		err = q.VerifyMembership(cacheCtx, req.ClientId, req.ProofHeight, req.TimeDelay, req.BlockDelay, req.Proof, req.MerklePath, req.Value)
+	} else {
+		err = q.VerifyNonMembership(cacheCtx, req.ClientId, req.ProofHeight, req.TimeDelay, req.BlockDelay, req.Proof, req.MerklePath)
+	}
+
+	if err != nil {
+		codespace, code, _ := sdkerrors.ABCIInfo(err, false)
+		return &types.QueryVerifyProofResponse{
+			Success:   false,
+			Error:     err.Error(),
+			Codespace: codespace,
+			Code:      code,
+		}, nil
+	}
+
+	return &types.QueryVerifyProofResponse{
+		Success: true,
+	}, nil
+}
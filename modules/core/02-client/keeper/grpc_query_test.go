@@ -10,7 +10,10 @@ import (
 	"google.golang.org/grpc/metadata"
 
 	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibcdmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/01-dymint/types"
 	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
@@ -652,3 +655,171 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	res, _ := suite.chainA.QueryServer.ClientParams(ctx, &types.QueryClientParamsRequest{})
 	suite.Require().Equal(&expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestQueryVerifyProof() {
+	var (
+		req          *types.QueryVerifyProofRequest
+		path         *ibctesting.Path
+		expCodespace string
+	)
+
+	testCases := []struct {
+		msg        string
+		malleate   func()
+		expPass    bool
+		expSuccess bool
+	}{
+		{
+			"success: membership proof",
+			func() {},
+			true, true,
+		},
+		{
+			"success: non-membership proof",
+			func() {
+				proof, proofHeight := path.EndpointB.QueryProof(host.ConnectionKey(ibctesting.InvalidID))
+				merklePath, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath(host.ConnectionPath(ibctesting.InvalidID)))
+				suite.Require().NoError(err)
+
+				req.Proof = proof
+				req.ProofHeight = proofHeight
+				req.MerklePath = merklePath
+				req.Value = nil
+			},
+			true, true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false, false,
+		},
+		{
+			"invalid client ID",
+			func() {
+				req.ClientId = ""
+			},
+			false, false,
+		},
+		{
+			"empty proof",
+			func() {
+				req.Proof = nil
+			},
+			false, false,
+		},
+		{
+			"zero proof height",
+			func() {
+				req.ProofHeight = types.ZeroHeight()
+			},
+			false, false,
+		},
+		{
+			"empty merkle path",
+			func() {
+				req.MerklePath = commitmenttypes.MerklePath{}
+			},
+			false, false,
+		},
+		{
+			"client not found",
+			func() {
+				req.ClientId = ibctesting.InvalidID
+			},
+			false, false,
+		},
+		{
+			"verification failed: value does not match",
+			func() {
+				req.Value = []byte("invalid value")
+				expCodespace = commitmenttypes.SubModuleName
+			},
+			true, false,
+		},
+		{
+			"verification failed: path does not match",
+			func() {
+				merklePath, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath(host.ConnectionPath(ibctesting.InvalidID)))
+				suite.Require().NoError(err)
+
+				req.MerklePath = merklePath
+				expCodespace = commitmenttypes.SubModuleName
+			},
+			true, false,
+		},
+		{
+			"verification failed: consensus state not found at proof height",
+			func() {
+				req.ProofHeight = req.ProofHeight.Increment().(types.Height)
+			},
+			true, false,
+		},
+		{
+			"verification failed: client is frozen",
+			func() {
+				clientState := path.EndpointA.GetClientState()
+				switch cs := clientState.(type) {
+				case *ibctmtypes.ClientState:
+					cs.FrozenHeight = types.NewHeight(0, 1)
+				case *ibcdmtypes.ClientState:
+					cs.FrozenHeight = types.NewHeight(0, 1)
+				}
+				path.EndpointA.SetClientState(clientState)
+
+				expCodespace = types.SubModuleName
+			},
+			true, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			expCodespace = ""
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			// prove the connection end stored on chainB
+			proof, proofHeight := path.EndpointB.QueryProof(host.ConnectionKey(path.EndpointB.ConnectionID))
+			merklePath, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath(host.ConnectionPath(path.EndpointB.ConnectionID)))
+			suite.Require().NoError(err)
+
+			connection := path.EndpointB.GetConnection()
+			value, err := suite.chainB.Codec.Marshal(&connection)
+			suite.Require().NoError(err)
+
+			req = &types.QueryVerifyProofRequest{
+				ClientId:    path.EndpointA.ClientID,
+				Proof:       proof,
+				ProofHeight: proofHeight,
+				MerklePath:  merklePath,
+				Value:       value,
+			}
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.QueryServer.VerifyProof(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(tc.expSuccess, res.Success)
+
+				if tc.expSuccess {
+					suite.Require().Empty(res.Error)
+				} else {
+					suite.Require().NotEmpty(res.Error)
+					suite.Require().NotZero(res.Code)
+					if expCodespace != "" {
+						suite.Require().Equal(expCodespace, res.Codespace)
+					}
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
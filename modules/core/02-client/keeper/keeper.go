@@ -5,12 +5,14 @@ import (
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
@@ -25,17 +27,20 @@ type Keeper struct {
 	paramSpace    paramtypes.Subspace
 	stakingKeeper types.StakingKeeper
 	upgradeKeeper types.UpgradeKeeper
+	lightClients  *types.LightClientRegistry
 	selfClient    exported.SelfClient
 	clientHooks   exported.ClientHooks
 }
 
-// NewKeeper creates a new NewKeeper instance
+// NewKeeper creates a new NewKeeper instance. Only clients of the types registered on the
+// light client registry can be created.
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	key sdk.StoreKey,
 	paramSpace paramtypes.Subspace,
 	sk types.StakingKeeper,
 	uk types.UpgradeKeeper,
+	lightClients *types.LightClientRegistry,
 	selfClient exported.SelfClient,
 	clientHooks exported.ClientHooks) Keeper {
 	// set KeyTable if it has not already been set
@@ -49,6 +54,7 @@ func NewKeeper(
 		paramSpace:    paramSpace,
 		stakingKeeper: sk,
 		upgradeKeeper: uk,
+		lightClients:  lightClients,
 		selfClient:    selfClient,
 		clientHooks:   clientHooks,
 	}
@@ -59,6 +65,19 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+host.ModuleName+"/"+types.SubModuleName)
 }
 
+// RegisterLightClientModule registers the light client module on the light client registry
+// of the keeper. It panics if the client type of the module is already registered. The
+// interfaces of the module are not registered on the codec of the keeper, applications
+// should rather register the module on the registry given to AppModuleBasic.
+func (k Keeper) RegisterLightClientModule(module types.LightClientModule) {
+	k.lightClients.Register(module)
+}
+
+// GetLightClientRegistry returns the light client registry of the keeper.
+func (k Keeper) GetLightClientRegistry() *types.LightClientRegistry {
+	return k.lightClients
+}
+
 // GenerateClientIdentifier returns the next client identifier.
 func (k Keeper) GenerateClientIdentifier(ctx sdk.Context, clientType string) string {
 	nextClientSeq := k.GetNextClientSequence(ctx)
@@ -171,7 +190,7 @@ func (k Keeper) GetAllClientMetadata(ctx sdk.Context, genClients []types.Identif
 		if err != nil {
 			return nil, err
 		}
-		gms := cs.ExportMetadata(k.ClientStore(ctx, ic.ClientId))
+		gms := cs.ExportMetadata(k.ReadOnlyClientStore(ctx, ic.ClientId))
 		if len(gms) == 0 {
 			continue
 		}
@@ -337,6 +356,49 @@ func (k Keeper) GetAllClients(ctx sdk.Context) (states []exported.ClientState) {
 // ClientStore returns isolated prefix store for each client so they can read/write in separate
 // namespace without being able to read/write other client's data
 func (k Keeper) ClientStore(ctx sdk.Context, clientID string) sdk.KVStore {
-	clientPrefix := []byte(fmt.Sprintf("%s/%s/", host.KeyClientStorePrefix, clientID))
-	return prefix.NewStore(ctx.KVStore(k.storeKey), clientPrefix)
+	return prefix.NewStore(ctx.KVStore(k.storeKey), clientStorePrefix(clientID))
+}
+
+// ReadOnlyClientStore returns the client store of the given client wrapped in a store
+// rejecting every write, except to the writable keys, with ErrClientStoreWrite. It must
+// be given to light clients in paths which are not expected to change the client.
+func (k Keeper) ReadOnlyClientStore(ctx sdk.Context, clientID string, writableKeys ...[]byte) sdk.KVStore {
+	return k.lightClientStore(clientID, types.NewReadOnlyStore(k.ClientStore(ctx, clientID), writableKeys...))
+}
+
+// ProvenClientStore returns the client store of a client proven on a counterparty chain,
+// whose client store is not available, as given to the light client of the given client.
+// The store only holds the proven client state and rejects every write.
+func (k Keeper) ProvenClientStore(clientID string, clientState exported.ClientState) sdk.KVStore {
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	store.Set(host.ClientStateKey(), k.MustMarshalClientState(clientState))
+
+	return k.lightClientStore(clientID, types.NewReadOnlyStore(store))
+}
+
+// trackingClientStore returns the client store of the given client wrapped in a store
+// recording the keys written by the light client.
+func (k Keeper) trackingClientStore(ctx sdk.Context, clientID string) *types.TrackingStore {
+	return types.NewTrackingStore(ctx.KVStore(k.storeKey), clientStorePrefix(clientID))
+}
+
+// lightClientStore returns the client store given to the light client of the given client,
+// as wrapped by the light client module of its client type.
+func (k Keeper) lightClientStore(clientID string, clientStore sdk.KVStore) sdk.KVStore {
+	clientType, _, err := types.ParseClientIdentifier(clientID)
+	if err != nil {
+		return clientStore
+	}
+
+	return k.lightClients.WrapClientStore(clientType, clientStore)
+}
+
+// logClientStoreWrites logs the keys of the client store written by the light client.
+func (k Keeper) logClientStoreWrites(ctx sdk.Context, clientID, action string, clientStore *types.TrackingStore) {
+	k.Logger(ctx).Debug("light client wrote to client store", "client-id", clientID, "action", action, "keys", clientStore.WrittenKeys())
+}
+
+// clientStorePrefix returns the prefix of the client store of the given client.
+func clientStorePrefix(clientID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", host.KeyClientStorePrefix, clientID))
 }
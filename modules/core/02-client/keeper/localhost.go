@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	localhosttypes "github.com/cosmos/ibc-go/v3/modules/light-clients/09-localhost/types"
+)
+
+// CreateLocalhostClient creates the localhost client, tracking the running chain at its
+// current height. The localhost client uses the 09-localhost client identifier and must
+// be allowed by the client params.
+func (k Keeper) CreateLocalhostClient(ctx sdk.Context) error {
+	if !k.GetParams(ctx).IsAllowedClient(exported.Localhost) {
+		return sdkerrors.Wrap(types.ErrInvalidClientType, "localhost client is not registered in the allowlist")
+	}
+
+	if _, found := k.GetClientState(ctx, exported.Localhost); found {
+		return sdkerrors.Wrap(types.ErrClientExists, exported.Localhost)
+	}
+
+	clientState := localhosttypes.NewClientState(ctx.ChainID(), types.GetSelfHeight(ctx))
+	k.SetClientState(ctx, exported.Localhost, clientState)
+
+	k.Logger(ctx).Info("localhost client created at height", "height", clientState.GetLatestHeight().String())
+
+	EmitCreateClientEvent(ctx, exported.Localhost, clientState)
+
+	return nil
+}
+
+// UpdateLocalhostClient sets the latest height of the localhost client to the height of
+// the running chain. It is called on every BeginBlock and returns false if the localhost
+// client does not exist.
+func (k Keeper) UpdateLocalhostClient(ctx sdk.Context) bool {
+	if _, found := k.GetClientState(ctx, exported.Localhost); !found {
+		return false
+	}
+
+	k.SetClientState(ctx, exported.Localhost, localhosttypes.NewClientState(ctx.ChainID(), types.GetSelfHeight(ctx)))
+
+	return true
+}
@@ -8,6 +8,8 @@ import (
 
 	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibcdmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/01-dymint/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
 )
 
 // ClientUpdateProposal will retrieve the subject and substitute client.
@@ -17,8 +19,9 @@ import (
 // client implementations are responsible for validating the parameters of the
 // subtitute (enusring they match the subject's parameters) as well as copying
 // the necessary consensus states from the subtitute to the subject client
-// store. The substitute must be Active and the subject must not be Active.
-func (k Keeper) ClientUpdateProposal(ctx sdk.Context, p *types.ClientUpdateProposal) error {
+// store. The substitute must be Active and the subject must not be Active. The
+// substitute client store is read-only.
+func (k Keeper) ClientUpdateProposal(ctx sdk.Context, p *types.ClientUpdateProposal) (err error) {
 	if p.SubjectClientId == exported.Localhost || p.SubstituteClientId == exported.Localhost {
 		return sdkerrors.Wrap(types.ErrInvalidUpdateClientProposal, "cannot update localhost client with proposal")
 	}
@@ -28,9 +31,7 @@ func (k Keeper) ClientUpdateProposal(ctx sdk.Context, p *types.ClientUpdatePropo
 		return sdkerrors.Wrapf(types.ErrClientNotFound, "subject client with ID %s", p.SubjectClientId)
 	}
 
-	subjectClientStore := k.ClientStore(ctx, p.SubjectClientId)
-
-	if status := subjectClientState.Status(ctx, subjectClientStore, k.cdc); status == exported.Active {
+	if status := subjectClientState.Status(ctx, k.ReadOnlyClientStore(ctx, p.SubjectClientId), k.cdc); status == exported.Active {
 		return sdkerrors.Wrap(types.ErrInvalidUpdateClientProposal, "cannot update Active subject client")
 	}
 
@@ -43,16 +44,20 @@ func (k Keeper) ClientUpdateProposal(ctx sdk.Context, p *types.ClientUpdatePropo
 		return sdkerrors.Wrapf(types.ErrInvalidHeight, "subject client state latest height is greater or equal to substitute client state latest height (%s >= %s)", subjectClientState.GetLatestHeight(), substituteClientState.GetLatestHeight())
 	}
 
-	substituteClientStore := k.ClientStore(ctx, p.SubstituteClientId)
+	substituteClientStore := k.ReadOnlyClientStore(ctx, p.SubstituteClientId)
 
 	if status := substituteClientState.Status(ctx, substituteClientStore, k.cdc); status != exported.Active {
 		return sdkerrors.Wrapf(types.ErrClientNotActive, "substitute client is not Active, status is %s", status)
 	}
 
-	clientState, err := subjectClientState.CheckSubstituteAndUpdateState(ctx, k.cdc, subjectClientStore, substituteClientStore, substituteClientState)
+	defer types.RecoverClientStoreWrite(&err)
+
+	subjectClientStore := k.trackingClientStore(ctx, p.SubjectClientId)
+	clientState, err := subjectClientState.CheckSubstituteAndUpdateState(ctx, k.cdc, k.lightClientStore(p.SubjectClientId, subjectClientStore), substituteClientStore, substituteClientState)
 	if err != nil {
 		return err
 	}
+	k.logClientStoreWrites(ctx, p.SubjectClientId, "proposal", subjectClientStore)
 	k.SetClientState(ctx, p.SubjectClientId, clientState)
 
 	k.Logger(ctx).Info("client updated after governance proposal passed", "client-id", p.SubjectClientId, "height", clientState.GetLatestHeight().String())
@@ -75,6 +80,56 @@ func (k Keeper) ClientUpdateProposal(ctx sdk.Context, p *types.ClientUpdatePropo
 	return nil
 }
 
+// ClientMigrationProposal will convert the 07-tendermint client referenced by the proposal
+// to the 01-dymint client type in place. The client state and all consensus states are
+// migrated while the consensus metadata is kept, so connections and channels built on the
+// client identifier keep working. The dymint client type must be allowed by the client params.
+func (k Keeper) ClientMigrationProposal(ctx sdk.Context, p *types.ClientMigrationProposal) error {
+	clientState, found := k.GetClientState(ctx, p.ClientId)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrClientNotFound, "client with ID %s", p.ClientId)
+	}
+
+	tmClientState, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrInvalidClientMigrationProposal, "expected client state type %T, got %T", &ibctmtypes.ClientState{}, clientState)
+	}
+
+	if !k.GetParams(ctx).IsAllowedClient(exported.Dymint) {
+		return sdkerrors.Wrapf(types.ErrInvalidClientType, "client state type %s is not registered in the allowlist", exported.Dymint)
+	}
+
+	if !k.lightClients.HasClientType(exported.Dymint) {
+		return sdkerrors.Wrapf(types.ErrInvalidClientType, "light client module for client state type %s is not registered", exported.Dymint)
+	}
+
+	clientStore := k.trackingClientStore(ctx, p.ClientId)
+	migratedClientState, err := ibcdmtypes.MigrateFromTendermint(clientStore, k.cdc, tmClientState)
+	if err != nil {
+		return err
+	}
+	k.logClientStoreWrites(ctx, p.ClientId, "migrate", clientStore)
+	k.SetClientState(ctx, p.ClientId, migratedClientState)
+
+	k.Logger(ctx).Info("client migrated after governance proposal passed", "client-id", p.ClientId, "client-type", migratedClientState.ClientType())
+
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			[]string{"ibc", "client", "migrate"},
+			1,
+			[]metrics.Label{
+				telemetry.NewLabel(types.LabelClientType, migratedClientState.ClientType()),
+				telemetry.NewLabel(types.LabelClientID, p.ClientId),
+			},
+		)
+	}()
+
+	// emitting events in the keeper for proposal migrations of clients
+	EmitMigrateClientProposalEvent(ctx, p.ClientId, migratedClientState)
+
+	return nil
+}
+
 // HandleUpgradeProposal sets the upgraded client state in the upgrade store. It clears
 // an IBC client state and consensus state if a previous plan was set. Then  it
 // will schedule an upgrade and finally set the upgraded client state in upgrade
@@ -5,7 +5,9 @@ import (
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibcdmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/01-dymint/types"
 	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
@@ -276,3 +278,103 @@ func (suite *KeeperTestSuite) TestHandleUpgradeProposal() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestClientMigrationProposal() {
+	var (
+		path    *ibctesting.Path
+		content govtypes.Content
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"valid client migration proposal", func() {}, true,
+		},
+		{
+			"client not found", func() {
+				content = types.NewClientMigrationProposal(ibctesting.Title, ibctesting.Description, ibctesting.InvalidID)
+			}, false,
+		},
+		{
+			"client is not a tendermint client", func() {
+				err := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientMigrationProposal(suite.chainA.GetContext(), content.(*types.ClientMigrationProposal))
+				suite.Require().NoError(err)
+			}, false,
+		},
+		{
+			"dymint client type is not allowed", func() {
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(exported.Tendermint))
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			// send a packet from chainB, updating the client on chainA before it is migrated
+			timeoutHeight := types.NewHeight(0, 110)
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, 1, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, timeoutHeight, 0)
+			err := path.EndpointB.SendPacket(packet)
+			suite.Require().NoError(err)
+
+			tmClientState := path.EndpointA.GetClientState().(*ibctmtypes.ClientState)
+			consensusStates := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetAllConsensusStates(suite.chainA.GetContext())
+
+			content = types.NewClientMigrationProposal(ibctesting.Title, ibctesting.Description, path.EndpointA.ClientID)
+
+			tc.malleate()
+
+			migrationProp, ok := content.(*types.ClientMigrationProposal)
+			suite.Require().True(ok)
+			err = suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientMigrationProposal(suite.chainA.GetContext(), migrationProp)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				clientState, ok := path.EndpointA.GetClientState().(*ibcdmtypes.ClientState)
+				suite.Require().True(ok)
+				suite.Require().Equal(tmClientState.ChainId, clientState.ChainId)
+				suite.Require().Equal(tmClientState.LatestHeight, clientState.LatestHeight)
+				suite.Require().Equal(tmClientState.ProofSpecs, clientState.ProofSpecs)
+
+				clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+				for _, clientConsensusStates := range consensusStates {
+					if clientConsensusStates.ClientId != path.EndpointA.ClientID {
+						continue
+					}
+
+					for _, cs := range clientConsensusStates.ConsensusStates {
+						tmConsensusState := cs.ConsensusState.GetCachedValue().(*ibctmtypes.ConsensusState)
+
+						consensusState, err := ibcdmtypes.GetConsensusState(clientStore, suite.chainA.App.AppCodec(), cs.Height)
+						suite.Require().NoError(err)
+						suite.Require().Equal(tmConsensusState.Timestamp, consensusState.Timestamp)
+						suite.Require().Equal(tmConsensusState.Root, consensusState.Root)
+						suite.Require().Equal(tmConsensusState.NextValidatorsHash, consensusState.NextValidatorsHash)
+
+						_, found := ibcdmtypes.GetProcessedTime(clientStore, cs.Height)
+						suite.Require().True(found)
+						_, found = ibcdmtypes.GetProcessedHeight(clientStore, cs.Height)
+						suite.Require().True(found)
+						suite.Require().NotNil(ibcdmtypes.GetIterationKey(clientStore, cs.Height))
+					}
+				}
+
+				// the channel built on the migrated client keeps working
+				err = path.EndpointA.RecvPacket(packet)
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
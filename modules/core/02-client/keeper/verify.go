@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// VerifyMembership verifies a proof of the existence of a value at the given path in the
+// counterparty state tracked by the given client, at the given height. The path must be
+// the full commitment path, including the counterparty commitment prefix. The client must
+// be active.
+func (k Keeper) VerifyMembership(
+	ctx sdk.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+	value []byte,
+) (err error) {
+	defer types.RecoverClientStoreWrite(&err)
+
+	clientState, clientStore, err := k.getActiveClient(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	return clientState.VerifyMembership(ctx, clientStore, k.cdc, height, delayTimePeriod, delayBlockPeriod, proof, path, value)
+}
+
+// VerifyNonMembership verifies a proof of the absence of the given path in the counterparty
+// state tracked by the given client, at the given height. The path must be the full
+// commitment path, including the counterparty commitment prefix. The client must be active.
+func (k Keeper) VerifyNonMembership(
+	ctx sdk.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+) (err error) {
+	defer types.RecoverClientStoreWrite(&err)
+
+	clientState, clientStore, err := k.getActiveClient(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	return clientState.VerifyNonMembership(ctx, clientStore, k.cdc, height, delayTimePeriod, delayBlockPeriod, proof, path)
+}
+
+// VerifyMemberships verifies a proof of the existence of each value at the corresponding path
+// in the counterparty state tracked by the given client, at the given height. The proofs are
+// verified one by one, but the client is only loaded once. The paths must be full commitment
+// paths, including the counterparty commitment prefix. The client must be active.
+func (k Keeper) VerifyMemberships(
+	ctx sdk.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proofs [][]byte,
+	paths []exported.Path,
+	values [][]byte,
+) (err error) {
+	defer types.RecoverClientStoreWrite(&err)
+
+	if len(proofs) != len(paths) || len(values) != len(paths) {
+		return sdkerrors.Wrapf(
+			types.ErrFailedMembershipVerification,
+			"number of proofs %d and values %d not same as number of paths %d", len(proofs), len(values), len(paths),
+		)
+	}
+
+	clientState, clientStore, err := k.getActiveClient(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	for i := range paths {
+		if err := clientState.VerifyMembership(ctx, clientStore, k.cdc, height, delayTimePeriod, delayBlockPeriod, proofs[i], paths[i], values[i]); err != nil {
+			return sdkerrors.Wrapf(err, "proof at index %d", i)
+		}
+	}
+
+	return nil
+}
+
+// VerifyBatchMembership verifies a batch proof of the existence of each value at the
+// corresponding path in the counterparty state tracked by the given client, at the given
+// height. The paths must be full commitment paths sharing the counterparty commitment
+// prefix. The client must be active and implement exported.BatchVerificationClientState.
+func (k Keeper) VerifyBatchMembership(
+	ctx sdk.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	paths []exported.Path,
+	values [][]byte,
+) (err error) {
+	defer types.RecoverClientStoreWrite(&err)
+
+	clientState, clientStore, err := k.getActiveBatchClient(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	return clientState.VerifyBatchMembership(ctx, clientStore, k.cdc, height, delayTimePeriod, delayBlockPeriod, proof, paths, values)
+}
+
+// VerifyBatchNonMembership verifies a batch proof of the absence of each path in the
+// counterparty state tracked by the given client, at the given height. The paths must be
+// full commitment paths sharing the counterparty commitment prefix. The client must be
+// active and implement exported.BatchVerificationClientState.
+func (k Keeper) VerifyBatchNonMembership(
+	ctx sdk.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	paths []exported.Path,
+) (err error) {
+	defer types.RecoverClientStoreWrite(&err)
+
+	clientState, clientStore, err := k.getActiveBatchClient(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	return clientState.VerifyBatchNonMembership(ctx, clientStore, k.cdc, height, delayTimePeriod, delayBlockPeriod, proof, paths)
+}
+
+// getActiveBatchClient returns the client state and the verification store of an active
+// client which supports batch proof verification.
+func (k Keeper) getActiveBatchClient(ctx sdk.Context, clientID string) (exported.BatchVerificationClientState, sdk.KVStore, error) {
+	clientState, clientStore, err := k.getActiveClient(ctx, clientID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	batchClientState, ok := clientState.(exported.BatchVerificationClientState)
+	if !ok {
+		return nil, nil, sdkerrors.Wrapf(types.ErrBatchVerificationNotSupported, "client (%s) of type %s", clientID, clientState.ClientType())
+	}
+
+	return batchClientState, clientStore, nil
+}
+
+// getActiveClient returns the client state and the read-only store used for verification of
+// an active client. The localhost client verifies the local IBC store, other clients their
+// client store. Solo machines increment their sequence on verification, thus the client
+// state key of their client store stays writable. It is read-only for all other clients.
+func (k Keeper) getActiveClient(ctx sdk.Context, clientID string) (exported.ClientState, sdk.KVStore, error) {
+	clientState, found := k.GetClientState(ctx, clientID)
+	if !found {
+		return nil, nil, sdkerrors.Wrap(types.ErrClientNotFound, clientID)
+	}
+
+	var writableKeys [][]byte
+	if clientState.ClientType() == exported.Solomachine {
+		writableKeys = append(writableKeys, host.ClientStateKey())
+	}
+
+	clientStore := k.ReadOnlyClientStore(ctx, clientID, writableKeys...)
+	if clientID == exported.Localhost {
+		clientStore = types.NewReadOnlyStore(ctx.KVStore(k.storeKey))
+	}
+
+	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
+		return nil, nil, sdkerrors.Wrapf(types.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	return clientState, clientStore, nil
+}
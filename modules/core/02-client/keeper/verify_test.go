@@ -0,0 +1,556 @@
+package keeper_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	solomachinetypes "github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+	wasmtesting "github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/testing"
+	wasmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestVerifyMembership() {
+	var (
+		path     *ibctesting.Path
+		clientID string
+		proof    []byte
+		value    []byte
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"client not found", func() {
+				clientID = ibctesting.InvalidID
+			}, false,
+		},
+		{
+			"client not active", func() {
+				clientState := path.EndpointA.GetClientState().(*ibctmtypes.ClientState)
+				clientState.FrozenHeight = types.NewHeight(0, 1)
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), path.EndpointA.ClientID, clientState)
+			}, false,
+		},
+		{
+			"value does not match", func() {
+				value = []byte("invalid value")
+			}, false,
+		},
+		{
+			"invalid proof", func() {
+				proof = []byte("invalid proof")
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+			clientID = path.EndpointA.ClientID
+
+			// prove the connection end stored on chainB
+			var proofHeight exported.Height
+			proof, proofHeight = path.EndpointB.QueryProof(host.ConnectionKey(path.EndpointB.ConnectionID))
+
+			merklePath, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath(host.ConnectionPath(path.EndpointB.ConnectionID)))
+			suite.Require().NoError(err)
+
+			connection := path.EndpointB.GetConnection()
+			value, err = suite.chainB.Codec.Marshal(&connection)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			err = suite.chainA.App.GetIBCKeeper().ClientKeeper.VerifyMembership(
+				suite.chainA.GetContext(), clientID, proofHeight, 0, 0, proof, merklePath, value,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestVerifyNonMembership() {
+	var (
+		path     *ibctesting.Path
+		clientID string
+		proof    []byte
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"client not found", func() {
+				clientID = ibctesting.InvalidID
+			}, false,
+		},
+		{
+			"client not active", func() {
+				clientState := path.EndpointA.GetClientState().(*ibctmtypes.ClientState)
+				clientState.FrozenHeight = types.NewHeight(0, 1)
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), path.EndpointA.ClientID, clientState)
+			}, false,
+		},
+		{
+			"invalid proof", func() {
+				proof = []byte("invalid proof")
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+			clientID = path.EndpointA.ClientID
+
+			// prove the absence of a connection which does not exist on chainB
+			var proofHeight exported.Height
+			proof, proofHeight = path.EndpointB.QueryProof(host.ConnectionKey(ibctesting.InvalidID))
+
+			merklePath, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath(host.ConnectionPath(ibctesting.InvalidID)))
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			err = suite.chainA.App.GetIBCKeeper().ClientKeeper.VerifyNonMembership(
+				suite.chainA.GetContext(), clientID, proofHeight, 0, 0, proof, merklePath,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestVerifyBatchMembership() {
+	var (
+		path     *ibctesting.Path
+		clientID string
+		proof    []byte
+		values   [][]byte
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"client not found", func() {
+				clientID = ibctesting.InvalidID
+			}, false,
+		},
+		{
+			"client not active", func() {
+				clientState := path.EndpointA.GetClientState().(*ibctmtypes.ClientState)
+				clientState.FrozenHeight = types.NewHeight(0, 1)
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), path.EndpointA.ClientID, clientState)
+			}, false,
+		},
+		{
+			"client does not support batch verification", func() {
+				solomachine := ibctesting.NewSolomachine(suite.T(), suite.cdc, "solo machine", "", 1)
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), path.EndpointA.ClientID, solomachine.ClientState())
+			}, false,
+		},
+		{
+			"value does not match", func() {
+				values[1] = []byte("invalid value")
+			}, false,
+		},
+		{
+			"invalid proof", func() {
+				proof = []byte("invalid proof")
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+			clientID = path.EndpointA.ClientID
+
+			// prove the connection end and the client state stored on chainB with a single proof
+			var proofHeight exported.Height
+			proof, proofHeight = path.EndpointB.QueryProofs(
+				host.ConnectionKey(path.EndpointB.ConnectionID),
+				host.FullClientStateKey(path.EndpointB.ClientID),
+			)
+
+			connectionPath, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath(host.ConnectionPath(path.EndpointB.ConnectionID)))
+			suite.Require().NoError(err)
+			clientStatePath, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath(host.FullClientStatePath(path.EndpointB.ClientID)))
+			suite.Require().NoError(err)
+
+			connection := path.EndpointB.GetConnection()
+			connectionBz, err := suite.chainB.Codec.Marshal(&connection)
+			suite.Require().NoError(err)
+			clientStateBz, err := types.MarshalClientState(suite.chainB.Codec, path.EndpointB.GetClientState())
+			suite.Require().NoError(err)
+
+			values = [][]byte{connectionBz, clientStateBz}
+
+			tc.malleate()
+
+			err = suite.chainA.App.GetIBCKeeper().ClientKeeper.VerifyBatchMembership(
+				suite.chainA.GetContext(), clientID, proofHeight, 0, 0, proof, []exported.Path{connectionPath, clientStatePath}, values,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestVerifyBatchNonMembership() {
+	var (
+		path     *ibctesting.Path
+		clientID string
+		proof    []byte
+		paths    []exported.Path
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"client not found", func() {
+				clientID = ibctesting.InvalidID
+			}, false,
+		},
+		{
+			"client does not support batch verification", func() {
+				solomachine := ibctesting.NewSolomachine(suite.T(), suite.cdc, "solo machine", "", 1)
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), path.EndpointA.ClientID, solomachine.ClientState())
+			}, false,
+		},
+		{
+			"path exists", func() {
+				connectionPath, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath(host.ConnectionPath(path.EndpointB.ConnectionID)))
+				suite.Require().NoError(err)
+
+				paths[1] = connectionPath
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+			clientID = path.EndpointA.ClientID
+
+			// prove the absence of connections which do not exist on chainB with a single proof
+			connectionIDs := []string{ibctesting.InvalidID, "connection-100"}
+
+			keys := make([][]byte, len(connectionIDs))
+			paths = make([]exported.Path, len(connectionIDs))
+			for i, connectionID := range connectionIDs {
+				keys[i] = host.ConnectionKey(connectionID)
+
+				merklePath, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath(host.ConnectionPath(connectionID)))
+				suite.Require().NoError(err)
+				paths[i] = merklePath
+			}
+
+			var proofHeight exported.Height
+			proof, proofHeight = path.EndpointB.QueryProofs(keys...)
+
+			tc.malleate()
+
+			err := suite.chainA.App.GetIBCKeeper().ClientKeeper.VerifyBatchNonMembership(
+				suite.chainA.GetContext(), clientID, proofHeight, 0, 0, proof, paths,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+var wasmHeight = types.NewHeight(0, 10)
+
+// TestVerifyMembershipReadOnlyClientStore verifies that verification leaves the IBC store
+// unchanged for all in-tree clients, except for the sequence of solo machines.
+func (suite *KeeperTestSuite) TestVerifyMembershipReadOnlyClientStore() {
+	var (
+		clientID    string
+		proofHeight exported.Height
+		proof       []byte
+		merklePath  exported.Path
+		value       []byte
+		writtenKeys []string
+	)
+
+	// proveConnection sets up a client on chainA tracking chainB and proves a connection end of chainB.
+	proveConnection := func() {
+		path := ibctesting.NewPath(suite.chainA, suite.chainB)
+		suite.coordinator.SetupConnections(path)
+		clientID = path.EndpointA.ClientID
+
+		proof, proofHeight = path.EndpointB.QueryProof(host.ConnectionKey(path.EndpointB.ConnectionID))
+
+		var err error
+		merklePath, err = commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath(host.ConnectionPath(path.EndpointB.ConnectionID)))
+		suite.Require().NoError(err)
+
+		connection := path.EndpointB.GetConnection()
+		value, err = suite.chainB.Codec.Marshal(&connection)
+		suite.Require().NoError(err)
+	}
+
+	testCases := []struct {
+		msg   string
+		setup func()
+	}{
+		{
+			exported.Tendermint, proveConnection,
+		},
+		{
+			exported.Dymint, func() {
+				suite.coordinator = ibctesting.NewCoordinatorWithConsensusType(suite.T(), []string{exported.Tendermint, exported.Dymint})
+				suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+				suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+				proveConnection()
+			},
+		},
+		{
+			exported.Solomachine, func() {
+				solomachine := ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, "solo machine", "", 1)
+
+				var err error
+				clientID, err = suite.chainA.App.GetIBCKeeper().ClientKeeper.CreateClient(suite.chainA.GetContext(), solomachine.ClientState(), solomachine.ConsensusState())
+				suite.Require().NoError(err)
+
+				path := solomachine.GetConnectionStatePath(ibctesting.FirstConnectionID)
+				value = []byte("connection")
+				proof = solomachine.GenerateProof(solomachinetypes.MEMBERSHIP, path, value)
+				proofHeight = solomachine.GetHeight()
+				merklePath = path
+
+				// verification increments the sequence of the client state
+				writtenKeys = []string{string(host.FullClientStateKey(clientID))}
+			},
+		},
+		{
+			exported.Wasm, func() {
+				var engine *wasmtesting.MockWasmEngine
+				clientID, engine = suite.createWasmClient()
+
+				engine.RegisterSudoCallback("verify_membership", func(_ []byte, _ wasmtypes.Env, _ []byte, _ sdk.KVStore, _ sdk.GasMeter) ([]byte, error) {
+					return nil, nil
+				})
+
+				proof = []byte("proof")
+				proofHeight = wasmHeight
+
+				var err error
+				merklePath, err = commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath("key"))
+				suite.Require().NoError(err)
+
+				value = []byte("value")
+			},
+		},
+		{
+			exported.Localhost, func() {
+				clientID = exported.Localhost
+				proofHeight = types.GetSelfHeight(suite.chainA.GetContext())
+
+				var err error
+				merklePath, err = commitmenttypes.ApplyPrefix(suite.chainA.GetPrefix(), commitmenttypes.NewMerklePath(host.FullClientStatePath(exported.Localhost)))
+				suite.Require().NoError(err)
+
+				value = suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(host.StoreKey)).Get(host.FullClientStateKey(exported.Localhost))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			writtenKeys = nil
+
+			tc.setup()
+
+			ctx := suite.chainA.GetContext()
+			clientState, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientState(ctx, clientID)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.msg, clientState.ClientType())
+
+			before := suite.ibcStoreSnapshot(ctx)
+
+			err := suite.chainA.App.GetIBCKeeper().ClientKeeper.VerifyMembership(ctx, clientID, proofHeight, 0, 0, proof, merklePath, value)
+			suite.Require().NoError(err)
+
+			suite.Require().Equal(writtenKeys, changedKeys(before, suite.ibcStoreSnapshot(ctx)))
+		})
+	}
+}
+
+// TestVerifyMembershipClientStateWrite verifies that clients other than solo machines cannot
+// write their client state on verification.
+func (suite *KeeperTestSuite) TestVerifyMembershipClientStateWrite() {
+	clientID, engine := suite.createWasmClient()
+
+	engine.RegisterSudoCallback("verify_membership", func(_ []byte, _ wasmtypes.Env, _ []byte, store sdk.KVStore, _ sdk.GasMeter) ([]byte, error) {
+		store.Set(host.ClientStateKey(), []byte("client state"))
+		return nil, nil
+	})
+
+	ctx := suite.chainA.GetContext()
+	merklePath, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath("key"))
+	suite.Require().NoError(err)
+
+	before := suite.ibcStoreSnapshot(ctx)
+
+	err = suite.chainA.App.GetIBCKeeper().ClientKeeper.VerifyMembership(ctx, clientID, wasmHeight, 0, 0, []byte("proof"), merklePath, []byte("value"))
+	suite.Require().ErrorIs(err, types.ErrClientStoreWrite)
+	suite.Require().Empty(changedKeys(before, suite.ibcStoreSnapshot(ctx)))
+}
+
+// TestProvenClientStore verifies that the light client of a client proven on a counterparty
+// chain is given a client store wrapped by its light client module and holding the proven
+// client state.
+func (suite *KeeperTestSuite) TestProvenClientStore() {
+	clientID, engine := suite.createWasmClient()
+
+	engine.RegisterQueryCallback("status", func(_ []byte, _ wasmtypes.Env, _ []byte, store sdk.KVStore, _ sdk.GasMeter) ([]byte, error) {
+		if store.Get(host.ClientStateKey()) == nil {
+			return json.Marshal(wasmtypes.StatusResult{Status: exported.Active})
+		}
+		return json.Marshal(wasmtypes.StatusResult{Status: exported.Frozen})
+	})
+
+	ctx := suite.chainA.GetContext()
+	clientState := wasmtypes.NewClientState([]byte("proven client state"), wasmtesting.Checksum, wasmHeight)
+	clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ProvenClientStore(clientID, clientState)
+
+	suite.Require().Equal(suite.chainA.App.GetIBCKeeper().ClientKeeper.MustMarshalClientState(clientState), clientStore.Get(host.ClientStateKey()))
+	suite.Require().Equal(exported.Frozen, clientState.Status(ctx, clientStore, suite.chainA.App.AppCodec()))
+	suite.Require().Panics(func() {
+		clientStore.Set(host.ClientStateKey(), []byte("client state"))
+	})
+}
+
+// createWasmClient creates an active wasm client on chainA at wasmHeight and returns its
+// identifier along with the mock wasm engine executing its contract.
+func (suite *KeeperTestSuite) createWasmClient() (string, *wasmtesting.MockWasmEngine) {
+	app := suite.chainA.GetSimApp()
+	ctx := suite.chainA.GetContext()
+
+	engine := app.WasmClientKeeper.GetWasmEngine().(*wasmtesting.MockWasmEngine)
+	engine.RegisterQueryCallback("status", func(_ []byte, _ wasmtypes.Env, _ []byte, _ sdk.KVStore, _ sdk.GasMeter) ([]byte, error) {
+		return json.Marshal(wasmtypes.StatusResult{Status: exported.Active})
+	})
+
+	_, err := app.WasmClientKeeper.StoreCode(sdk.WrapSDKContext(ctx), wasmtypes.NewMsgStoreCode(app.WasmClientKeeper.GetAuthority(), wasmtesting.Code))
+	suite.Require().NoError(err)
+
+	params := app.IBCKeeper.ClientKeeper.GetParams(ctx)
+	params.AllowedClients = append(params.AllowedClients, exported.Wasm)
+	app.IBCKeeper.ClientKeeper.SetParams(ctx, params)
+
+	clientState := wasmtypes.NewClientState([]byte("client state"), wasmtesting.Checksum, wasmHeight)
+	consensusState := wasmtypes.NewConsensusState([]byte("consensus state"), uint64(ctx.BlockTime().UnixNano()))
+
+	clientID, err := app.IBCKeeper.ClientKeeper.CreateClient(ctx, clientState, consensusState)
+	suite.Require().NoError(err)
+
+	return clientID, engine
+}
+
+// ibcStoreSnapshot returns all entries of the IBC store of chainA.
+func (suite *KeeperTestSuite) ibcStoreSnapshot(ctx sdk.Context) map[string][]byte {
+	snapshot := make(map[string][]byte)
+
+	iterator := ctx.KVStore(suite.chainA.GetSimApp().GetKey(host.StoreKey)).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		snapshot[string(iterator.Key())] = iterator.Value()
+	}
+
+	return snapshot
+}
+
+// changedKeys returns the sorted keys which were set, changed or deleted between both snapshots.
+func changedKeys(before, after map[string][]byte) []string {
+	var keys []string
+	for key, value := range after {
+		if !bytes.Equal(before[key], value) {
+			keys = append(keys, key)
+		}
+	}
+
+	for key := range before {
+		if _, found := after[key]; !found {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+	return keys
+}
@@ -123,6 +123,22 @@ func (cs ClientState) VerifyUpgradeAndUpdateState(
 	panic("legacy solo machine is deprecated!")
 }
 
+// VerifyMembership panics!
+func (cs ClientState) VerifyMembership(
+	_ sdk.Context, _ sdk.KVStore, _ codec.BinaryCodec,
+	_ exported.Height, _ uint64, _ uint64, _ []byte, _ exported.Path, _ []byte,
+) error {
+	panic("legacy solo machine is deprecated!")
+}
+
+// VerifyNonMembership panics!
+func (cs ClientState) VerifyNonMembership(
+	_ sdk.Context, _ sdk.KVStore, _ codec.BinaryCodec,
+	_ exported.Height, _ uint64, _ uint64, _ []byte, _ exported.Path,
+) error {
+	panic("legacy solo machine is deprecated!")
+}
+
 // VerifyClientState panics!
 func (cs ClientState) VerifyClientState(
 	store sdk.KVStore, cdc codec.BinaryCodec,
@@ -192,6 +208,15 @@ func (cs ClientState) VerifyNextSequenceRecv(
 	panic("legacy solo machine is deprecated!")
 }
 
+// VerifyNextSequenceAck panics!
+func (cs ClientState) VerifyNextSequenceAck(
+	sdk.Context, sdk.KVStore, codec.BinaryCodec, exported.Height,
+	uint64, uint64, exported.Prefix, []byte,
+	string, string, uint64,
+) error {
+	panic("legacy solo machine is deprecated!")
+}
+
 // ClientType panics!
 func (ConsensusState) ClientType() string {
 	panic("legacy solo machine is deprecated!")
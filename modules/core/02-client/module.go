@@ -14,13 +14,13 @@ func Name() string {
 }
 
 // GetQueryCmd returns no root query command for the IBC client
-func GetQueryCmd() *cobra.Command {
-	return cli.GetQueryCmd()
+func GetQueryCmd(lightClientCmds ...*cobra.Command) *cobra.Command {
+	return cli.GetQueryCmd(lightClientCmds...)
 }
 
 // GetTxCmd returns the root tx command for 02-client.
-func GetTxCmd() *cobra.Command {
-	return cli.NewTxCmd()
+func GetTxCmd(lightClientCmds ...*cobra.Command) *cobra.Command {
+	return cli.NewTxCmd(lightClientCmds...)
 }
 
 // RegisterQueryService registers the gRPC query service for IBC client.
@@ -17,6 +17,8 @@ func NewClientProposalHandler(k keeper.Keeper) govtypes.Handler {
 			return k.ClientUpdateProposal(ctx, c)
 		case *types.UpgradeProposal:
 			return k.HandleUpgradeProposal(ctx, c)
+		case *types.ClientMigrationProposal:
+			return k.ClientMigrationProposal(ctx, c)
 
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized ibc proposal content type: %T", c)
@@ -290,6 +290,53 @@ func (m *UpgradeProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpgradeProposal proto.InternalMessageInfo
 
+// ClientMigrationProposal is a gov Content type for migrating a 07-tendermint
+// client to the 01-dymint client type in place. If it passes, the client state
+// and every consensus state stored under the client identifier are converted
+// while the client identifier, and therefore the connections and channels built
+// on it, are kept.
+type ClientMigrationProposal struct {
+	// the title of the migration proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// the client identifier for the client to be migrated if the proposal passes
+	ClientId string `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty" yaml:"client_id"`
+}
+
+func (m *ClientMigrationProposal) Reset()         { *m = ClientMigrationProposal{} }
+func (m *ClientMigrationProposal) String() string { return proto.CompactTextString(m) }
+func (*ClientMigrationProposal) ProtoMessage()    {}
+func (*ClientMigrationProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6bc4c8185546947, []int{5}
+}
+func (m *ClientMigrationProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientMigrationProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientMigrationProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClientMigrationProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientMigrationProposal.Merge(m, src)
+}
+func (m *ClientMigrationProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientMigrationProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientMigrationProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientMigrationProposal proto.InternalMessageInfo
+
 // Height is a monotonically increasing data type
 // that can be compared against another Height for the purposes of updating and
 // freezing clients
@@ -310,7 +357,7 @@ type Height struct {
 func (m *Height) Reset()      { *m = Height{} }
 func (*Height) ProtoMessage() {}
 func (*Height) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6bc4c8185546947, []int{5}
+	return fileDescriptor_b6bc4c8185546947, []int{6}
 }
 func (m *Height) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -349,7 +396,7 @@ func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6bc4c8185546947, []int{6}
+	return fileDescriptor_b6bc4c8185546947, []int{7}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -391,6 +438,7 @@ func init() {
 	proto.RegisterType((*ClientConsensusStates)(nil), "ibc.core.client.v1.ClientConsensusStates")
 	proto.RegisterType((*ClientUpdateProposal)(nil), "ibc.core.client.v1.ClientUpdateProposal")
 	proto.RegisterType((*UpgradeProposal)(nil), "ibc.core.client.v1.UpgradeProposal")
+	proto.RegisterType((*ClientMigrationProposal)(nil), "ibc.core.client.v1.ClientMigrationProposal")
 	proto.RegisterType((*Height)(nil), "ibc.core.client.v1.Height")
 	proto.RegisterType((*Params)(nil), "ibc.core.client.v1.Params")
 }
@@ -398,53 +446,55 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/client/v1/client.proto", fileDescriptor_b6bc4c8185546947) }
 
 var fileDescriptor_b6bc4c8185546947 = []byte{
-	// 734 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xbd, 0x6e, 0x13, 0x4b,
-	0x18, 0xf5, 0x3a, 0xbe, 0x56, 0x3c, 0xbe, 0x8a, 0x73, 0x37, 0xce, 0x8d, 0xaf, 0x6f, 0xe4, 0xb1,
-	0x46, 0x14, 0x16, 0x22, 0xbb, 0xd8, 0x91, 0x50, 0xe4, 0x0e, 0xbb, 0x49, 0x0a, 0x90, 0x59, 0x14,
-	0x21, 0x68, 0xac, 0xfd, 0x99, 0xac, 0x27, 0x5a, 0xef, 0x58, 0x3b, 0xb3, 0x06, 0xbf, 0x01, 0x1d,
-	0x94, 0x20, 0xa5, 0xc8, 0x1b, 0xd0, 0xf0, 0x08, 0x14, 0x29, 0x23, 0x2a, 0xaa, 0x15, 0x4a, 0x1a,
-	0x6a, 0x3f, 0x01, 0xf2, 0xcc, 0x6c, 0x62, 0xe7, 0x07, 0x10, 0x74, 0x33, 0x67, 0xce, 0x9e, 0x39,
-	0xdf, 0xf1, 0x1e, 0x2f, 0x80, 0xc4, 0x71, 0x4d, 0x97, 0x46, 0xd8, 0x74, 0x03, 0x82, 0x43, 0x6e,
-	0x8e, 0x9b, 0x6a, 0x65, 0x8c, 0x22, 0xca, 0xa9, 0xae, 0x13, 0xc7, 0x35, 0x66, 0x04, 0x43, 0xc1,
-	0xe3, 0x66, 0xb5, 0xec, 0x53, 0x9f, 0x8a, 0x63, 0x73, 0xb6, 0x92, 0xcc, 0xea, 0x7f, 0x3e, 0xa5,
-	0x7e, 0x80, 0x4d, 0xb1, 0x73, 0xe2, 0x03, 0xd3, 0x0e, 0x27, 0xea, 0xe8, 0x8e, 0x4b, 0xd9, 0x90,
-	0x32, 0x33, 0x1e, 0xf9, 0x91, 0xed, 0x61, 0x73, 0xdc, 0x74, 0x30, 0xb7, 0x9b, 0xe9, 0x3e, 0x15,
-	0x90, 0xac, 0xbe, 0x54, 0x96, 0x1b, 0x79, 0x84, 0x8e, 0x34, 0xb0, 0xbe, 0xe7, 0xe1, 0x90, 0x93,
-	0x03, 0x82, 0xbd, 0xae, 0x70, 0xf2, 0x94, 0xdb, 0x1c, 0xeb, 0x4d, 0x50, 0x90, 0xc6, 0xfa, 0xc4,
-	0xab, 0x68, 0x75, 0xad, 0x51, 0xe8, 0x94, 0xa7, 0x09, 0x5c, 0x9d, 0xd8, 0xc3, 0xa0, 0x8d, 0x2e,
-	0x8e, 0x90, 0xb5, 0x2c, 0xd7, 0x7b, 0x9e, 0xde, 0x03, 0x7f, 0x2b, 0x9c, 0xcd, 0x24, 0x2a, 0xd9,
-	0xba, 0xd6, 0x28, 0xb6, 0xca, 0x86, 0xf4, 0x6f, 0xa4, 0xfe, 0x8d, 0x87, 0xe1, 0xa4, 0xb3, 0x31,
-	0x4d, 0xe0, 0xda, 0x82, 0x96, 0x78, 0x06, 0x59, 0x45, 0xf7, 0xd2, 0x04, 0xfa, 0xa0, 0x81, 0x4a,
-	0x97, 0x86, 0x0c, 0x87, 0x2c, 0x66, 0x02, 0x7a, 0x46, 0xf8, 0x60, 0x17, 0x13, 0x7f, 0xc0, 0xf5,
-	0x1d, 0x90, 0x1f, 0x88, 0x95, 0xb0, 0x57, 0x6c, 0x55, 0x8d, 0xeb, 0x91, 0x1a, 0x92, 0xdb, 0xc9,
-	0x9d, 0x24, 0x30, 0x63, 0x29, 0xbe, 0xfe, 0x1c, 0x94, 0xdc, 0x54, 0xf5, 0x17, 0xbc, 0x56, 0xa7,
-	0x09, 0xfc, 0x57, 0x79, 0x5d, 0x7c, 0x0c, 0x59, 0x2b, 0xee, 0x82, 0x3d, 0xf4, 0x49, 0x03, 0xeb,
-	0x32, 0xc6, 0x45, 0xdf, 0xec, 0x77, 0x02, 0x7d, 0x05, 0x56, 0xaf, 0x5c, 0xc8, 0x2a, 0xd9, 0xfa,
-	0x52, 0xa3, 0xd8, 0xba, 0x77, 0xd3, 0xac, 0xb7, 0x25, 0xd5, 0x81, 0xb3, 0xe9, 0xa7, 0x09, 0xdc,
-	0xb8, 0x71, 0x08, 0x86, 0xac, 0xd2, 0xe2, 0x14, 0x0c, 0xbd, 0xc9, 0x82, 0xb2, 0x1c, 0x63, 0x7f,
-	0xe4, 0xd9, 0x1c, 0xf7, 0x22, 0x3a, 0xa2, 0xcc, 0x0e, 0xf4, 0x32, 0xf8, 0x8b, 0x13, 0x1e, 0x60,
-	0x39, 0x81, 0x25, 0x37, 0x7a, 0x1d, 0x14, 0x3d, 0xcc, 0xdc, 0x88, 0x8c, 0x38, 0xa1, 0xa1, 0x08,
-	0xb3, 0x60, 0xcd, 0x43, 0xfa, 0x2e, 0xf8, 0x87, 0xc5, 0xce, 0x21, 0x76, 0x79, 0xff, 0x32, 0x85,
-	0x25, 0x91, 0xc2, 0xe6, 0x34, 0x81, 0x15, 0xe9, 0xec, 0x1a, 0x05, 0x59, 0x25, 0x85, 0x75, 0xd3,
-	0x50, 0x9e, 0x80, 0x32, 0x8b, 0x1d, 0xc6, 0x09, 0x8f, 0x39, 0x9e, 0x13, 0xcb, 0x09, 0x31, 0x38,
-	0x4d, 0xe0, 0xff, 0x17, 0x62, 0xd7, 0x58, 0xc8, 0xd2, 0x2f, 0xe1, 0x54, 0xb2, 0x8d, 0x5e, 0x1f,
-	0xc3, 0xcc, 0xe7, 0x8f, 0x5b, 0x55, 0xd5, 0x0d, 0x9f, 0x8e, 0x0d, 0x55, 0xa5, 0x59, 0xa8, 0x1c,
-	0x87, 0x1c, 0xbd, 0xcf, 0x82, 0xd2, 0xbe, 0xac, 0xd5, 0x1f, 0x87, 0xf1, 0x00, 0xe4, 0x46, 0x81,
-	0x1d, 0x8a, 0xf9, 0x8b, 0xad, 0x4d, 0x43, 0x5d, 0x9b, 0xb6, 0x36, 0xbd, 0xba, 0x17, 0xd8, 0xa1,
-	0x7a, 0x73, 0x05, 0x5f, 0x3f, 0x04, 0xeb, 0x8a, 0xe3, 0xf5, 0x17, 0x9a, 0x96, 0xfb, 0xc1, 0xdb,
-	0x5b, 0x9f, 0x26, 0x70, 0x53, 0x26, 0x72, 0xe3, 0xc3, 0xc8, 0x5a, 0x4b, 0xf1, 0xb9, 0xfe, 0xb7,
-	0xef, 0xce, 0x32, 0x79, 0x77, 0x0c, 0x33, 0xdf, 0x8e, 0xa1, 0xf6, 0x93, 0x6c, 0x8e, 0x34, 0x90,
-	0x57, 0xa5, 0xec, 0x82, 0x52, 0x84, 0xc7, 0x84, 0x11, 0x1a, 0xf6, 0xc3, 0x78, 0xe8, 0xe0, 0x48,
-	0x84, 0x93, 0x9b, 0x2f, 0xd1, 0x15, 0x02, 0xb2, 0x56, 0x52, 0xe4, 0xb1, 0x00, 0x16, 0x44, 0x54,
-	0xc5, 0xb3, 0xb7, 0x8a, 0x48, 0xc2, 0x9c, 0x88, 0x74, 0xd2, 0x5e, 0x4e, 0x07, 0x40, 0x8f, 0x40,
-	0xbe, 0x67, 0x47, 0xf6, 0x90, 0xcd, 0x84, 0xed, 0x20, 0xa0, 0x2f, 0x2f, 0x22, 0x60, 0x15, 0xad,
-	0xbe, 0xd4, 0x28, 0xcc, 0x0b, 0x5f, 0x21, 0x20, 0x6b, 0x45, 0x21, 0x32, 0x1d, 0xd6, 0xb1, 0x4e,
-	0xce, 0x6a, 0xda, 0xe9, 0x59, 0x4d, 0xfb, 0x7a, 0x56, 0xd3, 0xde, 0x9e, 0xd7, 0x32, 0xa7, 0xe7,
-	0xb5, 0xcc, 0x97, 0xf3, 0x5a, 0xe6, 0xc5, 0x8e, 0x4f, 0xf8, 0x20, 0x76, 0x0c, 0x97, 0x0e, 0xd5,
-	0xdf, 0xac, 0x49, 0x1c, 0x77, 0xcb, 0xa7, 0xe6, 0x78, 0xdb, 0x1c, 0x52, 0x2f, 0x0e, 0x30, 0x93,
-	0x1f, 0x85, 0xfb, 0xad, 0x2d, 0xf5, 0x5d, 0xe0, 0x93, 0x11, 0x66, 0x4e, 0x5e, 0xfc, 0x64, 0xdb,
-	0xdf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x08, 0xd1, 0x44, 0x6d, 0x37, 0x06, 0x00, 0x00,
+	// 755 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xbf, 0x6f, 0xd3, 0x4e,
+	0x1c, 0x8d, 0x93, 0x7c, 0xa3, 0xe6, 0xf2, 0x55, 0x53, 0xdc, 0x94, 0x86, 0x50, 0xc5, 0xd1, 0x89,
+	0x21, 0x42, 0xd4, 0x26, 0xa9, 0x84, 0xaa, 0x6c, 0x24, 0x4b, 0x3b, 0x14, 0x05, 0xa3, 0x0a, 0xc1,
+	0x12, 0xf9, 0xc7, 0xd5, 0xb9, 0xca, 0xf1, 0x45, 0xbe, 0x73, 0x20, 0xff, 0x01, 0x1b, 0x8c, 0x20,
+	0x75, 0xe8, 0xc8, 0xc6, 0xc2, 0x9f, 0xc0, 0xd0, 0xb1, 0x62, 0x62, 0xb2, 0x50, 0xbb, 0x30, 0xe7,
+	0x2f, 0x40, 0xf6, 0x9d, 0xdb, 0xb8, 0x3f, 0xa0, 0xa2, 0xdb, 0xdd, 0xbb, 0x77, 0xcf, 0xef, 0xf3,
+	0xee, 0x3e, 0x3e, 0xa0, 0x60, 0xd3, 0xd2, 0x2c, 0xe2, 0x23, 0xcd, 0x72, 0x31, 0xf2, 0x98, 0x36,
+	0x69, 0x89, 0x91, 0x3a, 0xf6, 0x09, 0x23, 0xb2, 0x8c, 0x4d, 0x4b, 0x8d, 0x08, 0xaa, 0x80, 0x27,
+	0xad, 0x5a, 0xc5, 0x21, 0x0e, 0x89, 0x97, 0xb5, 0x68, 0xc4, 0x99, 0xb5, 0x7b, 0x0e, 0x21, 0x8e,
+	0x8b, 0xb4, 0x78, 0x66, 0x06, 0x7b, 0x9a, 0xe1, 0x4d, 0xc5, 0xd2, 0x03, 0x8b, 0xd0, 0x11, 0xa1,
+	0x5a, 0x30, 0x76, 0x7c, 0xc3, 0x46, 0xda, 0xa4, 0x65, 0x22, 0x66, 0xb4, 0x92, 0x79, 0x22, 0xc0,
+	0x59, 0x03, 0xae, 0xcc, 0x27, 0x7c, 0x09, 0x1e, 0x48, 0x60, 0x65, 0xdb, 0x46, 0x1e, 0xc3, 0x7b,
+	0x18, 0xd9, 0xbd, 0xd8, 0xc9, 0x0b, 0x66, 0x30, 0x24, 0xb7, 0x40, 0x91, 0x1b, 0x1b, 0x60, 0xbb,
+	0x2a, 0x35, 0xa4, 0x66, 0xb1, 0x5b, 0x99, 0x85, 0xca, 0xd2, 0xd4, 0x18, 0xb9, 0x1d, 0x78, 0xb6,
+	0x04, 0xf5, 0x05, 0x3e, 0xde, 0xb6, 0xe5, 0x3e, 0xf8, 0x5f, 0xe0, 0x34, 0x92, 0xa8, 0x66, 0x1b,
+	0x52, 0xb3, 0xd4, 0xae, 0xa8, 0xdc, 0xbf, 0x9a, 0xf8, 0x57, 0x9f, 0x7a, 0xd3, 0xee, 0xea, 0x2c,
+	0x54, 0x96, 0x53, 0x5a, 0xf1, 0x1e, 0xa8, 0x97, 0xac, 0x73, 0x13, 0xf0, 0x8b, 0x04, 0xaa, 0x3d,
+	0xe2, 0x51, 0xe4, 0xd1, 0x80, 0xc6, 0xd0, 0x4b, 0xcc, 0x86, 0x5b, 0x08, 0x3b, 0x43, 0x26, 0x6f,
+	0x82, 0xc2, 0x30, 0x1e, 0xc5, 0xf6, 0x4a, 0xed, 0x9a, 0x7a, 0x39, 0x52, 0x95, 0x73, 0xbb, 0xf9,
+	0xa3, 0x50, 0xc9, 0xe8, 0x82, 0x2f, 0xbf, 0x02, 0x65, 0x2b, 0x51, 0xbd, 0x81, 0xd7, 0xda, 0x2c,
+	0x54, 0xee, 0x0a, 0xaf, 0xe9, 0x6d, 0x50, 0x5f, 0xb4, 0x52, 0xf6, 0xe0, 0x37, 0x09, 0xac, 0xf0,
+	0x18, 0xd3, 0xbe, 0xe9, 0xbf, 0x04, 0xfa, 0x16, 0x2c, 0x5d, 0xf8, 0x20, 0xad, 0x66, 0x1b, 0xb9,
+	0x66, 0xa9, 0xfd, 0xe8, 0xaa, 0x5a, 0xaf, 0x4b, 0xaa, 0xab, 0x44, 0xd5, 0xcf, 0x42, 0x65, 0xf5,
+	0xca, 0x22, 0x28, 0xd4, 0xcb, 0xe9, 0x2a, 0x28, 0x7c, 0x9f, 0x05, 0x15, 0x5e, 0xc6, 0xee, 0xd8,
+	0x36, 0x18, 0xea, 0xfb, 0x64, 0x4c, 0xa8, 0xe1, 0xca, 0x15, 0xf0, 0x1f, 0xc3, 0xcc, 0x45, 0xbc,
+	0x02, 0x9d, 0x4f, 0xe4, 0x06, 0x28, 0xd9, 0x88, 0x5a, 0x3e, 0x1e, 0x33, 0x4c, 0xbc, 0x38, 0xcc,
+	0xa2, 0x3e, 0x0f, 0xc9, 0x5b, 0xe0, 0x0e, 0x0d, 0xcc, 0x7d, 0x64, 0xb1, 0xc1, 0x79, 0x0a, 0xb9,
+	0x38, 0x85, 0xb5, 0x59, 0xa8, 0x54, 0xb9, 0xb3, 0x4b, 0x14, 0xa8, 0x97, 0x05, 0xd6, 0x4b, 0x42,
+	0x79, 0x0e, 0x2a, 0x34, 0x30, 0x29, 0xc3, 0x2c, 0x60, 0x68, 0x4e, 0x2c, 0x1f, 0x8b, 0x29, 0xb3,
+	0x50, 0xb9, 0x7f, 0x26, 0x76, 0x89, 0x05, 0x75, 0xf9, 0x1c, 0x4e, 0x24, 0x3b, 0xf0, 0xdd, 0xa1,
+	0x92, 0xf9, 0xfe, 0x75, 0xbd, 0x26, 0x7a, 0xc3, 0x21, 0x13, 0x55, 0xb4, 0x52, 0x14, 0x2a, 0x43,
+	0x1e, 0x83, 0x9f, 0xb2, 0xa0, 0xbc, 0xcb, 0xdb, 0xea, 0xd6, 0x61, 0x3c, 0x01, 0xf9, 0xb1, 0x6b,
+	0x78, 0x71, 0xfd, 0xa5, 0xf6, 0x9a, 0x2a, 0x3e, 0x9b, 0x74, 0x6d, 0xf2, 0xe9, 0xbe, 0x6b, 0x78,
+	0xe2, 0xe6, 0xc6, 0x7c, 0x79, 0x1f, 0xac, 0x08, 0x8e, 0x3d, 0x48, 0x75, 0x5a, 0xfe, 0x0f, 0xb7,
+	0xb7, 0x31, 0x0b, 0x95, 0x35, 0x9e, 0xc8, 0x95, 0x9b, 0xa1, 0xbe, 0x9c, 0xe0, 0x73, 0xfd, 0xdf,
+	0x79, 0x18, 0x65, 0xf2, 0xf1, 0x50, 0xc9, 0xfc, 0x3a, 0x54, 0xa4, 0xbf, 0x64, 0xf3, 0x59, 0x02,
+	0xab, 0x7c, 0xef, 0x0e, 0x76, 0x7c, 0x23, 0xaa, 0xf1, 0xd6, 0x19, 0xa5, 0xda, 0x25, 0x77, 0x93,
+	0x76, 0xb9, 0xd1, 0x31, 0x1e, 0x48, 0xa0, 0x20, 0xfe, 0x1f, 0x3d, 0x50, 0xf6, 0xd1, 0x04, 0x53,
+	0x4c, 0xbc, 0x81, 0x17, 0x8c, 0x4c, 0xe4, 0xc7, 0x1e, 0xf3, 0xf3, 0xfd, 0x7e, 0x81, 0x00, 0xf5,
+	0xc5, 0x04, 0x79, 0x16, 0x03, 0x29, 0x11, 0xf1, 0x37, 0xca, 0x5e, 0x2b, 0xc2, 0x09, 0x73, 0x22,
+	0xdc, 0x49, 0x67, 0x21, 0xc9, 0x1a, 0xee, 0x80, 0x42, 0xdf, 0xf0, 0x8d, 0x11, 0x8d, 0x84, 0x0d,
+	0xd7, 0x25, 0x6f, 0xce, 0x4e, 0x8b, 0x56, 0xa5, 0x46, 0xae, 0x59, 0x9c, 0x17, 0xbe, 0x40, 0x80,
+	0xfa, 0xa2, 0x40, 0xf8, 0x61, 0xd0, 0xae, 0x7e, 0x74, 0x52, 0x97, 0x8e, 0x4f, 0xea, 0xd2, 0xcf,
+	0x93, 0xba, 0xf4, 0xe1, 0xb4, 0x9e, 0x39, 0x3e, 0xad, 0x67, 0x7e, 0x9c, 0xd6, 0x33, 0xaf, 0x37,
+	0x1d, 0xcc, 0x86, 0x81, 0xa9, 0x5a, 0x64, 0x24, 0x5e, 0x04, 0x0d, 0x9b, 0xd6, 0xba, 0x43, 0xb4,
+	0xc9, 0x86, 0x36, 0x22, 0x76, 0xe0, 0x22, 0xca, 0xdf, 0xaf, 0xc7, 0xed, 0x75, 0xf1, 0x84, 0xb1,
+	0xe9, 0x18, 0x51, 0xb3, 0x10, 0xdf, 0xae, 0x8d, 0xdf, 0x03, 0x00, 0x16, 0x44, 0x0c, 0x64, 0xe2,
+	0x06, 0x00, 0x00,
 }
 
 func (this *UpgradeProposal) Equal(that interface{}) bool {
@@ -721,6 +771,50 @@ func (m *UpgradeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClientMigrationProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientMigrationProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientMigrationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Height) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -896,6 +990,27 @@ func (m *UpgradeProposal) Size() (n int) {
 	return n
 }
 
+func (m *ClientMigrationProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	return n
+}
+
 func (m *Height) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1646,6 +1761,152 @@ func (m *UpgradeProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClientMigrationProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientMigrationProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientMigrationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Height) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
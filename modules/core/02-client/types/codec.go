@@ -38,6 +38,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*govtypes.Content)(nil),
 		&ClientUpdateProposal{},
 		&UpgradeProposal{},
+		&ClientMigrationProposal{},
 	)
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
@@ -34,4 +34,10 @@ var (
 	ErrInvalidSubstitute                      = sdkerrors.Register(SubModuleName, 27, "invalid client state substitute")
 	ErrInvalidUpgradeProposal                 = sdkerrors.Register(SubModuleName, 28, "invalid upgrade proposal")
 	ErrClientNotActive                        = sdkerrors.Register(SubModuleName, 29, "client is not active")
+	ErrFailedNextSeqAckVerification           = sdkerrors.Register(SubModuleName, 30, "next sequence acknowledgement verification failed")
+	ErrFailedMembershipVerification           = sdkerrors.Register(SubModuleName, 31, "membership verification failed")
+	ErrFailedNonMembershipVerification        = sdkerrors.Register(SubModuleName, 32, "non-membership verification failed")
+	ErrInvalidClientMigrationProposal         = sdkerrors.Register(SubModuleName, 33, "invalid client migration proposal")
+	ErrBatchVerificationNotSupported          = sdkerrors.Register(SubModuleName, 34, "client does not support batch proof verification")
+	ErrClientStoreWrite                       = sdkerrors.Register(SubModuleName, 35, "light client write to client store rejected")
 )
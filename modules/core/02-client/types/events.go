@@ -25,6 +25,7 @@ var (
 	EventTypeSubmitMisbehaviour    = "client_misbehaviour"
 	EventTypeUpdateClientProposal  = "update_client_proposal"
 	EventTypeUpgradeClientProposal = "upgrade_client_proposal"
+	EventTypeMigrateClientProposal = "migrate_client_proposal"
 
 	AttributeValueCategory = fmt.Sprintf("%s_%s", host.ModuleName, SubModuleName)
 )
@@ -135,12 +135,22 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("invalid client %v index %d: %w", client, i, err)
 		}
 
+		// the localhost client identifier is the client type, without sequence
+		if client.ClientId == exported.Localhost {
+			if clientState.ClientType() != exported.Localhost {
+				return fmt.Errorf("client state type %s does not equal localhost client type", clientState.ClientType())
+			}
+
+			validClients[client.ClientId] = clientState.ClientType()
+			continue
+		}
+
 		clientType, sequence, err := ParseClientIdentifier(client.ClientId)
 		if err != nil {
 			return err
 		}
 
-		if clientType != clientState.ClientType() {
+		if clientType != clientState.ClientType() && !IsMigratedClientType(clientType, clientState.ClientType()) {
 			return fmt.Errorf("client state type %s does not equal client type in client identifier %s", clientState.ClientType(), clientType)
 		}
 
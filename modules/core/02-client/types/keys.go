@@ -9,6 +9,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
 
 const (
@@ -43,6 +44,13 @@ func IsValidClientID(clientID string) bool {
 	return err == nil
 }
 
+// IsMigratedClientType returns true if a client state of the given client type may be stored
+// under a client identifier of the identifier client type. 07-tendermint clients are migrated
+// in place to the 01-dymint client type by a ClientMigrationProposal.
+func IsMigratedClientType(identifierClientType, clientType string) bool {
+	return identifierClientType == exported.Tendermint && clientType == exported.Dymint
+}
+
 // ParseClientIdentifier parses the client type and sequence from the client identifier.
 func ParseClientIdentifier(clientID string) (string, uint64, error) {
 	if !IsClientIDFormat(clientID) {
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
 
 // tests ParseClientIdentifier and IsValidClientID
@@ -54,3 +55,10 @@ func TestParseClientIdentifier(t *testing.T) {
 		}
 	}
 }
+
+func TestIsMigratedClientType(t *testing.T) {
+	require.True(t, types.IsMigratedClientType(exported.Tendermint, exported.Dymint))
+	require.False(t, types.IsMigratedClientType(exported.Dymint, exported.Tendermint))
+	require.False(t, types.IsMigratedClientType(exported.Tendermint, exported.Tendermint))
+	require.False(t, types.IsMigratedClientType(exported.Solomachine, exported.Dymint))
+}
@@ -15,17 +15,21 @@ const (
 	// ProposalTypeClientUpdate defines the type for a ClientUpdateProposal
 	ProposalTypeClientUpdate = "ClientUpdate"
 	ProposalTypeUpgrade      = "IBCUpgrade"
+	// ProposalTypeClientMigration defines the type for a ClientMigrationProposal
+	ProposalTypeClientMigration = "ClientMigration"
 )
 
 var (
 	_ govtypes.Content                   = &ClientUpdateProposal{}
 	_ govtypes.Content                   = &UpgradeProposal{}
+	_ govtypes.Content                   = &ClientMigrationProposal{}
 	_ codectypes.UnpackInterfacesMessage = &UpgradeProposal{}
 )
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeClientUpdate)
 	govtypes.RegisterProposalType(ProposalTypeUpgrade)
+	govtypes.RegisterProposalType(ProposalTypeClientMigration)
 }
 
 // NewClientUpdateProposal creates a new client update proposal.
@@ -140,3 +144,42 @@ func (up UpgradeProposal) String() string {
 func (up UpgradeProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return unpacker.UnpackAny(up.UpgradedClientState, new(exported.ClientState))
 }
+
+// NewClientMigrationProposal creates a new client migration proposal.
+func NewClientMigrationProposal(title, description, clientID string) govtypes.Content {
+	return &ClientMigrationProposal{
+		Title:       title,
+		Description: description,
+		ClientId:    clientID,
+	}
+}
+
+// GetTitle returns the title of a client migration proposal.
+func (cmp *ClientMigrationProposal) GetTitle() string { return cmp.Title }
+
+// GetDescription returns the description of a client migration proposal.
+func (cmp *ClientMigrationProposal) GetDescription() string { return cmp.Description }
+
+// ProposalRoute returns the routing key of a client migration proposal.
+func (cmp *ClientMigrationProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a client migration proposal.
+func (cmp *ClientMigrationProposal) ProposalType() string { return ProposalTypeClientMigration }
+
+// ValidateBasic runs basic stateless validity checks
+func (cmp *ClientMigrationProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(cmp); err != nil {
+		return err
+	}
+
+	clientType, _, err := ParseClientIdentifier(cmp.ClientId)
+	if err != nil {
+		return err
+	}
+
+	if clientType != exported.Tendermint {
+		return sdkerrors.Wrapf(ErrInvalidClientMigrationProposal, "only %s clients can be migrated, got client type %s", exported.Tendermint, clientType)
+	}
+
+	return nil
+}
@@ -88,6 +88,51 @@ func (suite *TypesTestSuite) TestMarshalClientUpdateProposalProposal() {
 	suite.Require().NoError(err)
 }
 
+func (suite *TypesTestSuite) TestClientMigrationProposalValidateBasic() {
+	testCases := []struct {
+		name     string
+		proposal govtypes.Content
+		expPass  bool
+	}{
+		{
+			"success",
+			types.NewClientMigrationProposal(ibctesting.Title, ibctesting.Description, "07-tendermint-0"),
+			true,
+		},
+		{
+			"fails validate abstract - empty title",
+			types.NewClientMigrationProposal("", ibctesting.Description, "07-tendermint-0"),
+			false,
+		},
+		{
+			"invalid clientID",
+			types.NewClientMigrationProposal(ibctesting.Title, ibctesting.Description, ibctesting.InvalidID),
+			false,
+		},
+		{
+			"client is not a tendermint client",
+			types.NewClientMigrationProposal(ibctesting.Title, ibctesting.Description, "06-solomachine-0"),
+			false,
+		},
+		{
+			"client is already a dymint client",
+			types.NewClientMigrationProposal(ibctesting.Title, ibctesting.Description, "01-dymint-0"),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+
+		err := tc.proposal.ValidateBasic()
+
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
 func (suite *TypesTestSuite) TestUpgradeProposalValidateBasic() {
 	var (
 		proposal govtypes.Content
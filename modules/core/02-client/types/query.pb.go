@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	types1 "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -960,6 +961,185 @@ func (m *QueryUpgradedConsensusStateResponse) GetUpgradedConsensusState() *types
 	return nil
 }
 
+// QueryVerifyProofRequest is the request type for the Query/VerifyProof RPC
+// method. A membership proof is verified if the value is set, a non-membership
+// proof otherwise.
+type QueryVerifyProofRequest struct {
+	// client unique identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// the proof to be verified by the client
+	Proof []byte `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	// the height of the commitment root at which the proof is verified
+	ProofHeight Height `protobuf:"bytes,3,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	// the full commitment path, including the counterparty commitment prefix
+	MerklePath types1.MerklePath `protobuf:"bytes,4,opt,name=merkle_path,json=merklePath,proto3" json:"merkle_path"`
+	// the value which is proven to be stored at the path
+	Value []byte `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	// the delay time period in nanoseconds which must have passed since the
+	// consensus state at the proof height was processed
+	TimeDelay uint64 `protobuf:"varint,6,opt,name=time_delay,json=timeDelay,proto3" json:"time_delay,omitempty"`
+	// the delay block period which must have passed since the consensus state
+	// at the proof height was processed
+	BlockDelay uint64 `protobuf:"varint,7,opt,name=block_delay,json=blockDelay,proto3" json:"block_delay,omitempty"`
+}
+
+func (m *QueryVerifyProofRequest) Reset()         { *m = QueryVerifyProofRequest{} }
+func (m *QueryVerifyProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyProofRequest) ProtoMessage()    {}
+func (*QueryVerifyProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc42cdfd1d52d76e, []int{18}
+}
+func (m *QueryVerifyProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifyProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyProofRequest.Merge(m, src)
+}
+func (m *QueryVerifyProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifyProofRequest proto.InternalMessageInfo
+
+func (m *QueryVerifyProofRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *QueryVerifyProofRequest) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *QueryVerifyProofRequest) GetProofHeight() Height {
+	if m != nil {
+		return m.ProofHeight
+	}
+	return Height{}
+}
+
+func (m *QueryVerifyProofRequest) GetMerklePath() types1.MerklePath {
+	if m != nil {
+		return m.MerklePath
+	}
+	return types1.MerklePath{}
+}
+
+func (m *QueryVerifyProofRequest) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *QueryVerifyProofRequest) GetTimeDelay() uint64 {
+	if m != nil {
+		return m.TimeDelay
+	}
+	return 0
+}
+
+func (m *QueryVerifyProofRequest) GetBlockDelay() uint64 {
+	if m != nil {
+		return m.BlockDelay
+	}
+	return 0
+}
+
+// QueryVerifyProofResponse is the response type for the Query/VerifyProof RPC
+// method. If the verification failed, the error and its registered codespace
+// and code are returned.
+type QueryVerifyProofResponse struct {
+	// true if the proof was verified
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// the error returned by the verification
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// the codespace of the error
+	Codespace string `protobuf:"bytes,3,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	// the code of the error
+	Code uint32 `protobuf:"varint,4,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (m *QueryVerifyProofResponse) Reset()         { *m = QueryVerifyProofResponse{} }
+func (m *QueryVerifyProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyProofResponse) ProtoMessage()    {}
+func (*QueryVerifyProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc42cdfd1d52d76e, []int{19}
+}
+func (m *QueryVerifyProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifyProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyProofResponse.Merge(m, src)
+}
+func (m *QueryVerifyProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifyProofResponse proto.InternalMessageInfo
+
+func (m *QueryVerifyProofResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *QueryVerifyProofResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *QueryVerifyProofResponse) GetCodespace() string {
+	if m != nil {
+		return m.Codespace
+	}
+	return ""
+}
+
+func (m *QueryVerifyProofResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryClientStateRequest)(nil), "ibc.core.client.v1.QueryClientStateRequest")
 	proto.RegisterType((*QueryClientStateResponse)(nil), "ibc.core.client.v1.QueryClientStateResponse")
@@ -979,78 +1159,93 @@ func init() {
 	proto.RegisterType((*QueryUpgradedClientStateResponse)(nil), "ibc.core.client.v1.QueryUpgradedClientStateResponse")
 	proto.RegisterType((*QueryUpgradedConsensusStateRequest)(nil), "ibc.core.client.v1.QueryUpgradedConsensusStateRequest")
 	proto.RegisterType((*QueryUpgradedConsensusStateResponse)(nil), "ibc.core.client.v1.QueryUpgradedConsensusStateResponse")
+	proto.RegisterType((*QueryVerifyProofRequest)(nil), "ibc.core.client.v1.QueryVerifyProofRequest")
+	proto.RegisterType((*QueryVerifyProofResponse)(nil), "ibc.core.client.v1.QueryVerifyProofResponse")
 }
 
 func init() { proto.RegisterFile("ibc/core/client/v1/query.proto", fileDescriptor_dc42cdfd1d52d76e) }
 
 var fileDescriptor_dc42cdfd1d52d76e = []byte{
-	// 1055 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xa4, 0x69, 0xd4, 0x3e, 0xbb, 0x09, 0x9a, 0xe6, 0xc3, 0xdd, 0x16, 0xc7, 0xd9, 0x20,
-	0x9a, 0x96, 0x64, 0x27, 0x71, 0x68, 0x12, 0x21, 0x21, 0x41, 0x2a, 0x95, 0xf6, 0x52, 0xca, 0x22,
-	0x04, 0x42, 0x42, 0xd1, 0xee, 0x7a, 0xb2, 0x59, 0xc9, 0xde, 0x71, 0x3d, 0xbb, 0x96, 0xa2, 0x2a,
-	0x97, 0x9e, 0x10, 0x27, 0x24, 0x24, 0xae, 0x48, 0x1c, 0x39, 0x54, 0x1c, 0x90, 0xb8, 0x72, 0x82,
-	0x1c, 0x38, 0x54, 0x82, 0x03, 0x27, 0x8a, 0x12, 0xfe, 0x10, 0xe4, 0x99, 0x59, 0x7b, 0xd7, 0x1e,
-	0xd7, 0x6b, 0x14, 0xb8, 0xed, 0xbe, 0xcf, 0xdf, 0xfb, 0xbd, 0xe7, 0xf7, 0xd6, 0x50, 0x0e, 0x5c,
-	0x8f, 0x78, 0xac, 0x45, 0x89, 0x57, 0x0f, 0x68, 0x18, 0x91, 0xf6, 0x26, 0x79, 0x1c, 0xd3, 0xd6,
-	0x91, 0xd5, 0x6c, 0xb1, 0x88, 0x61, 0x1c, 0xb8, 0x9e, 0xd5, 0xd1, 0x5b, 0x52, 0x6f, 0xb5, 0x37,
-	0x8d, 0xdb, 0x1e, 0xe3, 0x0d, 0xc6, 0x89, 0xeb, 0x70, 0x2a, 0x8d, 0x49, 0x7b, 0xd3, 0xa5, 0x91,
-	0xb3, 0x49, 0x9a, 0x8e, 0x1f, 0x84, 0x4e, 0x14, 0xb0, 0x50, 0xfa, 0x1b, 0x4b, 0x9a, 0xf8, 0x2a,
-	0x92, 0x34, 0xb8, 0xe6, 0x33, 0xe6, 0xd7, 0x29, 0x11, 0x6f, 0x6e, 0x7c, 0x40, 0x9c, 0x50, 0xe5,
-	0x36, 0x6e, 0x28, 0x95, 0xd3, 0x0c, 0x88, 0x13, 0x86, 0x2c, 0x12, 0x81, 0xb9, 0xd2, 0xce, 0xf9,
-	0xcc, 0x67, 0xe2, 0x91, 0x74, 0x9e, 0xa4, 0xd4, 0xdc, 0x86, 0xc5, 0x0f, 0x3a, 0x88, 0xee, 0x8a,
-	0x1c, 0x1f, 0x46, 0x4e, 0x44, 0x6d, 0xfa, 0x38, 0xa6, 0x3c, 0xc2, 0xd7, 0xe1, 0xb2, 0xcc, 0xbc,
-	0x1f, 0xd4, 0x4a, 0xa8, 0x82, 0x56, 0x2f, 0xdb, 0x97, 0xa4, 0xe0, 0x41, 0xcd, 0x7c, 0x86, 0xa0,
-	0x34, 0xe8, 0xc8, 0x9b, 0x2c, 0xe4, 0x14, 0xef, 0x40, 0x51, 0x79, 0xf2, 0x8e, 0x5c, 0x38, 0x17,
-	0xaa, 0x73, 0x96, 0xc4, 0x67, 0x25, 0xd0, 0xad, 0x77, 0xc3, 0x23, 0xbb, 0xe0, 0xf5, 0x02, 0xe0,
-	0x39, 0xb8, 0xd8, 0x6c, 0x31, 0x76, 0x50, 0x9a, 0xac, 0xa0, 0xd5, 0xa2, 0x2d, 0x5f, 0xf0, 0x5d,
-	0x28, 0x8a, 0x87, 0xfd, 0x43, 0x1a, 0xf8, 0x87, 0x51, 0xe9, 0x82, 0x08, 0x67, 0x58, 0x83, 0x54,
-	0x5b, 0xf7, 0x85, 0xc5, 0xde, 0xd4, 0xc9, 0x9f, 0x4b, 0x13, 0x76, 0x41, 0x78, 0x49, 0x91, 0xe9,
-	0x0e, 0xe2, 0xe5, 0x49, 0xa5, 0xf7, 0x00, 0x7a, 0x8d, 0x50, 0x68, 0x5f, 0xb7, 0x64, 0xd7, 0xac,
-	0x4e, 0xd7, 0x2c, 0xd9, 0x62, 0xd5, 0x35, 0xeb, 0x91, 0xe3, 0x27, 0x2c, 0xd9, 0x29, 0x4f, 0xf3,
-	0x77, 0x04, 0xd7, 0x34, 0x49, 0x14, 0x2b, 0x21, 0x5c, 0x49, 0xb3, 0xc2, 0x4b, 0xa8, 0x72, 0x61,
-	0xb5, 0x50, 0xbd, 0xa5, 0xab, 0xe3, 0x41, 0x8d, 0x86, 0x51, 0x70, 0x10, 0xd0, 0x5a, 0x2a, 0xd4,
-	0x5e, 0xb9, 0x53, 0xd6, 0x77, 0x2f, 0x96, 0x16, 0xb4, 0x6a, 0x6e, 0x17, 0x53, 0x5c, 0x72, 0xfc,
-	0x5e, 0xa6, 0xaa, 0x49, 0x51, 0xd5, 0xcd, 0x91, 0x55, 0x49, 0xb0, 0x99, 0xb2, 0xbe, 0x47, 0x60,
-	0xc8, 0xb2, 0x3a, 0xaa, 0x90, 0xc7, 0x3c, 0xf7, 0x9c, 0xe0, 0x9b, 0x30, 0xdb, 0xa2, 0xed, 0x80,
-	0x07, 0x2c, 0xdc, 0x0f, 0xe3, 0x86, 0x4b, 0x5b, 0x02, 0xc9, 0x94, 0x3d, 0x93, 0x88, 0x1f, 0x0a,
-	0x69, 0xc6, 0x30, 0xd5, 0xe7, 0x94, 0xa1, 0x6c, 0x24, 0x5e, 0x81, 0x2b, 0xf5, 0x4e, 0x7d, 0x51,
-	0x62, 0x36, 0x55, 0x41, 0xab, 0x97, 0xec, 0xa2, 0x14, 0xaa, 0x6e, 0xff, 0x88, 0xe0, 0xba, 0x16,
-	0xb2, 0xea, 0xc5, 0xdb, 0x30, 0xeb, 0x25, 0x9a, 0x1c, 0x43, 0x3a, 0xe3, 0x65, 0xc2, 0xfc, 0x97,
-	0x73, 0xfa, 0x54, 0x8f, 0x9c, 0xe7, 0x62, 0xfb, 0x9e, 0xa6, 0xe5, 0xff, 0x66, 0x90, 0x7f, 0x46,
-	0x70, 0x43, 0x0f, 0x42, 0xf1, 0xf7, 0x19, 0xbc, 0xd2, 0xc7, 0x5f, 0x32, 0xce, 0x6b, 0xba, 0x72,
-	0xb3, 0x61, 0x3e, 0x0e, 0xa2, 0xc3, 0x0c, 0x01, 0xb3, 0x59, 0x7a, 0xcf, 0x71, 0x74, 0x3f, 0x47,
-	0xb0, 0xac, 0x29, 0x44, 0x66, 0xff, 0x7f, 0x39, 0xfd, 0x05, 0x81, 0xf9, 0x32, 0x28, 0x8a, 0xd9,
-	0x4f, 0x60, 0xb1, 0x8f, 0x59, 0x35, 0x4e, 0x09, 0xc1, 0xa3, 0xe7, 0x69, 0xde, 0xd3, 0x65, 0x38,
-	0x3f, 0x52, 0x77, 0x06, 0x56, 0x69, 0x9c, 0x8b, 0x4a, 0x73, 0x6b, 0x60, 0x3d, 0xc6, 0xbd, 0xc2,
-	0x17, 0x60, 0x9a, 0x0b, 0x89, 0x72, 0x53, 0x6f, 0xa6, 0x91, 0xc9, 0xf6, 0xc8, 0x69, 0x39, 0x8d,
-	0x24, 0x9b, 0xf9, 0x7e, 0x26, 0x60, 0xa2, 0x53, 0x01, 0xab, 0x30, 0xdd, 0x14, 0x12, 0xf5, 0xd3,
-	0xd6, 0x12, 0xa7, 0x7c, 0x94, 0xa5, 0xb9, 0x0c, 0x4b, 0x22, 0xe0, 0x47, 0x4d, 0xbf, 0xe5, 0xd4,
-	0x32, 0xeb, 0x35, 0xc9, 0x59, 0x87, 0xca, 0x70, 0x13, 0x95, 0xfa, 0x3e, 0xcc, 0xc7, 0x4a, 0xbd,
-	0x9f, 0xfb, 0x12, 0x5e, 0x8d, 0x07, 0x23, 0x9a, 0xaf, 0xa9, 0xa1, 0xe9, 0x66, 0xd3, 0xad, 0x60,
-	0x33, 0x86, 0x95, 0x97, 0x5a, 0x29, 0x58, 0x0f, 0xa1, 0xd4, 0x83, 0x35, 0xc6, 0xfa, 0x5b, 0x88,
-	0xb5, 0x71, 0xab, 0xbf, 0x16, 0xe1, 0xa2, 0xc8, 0x8b, 0xbf, 0x41, 0x50, 0x48, 0xc1, 0xc6, 0x6f,
-	0xe8, 0xb8, 0x1e, 0xf2, 0xa1, 0x61, 0xac, 0xe5, 0x33, 0x96, 0x45, 0x98, 0x77, 0x9e, 0xfe, 0xf6,
-	0xf7, 0x57, 0x93, 0x04, 0xaf, 0x93, 0xa1, 0x9f, 0x4a, 0x6a, 0x23, 0x91, 0x27, 0xdd, 0x51, 0x3c,
-	0xc6, 0x5f, 0x23, 0x28, 0xa6, 0x8f, 0x25, 0xce, 0x95, 0x35, 0x99, 0x34, 0x63, 0x3d, 0xa7, 0xb5,
-	0x02, 0x79, 0x4b, 0x80, 0x5c, 0xc1, 0xcb, 0x23, 0x41, 0xe2, 0x17, 0x08, 0x66, 0xb2, 0xbc, 0x62,
-	0x6b, 0x78, 0x32, 0x5d, 0xfb, 0x0d, 0x92, 0xdb, 0x5e, 0xc1, 0xab, 0x0b, 0x78, 0x07, 0xb8, 0xa6,
-	0x85, 0xd7, 0xb7, 0xd8, 0xd3, 0x34, 0x92, 0xe4, 0x18, 0x93, 0x27, 0x7d, 0x67, 0xfd, 0x98, 0xc8,
-	0x35, 0x95, 0x52, 0x48, 0xc1, 0x31, 0x7e, 0x86, 0x60, 0xb6, 0xef, 0x90, 0xe0, 0xbc, 0x90, 0xbb,
-	0x0d, 0xd8, 0xc8, 0xef, 0xa0, 0x8a, 0xdc, 0x15, 0x45, 0x56, 0xf1, 0xc6, 0xb8, 0x45, 0xe2, 0x13,
-	0x04, 0xf3, 0xda, 0x2d, 0x8d, 0xef, 0xe4, 0x44, 0x91, 0x3d, 0x30, 0xc6, 0xf6, 0xb8, 0x6e, 0xaa,
-	0x84, 0x77, 0x44, 0x09, 0x6f, 0xe1, 0xdd, 0xb1, 0xfb, 0xa4, 0x6e, 0x06, 0xfe, 0x36, 0x33, 0xf6,
-	0x71, 0xbe, 0xb1, 0x8f, 0xc7, 0x1a, 0xfb, 0xde, 0x0e, 0xcf, 0xfd, 0xdb, 0x8c, 0xb3, 0x7c, 0x7f,
-	0xd1, 0x05, 0x29, 0xd7, 0xf1, 0x48, 0x90, 0x99, 0x2b, 0x30, 0x12, 0x64, 0xf6, 0x2e, 0x98, 0xaf,
-	0x0a, 0x90, 0x8b, 0x78, 0x5e, 0x82, 0xec, 0xe2, 0x93, 0x27, 0x00, 0xff, 0x80, 0xe0, 0xaa, 0x66,
-	0xb7, 0xe3, 0xad, 0xa1, 0x59, 0x86, 0x1f, 0x0b, 0xe3, 0xcd, 0xf1, 0x9c, 0x14, 0xc2, 0xaa, 0x40,
-	0xb8, 0x86, 0x6f, 0xeb, 0x68, 0xd4, 0x1e, 0x16, 0x8e, 0x7f, 0x42, 0xb0, 0xa0, 0x5f, 0xff, 0x78,
-	0x7b, 0x34, 0x08, 0xed, 0x5a, 0xd9, 0x19, 0xdb, 0x2f, 0xcf, 0x18, 0x0c, 0xbb, 0x40, 0x7c, 0xcf,
-	0x3e, 0x39, 0x2d, 0xa3, 0xe7, 0xa7, 0x65, 0xf4, 0xd7, 0x69, 0x19, 0x7d, 0x79, 0x56, 0x9e, 0x78,
-	0x7e, 0x56, 0x9e, 0xf8, 0xe3, 0xac, 0x3c, 0xf1, 0xe9, 0xae, 0x1f, 0x44, 0x87, 0xb1, 0x6b, 0x79,
-	0xac, 0x41, 0xd4, 0x9f, 0xe9, 0xc0, 0xf5, 0xd6, 0x7d, 0x46, 0xda, 0x5b, 0xa4, 0xc1, 0x6a, 0x71,
-	0x9d, 0x72, 0x99, 0x67, 0xa3, 0xba, 0xae, 0x52, 0x45, 0x47, 0x4d, 0xca, 0xdd, 0x69, 0x71, 0xc8,
-	0xb6, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0x42, 0x17, 0xfc, 0x54, 0xb8, 0x0f, 0x00, 0x00,
+	// 1263 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xa4, 0x69, 0x5a, 0x3f, 0x3b, 0xcd, 0x57, 0xd3, 0x26, 0x71, 0xb6, 0xa9, 0xe3, 0x6c,
+	0xbe, 0x90, 0x34, 0x4d, 0x76, 0x13, 0x87, 0x26, 0x51, 0x25, 0x24, 0x48, 0x50, 0x69, 0x0e, 0x94,
+	0xb0, 0x88, 0x1f, 0x42, 0x42, 0xd6, 0x7a, 0x3d, 0xb1, 0x57, 0xb5, 0x77, 0xdd, 0x9d, 0x5d, 0x4b,
+	0x51, 0xc9, 0xa5, 0x27, 0xc4, 0x09, 0x09, 0xc1, 0x15, 0x89, 0x23, 0x87, 0x0a, 0x21, 0x24, 0xae,
+	0x9c, 0x20, 0xc7, 0x4a, 0x70, 0xe0, 0x44, 0x51, 0xd2, 0x3f, 0x04, 0xed, 0xcc, 0xac, 0xbd, 0x1b,
+	0x8f, 0x93, 0x35, 0x6a, 0xb9, 0x79, 0xde, 0xbc, 0x1f, 0x9f, 0xf7, 0x79, 0x6f, 0xe7, 0x3d, 0x19,
+	0x0a, 0x76, 0xc5, 0xd2, 0x2d, 0xd7, 0x23, 0xba, 0xd5, 0xb0, 0x89, 0xe3, 0xeb, 0xed, 0x35, 0xfd,
+	0x61, 0x40, 0xbc, 0x03, 0xad, 0xe5, 0xb9, 0xbe, 0x8b, 0xb1, 0x5d, 0xb1, 0xb4, 0xf0, 0x5e, 0xe3,
+	0xf7, 0x5a, 0x7b, 0x4d, 0x59, 0xb2, 0x5c, 0xda, 0x74, 0xa9, 0x5e, 0x31, 0x29, 0xe1, 0xca, 0x7a,
+	0x7b, 0xad, 0x42, 0x7c, 0x73, 0x4d, 0x6f, 0x99, 0x35, 0xdb, 0x31, 0x7d, 0xdb, 0x75, 0xb8, 0xbd,
+	0x32, 0x2b, 0xf1, 0x2f, 0x3c, 0x71, 0x85, 0x85, 0xae, 0x82, 0xdb, 0x6c, 0xda, 0x7e, 0x33, 0x52,
+	0xea, 0x9c, 0x84, 0xe2, 0x74, 0xcd, 0x75, 0x6b, 0x0d, 0xa2, 0xb3, 0x53, 0x25, 0xd8, 0xd7, 0x4d,
+	0x47, 0x80, 0x54, 0x66, 0xc4, 0x95, 0xd9, 0xb2, 0x75, 0xd3, 0x71, 0x5c, 0x9f, 0x21, 0xa0, 0xe2,
+	0xf6, 0x5a, 0xcd, 0xad, 0xb9, 0xec, 0xa7, 0x1e, 0xfe, 0xe2, 0x52, 0x75, 0x03, 0xa6, 0xde, 0x0b,
+	0xa1, 0xef, 0x30, 0x30, 0xef, 0xfb, 0xa6, 0x4f, 0x0c, 0xf2, 0x30, 0x20, 0xd4, 0xc7, 0xd7, 0x21,
+	0xc3, 0x21, 0x96, 0xed, 0x6a, 0x1e, 0x15, 0xd1, 0x62, 0xc6, 0xb8, 0xcc, 0x05, 0xbb, 0x55, 0xf5,
+	0x09, 0x82, 0x7c, 0xaf, 0x21, 0x6d, 0xb9, 0x0e, 0x25, 0x78, 0x13, 0x72, 0xc2, 0x92, 0x86, 0x72,
+	0x66, 0x9c, 0x2d, 0x5d, 0xd3, 0x38, 0x3e, 0x2d, 0x82, 0xae, 0xbd, 0xe9, 0x1c, 0x18, 0x59, 0xab,
+	0xeb, 0x00, 0x5f, 0x83, 0x8b, 0x2d, 0xcf, 0x75, 0xf7, 0xf3, 0xc3, 0x45, 0xb4, 0x98, 0x33, 0xf8,
+	0x01, 0xef, 0x40, 0x8e, 0xfd, 0x28, 0xd7, 0x89, 0x5d, 0xab, 0xfb, 0xf9, 0x0b, 0xcc, 0x9d, 0xa2,
+	0xf5, 0xd6, 0x44, 0xbb, 0xc7, 0x34, 0xb6, 0x47, 0x8e, 0xfe, 0x9a, 0x1d, 0x32, 0xb2, 0xcc, 0x8a,
+	0x8b, 0xd4, 0x4a, 0x2f, 0x5e, 0x1a, 0x65, 0x7a, 0x17, 0xa0, 0x5b, 0x31, 0x81, 0xf6, 0x55, 0x8d,
+	0x97, 0x57, 0x0b, 0xcb, 0xab, 0xf1, 0x5e, 0x10, 0xe5, 0xd5, 0xf6, 0xcc, 0x5a, 0xc4, 0x92, 0x11,
+	0xb3, 0x54, 0xff, 0x40, 0x30, 0x2d, 0x09, 0x22, 0x58, 0x71, 0x60, 0x2c, 0xce, 0x0a, 0xcd, 0xa3,
+	0xe2, 0x85, 0xc5, 0x6c, 0xe9, 0xa6, 0x2c, 0x8f, 0xdd, 0x2a, 0x71, 0x7c, 0x7b, 0xdf, 0x26, 0xd5,
+	0x98, 0xab, 0xed, 0x42, 0x98, 0xd6, 0xf7, 0xcf, 0x66, 0x27, 0xa5, 0xd7, 0xd4, 0xc8, 0xc5, 0xb8,
+	0xa4, 0xf8, 0xed, 0x44, 0x56, 0xc3, 0x2c, 0xab, 0x85, 0x73, 0xb3, 0xe2, 0x60, 0x13, 0x69, 0xfd,
+	0x80, 0x40, 0xe1, 0x69, 0x85, 0x57, 0x0e, 0x0d, 0x68, 0xea, 0x3e, 0xc1, 0x0b, 0x30, 0xee, 0x91,
+	0xb6, 0x4d, 0x6d, 0xd7, 0x29, 0x3b, 0x41, 0xb3, 0x42, 0x3c, 0x86, 0x64, 0xc4, 0xb8, 0x12, 0x89,
+	0xef, 0x33, 0x69, 0x42, 0x31, 0x56, 0xe7, 0x98, 0x22, 0x2f, 0x24, 0x9e, 0x87, 0xb1, 0x46, 0x98,
+	0x9f, 0x1f, 0xa9, 0x8d, 0x14, 0xd1, 0xe2, 0x65, 0x23, 0xc7, 0x85, 0xa2, 0xda, 0x3f, 0x23, 0xb8,
+	0x2e, 0x85, 0x2c, 0x6a, 0xf1, 0x3a, 0x8c, 0x5b, 0xd1, 0x4d, 0x8a, 0x26, 0xbd, 0x62, 0x25, 0xdc,
+	0xbc, 0xcc, 0x3e, 0x7d, 0x2c, 0x47, 0x4e, 0x53, 0xb1, 0x7d, 0x57, 0x52, 0xf2, 0x7f, 0xd3, 0xc8,
+	0xbf, 0x22, 0x98, 0x91, 0x83, 0x10, 0xfc, 0x7d, 0x0a, 0xff, 0x3b, 0xc5, 0x5f, 0xd4, 0xce, 0xcb,
+	0xb2, 0x74, 0x93, 0x6e, 0x3e, 0xb2, 0xfd, 0x7a, 0x82, 0x80, 0xf1, 0x24, 0xbd, 0x2f, 0xb0, 0x75,
+	0x3f, 0x47, 0x30, 0x27, 0x49, 0x84, 0x47, 0xff, 0x6f, 0x39, 0xfd, 0x0d, 0x81, 0x7a, 0x16, 0x14,
+	0xc1, 0xec, 0xc7, 0x30, 0x75, 0x8a, 0x59, 0xd1, 0x4e, 0x11, 0xc1, 0xe7, 0xf7, 0xd3, 0x84, 0x25,
+	0x8b, 0xf0, 0xe2, 0x48, 0xdd, 0xec, 0x79, 0x4a, 0x83, 0x54, 0x54, 0xaa, 0xeb, 0x30, 0x2d, 0x31,
+	0x14, 0x89, 0x4f, 0xc2, 0x28, 0x65, 0x12, 0x61, 0x26, 0x4e, 0xaa, 0x92, 0x88, 0xb6, 0x67, 0x7a,
+	0x66, 0x33, 0x8a, 0xa6, 0xbe, 0x0b, 0xd3, 0x92, 0x3b, 0xe1, 0xb0, 0x04, 0xa3, 0x2d, 0x26, 0x11,
+	0x9f, 0xb6, 0x94, 0x38, 0x61, 0x23, 0x34, 0xd5, 0x39, 0x98, 0x65, 0x0e, 0x3f, 0x68, 0xd5, 0x3c,
+	0xb3, 0x9a, 0x78, 0x5e, 0xa3, 0x98, 0x0d, 0x28, 0xf6, 0x57, 0x11, 0xa1, 0xef, 0xc1, 0x44, 0x20,
+	0xae, 0xcb, 0xa9, 0x27, 0xe1, 0xd5, 0xa0, 0xd7, 0xa3, 0xfa, 0x7f, 0x50, 0x93, 0xd1, 0x64, 0x4f,
+	0xb0, 0x1a, 0xc0, 0xfc, 0x99, 0x5a, 0x02, 0xd6, 0x7d, 0xc8, 0x77, 0x61, 0x0d, 0xf0, 0xfc, 0x4d,
+	0x06, 0x52, 0xbf, 0xea, 0x8f, 0xc3, 0x62, 0x7b, 0xf8, 0x90, 0x78, 0xf6, 0xfe, 0xc1, 0x5e, 0xf8,
+	0x8c, 0xa5, 0xfa, 0xa6, 0x5e, 0xde, 0xfb, 0x89, 0x77, 0x21, 0xdb, 0x24, 0xde, 0x83, 0x06, 0x29,
+	0xb7, 0x4c, 0xbf, 0xce, 0x86, 0x43, 0xb6, 0xa4, 0xc6, 0x7c, 0x74, 0x17, 0xaa, 0xf6, 0x9a, 0xf6,
+	0x0e, 0x53, 0xdd, 0x33, 0xfd, 0xba, 0xf0, 0x05, 0xcd, 0x8e, 0x24, 0x44, 0xd9, 0x36, 0x1b, 0x01,
+	0xc9, 0x5f, 0xe4, 0x28, 0xd9, 0x01, 0xdf, 0x00, 0xf0, 0xed, 0x26, 0x29, 0x57, 0x49, 0xc3, 0x3c,
+	0xc8, 0x8f, 0xb2, 0x19, 0x95, 0x09, 0x25, 0x6f, 0x85, 0x02, 0x3c, 0x0b, 0xd9, 0x4a, 0xc3, 0xb5,
+	0x1e, 0x88, 0xfb, 0x4b, 0xec, 0x1e, 0x98, 0x88, 0x29, 0xa8, 0x9f, 0x41, 0xbe, 0x97, 0x33, 0x51,
+	0xa0, 0x3c, 0x5c, 0xa2, 0x81, 0x65, 0x11, 0xca, 0x7b, 0xf6, 0xb2, 0x11, 0x1d, 0x43, 0x2c, 0xc4,
+	0xf3, 0x5c, 0x3e, 0x3d, 0x33, 0x06, 0x3f, 0xe0, 0x19, 0xc8, 0x58, 0x6e, 0x95, 0xd0, 0x96, 0x69,
+	0x11, 0x46, 0x57, 0xc6, 0xe8, 0x0a, 0x30, 0x86, 0x91, 0xf0, 0xc0, 0x38, 0x18, 0x33, 0xd8, 0xef,
+	0xd2, 0xf3, 0x31, 0xb8, 0xc8, 0xc2, 0xe3, 0x6f, 0x11, 0x64, 0x63, 0x9d, 0x86, 0x6f, 0xc9, 0x78,
+	0xee, 0xb3, 0x1b, 0x2a, 0xcb, 0xe9, 0x94, 0x79, 0x5a, 0xea, 0xed, 0xc7, 0xbf, 0x3f, 0xff, 0x6a,
+	0x58, 0xc7, 0x2b, 0x7a, 0xdf, 0x35, 0x58, 0x0c, 0x11, 0xfd, 0x51, 0xa7, 0x69, 0x0e, 0xf1, 0x37,
+	0x08, 0x72, 0x3b, 0xf1, 0x8d, 0x26, 0x55, 0xd4, 0xe8, 0x71, 0x50, 0x56, 0x52, 0x6a, 0x0b, 0x90,
+	0x37, 0x19, 0xc8, 0x79, 0x3c, 0x77, 0x2e, 0x48, 0xfc, 0x0c, 0xc1, 0x95, 0xe4, 0xa7, 0x80, 0xb5,
+	0xfe, 0xc1, 0x64, 0x5f, 0xac, 0xa2, 0xa7, 0xd6, 0x17, 0xf0, 0x1a, 0x0c, 0xde, 0x3e, 0xae, 0x4a,
+	0xe1, 0x9d, 0x9a, 0xc5, 0x71, 0x1a, 0xf5, 0x68, 0x7f, 0xd2, 0x1f, 0x9d, 0xda, 0xc4, 0x0e, 0x75,
+	0xfe, 0xa1, 0xc5, 0x2e, 0xb8, 0xe0, 0x10, 0x3f, 0x41, 0x30, 0x7e, 0x6a, 0xf6, 0xe3, 0xb4, 0x90,
+	0x3b, 0x05, 0x58, 0x4d, 0x6f, 0x20, 0x92, 0xdc, 0x62, 0x49, 0x96, 0xf0, 0xea, 0xa0, 0x49, 0xe2,
+	0x23, 0x04, 0x13, 0xd2, 0xc1, 0x8a, 0x6f, 0xa7, 0x44, 0x91, 0xdc, 0x09, 0x94, 0x8d, 0x41, 0xcd,
+	0x44, 0x0a, 0x6f, 0xb0, 0x14, 0xee, 0xe0, 0xad, 0x81, 0xeb, 0x24, 0xc6, 0x3c, 0xfe, 0x2e, 0xd1,
+	0xf6, 0x41, 0xba, 0xb6, 0x0f, 0x06, 0x6a, 0xfb, 0x80, 0x0e, 0xfc, 0x6d, 0x06, 0x49, 0xbe, 0xbf,
+	0xe8, 0x80, 0xe4, 0x13, 0xf4, 0x5c, 0x90, 0x89, 0xc1, 0xad, 0xac, 0xa4, 0xd4, 0x16, 0x20, 0x6f,
+	0x30, 0x90, 0x53, 0x78, 0x82, 0x83, 0xec, 0xe0, 0xe3, 0x53, 0x1b, 0xff, 0x84, 0xe0, 0xaa, 0x64,
+	0x1c, 0xe3, 0xf5, 0xbe, 0x51, 0xfa, 0xcf, 0x77, 0xe5, 0xb5, 0xc1, 0x8c, 0x04, 0xc2, 0x12, 0x43,
+	0xb8, 0x8c, 0x97, 0x64, 0x34, 0x4a, 0x77, 0x01, 0x8a, 0x7f, 0x41, 0x30, 0x29, 0x9f, 0xd8, 0x78,
+	0xe3, 0x7c, 0x10, 0xd2, 0x67, 0x65, 0x73, 0x60, 0xbb, 0x34, 0x6d, 0xd0, 0x6f, 0x69, 0xa0, 0xf8,
+	0x6b, 0x04, 0xd9, 0xd8, 0x20, 0x3b, 0x63, 0x88, 0xf4, 0xae, 0x08, 0xca, 0x72, 0x3a, 0x65, 0x81,
+	0xf0, 0x16, 0x43, 0xf8, 0x8a, 0x5a, 0x94, 0x21, 0x6c, 0x33, 0x83, 0x32, 0x5b, 0x04, 0xee, 0xa0,
+	0xa5, 0x6d, 0xe3, 0xe8, 0xb8, 0x80, 0x9e, 0x1e, 0x17, 0xd0, 0xdf, 0xc7, 0x05, 0xf4, 0xe5, 0x49,
+	0x61, 0xe8, 0xe9, 0x49, 0x61, 0xe8, 0xcf, 0x93, 0xc2, 0xd0, 0x27, 0x5b, 0x35, 0xdb, 0xaf, 0x07,
+	0x95, 0x70, 0x0f, 0xd0, 0xc5, 0x1f, 0x38, 0x76, 0xc5, 0x5a, 0xa9, 0xb9, 0x7a, 0x7b, 0x5d, 0x6f,
+	0xba, 0xd5, 0xa0, 0x41, 0x28, 0xf7, 0xbe, 0x5a, 0x5a, 0x11, 0x01, 0xfc, 0x83, 0x16, 0xa1, 0x95,
+	0x51, 0xb6, 0x13, 0xad, 0xff, 0x33, 0x00, 0x74, 0xa7, 0xe6, 0xba, 0x2c, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1085,6 +1280,9 @@ type QueryClient interface {
 	UpgradedClientState(ctx context.Context, in *QueryUpgradedClientStateRequest, opts ...grpc.CallOption) (*QueryUpgradedClientStateResponse, error)
 	// UpgradedConsensusState queries an Upgraded IBC consensus state.
 	UpgradedConsensusState(ctx context.Context, in *QueryUpgradedConsensusStateRequest, opts ...grpc.CallOption) (*QueryUpgradedConsensusStateResponse, error)
+	// VerifyProof runs the membership or non-membership verification of a proof
+	// against an IBC client without any state changes.
+	VerifyProof(ctx context.Context, in *QueryVerifyProofRequest, opts ...grpc.CallOption) (*QueryVerifyProofResponse, error)
 }
 
 type queryClient struct {
@@ -1176,6 +1374,15 @@ func (c *queryClient) UpgradedConsensusState(ctx context.Context, in *QueryUpgra
 	return out, nil
 }
 
+func (c *queryClient) VerifyProof(ctx context.Context, in *QueryVerifyProofRequest, opts ...grpc.CallOption) (*QueryVerifyProofResponse, error) {
+	out := new(QueryVerifyProofResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.client.v1.Query/VerifyProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// ClientState queries an IBC light client.
@@ -1198,6 +1405,9 @@ type QueryServer interface {
 	UpgradedClientState(context.Context, *QueryUpgradedClientStateRequest) (*QueryUpgradedClientStateResponse, error)
 	// UpgradedConsensusState queries an Upgraded IBC consensus state.
 	UpgradedConsensusState(context.Context, *QueryUpgradedConsensusStateRequest) (*QueryUpgradedConsensusStateResponse, error)
+	// VerifyProof runs the membership or non-membership verification of a proof
+	// against an IBC client without any state changes.
+	VerifyProof(context.Context, *QueryVerifyProofRequest) (*QueryVerifyProofResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1231,6 +1441,9 @@ func (*UnimplementedQueryServer) UpgradedClientState(ctx context.Context, req *Q
 func (*UnimplementedQueryServer) UpgradedConsensusState(ctx context.Context, req *QueryUpgradedConsensusStateRequest) (*QueryUpgradedConsensusStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpgradedConsensusState not implemented")
 }
+func (*UnimplementedQueryServer) VerifyProof(ctx context.Context, req *QueryVerifyProofRequest) (*QueryVerifyProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyProof not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1398,6 +1611,24 @@ func _Query_UpgradedConsensusState_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VerifyProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.client.v1.Query/VerifyProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyProof(ctx, req.(*QueryVerifyProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.core.client.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1438,6 +1669,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpgradedConsensusState",
 			Handler:    _Query_UpgradedConsensusState_Handler,
 		},
+		{
+			MethodName: "VerifyProof",
+			Handler:    _Query_VerifyProof_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/core/client/v1/query.proto",
@@ -2127,6 +2362,132 @@ func (m *QueryUpgradedConsensusStateResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryVerifyProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockDelay != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockDelay))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TimeDelay != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TimeDelay))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.MerklePath.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Codespace) > 0 {
+		i -= len(m.Codespace)
+		copy(dAtA[i:], m.Codespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Codespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2407,6 +2768,60 @@ func (m *QueryUpgradedConsensusStateResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryVerifyProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MerklePath.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TimeDelay != 0 {
+		n += 1 + sovQuery(uint64(m.TimeDelay))
+	}
+	if m.BlockDelay != 0 {
+		n += 1 + sovQuery(uint64(m.BlockDelay))
+	}
+	return n
+}
+
+func (m *QueryVerifyProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovQuery(uint64(m.Code))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4195,6 +4610,413 @@ func (m *QueryUpgradedConsensusStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryVerifyProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerklePath", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MerklePath.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeDelay", wireType)
+			}
+			m.TimeDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockDelay", wireType)
+			}
+			m.BlockDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,225 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// LightClientModule defines a light client type which may be registered on the 02-client
+// keeper. Only clients of registered types can be created, imported in genesis and used
+// as the self client of the chain.
+type LightClientModule interface {
+	// ClientType returns the client type of the light client, which prefixes the identifiers
+	// of its clients.
+	ClientType() string
+
+	// RegisterInterfaces registers the implementations of the client state, consensus state,
+	// header and misbehaviour interfaces of the light client.
+	RegisterInterfaces(registry codectypes.InterfaceRegistry)
+}
+
+// SelfClientModule defines a light client module which provides the logic to validate
+// and construct the states of clients tracking the running chain on its counterparties.
+type SelfClientModule interface {
+	LightClientModule
+
+	SelfClient() exported.SelfClient
+}
+
+// GenesisMetadataModule defines a light client module which validates the metadata its
+// clients export to genesis.
+type GenesisMetadataModule interface {
+	LightClientModule
+
+	// ValidateGenesisMetadata validates the metadata of the client with the given client
+	// state, as returned by its ExportMetadata function.
+	ValidateGenesisMetadata(clientState exported.ClientState, metadata []GenesisMetadata) error
+}
+
+// CLIModule defines a light client module which provides CLI commands. The commands are
+// added to the IBC client commands and may be nil.
+type CLIModule interface {
+	LightClientModule
+
+	GetTxCmd() *cobra.Command
+	GetQueryCmd() *cobra.Command
+}
+
+// ClientStoreModule defines a light client module which provides its clients with the
+// dependencies they are executed with, such as a virtual machine, through their client store.
+type ClientStoreModule interface {
+	LightClientModule
+
+	// WrapClientStore wraps the client store given to the clients of the module.
+	WrapClientStore(clientStore sdk.KVStore) sdk.KVStore
+}
+
+// LightClientRegistry holds the light client modules registered on the 02-client keeper
+// by client type.
+type LightClientRegistry struct {
+	modules map[string]LightClientModule
+}
+
+// NewLightClientRegistry returns a LightClientRegistry with the given light client modules.
+func NewLightClientRegistry(modules ...LightClientModule) *LightClientRegistry {
+	registry := &LightClientRegistry{
+		modules: make(map[string]LightClientModule),
+	}
+
+	for _, module := range modules {
+		registry.Register(module)
+	}
+
+	return registry
+}
+
+// Register registers the light client module. It panics if the client type of the module
+// is invalid or already registered.
+func (r *LightClientRegistry) Register(module LightClientModule) {
+	clientType := module.ClientType()
+	if err := ValidateClientType(clientType); err != nil {
+		panic(fmt.Errorf("cannot register light client module: %w", err))
+	}
+
+	if r.HasClientType(clientType) {
+		panic(fmt.Errorf("light client module for client type %s already registered", clientType))
+	}
+
+	r.modules[clientType] = module
+}
+
+// HasClientType returns true if a light client module is registered for the client type.
+func (r *LightClientRegistry) HasClientType(clientType string) bool {
+	_, found := r.modules[clientType]
+	return found
+}
+
+// GetModule returns the light client module registered for the client type.
+func (r *LightClientRegistry) GetModule(clientType string) (LightClientModule, bool) {
+	module, found := r.modules[clientType]
+	return module, found
+}
+
+// ClientTypes returns the sorted client types of the registered light client modules.
+func (r *LightClientRegistry) ClientTypes() []string {
+	clientTypes := make([]string, 0, len(r.modules))
+	for clientType := range r.modules {
+		clientTypes = append(clientTypes, clientType)
+	}
+	sort.Strings(clientTypes)
+
+	return clientTypes
+}
+
+// RegisterInterfaces registers the interface implementations of all registered light client
+// modules.
+func (r *LightClientRegistry) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	for _, clientType := range r.ClientTypes() {
+		r.modules[clientType].RegisterInterfaces(registry)
+	}
+}
+
+// SelfClient returns the self client of the light client module registered for the client type.
+func (r *LightClientRegistry) SelfClient(clientType string) (exported.SelfClient, error) {
+	module, found := r.modules[clientType]
+	if !found {
+		return nil, sdkerrors.Wrapf(ErrInvalidClientType, "light client module for client type %s is not registered", clientType)
+	}
+
+	selfClientModule, ok := module.(SelfClientModule)
+	if !ok {
+		return nil, sdkerrors.Wrapf(ErrInvalidClientType, "light client module for client type %s does not provide a self client", clientType)
+	}
+
+	return selfClientModule.SelfClient(), nil
+}
+
+// WrapClientStore wraps the client store of a client of the given type with the light client
+// module registered for the client type, if it supports it. The client store is returned
+// unchanged otherwise.
+func (r *LightClientRegistry) WrapClientStore(clientType string, clientStore sdk.KVStore) sdk.KVStore {
+	module, ok := r.modules[clientType].(ClientStoreModule)
+	if !ok {
+		return clientStore
+	}
+
+	return module.WrapClientStore(clientStore)
+}
+
+// ValidateGenesis validates that the clients of the genesis state are of registered client
+// types and validates their metadata with the light client modules which support it. It
+// expects the genesis state to be validated.
+func (r *LightClientRegistry) ValidateGenesis(gs GenesisState) error {
+	clientStates := make(map[string]exported.ClientState)
+	for _, client := range gs.Clients {
+		clientState, ok := client.ClientState.GetCachedValue().(exported.ClientState)
+		if !ok {
+			return fmt.Errorf("invalid client state with ID %s", client.ClientId)
+		}
+
+		if !r.HasClientType(clientState.ClientType()) {
+			return fmt.Errorf("client type %s of client %s is not registered", clientState.ClientType(), client.ClientId)
+		}
+
+		clientStates[client.ClientId] = clientState
+	}
+
+	for _, clientMetadata := range gs.ClientsMetadata {
+		clientState, ok := clientStates[clientMetadata.ClientId]
+		if !ok {
+			return fmt.Errorf("metadata in genesis has a client id %s that does not map to a genesis client", clientMetadata.ClientId)
+		}
+
+		module, ok := r.modules[clientState.ClientType()].(GenesisMetadataModule)
+		if !ok {
+			continue
+		}
+
+		if err := module.ValidateGenesisMetadata(clientState, clientMetadata.ClientMetadata); err != nil {
+			return fmt.Errorf("invalid metadata of client %s: %w", clientMetadata.ClientId, err)
+		}
+	}
+
+	return nil
+}
+
+// GetTxCmds returns the transaction commands of the registered light client modules.
+func (r *LightClientRegistry) GetTxCmds() []*cobra.Command {
+	var cmds []*cobra.Command
+	for _, clientType := range r.ClientTypes() {
+		module, ok := r.modules[clientType].(CLIModule)
+		if !ok {
+			continue
+		}
+
+		if cmd := module.GetTxCmd(); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	}
+
+	return cmds
+}
+
+// GetQueryCmds returns the query commands of the registered light client modules.
+func (r *LightClientRegistry) GetQueryCmds() []*cobra.Command {
+	var cmds []*cobra.Command
+	for _, clientType := range r.ClientTypes() {
+		module, ok := r.modules[clientType].(CLIModule)
+		if !ok {
+			continue
+		}
+
+		if cmd := module.GetQueryCmd(); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	}
+
+	return cmds
+}
@@ -0,0 +1,184 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+)
+
+const mockClientType = "9999-mock"
+
+var _ types.SelfClientModule = mockLightClientModule{}
+
+// mockLightClientModule is a light client module which is not part of this repository.
+type mockLightClientModule struct {
+	clientType string
+}
+
+func (m mockLightClientModule) ClientType() string {
+	return m.clientType
+}
+
+func (mockLightClientModule) RegisterInterfaces(codectypes.InterfaceRegistry) {}
+
+func (mockLightClientModule) SelfClient() exported.SelfClient {
+	return ibctmtypes.NewSelfClient()
+}
+
+func (mockLightClientModule) ValidateGenesisMetadata(_ exported.ClientState, metadata []types.GenesisMetadata) error {
+	for _, gm := range metadata {
+		if string(gm.Key) != "mock/processed" {
+			return errors.New("unexpected metadata key")
+		}
+	}
+
+	return nil
+}
+
+func (m mockLightClientModule) GetTxCmd() *cobra.Command {
+	return &cobra.Command{Use: m.clientType}
+}
+
+func (mockLightClientModule) GetQueryCmd() *cobra.Command {
+	return nil
+}
+
+// mockClientState is a client state of the mock light client.
+type mockClientState struct {
+	*ibctmtypes.ClientState
+}
+
+func (*mockClientState) ClientType() string {
+	return mockClientType
+}
+
+func TestLightClientRegistryRegister(t *testing.T) {
+	registry := types.NewLightClientRegistry(mockLightClientModule{clientType: mockClientType})
+
+	require.True(t, registry.HasClientType(mockClientType))
+	require.False(t, registry.HasClientType(exported.Tendermint))
+
+	// duplicate client type
+	require.Panics(t, func() {
+		registry.Register(mockLightClientModule{clientType: mockClientType})
+	})
+
+	// invalid client type
+	require.Panics(t, func() {
+		registry.Register(mockLightClientModule{clientType: "mock"})
+	})
+
+	registry.Register(mockLightClientModule{clientType: "1000-mock"})
+	require.Equal(t, []string{"1000-mock", mockClientType}, registry.ClientTypes())
+
+	module, found := registry.GetModule("1000-mock")
+	require.True(t, found)
+	require.Equal(t, "1000-mock", module.ClientType())
+}
+
+func TestLightClientRegistrySelfClient(t *testing.T) {
+	registry := types.NewLightClientRegistry(mockLightClientModule{clientType: mockClientType})
+
+	selfClient, err := registry.SelfClient(mockClientType)
+	require.NoError(t, err)
+	require.NotNil(t, selfClient)
+
+	_, err = registry.SelfClient(exported.Tendermint)
+	require.ErrorIs(t, err, types.ErrInvalidClientType)
+}
+
+func TestLightClientRegistryValidateGenesis(t *testing.T) {
+	clientID := types.FormatClientIdentifier(mockClientType, 0)
+
+	testCases := []struct {
+		name     string
+		genState types.GenesisState
+		expPass  bool
+	}{
+		{
+			"valid genesis",
+			types.GenesisState{
+				Clients: []types.IdentifiedClientState{
+					{ClientId: clientID, ClientState: codectypes.UnsafePackAny(&mockClientState{&ibctmtypes.ClientState{}})},
+				},
+				ClientsMetadata: []types.IdentifiedGenesisMetadata{
+					types.NewIdentifiedGenesisMetadata(clientID, []types.GenesisMetadata{
+						types.NewGenesisMetadata([]byte("mock/processed"), []byte("value")),
+					}),
+				},
+			},
+			true,
+		},
+		{
+			"unregistered client type",
+			types.GenesisState{
+				Clients: []types.IdentifiedClientState{
+					{ClientId: clientID, ClientState: codectypes.UnsafePackAny(&ibctmtypes.ClientState{})},
+				},
+			},
+			false,
+		},
+		{
+			"invalid metadata",
+			types.GenesisState{
+				Clients: []types.IdentifiedClientState{
+					{ClientId: clientID, ClientState: codectypes.UnsafePackAny(&mockClientState{&ibctmtypes.ClientState{}})},
+				},
+				ClientsMetadata: []types.IdentifiedGenesisMetadata{
+					types.NewIdentifiedGenesisMetadata(clientID, []types.GenesisMetadata{
+						types.NewGenesisMetadata([]byte("processed"), []byte("value")),
+					}),
+				},
+			},
+			false,
+		},
+		{
+			"metadata of unknown client",
+			types.GenesisState{
+				ClientsMetadata: []types.IdentifiedGenesisMetadata{
+					types.NewIdentifiedGenesisMetadata(clientID, []types.GenesisMetadata{
+						types.NewGenesisMetadata([]byte("mock/processed"), []byte("value")),
+					}),
+				},
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			registry := types.NewLightClientRegistry(mockLightClientModule{clientType: mockClientType})
+
+			err := registry.ValidateGenesis(tc.genState)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestLightClientRegistryCmds(t *testing.T) {
+	registry := types.NewLightClientRegistry(
+		mockLightClientModule{clientType: mockClientType},
+		mockLightClientModule{clientType: "1000-mock"},
+	)
+
+	txCmds := registry.GetTxCmds()
+	require.Len(t, txCmds, 2)
+	require.Equal(t, "1000-mock", txCmds[0].Use)
+	require.Equal(t, mockClientType, txCmds[1].Use)
+
+	// nil commands are skipped
+	require.Empty(t, registry.GetQueryCmds())
+}
@@ -0,0 +1,152 @@
+package types
+
+import (
+	"bytes"
+	"io"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.KVStore = (*readOnlyStore)(nil)
+	_ sdk.KVStore = (*TrackingStore)(nil)
+)
+
+// readOnlyStore wraps a store given to a light client and rejects every write, except
+// to the writable keys, by panicking with ErrClientStoreWrite.
+type readOnlyStore struct {
+	sdk.KVStore
+
+	writableKeys [][]byte
+}
+
+// NewReadOnlyStore returns a store which rejects every write to the given store, except
+// to the writable keys. A rejected write panics with ErrClientStoreWrite, which may be
+// recovered as an error using RecoverClientStoreWrite. Cache wrapping the returned store
+// does not allow writes to bypass it.
+func NewReadOnlyStore(store sdk.KVStore, writableKeys ...[]byte) sdk.KVStore {
+	return &readOnlyStore{
+		KVStore:      store,
+		writableKeys: writableKeys,
+	}
+}
+
+// Set implements sdk.KVStore. It panics if the key is not writable.
+func (s *readOnlyStore) Set(key, value []byte) {
+	s.assertWritable(key)
+	s.KVStore.Set(key, value)
+}
+
+// Delete implements sdk.KVStore. It panics if the key is not writable.
+func (s *readOnlyStore) Delete(key []byte) {
+	s.assertWritable(key)
+	s.KVStore.Delete(key)
+}
+
+// CacheWrap implements sdk.KVStore. Writes of the cache are applied through the read-only store.
+func (s *readOnlyStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements sdk.KVStore. Writes of the cache are applied through the read-only store.
+func (s *readOnlyStore) CacheWrapWithTrace(w io.Writer, tc storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// CacheWrapWithListeners implements sdk.KVStore. Writes of the cache are applied through the read-only store.
+func (s *readOnlyStore) CacheWrapWithListeners(storeKey storetypes.StoreKey, listeners []storetypes.WriteListener) storetypes.CacheWrap {
+	return cachekv.NewStore(listenkv.NewStore(s, storeKey, listeners))
+}
+
+// assertWritable panics with ErrClientStoreWrite if the key is not one of the writable keys.
+func (s *readOnlyStore) assertWritable(key []byte) {
+	for _, writableKey := range s.writableKeys {
+		if bytes.Equal(key, writableKey) {
+			return
+		}
+	}
+
+	panic(sdkerrors.Wrapf(ErrClientStoreWrite, "write to key %s of a read-only client store", key))
+}
+
+// TrackingStore wraps the store of a single client and records the keys written by the
+// light client. Keys are relative to the client prefix and writes are only applied under
+// it, thus a write can not escape the client store. Cache wrapping the store does not
+// allow writes to bypass the tracking.
+type TrackingStore struct {
+	sdk.KVStore
+
+	writtenKeys map[string]struct{}
+}
+
+// NewTrackingStore returns a TrackingStore for the client store under the given client
+// prefix of the parent store.
+func NewTrackingStore(parent sdk.KVStore, clientPrefix []byte) *TrackingStore {
+	return &TrackingStore{
+		KVStore:     prefix.NewStore(parent, clientPrefix),
+		writtenKeys: make(map[string]struct{}),
+	}
+}
+
+// Set implements sdk.KVStore. The key is recorded as written.
+func (s *TrackingStore) Set(key, value []byte) {
+	s.KVStore.Set(key, value)
+	s.writtenKeys[string(key)] = struct{}{}
+}
+
+// Delete implements sdk.KVStore. The key is recorded as written.
+func (s *TrackingStore) Delete(key []byte) {
+	s.KVStore.Delete(key)
+	s.writtenKeys[string(key)] = struct{}{}
+}
+
+// CacheWrap implements sdk.KVStore. Writes of the cache are applied through the tracking store.
+func (s *TrackingStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements sdk.KVStore. Writes of the cache are applied through the tracking store.
+func (s *TrackingStore) CacheWrapWithTrace(w io.Writer, tc storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// CacheWrapWithListeners implements sdk.KVStore. Writes of the cache are applied through the tracking store.
+func (s *TrackingStore) CacheWrapWithListeners(storeKey storetypes.StoreKey, listeners []storetypes.WriteListener) storetypes.CacheWrap {
+	return cachekv.NewStore(listenkv.NewStore(s, storeKey, listeners))
+}
+
+// WrittenKeys returns the sorted keys, relative to the client prefix, which were set or
+// deleted through the store.
+func (s *TrackingStore) WrittenKeys() []string {
+	keys := make([]string, 0, len(s.writtenKeys))
+	for key := range s.writtenKeys {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// RecoverClientStoreWrite recovers a panic raised by a write rejected by a read-only client
+// store and sets it as the error pointed to. Any other panic is raised again. It must be
+// deferred by the caller of the light client.
+func RecoverClientStoreWrite(err *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	if recovered, ok := r.(error); ok && ErrClientStoreWrite.Is(recovered) {
+		*err = recovered
+		return
+	}
+
+	panic(r)
+}
@@ -0,0 +1,173 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+)
+
+var (
+	clientPrefix = []byte("clients/07-tendermint-0/")
+	writableKey  = []byte("clientState")
+)
+
+func TestReadOnlyStore(t *testing.T) {
+	testCases := []struct {
+		name  string
+		write func(store sdk.KVStore)
+		pass  bool
+	}{
+		{
+			"set writable key", func(store sdk.KVStore) {
+				store.Set(writableKey, []byte("value"))
+			}, true,
+		},
+		{
+			"delete writable key", func(store sdk.KVStore) {
+				store.Delete(writableKey)
+			}, true,
+		},
+		{
+			"set writable key through cache", func(store sdk.KVStore) {
+				cache := store.CacheWrap()
+				cache.(sdk.KVStore).Set(writableKey, []byte("value"))
+				cache.Write()
+			}, true,
+		},
+		{
+			"set key", func(store sdk.KVStore) {
+				store.Set([]byte("key"), []byte("value"))
+			}, false,
+		},
+		{
+			"delete key", func(store sdk.KVStore) {
+				store.Delete([]byte("key"))
+			}, false,
+		},
+		{
+			"set key with writable key prefix", func(store sdk.KVStore) {
+				store.Set(append(writableKey, '/'), []byte("value"))
+			}, false,
+		},
+		{
+			"set key through cache", func(store sdk.KVStore) {
+				cache := store.CacheWrap()
+				cache.(sdk.KVStore).Set([]byte("key"), []byte("value"))
+				cache.Write()
+			}, false,
+		},
+		{
+			"set key through traced cache", func(store sdk.KVStore) {
+				cache := store.CacheWrapWithTrace(&bytes.Buffer{}, nil)
+				cache.(sdk.KVStore).Set([]byte("key"), []byte("value"))
+				cache.Write()
+			}, false,
+		},
+		{
+			"set key through listened cache", func(store sdk.KVStore) {
+				cache := store.CacheWrapWithListeners(nil, nil)
+				cache.(sdk.KVStore).Set([]byte("key"), []byte("value"))
+				cache.Write()
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			parent := dbadapter.Store{DB: dbm.NewMemDB()}
+			parent.Set([]byte("key"), []byte("stored"))
+
+			store := types.NewReadOnlyStore(parent, writableKey)
+
+			if tc.pass {
+				require.NotPanics(t, func() { tc.write(store) })
+				return
+			}
+
+			err := func() (err error) {
+				defer types.RecoverClientStoreWrite(&err)
+
+				tc.write(store)
+				return nil
+			}()
+			require.ErrorIs(t, err, types.ErrClientStoreWrite)
+
+			// rejected writes must not reach the parent store
+			require.Equal(t, []byte("stored"), parent.Get([]byte("key")))
+			require.False(t, parent.Has(append(writableKey, '/')))
+		})
+	}
+}
+
+func TestReadOnlyStoreReads(t *testing.T) {
+	parent := dbadapter.Store{DB: dbm.NewMemDB()}
+	parent.Set([]byte("key"), []byte("value"))
+
+	store := types.NewReadOnlyStore(parent)
+	require.True(t, store.Has([]byte("key")))
+	require.Equal(t, []byte("value"), store.Get([]byte("key")))
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	require.True(t, iterator.Valid())
+	require.Equal(t, []byte("key"), iterator.Key())
+}
+
+func TestTrackingStore(t *testing.T) {
+	parent := dbadapter.Store{DB: dbm.NewMemDB()}
+	store := types.NewTrackingStore(parent, clientPrefix)
+
+	store.Set([]byte("consensusStates/1-1"), []byte("value"))
+	store.Set([]byte("clientState"), []byte("value"))
+	store.Delete([]byte("consensusStates/1-0"))
+
+	// writes of a cache are tracked
+	cache := store.CacheWrap()
+	cache.(sdk.KVStore).Set([]byte("iterateConsensusStates/1-1"), []byte("value"))
+	cache.Write()
+
+	require.Equal(t, []string{"clientState", "consensusStates/1-0", "consensusStates/1-1", "iterateConsensusStates/1-1"}, store.WrittenKeys())
+
+	// writes only land under the client prefix of the parent store
+	require.Equal(t, []byte("value"), parent.Get(append(clientPrefix, []byte("clientState")...)))
+	require.False(t, parent.Has([]byte("clientState")))
+
+	iterator := parent.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		require.Equal(t, clientPrefix, iterator.Key()[:len(clientPrefix)])
+	}
+
+	// keys escaping the client prefix stay under it
+	otherClientKey := []byte("../07-tendermint-1/clientState")
+	store.Set(otherClientKey, []byte("value"))
+	require.False(t, prefix.NewStore(parent, []byte("clients/07-tendermint-1/")).Has([]byte("clientState")))
+	require.True(t, parent.Has(append(clientPrefix, otherClientKey...)))
+}
+
+func TestRecoverClientStoreWrite(t *testing.T) {
+	write := func(store sdk.KVStore) (err error) {
+		defer types.RecoverClientStoreWrite(&err)
+
+		store.Set([]byte("key"), []byte("value"))
+		return nil
+	}
+
+	parent := dbadapter.Store{DB: dbm.NewMemDB()}
+	require.ErrorIs(t, write(types.NewReadOnlyStore(parent)), types.ErrClientStoreWrite)
+	require.NoError(t, write(parent))
+
+	// other panics are not recovered
+	require.Panics(t, func() {
+		_ = write(nil)
+	})
+}
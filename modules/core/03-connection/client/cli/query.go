@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -13,6 +14,10 @@ import (
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
+const (
+	flagState = "state"
+)
+
 // GetCmdQueryConnections defines the command to query all the connection ends
 // that this chain mantains.
 func GetCmdQueryConnections() *cobra.Command {
@@ -20,7 +25,7 @@ func GetCmdQueryConnections() *cobra.Command {
 		Use:     "connections",
 		Short:   "Query all connections",
 		Long:    "Query all connections ends from a chain",
-		Example: fmt.Sprintf("%s query %s %s connections", version.AppName, host.ModuleName, types.SubModuleName),
+		Example: fmt.Sprintf("%s query %s %s connections --%s OPEN", version.AppName, host.ModuleName, types.SubModuleName, flagState),
 		Args:    cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -34,8 +39,14 @@ func GetCmdQueryConnections() *cobra.Command {
 				return err
 			}
 
+			state, err := parseState(cmd)
+			if err != nil {
+				return err
+			}
+
 			req := &types.QueryConnectionsRequest{
 				Pagination: pageReq,
+				State:      state,
 			}
 
 			res, err := queryClient.Connections(cmd.Context(), req)
@@ -47,12 +58,31 @@ func GetCmdQueryConnections() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagState, "", "only return connection ends in the given state (INIT, TRYOPEN, OPEN or CLOSED)")
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "connection ends")
 
 	return cmd
 }
 
+// parseState parses the optional connection state filter flag.
+func parseState(cmd *cobra.Command) (types.State, error) {
+	stateStr, err := cmd.Flags().GetString(flagState)
+	if err != nil || stateStr == "" {
+		return types.UNINITIALIZED, err
+	}
+
+	stateStr = strings.ToUpper(stateStr)
+	if state, ok := types.State_value[stateStr]; ok {
+		return types.State(state), nil
+	}
+	if state, ok := types.State_value["STATE_"+stateStr]; ok {
+		return types.State(state), nil
+	}
+
+	return types.UNINITIALIZED, fmt.Errorf("invalid connection state %s", stateStr)
+}
+
 // GetCmdQueryConnection defines the command to query a connection end
 func GetCmdQueryConnection() *cobra.Command {
 	cmd := &cobra.Command{
@@ -14,6 +14,18 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 		conn := types.NewConnectionEnd(connection.State, connection.ClientId, connection.Counterparty, connection.Versions, connection.DelayPeriod)
 		k.SetConnection(ctx, connection.Id, conn)
 	}
+	for _, startTime := range gs.HandshakeStartTimes {
+		k.SetHandshakeStartTime(ctx, startTime.ConnectionId, startTime.Timestamp)
+	}
+	for _, connection := range gs.Connections {
+		// the opening handshake of an imported connection without a start time starts at genesis
+		if connection.State != types.INIT && connection.State != types.TRYOPEN {
+			continue
+		}
+		if _, found := k.GetHandshakeStartTime(ctx, connection.Id); !found {
+			k.SetHandshakeStartTime(ctx, connection.Id, uint64(ctx.BlockTime().UnixNano()))
+		}
+	}
 	for _, connPaths := range gs.ClientConnectionPaths {
 		k.SetClientConnectionPaths(ctx, connPaths.ClientId, connPaths.Paths)
 	}
@@ -28,5 +40,6 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 		ClientConnectionPaths:  k.GetAllClientConnectionPaths(ctx),
 		NextConnectionSequence: k.GetNextConnectionSequence(ctx),
 		Params:                 k.GetParams(ctx),
+		HandshakeStartTimes:    k.GetAllHandshakeStartTimes(ctx),
 	}
 }
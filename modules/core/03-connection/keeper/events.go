@@ -73,3 +73,37 @@ func EmitConnectionOpenConfirmEvent(ctx sdk.Context, connectionID string, connec
 		),
 	})
 }
+
+// EmitConnectionOpenCancelEvent emits a connection open cancel event
+func EmitConnectionOpenCancelEvent(ctx sdk.Context, connectionID string, connectionEnd types.ConnectionEnd) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConnectionOpenCancel,
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyClientID, connectionEnd.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyClientID, connectionEnd.Counterparty.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyConnectionID, connectionEnd.Counterparty.ConnectionId),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// EmitConnectionCloseEvent emits a connection close event
+func EmitConnectionCloseEvent(ctx sdk.Context, connectionID string, connectionEnd types.ConnectionEnd) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConnectionClose,
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyClientID, connectionEnd.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyClientID, connectionEnd.Counterparty.ClientId),
+			sdk.NewAttribute(types.AttributeKeyCounterpartyConnectionID, connectionEnd.Counterparty.ConnectionId),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
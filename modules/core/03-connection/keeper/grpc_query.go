@@ -53,20 +53,28 @@ func (q Keeper) Connections(c context.Context, req *types.QueryConnectionsReques
 	connections := []*types.IdentifiedConnection{}
 	store := prefix.NewStore(ctx.KVStore(q.storeKey), []byte(host.KeyConnectionPrefix))
 
-	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
 		var result types.ConnectionEnd
 		if err := q.cdc.Unmarshal(value, &result); err != nil {
-			return err
+			return false, err
+		}
+
+		// filter connections by state if a state is provided
+		if req.State != types.UNINITIALIZED && result.State != req.State {
+			return false, nil
 		}
 
 		connectionID, err := host.ParseConnectionPath(string(key))
 		if err != nil {
-			return err
+			return false, err
+		}
+
+		if accumulate {
+			identifiedConnection := types.NewIdentifiedConnection(connectionID, result)
+			connections = append(connections, &identifiedConnection)
 		}
 
-		identifiedConnection := types.NewIdentifiedConnection(connectionID, result)
-		connections = append(connections, &identifiedConnection)
-		return nil
+		return true, nil
 	})
 	if err != nil {
 		return nil, err
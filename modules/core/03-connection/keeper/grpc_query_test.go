@@ -148,6 +148,34 @@ func (suite *KeeperTestSuite) TestQueryConnections() {
 			},
 			true,
 		},
+		{
+			"success with state filter",
+			func() {
+				path1 := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path2 := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.SetupConnections(path1)
+				suite.coordinator.SetupClients(path2)
+
+				err := path2.EndpointA.ConnOpenInit()
+				suite.Require().NoError(err)
+
+				// counterparty connection id is blank after open init
+				counterparty2 := types.NewCounterparty(path2.EndpointB.ClientID, "", suite.chainB.GetPrefix())
+				conn2 := types.NewConnectionEnd(types.INIT, path2.EndpointA.ClientID, counterparty2, types.ExportedVersionsToProto(types.GetCompatibleVersions()), 0)
+				iconn2 := types.NewIdentifiedConnection(path2.EndpointA.ConnectionID, conn2)
+
+				expConnections = []*types.IdentifiedConnection{&iconn2}
+
+				req = &types.QueryConnectionsRequest{
+					State: types.INIT,
+					Pagination: &query.PageRequest{
+						Limit:      3,
+						CountTotal: true,
+					},
+				}
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -39,6 +39,7 @@ func (k Keeper) ConnOpenInit(
 	connectionID := k.GenerateConnectionIdentifier(ctx)
 	connection := types.NewConnectionEnd(types.INIT, clientID, counterparty, types.ExportedVersionsToProto(versions), delayPeriod)
 	k.SetConnection(ctx, connectionID, connection)
+	k.SetHandshakeStartTime(ctx, connectionID, uint64(ctx.BlockTime().UnixNano()))
 
 	if err := k.addConnectionToClient(ctx, clientID, connectionID); err != nil {
 		return "", err
@@ -181,6 +182,10 @@ func (k Keeper) ConnOpenTry(
 	}
 
 	k.SetConnection(ctx, connectionID, connection)
+	// a handshake continuing a previous connection keeps the start time set on INIT
+	if _, found := k.GetHandshakeStartTime(ctx, connectionID); !found {
+		k.SetHandshakeStartTime(ctx, connectionID, uint64(ctx.BlockTime().UnixNano()))
+	}
 	k.Logger(ctx).Info("connection state updated", "connection-id", connectionID, "previous-state", previousConnection.State.String(), "new-state", "TRYOPEN")
 
 	defer func() {
@@ -294,6 +299,7 @@ func (k Keeper) ConnOpenAck(
 	connection.Versions = []*types.Version{version}
 	connection.Counterparty.ConnectionId = counterpartyConnectionID
 	k.SetConnection(ctx, connectionID, connection)
+	k.deleteHandshakeStartTime(ctx, connectionID)
 
 	EmitConnectionOpenAckEvent(ctx, connectionID, connection)
 
@@ -339,6 +345,7 @@ func (k Keeper) ConnOpenConfirm(
 	// Update ChainB's connection to Open
 	connection.State = types.OPEN
 	k.SetConnection(ctx, connectionID, connection)
+	k.deleteHandshakeStartTime(ctx, connectionID)
 	k.Logger(ctx).Info("connection state updated", "connection-id", connectionID, "previous-state", "TRYOPEN", "new-state", "OPEN")
 
 	defer func() {
@@ -349,3 +356,91 @@ func (k Keeper) ConnOpenConfirm(
 
 	return nil
 }
+
+// ConnOpenCancel cancels the opening handshake of a connection which is stuck in
+// INIT or TRYOPEN and sets the connection end to CLOSED. The handshake is cancelled
+// either by proving that the counterparty connection end is CLOSED or, if no proof
+// is provided, once the handshake timeout has elapsed since the handshake started.
+//
+// The channel keeper is used to ensure that no channel opened on the connection is
+// still in INIT.
+//
+// NOTE: handshakes which started before their start time was tracked are considered
+// to have started at the zero time.
+func (k Keeper) ConnOpenCancel(
+	ctx sdk.Context,
+	channelKeeper types.ChannelKeeper,
+	connectionID string,
+	counterpartyConnection types.ConnectionEnd, // counterparty connection end in the CLOSED state
+	proofClosed []byte, // proof that the counterparty connection end is CLOSED, empty on timeout
+	proofHeight exported.Height, // height that relayer constructed proofClosed
+) error {
+	connection, found := k.GetConnection(ctx, connectionID)
+	if !found {
+		return sdkerrors.Wrap(types.ErrConnectionNotFound, connectionID)
+	}
+
+	if !(connection.State == types.INIT || connection.State == types.TRYOPEN) {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidConnectionState,
+			"connection state is not INIT or TRYOPEN (got %s)", connection.State.String(),
+		)
+	}
+
+	// channels can only be opened on a connection whose handshake is in progress with
+	// ChanOpenInit, any channel which is not CLOSED is in INIT
+	if channelKeeper.HasActiveChannels(ctx, connectionID) {
+		return sdkerrors.Wrapf(types.ErrConnectionInUse, "channel in INIT on connection %s", connectionID)
+	}
+
+	if len(proofClosed) == 0 {
+		handshakeTimeout := k.GetHandshakeTimeout(ctx)
+		if handshakeTimeout == 0 {
+			return sdkerrors.Wrap(types.ErrHandshakeTimeoutNotReached, "connection handshake timeout is disabled")
+		}
+
+		startTime, _ := k.GetHandshakeStartTime(ctx, connectionID)
+		if timeoutTime := startTime + handshakeTimeout; uint64(ctx.BlockTime().UnixNano()) < timeoutTime {
+			return sdkerrors.Wrapf(
+				types.ErrHandshakeTimeoutNotReached,
+				"block time < handshake timeout time (%d < %d)", ctx.BlockTime().UnixNano(), timeoutTime,
+			)
+		}
+	} else {
+		if connection.Counterparty.ConnectionId == "" {
+			return sdkerrors.Wrap(types.ErrInvalidCounterparty, "counterparty connection identifier is unknown, the handshake can only be cancelled on timeout")
+		}
+
+		// the counterparty connection end must be CLOSED and must refer to this connection end
+		prefix := k.GetCommitmentPrefix()
+		if !(counterpartyConnection.State == types.CLOSED &&
+			counterpartyConnection.ClientId == connection.Counterparty.ClientId &&
+			counterpartyConnection.Counterparty.ClientId == connection.ClientId &&
+			(counterpartyConnection.Counterparty.ConnectionId == "" || counterpartyConnection.Counterparty.ConnectionId == connectionID) &&
+			bytes.Equal(counterpartyConnection.Counterparty.Prefix.Bytes(), prefix.Bytes())) {
+			return sdkerrors.Wrap(types.ErrInvalidCounterparty, "counterparty connection end is not a CLOSED connection end of this connection")
+		}
+
+		// Check that the counterparty connection end is closed
+		if err := k.VerifyConnectionState(
+			ctx, connection, proofHeight, proofClosed, connection.Counterparty.ConnectionId,
+			counterpartyConnection,
+		); err != nil {
+			return err
+		}
+	}
+
+	k.Logger(ctx).Info("connection state updated", "connection-id", connectionID, "previous-state", connection.State.String(), "new-state", "CLOSED")
+
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "connection", "open-cancel")
+	}()
+
+	connection.State = types.CLOSED
+	k.SetConnection(ctx, connectionID, connection)
+	k.deleteHandshakeStartTime(ctx, connectionID)
+
+	EmitConnectionOpenCancelEvent(ctx, connectionID, connection)
+
+	return nil
+}
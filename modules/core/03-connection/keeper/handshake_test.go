@@ -5,6 +5,7 @@ import (
 
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
@@ -665,3 +666,153 @@ func (suite *KeeperTestSuite) TestConnOpenConfirm() {
 		})
 	}
 }
+
+// TestConnOpenCancel - chainB cancels a TRYOPEN connection either because the
+// handshake timeout elapsed or because chainA closed its INIT connection end
+func (suite *KeeperTestSuite) TestConnOpenCancel() {
+	var (
+		path                   *ibctesting.Path
+		counterpartyConnection types.ConnectionEnd
+		withProof              bool
+	)
+
+	closeConnectionA := func() {
+		connection := path.EndpointA.GetConnection()
+		connection.State = types.CLOSED
+		path.EndpointA.SetConnection(connection)
+		suite.coordinator.CommitBlock(suite.chainA)
+
+		counterpartyConnection = connection
+		withProof = true
+	}
+
+	setChannelB := func(state channeltypes.State) {
+		channel := channeltypes.NewChannel(
+			state, channeltypes.UNORDERED, channeltypes.NewCounterparty(ibctesting.MockPort, ""),
+			[]string{path.EndpointB.ConnectionID}, ibctesting.DefaultChannelVersion,
+		)
+		suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetChannel(suite.chainB.GetContext(), ibctesting.MockPort, "channel-0", channel)
+	}
+
+	setHandshakeTimeout := func(timeout uint64) {
+		params := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.GetParams(suite.chainB.GetContext())
+		params.HandshakeTimeout = timeout
+		suite.chainB.App.GetIBCKeeper().ConnectionKeeper.SetParams(suite.chainB.GetContext(), params)
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{"success: counterparty connection closed", func() {
+			closeConnectionA()
+		}, true},
+		{"success: handshake timeout elapsed", func() {
+			setHandshakeTimeout(uint64(time.Second))
+			suite.coordinator.IncrementTimeBy(time.Minute)
+		}, true},
+		{"success: handshake start time not set", func() {
+			setHandshakeTimeout(uint64(time.Second))
+			suite.chainB.App.GetIBCKeeper().ConnectionKeeper.SetHandshakeStartTime(suite.chainB.GetContext(), path.EndpointB.ConnectionID, 0)
+		}, true},
+		{"success: channel on the connection closed", func() {
+			setChannelB(channeltypes.CLOSED)
+			closeConnectionA()
+		}, true},
+		{"connection not found", func() {
+			path.EndpointB.ConnectionID = ibctesting.InvalidID
+		}, false},
+		{"channel in INIT on the connection", func() {
+			setChannelB(channeltypes.INIT)
+			closeConnectionA()
+		}, false},
+		{"connection state is not INIT or TRYOPEN", func() {
+			connection := path.EndpointB.GetConnection()
+			connection.State = types.OPEN
+			path.EndpointB.SetConnection(connection)
+			closeConnectionA()
+		}, false},
+		{"handshake timeout disabled", func() {
+			suite.coordinator.IncrementTimeBy(time.Minute)
+		}, false},
+		{"handshake timeout not reached", func() {
+			setHandshakeTimeout(uint64(time.Hour))
+			suite.coordinator.IncrementTimeBy(time.Minute)
+		}, false},
+		{"counterparty connection identifier unknown", func() {
+			closeConnectionA()
+			connection := path.EndpointB.GetConnection()
+			connection.Counterparty.ConnectionId = ""
+			path.EndpointB.SetConnection(connection)
+		}, false},
+		{"counterparty connection is not CLOSED", func() {
+			closeConnectionA()
+			counterpartyConnection.State = types.INIT
+		}, false},
+		{"counterparty connection refers to a different client", func() {
+			closeConnectionA()
+			counterpartyConnection.Counterparty.ClientId = ibctesting.InvalidID
+		}, false},
+		{"counterparty connection refers to a different connection", func() {
+			closeConnectionA()
+			counterpartyConnection.Counterparty.ConnectionId = ibctesting.InvalidID
+		}, false},
+		{"connection state verification failed", func() {
+			// chainA connection end is still in INIT
+			counterpartyConnection = path.EndpointA.GetConnection()
+			counterpartyConnection.State = types.CLOSED
+			withProof = true
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+			withProof = false
+			counterpartyConnection = types.ConnectionEnd{}
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+
+			err := path.EndpointA.ConnOpenInit()
+			suite.Require().NoError(err)
+
+			err = path.EndpointB.ConnOpenTry()
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			// ensure client is up to date to receive proof
+			err = path.EndpointB.UpdateClient()
+			suite.Require().NoError(err)
+
+			var (
+				proofClosed []byte
+				proofHeight = clienttypes.ZeroHeight()
+			)
+			if withProof {
+				connectionKey := host.ConnectionKey(path.EndpointA.ConnectionID)
+				proofClosed, proofHeight = suite.chainA.QueryProof(connectionKey)
+			}
+
+			err = suite.chainB.App.GetIBCKeeper().ConnectionKeeper.ConnOpenCancel(
+				suite.chainB.GetContext(), suite.chainB.App.GetIBCKeeper().ChannelKeeper, path.EndpointB.ConnectionID, counterpartyConnection, proofClosed, proofHeight,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				connection := path.EndpointB.GetConnection()
+				suite.Require().Equal(types.CLOSED, connection.State)
+
+				_, found := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.GetHandshakeStartTime(suite.chainB.GetContext(), path.EndpointB.ConnectionID)
+				suite.Require().False(found)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -83,9 +83,62 @@ func (k Keeper) SetConnection(ctx sdk.Context, connectionID string, connection t
 	store.Set(host.ConnectionKey(connectionID), bz)
 }
 
+// GetHandshakeStartTime returns the block time, in nanoseconds, at which the opening
+// handshake of a connection started. It returns false if the connection handshake is
+// not in progress.
+func (k Keeper) GetHandshakeStartTime(ctx sdk.Context, connectionID string) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.HandshakeStartTimeKey(connectionID))
+	if bz == nil {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// SetHandshakeStartTime sets the block time, in nanoseconds, at which the opening
+// handshake of a connection started.
+func (k Keeper) SetHandshakeStartTime(ctx sdk.Context, connectionID string, timestamp uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.HandshakeStartTimeKey(connectionID), sdk.Uint64ToBigEndian(timestamp))
+}
+
+// GetAllHandshakeStartTimes returns the opening handshake start times of all the
+// connections whose handshake is in progress.
+func (k Keeper) GetAllHandshakeStartTimes(ctx sdk.Context) (startTimes []types.HandshakeStartTime) {
+	k.IterateConnections(ctx, func(connection types.IdentifiedConnection) bool {
+		timestamp, found := k.GetHandshakeStartTime(ctx, connection.Id)
+		if found {
+			startTimes = append(startTimes, types.NewHandshakeStartTime(connection.Id, timestamp))
+		}
+		return false
+	})
+	return startTimes
+}
+
+// deleteHandshakeStartTime deletes the opening handshake start time of a connection.
+func (k Keeper) deleteHandshakeStartTime(ctx sdk.Context, connectionID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.HandshakeStartTimeKey(connectionID))
+}
+
+// CreateSentinelLocalhostConnection sets the open sentinel connection of the localhost
+// client, whose counterparty is itself.
+func (k Keeper) CreateSentinelLocalhostConnection(ctx sdk.Context) {
+	counterparty := types.NewCounterparty(exported.Localhost, exported.LocalhostConnectionID, commitmenttypes.NewMerklePrefix(k.GetCommitmentPrefix().Bytes()))
+	connection := types.NewConnectionEnd(types.OPEN, exported.Localhost, counterparty, types.ExportedVersionsToProto(types.GetCompatibleVersions()), 0)
+
+	k.SetConnection(ctx, exported.LocalhostConnectionID, connection)
+}
+
 // GetTimestampAtHeight returns the timestamp in nanoseconds of the consensus state at the
-// given height.
+// given height. The localhost client does not store consensus states, the timestamp of the
+// current block is returned for its connections.
 func (k Keeper) GetTimestampAtHeight(ctx sdk.Context, connection types.ConnectionEnd, height exported.Height) (uint64, error) {
+	if connection.GetClientID() == exported.Localhost {
+		return uint64(ctx.BlockTime().UnixNano()), nil
+	}
+
 	consensusState, found := k.clientKeeper.GetClientConsensusState(
 		ctx, connection.GetClientID(), height,
 	)
@@ -0,0 +1,413 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	multihoptypes "github.com/cosmos/ibc-go/v3/modules/core/33-multihop/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// VerifyMultihopChannelState verifies a multi-hop proof of the channel state of
+// the specified channel end, under the specified port, stored on the chain at
+// the end of the connection hops.
+func (k Keeper) VerifyMultihopChannelState(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	connectionHops []string,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	channel exported.ChannelI,
+) error {
+	multihopProof, consensusState, err := k.produceMultihopVerificationArgs(ctx, connection, height, proof)
+	if err != nil {
+		return err
+	}
+
+	channelEnd, ok := channel.(channeltypes.Channel)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "invalid channel type %T", channel)
+	}
+
+	bz, err := k.cdc.Marshal(&channelEnd)
+	if err != nil {
+		return err
+	}
+
+	if err := multihopProof.VerifyMembership(
+		k.cdc, consensusState, connection.GetCounterparty().GetPrefix(), connectionHops,
+		host.ChannelPath(portID, channelID), bz,
+	); err != nil {
+		return sdkerrors.Wrapf(err, "failed multi-hop channel state verification for client (%s)", connection.GetClientID())
+	}
+
+	return k.setLastHopConsensusState(ctx, connectionHops, multihopProof)
+}
+
+// VerifyMultihopPacketCommitment verifies a multi-hop proof of an outgoing packet
+// commitment at the specified port, specified channel, and specified sequence.
+// The largest delay period of the connections along the path is enforced, and
+// the additional channel time and block delays are applied on top of it.
+func (k Keeper) VerifyMultihopPacketCommitment(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	connectionHops []string,
+	height exported.Height,
+	channelTimeDelay uint64,
+	channelBlockDelay uint64,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+	commitmentBytes []byte,
+) error {
+	multihopProof, consensusState, err := k.produceMultihopVerificationArgs(ctx, connection, height, proof)
+	if err != nil {
+		return err
+	}
+
+	if err := k.verifyMultihopDelayPeriodPassed(ctx, connection, multihopProof, height, channelTimeDelay, channelBlockDelay); err != nil {
+		return err
+	}
+
+	if err := multihopProof.VerifyMembership(
+		k.cdc, consensusState, connection.GetCounterparty().GetPrefix(), connectionHops,
+		host.PacketCommitmentPath(portID, channelID, sequence), commitmentBytes,
+	); err != nil {
+		return sdkerrors.Wrapf(err, "failed multi-hop packet commitment verification for client (%s)", connection.GetClientID())
+	}
+
+	return k.setLastHopConsensusState(ctx, connectionHops, multihopProof)
+}
+
+// VerifyMultihopPacketAcknowledgement verifies a multi-hop proof of an incoming
+// packet acknowledgement at the specified port, specified channel, and specified
+// sequence. The largest delay period of the connections along the path is
+// enforced, and the additional channel time and block delays are applied on top
+// of it.
+func (k Keeper) VerifyMultihopPacketAcknowledgement(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	connectionHops []string,
+	height exported.Height,
+	channelTimeDelay uint64,
+	channelBlockDelay uint64,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+	acknowledgement []byte,
+) error {
+	multihopProof, consensusState, err := k.produceMultihopVerificationArgs(ctx, connection, height, proof)
+	if err != nil {
+		return err
+	}
+
+	if err := k.verifyMultihopDelayPeriodPassed(ctx, connection, multihopProof, height, channelTimeDelay, channelBlockDelay); err != nil {
+		return err
+	}
+
+	if err := multihopProof.VerifyMembership(
+		k.cdc, consensusState, connection.GetCounterparty().GetPrefix(), connectionHops,
+		host.PacketAcknowledgementPath(portID, channelID, sequence), channeltypes.CommitAcknowledgement(acknowledgement),
+	); err != nil {
+		return sdkerrors.Wrapf(err, "failed multi-hop packet acknowledgement verification for client (%s)", connection.GetClientID())
+	}
+
+	return k.setLastHopConsensusState(ctx, connectionHops, multihopProof)
+}
+
+// VerifyMultihopPacketReceiptAbsence verifies a multi-hop proof of the absence of
+// an incoming packet receipt at the specified port, specified channel, and
+// specified sequence. The largest delay period of the connections along the path
+// is enforced, and the additional channel time and block delays are applied on
+// top of it.
+func (k Keeper) VerifyMultihopPacketReceiptAbsence(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	connectionHops []string,
+	height exported.Height,
+	channelTimeDelay uint64,
+	channelBlockDelay uint64,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+) error {
+	multihopProof, consensusState, err := k.produceMultihopVerificationArgs(ctx, connection, height, proof)
+	if err != nil {
+		return err
+	}
+
+	if err := k.verifyMultihopDelayPeriodPassed(ctx, connection, multihopProof, height, channelTimeDelay, channelBlockDelay); err != nil {
+		return err
+	}
+
+	if err := multihopProof.VerifyNonMembership(
+		k.cdc, consensusState, connection.GetCounterparty().GetPrefix(), connectionHops,
+		host.PacketReceiptPath(portID, channelID, sequence),
+	); err != nil {
+		return sdkerrors.Wrapf(err, "failed multi-hop packet receipt absence verification for client (%s)", connection.GetClientID())
+	}
+
+	return k.setLastHopConsensusState(ctx, connectionHops, multihopProof)
+}
+
+// VerifyMultihopNextSequenceRecv verifies a multi-hop proof of the next sequence
+// number to be received of the specified channel at the specified port. The
+// largest delay period of the connections along the path is enforced, and the
+// additional channel time and block delays are applied on top of it.
+func (k Keeper) VerifyMultihopNextSequenceRecv(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	connectionHops []string,
+	height exported.Height,
+	channelTimeDelay uint64,
+	channelBlockDelay uint64,
+	proof []byte,
+	portID,
+	channelID string,
+	nextSequenceRecv uint64,
+) error {
+	multihopProof, consensusState, err := k.produceMultihopVerificationArgs(ctx, connection, height, proof)
+	if err != nil {
+		return err
+	}
+
+	if err := k.verifyMultihopDelayPeriodPassed(ctx, connection, multihopProof, height, channelTimeDelay, channelBlockDelay); err != nil {
+		return err
+	}
+
+	if err := multihopProof.VerifyMembership(
+		k.cdc, consensusState, connection.GetCounterparty().GetPrefix(), connectionHops,
+		host.NextSequenceRecvPath(portID, channelID), sdk.Uint64ToBigEndian(nextSequenceRecv),
+	); err != nil {
+		return sdkerrors.Wrapf(err, "failed multi-hop next sequence receive verification for client (%s)", connection.GetClientID())
+	}
+
+	return k.setLastHopConsensusState(ctx, connectionHops, multihopProof)
+}
+
+// VerifyMultihopNextSequenceAck verifies a multi-hop proof of the next sequence
+// number to be acknowledged of the specified channel at the specified port. The
+// largest delay period of the connections along the path is enforced, and the
+// additional channel time and block delays are applied on top of it.
+func (k Keeper) VerifyMultihopNextSequenceAck(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	connectionHops []string,
+	height exported.Height,
+	channelTimeDelay uint64,
+	channelBlockDelay uint64,
+	proof []byte,
+	portID,
+	channelID string,
+	nextSequenceAck uint64,
+) error {
+	multihopProof, consensusState, err := k.produceMultihopVerificationArgs(ctx, connection, height, proof)
+	if err != nil {
+		return err
+	}
+
+	if err := k.verifyMultihopDelayPeriodPassed(ctx, connection, multihopProof, height, channelTimeDelay, channelBlockDelay); err != nil {
+		return err
+	}
+
+	if err := multihopProof.VerifyMembership(
+		k.cdc, consensusState, connection.GetCounterparty().GetPrefix(), connectionHops,
+		host.NextSequenceAckPath(portID, channelID), sdk.Uint64ToBigEndian(nextSequenceAck),
+	); err != nil {
+		return sdkerrors.Wrapf(err, "failed multi-hop next sequence acknowledgement verification for client (%s)", connection.GetClientID())
+	}
+
+	return k.setLastHopConsensusState(ctx, connectionHops, multihopProof)
+}
+
+// VerifyMultihopClientFrozen verifies multi-hop proofs that the client of one of
+// the connections along the path of a multi-hop channel is frozen. The proofs
+// prove the connection end and its client state, which are both stored on the
+// chain reached by the connection hops preceding the connection. The proven
+// connection end and client state are provided as the values of the proofs.
+func (k Keeper) VerifyMultihopClientFrozen(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	connectionHops []string,
+	height exported.Height,
+	proofConnection []byte,
+	proofClientState []byte,
+) error {
+	connectionProof, consensusState, err := k.produceMultihopVerificationArgs(ctx, connection, height, proofConnection)
+	if err != nil {
+		return err
+	}
+
+	// the connection is stored on the chain reached after len(connectionProof.Hops)+1 hops
+	index := len(connectionProof.Hops) + 1
+	if index >= len(connectionHops) {
+		return sdkerrors.Wrapf(
+			multihoptypes.ErrInvalidMultihopProof,
+			"proof of connection at hop %d for a path of %d connection hops", index, len(connectionHops),
+		)
+	}
+
+	var frozenConnection types.ConnectionEnd
+	if err := k.cdc.Unmarshal(connectionProof.Value, &frozenConnection); err != nil {
+		return sdkerrors.Wrap(multihoptypes.ErrInvalidMultihopProof, "failed to unmarshal proven connection end")
+	}
+
+	if err := connectionProof.VerifyMembership(
+		k.cdc, consensusState, connection.GetCounterparty().GetPrefix(), connectionHops[:index],
+		host.ConnectionPath(connectionHops[index]), connectionProof.Value,
+	); err != nil {
+		return sdkerrors.Wrapf(err, "failed multi-hop connection state verification for client (%s)", connection.GetClientID())
+	}
+
+	var clientStateProof multihoptypes.MultihopProof
+	if err := k.cdc.Unmarshal(proofClientState, &clientStateProof); err != nil {
+		return sdkerrors.Wrap(multihoptypes.ErrInvalidMultihopProof, "failed to unmarshal client state proof")
+	}
+
+	clientState, err := clienttypes.UnmarshalClientState(k.cdc, clientStateProof.Value)
+	if err != nil {
+		return sdkerrors.Wrap(multihoptypes.ErrInvalidMultihopProof, "failed to unmarshal proven client state")
+	}
+
+	if err := clientStateProof.VerifyMembership(
+		k.cdc, consensusState, connection.GetCounterparty().GetPrefix(), connectionHops[:index],
+		host.FullClientStatePath(frozenConnection.ClientId), clientStateProof.Value,
+	); err != nil {
+		return sdkerrors.Wrapf(err, "failed multi-hop client state verification for client (%s)", connection.GetClientID())
+	}
+
+	// the client store of the proven client state is not available, the light client
+	// is given a client store holding the proven client state
+	clientStore := k.clientKeeper.ProvenClientStore(frozenConnection.ClientId, clientState)
+	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Frozen {
+		return sdkerrors.Wrapf(
+			multihoptypes.ErrClientNotFrozen,
+			"client (%s) of connection (%s) at hop %d is not frozen", frozenConnection.ClientId, connectionHops[index], index,
+		)
+	}
+
+	return nil
+}
+
+// GetLastHopConsensusState returns the latest consensus state of the last chain of the
+// path of the given connection hops, and its height, verified in a multi-hop proof. It
+// is the consensus state stored by the client of the last connection hop.
+func (k Keeper) GetLastHopConsensusState(ctx sdk.Context, connectionHops []string) (exported.ConsensusState, exported.Height, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastHopConsensusStateKey(connectionHops))
+	if bz == nil {
+		return nil, nil, false
+	}
+
+	var consensusStateWithHeight clienttypes.ConsensusStateWithHeight
+	k.cdc.MustUnmarshal(bz, &consensusStateWithHeight)
+
+	consensusState, err := clienttypes.UnpackConsensusState(consensusStateWithHeight.ConsensusState)
+	if err != nil {
+		panic(err)
+	}
+
+	return consensusState, consensusStateWithHeight.Height, true
+}
+
+// setLastHopConsensusState stores the consensus state of the last chain of the path
+// proven by a verified multi-hop proof, unless a consensus state at a greater or equal
+// height is already stored.
+func (k Keeper) setLastHopConsensusState(ctx sdk.Context, connectionHops []string, multihopProof multihoptypes.MultihopProof) error {
+	consensusState, height, err := multihopProof.LastConsensusState()
+	if err != nil {
+		return err
+	}
+
+	if _, latestHeight, found := k.GetLastHopConsensusState(ctx, connectionHops); found && latestHeight.GTE(height) {
+		return nil
+	}
+
+	consensusStateWithHeight := clienttypes.NewConsensusStateWithHeight(
+		clienttypes.NewHeight(height.GetRevisionNumber(), height.GetRevisionHeight()), consensusState,
+	)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastHopConsensusStateKey(connectionHops), k.cdc.MustMarshal(&consensusStateWithHeight))
+	return nil
+}
+
+// produceMultihopVerificationArgs performs the checks shared by the multi-hop
+// verification functions and returns the unmarshalled multi-hop proof and the
+// consensus state of the first chain of the path at the proof height.
+func (k Keeper) produceMultihopVerificationArgs(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+) (multihoptypes.MultihopProof, exported.ConsensusState, error) {
+	clientID := connection.GetClientID()
+	clientStore := k.clientKeeper.ReadOnlyClientStore(ctx, clientID)
+
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return multihoptypes.MultihopProof{}, nil, sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
+		return multihoptypes.MultihopProof{}, nil, sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	var multihopProof multihoptypes.MultihopProof
+	if err := k.cdc.Unmarshal(proof, &multihopProof); err != nil {
+		return multihoptypes.MultihopProof{}, nil, sdkerrors.Wrap(multihoptypes.ErrInvalidMultihopProof, "failed to unmarshal proof into multi-hop proof")
+	}
+
+	consensusState, found := k.clientKeeper.GetClientConsensusState(ctx, clientID, height)
+	if !found {
+		return multihoptypes.MultihopProof{}, nil, sdkerrors.Wrapf(
+			clienttypes.ErrConsensusStateNotFound,
+			"please ensure the proof was constructed against a height that exists on the client (%s): %s", clientID, height,
+		)
+	}
+
+	return multihopProof, consensusState, nil
+}
+
+// verifyMultihopDelayPeriodPassed enforces the largest delay period of the
+// connections along the path, plus the additional channel time and block delays,
+// on the consensus state of the first chain of the path.
+func (k Keeper) verifyMultihopDelayPeriodPassed(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	multihopProof multihoptypes.MultihopProof,
+	height exported.Height,
+	channelTimeDelay uint64,
+	channelBlockDelay uint64,
+) error {
+	clientID := connection.GetClientID()
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	// get time and block delays
+	timeDelay, blockDelay, err := k.getPacketDelaysForDelayPeriod(ctx, multihopProof.MaxDelayPeriod(connection), channelTimeDelay, channelBlockDelay)
+	if err != nil {
+		return err
+	}
+
+	delayClientState, ok := clientState.(exported.DelayPeriodClientState)
+	if !ok {
+		if timeDelay != 0 || blockDelay != 0 {
+			return sdkerrors.Wrapf(clienttypes.ErrInvalidClientType, "client (%s) does not support delay periods", clientID)
+		}
+		return nil
+	}
+
+	return delayClientState.VerifyDelayPeriodPassed(ctx, k.clientKeeper.ReadOnlyClientStore(ctx, clientID), height, timeDelay, blockDelay)
+}
@@ -13,9 +13,17 @@ func (k Keeper) GetMaxExpectedTimePerBlock(ctx sdk.Context) uint64 {
 	return res
 }
 
+// GetHandshakeTimeout retrieves the connection handshake timeout from the paramstore.
+// A zero value is returned if the parameter has not been set.
+func (k Keeper) GetHandshakeTimeout(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.GetIfExists(ctx, types.KeyHandshakeTimeout, &res)
+	return res
+}
+
 // GetParams returns the total set of ibc-connection parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(k.GetMaxExpectedTimePerBlock(ctx))
+	return types.NewParamsWithHandshakeTimeout(k.GetMaxExpectedTimePerBlock(ctx), k.GetHandshakeTimeout(ctx))
 }
 
 // SetParams sets the total set of ibc-connection parameters.
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+)
+
+// ConnectionCloseProposal will close the connection end referenced by the proposal.
+// A connection may be closed in any state, for example when its opening handshake
+// is stuck or when its client is permanently expired. The channel keeper is used
+// to ensure that every channel built on the connection has already been closed.
+func (k Keeper) ConnectionCloseProposal(ctx sdk.Context, channelKeeper types.ChannelKeeper, p *types.ConnectionCloseProposal) error {
+	connection, found := k.GetConnection(ctx, p.ConnectionId)
+	if !found {
+		return sdkerrors.Wrap(types.ErrConnectionNotFound, p.ConnectionId)
+	}
+
+	if connection.State == types.CLOSED {
+		return sdkerrors.Wrap(types.ErrInvalidConnectionState, "connection is already CLOSED")
+	}
+
+	if channelKeeper.HasActiveChannels(ctx, p.ConnectionId) {
+		return sdkerrors.Wrapf(types.ErrConnectionInUse, "connection %s", p.ConnectionId)
+	}
+
+	k.Logger(ctx).Info("connection state updated", "connection-id", p.ConnectionId, "previous-state", connection.State.String(), "new-state", "CLOSED")
+
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "connection", "close")
+	}()
+
+	connection.State = types.CLOSED
+	k.SetConnection(ctx, p.ConnectionId, connection)
+	k.deleteHandshakeStartTime(ctx, p.ConnectionId)
+
+	EmitConnectionCloseEvent(ctx, p.ConnectionId, connection)
+
+	return nil
+}
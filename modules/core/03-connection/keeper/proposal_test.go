@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestConnectionCloseProposal() {
+	var (
+		path         *ibctesting.Path
+		connectionID string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: connection stuck in INIT", func() {
+				err := path.EndpointA.ConnOpenInit()
+				suite.Require().NoError(err)
+				connectionID = path.EndpointA.ConnectionID
+			}, true,
+		},
+		{
+			"success: all channels closed", func() {
+				suite.coordinator.Setup(path)
+				err := path.EndpointA.SetChannelClosed()
+				suite.Require().NoError(err)
+				connectionID = path.EndpointA.ConnectionID
+			}, true,
+		},
+		{
+			"connection not found", func() {
+				connectionID = ibctesting.InvalidID
+			}, false,
+		},
+		{
+			"connection already closed", func() {
+				err := path.EndpointA.ConnOpenInit()
+				suite.Require().NoError(err)
+				connectionID = path.EndpointA.ConnectionID
+
+				connection := path.EndpointA.GetConnection()
+				connection.State = types.CLOSED
+				path.EndpointA.SetConnection(connection)
+			}, false,
+		},
+		{
+			"connection has an open channel", func() {
+				suite.coordinator.Setup(path)
+				connectionID = path.EndpointA.ConnectionID
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupClients(path)
+
+			tc.malleate()
+
+			content := types.NewConnectionCloseProposal(ibctesting.Title, ibctesting.Description, connectionID)
+			proposal, ok := content.(*types.ConnectionCloseProposal)
+			suite.Require().True(ok)
+
+			ibcKeeper := suite.chainA.App.GetIBCKeeper()
+			err := ibcKeeper.ConnectionKeeper.ConnectionCloseProposal(suite.chainA.GetContext(), ibcKeeper.ChannelKeeper, proposal)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				connection, found := ibcKeeper.ConnectionKeeper.GetConnection(suite.chainA.GetContext(), connectionID)
+				suite.Require().True(found)
+				suite.Require().Equal(types.CLOSED, connection.State)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
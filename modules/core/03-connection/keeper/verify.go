@@ -7,6 +7,10 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
 
@@ -20,20 +24,27 @@ func (k Keeper) VerifyClientState(
 	clientState exported.ClientState,
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
 
-	targetClient, found := k.clientKeeper.GetClientState(ctx, clientID)
-	if !found {
-		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
+	merklePath := commitmenttypes.NewMerklePath(host.FullClientStatePath(connection.GetCounterparty().GetClientID()))
+	merklePath, err := commitmenttypes.ApplyPrefix(connection.GetCounterparty().GetPrefix(), merklePath)
+	if err != nil {
+		return err
 	}
 
-	if status := targetClient.Status(ctx, clientStore, k.cdc); status != exported.Active {
-		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	if clientState == nil {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidClient, "client state cannot be empty")
 	}
 
-	if err := targetClient.VerifyClientState(
-		clientStore, k.cdc, height,
-		connection.GetCounterparty().GetPrefix(), connection.GetCounterparty().GetClientID(), proof, clientState); err != nil {
+	bz, err := k.cdc.MarshalInterface(clientState)
+	if err != nil {
+		return err
+	}
+
+	if err := k.clientKeeper.VerifyMembership(
+		ctx, clientID, height,
+		0, 0, // skip delay period checks for non-packet processing verification
+		proof, merklePath, bz,
+	); err != nil {
 		return sdkerrors.Wrapf(err, "failed client state verification for target client: %s", clientID)
 	}
 
@@ -51,20 +62,26 @@ func (k Keeper) VerifyClientConsensusState(
 	consensusState exported.ConsensusState,
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
 
-	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
-	if !found {
-		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
+	merklePath := commitmenttypes.NewMerklePath(host.FullConsensusStatePath(connection.GetCounterparty().GetClientID(), consensusHeight))
+	merklePath, err := commitmenttypes.ApplyPrefix(connection.GetCounterparty().GetPrefix(), merklePath)
+	if err != nil {
+		return err
 	}
 
-	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
-		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	if consensusState == nil {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidConsensus, "consensus state cannot be empty")
 	}
 
-	if err := clientState.VerifyClientConsensusState(
-		clientStore, k.cdc, height,
-		connection.GetCounterparty().GetClientID(), consensusHeight, connection.GetCounterparty().GetPrefix(), proof, consensusState,
+	bz, err := k.cdc.MarshalInterface(consensusState)
+	if err != nil {
+		return err
+	}
+
+	if err := k.clientKeeper.VerifyMembership(
+		ctx, clientID, height,
+		0, 0, // skip delay period checks for non-packet processing verification
+		proof, merklePath, bz,
 	); err != nil {
 		return sdkerrors.Wrapf(err, "failed consensus state verification for client (%s)", clientID)
 	}
@@ -83,20 +100,27 @@ func (k Keeper) VerifyConnectionState(
 	connectionEnd exported.ConnectionI, // opposite connection
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
 
-	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
-	if !found {
-		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
+	merklePath := commitmenttypes.NewMerklePath(host.ConnectionPath(connectionID))
+	merklePath, err := commitmenttypes.ApplyPrefix(connection.GetCounterparty().GetPrefix(), merklePath)
+	if err != nil {
+		return err
+	}
+
+	counterpartyConnection, ok := connectionEnd.(types.ConnectionEnd)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "invalid connection type %T", connectionEnd)
 	}
 
-	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
-		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	bz, err := k.cdc.Marshal(&counterpartyConnection)
+	if err != nil {
+		return err
 	}
 
-	if err := clientState.VerifyConnectionState(
-		clientStore, k.cdc, height,
-		connection.GetCounterparty().GetPrefix(), proof, connectionID, connectionEnd,
+	if err := k.clientKeeper.VerifyMembership(
+		ctx, clientID, height,
+		0, 0, // skip delay period checks for non-packet processing verification
+		proof, merklePath, bz,
 	); err != nil {
 		return sdkerrors.Wrapf(err, "failed connection state verification for client (%s)", clientID)
 	}
@@ -116,21 +140,27 @@ func (k Keeper) VerifyChannelState(
 	channel exported.ChannelI,
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
 
-	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
-	if !found {
-		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
+	merklePath := commitmenttypes.NewMerklePath(host.ChannelPath(portID, channelID))
+	merklePath, err := commitmenttypes.ApplyPrefix(connection.GetCounterparty().GetPrefix(), merklePath)
+	if err != nil {
+		return err
+	}
+
+	channelEnd, ok := channel.(channeltypes.Channel)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "invalid channel type %T", channel)
 	}
 
-	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
-		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	bz, err := k.cdc.Marshal(&channelEnd)
+	if err != nil {
+		return err
 	}
 
-	if err := clientState.VerifyChannelState(
-		clientStore, k.cdc, height,
-		connection.GetCounterparty().GetPrefix(), proof,
-		portID, channelID, channel,
+	if err := k.clientKeeper.VerifyMembership(
+		ctx, clientID, height,
+		0, 0, // skip delay period checks for non-packet processing verification
+		proof, merklePath, bz,
 	); err != nil {
 		return sdkerrors.Wrapf(err, "failed channel state verification for client (%s)", clientID)
 	}
@@ -139,11 +169,14 @@ func (k Keeper) VerifyChannelState(
 }
 
 // VerifyPacketCommitment verifies a proof of an outgoing packet commitment at
-// the specified port, specified channel, and specified sequence.
+// the specified port, specified channel, and specified sequence. The additional
+// channel time and block delays are applied on top of the connection delay period.
 func (k Keeper) VerifyPacketCommitment(
 	ctx sdk.Context,
 	connection exported.ConnectionI,
 	height exported.Height,
+	channelTimeDelay uint64,
+	channelBlockDelay uint64,
 	proof []byte,
 	portID,
 	channelID string,
@@ -151,26 +184,23 @@ func (k Keeper) VerifyPacketCommitment(
 	commitmentBytes []byte,
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
 
-	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
-	if !found {
-		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
+	// get time and block delays
+	timeDelay, blockDelay, err := k.getPacketDelays(ctx, connection, channelTimeDelay, channelBlockDelay)
+	if err != nil {
+		return err
 	}
 
-	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
-		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	merklePath := commitmenttypes.NewMerklePath(host.PacketCommitmentPath(portID, channelID, sequence))
+	merklePath, err = commitmenttypes.ApplyPrefix(connection.GetCounterparty().GetPrefix(), merklePath)
+	if err != nil {
+		return err
 	}
 
-	// get time and block delays
-	timeDelay := connection.GetDelayPeriod()
-	blockDelay := k.getBlockDelay(ctx, connection)
-
-	if err := clientState.VerifyPacketCommitment(
-		ctx, clientStore, k.cdc, height,
+	if err := k.clientKeeper.VerifyMembership(
+		ctx, clientID, height,
 		timeDelay, blockDelay,
-		connection.GetCounterparty().GetPrefix(), proof, portID, channelID,
-		sequence, commitmentBytes,
+		proof, merklePath, commitmentBytes,
 	); err != nil {
 		return sdkerrors.Wrapf(err, "failed packet commitment verification for client (%s)", clientID)
 	}
@@ -180,10 +210,14 @@ func (k Keeper) VerifyPacketCommitment(
 
 // VerifyPacketAcknowledgement verifies a proof of an incoming packet
 // acknowledgement at the specified port, specified channel, and specified sequence.
+// The additional channel time and block delays are applied on top of the
+// connection delay period.
 func (k Keeper) VerifyPacketAcknowledgement(
 	ctx sdk.Context,
 	connection exported.ConnectionI,
 	height exported.Height,
+	channelTimeDelay uint64,
+	channelBlockDelay uint64,
 	proof []byte,
 	portID,
 	channelID string,
@@ -191,26 +225,23 @@ func (k Keeper) VerifyPacketAcknowledgement(
 	acknowledgement []byte,
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
 
-	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
-	if !found {
-		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
+	// get time and block delays
+	timeDelay, blockDelay, err := k.getPacketDelays(ctx, connection, channelTimeDelay, channelBlockDelay)
+	if err != nil {
+		return err
 	}
 
-	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
-		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	merklePath := commitmenttypes.NewMerklePath(host.PacketAcknowledgementPath(portID, channelID, sequence))
+	merklePath, err = commitmenttypes.ApplyPrefix(connection.GetCounterparty().GetPrefix(), merklePath)
+	if err != nil {
+		return err
 	}
 
-	// get time and block delays
-	timeDelay := connection.GetDelayPeriod()
-	blockDelay := k.getBlockDelay(ctx, connection)
-
-	if err := clientState.VerifyPacketAcknowledgement(
-		ctx, clientStore, k.cdc, height,
+	if err := k.clientKeeper.VerifyMembership(
+		ctx, clientID, height,
 		timeDelay, blockDelay,
-		connection.GetCounterparty().GetPrefix(), proof, portID, channelID,
-		sequence, acknowledgement,
+		proof, merklePath, channeltypes.CommitAcknowledgement(acknowledgement),
 	); err != nil {
 		return sdkerrors.Wrapf(err, "failed packet acknowledgement verification for client (%s)", clientID)
 	}
@@ -220,37 +251,37 @@ func (k Keeper) VerifyPacketAcknowledgement(
 
 // VerifyPacketReceiptAbsence verifies a proof of the absence of an
 // incoming packet receipt at the specified port, specified channel, and
-// specified sequence.
+// specified sequence. The additional channel time and block delays are applied
+// on top of the connection delay period.
 func (k Keeper) VerifyPacketReceiptAbsence(
 	ctx sdk.Context,
 	connection exported.ConnectionI,
 	height exported.Height,
+	channelTimeDelay uint64,
+	channelBlockDelay uint64,
 	proof []byte,
 	portID,
 	channelID string,
 	sequence uint64,
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
 
-	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
-	if !found {
-		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
+	// get time and block delays
+	timeDelay, blockDelay, err := k.getPacketDelays(ctx, connection, channelTimeDelay, channelBlockDelay)
+	if err != nil {
+		return err
 	}
 
-	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
-		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	merklePath := commitmenttypes.NewMerklePath(host.PacketReceiptPath(portID, channelID, sequence))
+	merklePath, err = commitmenttypes.ApplyPrefix(connection.GetCounterparty().GetPrefix(), merklePath)
+	if err != nil {
+		return err
 	}
 
-	// get time and block delays
-	timeDelay := connection.GetDelayPeriod()
-	blockDelay := k.getBlockDelay(ctx, connection)
-
-	if err := clientState.VerifyPacketReceiptAbsence(
-		ctx, clientStore, k.cdc, height,
+	if err := k.clientKeeper.VerifyNonMembership(
+		ctx, clientID, height,
 		timeDelay, blockDelay,
-		connection.GetCounterparty().GetPrefix(), proof, portID, channelID,
-		sequence,
+		proof, merklePath,
 	); err != nil {
 		return sdkerrors.Wrapf(err, "failed packet receipt absence verification for client (%s)", clientID)
 	}
@@ -259,47 +290,230 @@ func (k Keeper) VerifyPacketReceiptAbsence(
 }
 
 // VerifyNextSequenceRecv verifies a proof of the next sequence number to be
-// received of the specified channel at the specified port.
+// received of the specified channel at the specified port. The additional
+// channel time and block delays are applied on top of the connection delay period.
 func (k Keeper) VerifyNextSequenceRecv(
 	ctx sdk.Context,
 	connection exported.ConnectionI,
 	height exported.Height,
+	channelTimeDelay uint64,
+	channelBlockDelay uint64,
 	proof []byte,
 	portID,
 	channelID string,
 	nextSequenceRecv uint64,
 ) error {
 	clientID := connection.GetClientID()
-	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
 
-	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
-	if !found {
-		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
+	// get time and block delays
+	timeDelay, blockDelay, err := k.getPacketDelays(ctx, connection, channelTimeDelay, channelBlockDelay)
+	if err != nil {
+		return err
+	}
+
+	merklePath := commitmenttypes.NewMerklePath(host.NextSequenceRecvPath(portID, channelID))
+	merklePath, err = commitmenttypes.ApplyPrefix(connection.GetCounterparty().GetPrefix(), merklePath)
+	if err != nil {
+		return err
 	}
 
-	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
-		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	if err := k.clientKeeper.VerifyMembership(
+		ctx, clientID, height,
+		timeDelay, blockDelay,
+		proof, merklePath, sdk.Uint64ToBigEndian(nextSequenceRecv),
+	); err != nil {
+		return sdkerrors.Wrapf(err, "failed next sequence receive verification for client (%s)", clientID)
 	}
 
+	return nil
+}
+
+// VerifyNextSequenceAck verifies a proof of the next sequence number to be
+// acknowledged of the specified channel at the specified port. The additional
+// channel time and block delays are applied on top of the connection delay period.
+func (k Keeper) VerifyNextSequenceAck(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	channelTimeDelay uint64,
+	channelBlockDelay uint64,
+	proof []byte,
+	portID,
+	channelID string,
+	nextSequenceAck uint64,
+) error {
+	clientID := connection.GetClientID()
+
 	// get time and block delays
-	timeDelay := connection.GetDelayPeriod()
-	blockDelay := k.getBlockDelay(ctx, connection)
+	timeDelay, blockDelay, err := k.getPacketDelays(ctx, connection, channelTimeDelay, channelBlockDelay)
+	if err != nil {
+		return err
+	}
+
+	merklePath := commitmenttypes.NewMerklePath(host.NextSequenceAckPath(portID, channelID))
+	merklePath, err = commitmenttypes.ApplyPrefix(connection.GetCounterparty().GetPrefix(), merklePath)
+	if err != nil {
+		return err
+	}
 
-	if err := clientState.VerifyNextSequenceRecv(
-		ctx, clientStore, k.cdc, height,
+	if err := k.clientKeeper.VerifyMembership(
+		ctx, clientID, height,
 		timeDelay, blockDelay,
-		connection.GetCounterparty().GetPrefix(), proof, portID, channelID,
-		nextSequenceRecv,
+		proof, merklePath, sdk.Uint64ToBigEndian(nextSequenceAck),
 	); err != nil {
-		return sdkerrors.Wrapf(err, "failed next sequence receive verification for client (%s)", clientID)
+		return sdkerrors.Wrapf(err, "failed next sequence acknowledgement verification for client (%s)", clientID)
 	}
 
 	return nil
 }
 
-// getBlockDelay calculates the block delay period from the time delay of the connection
+// VerifyPacketCommitments verifies the outgoing packet commitments of a batch of
+// packets sent on the specified port and channel, proven at the same height. The
+// commitments are verified with a single batch proof if proofBatch is set, or with
+// the proof at the same index in proofs otherwise. The delays and the client are
+// only loaded once for the whole batch.
+func (k Keeper) VerifyPacketCommitments(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	channelTimeDelay uint64,
+	channelBlockDelay uint64,
+	proofs [][]byte,
+	proofBatch []byte,
+	portID,
+	channelID string,
+	sequences []uint64,
+	commitments [][]byte,
+) error {
+	paths := make([]string, len(sequences))
+	for i, sequence := range sequences {
+		paths[i] = host.PacketCommitmentPath(portID, channelID, sequence)
+	}
+
+	if err := k.verifyPacketPaths(
+		ctx, connection, height,
+		channelTimeDelay, channelBlockDelay,
+		proofs, proofBatch, paths, commitments,
+	); err != nil {
+		return sdkerrors.Wrapf(err, "failed packet commitments verification for client (%s)", connection.GetClientID())
+	}
+
+	return nil
+}
+
+// VerifyPacketAcknowledgements verifies the incoming packet acknowledgements of a
+// batch of packets received on the specified port and channel, proven at the same
+// height. The acknowledgements are verified with a single batch proof if proofBatch
+// is set, or with the proof at the same index in proofs otherwise. The delays and
+// the client are only loaded once for the whole batch.
+func (k Keeper) VerifyPacketAcknowledgements(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	channelTimeDelay uint64,
+	channelBlockDelay uint64,
+	proofs [][]byte,
+	proofBatch []byte,
+	portID,
+	channelID string,
+	sequences []uint64,
+	acknowledgements [][]byte,
+) error {
+	paths := make([]string, len(sequences))
+	for i, sequence := range sequences {
+		paths[i] = host.PacketAcknowledgementPath(portID, channelID, sequence)
+	}
+
+	ackCommitments := make([][]byte, len(acknowledgements))
+	for i, acknowledgement := range acknowledgements {
+		ackCommitments[i] = channeltypes.CommitAcknowledgement(acknowledgement)
+	}
+
+	if err := k.verifyPacketPaths(
+		ctx, connection, height,
+		channelTimeDelay, channelBlockDelay,
+		proofs, proofBatch, paths, ackCommitments,
+	); err != nil {
+		return sdkerrors.Wrapf(err, "failed packet acknowledgements verification for client (%s)", connection.GetClientID())
+	}
+
+	return nil
+}
+
+// verifyPacketPaths verifies the existence of each value at the corresponding
+// packet path of the counterparty, applying the packet delays and the commitment
+// prefix of the counterparty. A single batch proof is verified if proofBatch is
+// set, a proof per path otherwise.
+func (k Keeper) verifyPacketPaths(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	channelTimeDelay uint64,
+	channelBlockDelay uint64,
+	proofs [][]byte,
+	proofBatch []byte,
+	paths []string,
+	values [][]byte,
+) error {
+	clientID := connection.GetClientID()
+
+	// get time and block delays
+	timeDelay, blockDelay, err := k.getPacketDelays(ctx, connection, channelTimeDelay, channelBlockDelay)
+	if err != nil {
+		return err
+	}
+
+	merklePaths := make([]exported.Path, len(paths))
+	for i, path := range paths {
+		merklePath, err := commitmenttypes.ApplyPrefix(connection.GetCounterparty().GetPrefix(), commitmenttypes.NewMerklePath(path))
+		if err != nil {
+			return err
+		}
+
+		merklePaths[i] = merklePath
+	}
+
+	if len(proofBatch) != 0 {
+		return k.clientKeeper.VerifyBatchMembership(
+			ctx, clientID, height,
+			timeDelay, blockDelay,
+			proofBatch, merklePaths, values,
+		)
+	}
+
+	return k.clientKeeper.VerifyMemberships(
+		ctx, clientID, height,
+		timeDelay, blockDelay,
+		proofs, merklePaths, values,
+	)
+}
+
+// getPacketDelays returns the time and block delays of a packet proof, which are the delays of the
+// connection increased by the additional delays of the channel. An error is returned if a delay overflows.
+func (k Keeper) getPacketDelays(ctx sdk.Context, connection exported.ConnectionI, channelTimeDelay, channelBlockDelay uint64) (uint64, uint64, error) {
+	return k.getPacketDelaysForDelayPeriod(ctx, connection.GetDelayPeriod(), channelTimeDelay, channelBlockDelay)
+}
+
+// getPacketDelaysForDelayPeriod returns the time and block delays of a packet proof over connections
+// with the given delay period, increased by the additional delays of the channel. An error is returned
+// if a delay overflows.
+func (k Keeper) getPacketDelaysForDelayPeriod(ctx sdk.Context, connectionTimeDelay, channelTimeDelay, channelBlockDelay uint64) (uint64, uint64, error) {
+	connectionBlockDelay := k.getBlockDelayForTimeDelay(ctx, connectionTimeDelay)
+
+	if channelTimeDelay > math.MaxUint64-connectionTimeDelay || channelBlockDelay > math.MaxUint64-connectionBlockDelay {
+		return 0, 0, sdkerrors.Wrapf(
+			channeltypes.ErrInvalidPacketDelay,
+			"channel delays (time: %d, blocks: %d) overflow the connection delays (time: %d, blocks: %d)",
+			channelTimeDelay, channelBlockDelay, connectionTimeDelay, connectionBlockDelay,
+		)
+	}
+
+	return connectionTimeDelay + channelTimeDelay, connectionBlockDelay + channelBlockDelay, nil
+}
+
+// getBlockDelayForTimeDelay calculates the block delay period from the given time delay
 // and the maximum expected time per block.
-func (k Keeper) getBlockDelay(ctx sdk.Context, connection exported.ConnectionI) uint64 {
+func (k Keeper) getBlockDelayForTimeDelay(ctx sdk.Context, timeDelay uint64) uint64 {
 	// expectedTimePerBlock should never be zero, however if it is then return a 0 blcok delay for safety
 	// as the expectedTimePerBlock parameter was not set.
 	expectedTimePerBlock := k.GetMaxExpectedTimePerBlock(ctx)
@@ -308,6 +522,5 @@ func (k Keeper) getBlockDelay(ctx sdk.Context, connection exported.ConnectionI)
 	}
 	// calculate minimum block delay by dividing time delay period
 	// by the expected time per block. Round up the block delay.
-	timeDelay := connection.GetDelayPeriod()
 	return uint64(math.Ceil(float64(timeDelay) / float64(expectedTimePerBlock)))
 }
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"math"
 	"time"
 
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
@@ -295,6 +296,9 @@ func (suite *KeeperTestSuite) TestVerifyPacketCommitment() {
 		heightDiff      uint64
 		delayTimePeriod uint64
 		timePerBlock    uint64
+
+		channelTimeDelay  uint64
+		channelBlockDelay uint64
 	)
 	cases := []struct {
 		name     string
@@ -314,6 +318,16 @@ func (suite *KeeperTestSuite) TestVerifyPacketCommitment() {
 			delayTimePeriod = uint64(1 * time.Second.Nanoseconds())
 			timePerBlock = 1
 		}, false},
+		{"channel delay time period has not passed", func() {
+			channelTimeDelay = uint64(1 * time.Hour.Nanoseconds())
+		}, false},
+		{"channel delay block period has not passed", func() {
+			channelBlockDelay = 100
+		}, false},
+		{"channel delay time period overflows connection delay", func() {
+			delayTimePeriod = uint64(1 * time.Second.Nanoseconds())
+			channelTimeDelay = math.MaxUint64
+		}, false},
 		{"client state not found- changed client ID", func() {
 			connection := path.EndpointB.GetConnection()
 			connection.ClientId = ibctesting.InvalidID
@@ -349,6 +363,8 @@ func (suite *KeeperTestSuite) TestVerifyPacketCommitment() {
 			heightDiff = 0
 			delayTimePeriod = 0
 			timePerBlock = 0
+			channelTimeDelay = 0
+			channelBlockDelay = 0
 			tc.malleate()
 
 			connection := path.EndpointB.GetConnection()
@@ -363,7 +379,7 @@ func (suite *KeeperTestSuite) TestVerifyPacketCommitment() {
 
 			commitment := channeltypes.CommitPacket(suite.chainB.App.GetIBCKeeper().Codec(), packet)
 			err = suite.chainB.App.GetIBCKeeper().ConnectionKeeper.VerifyPacketCommitment(
-				suite.chainB.GetContext(), connection, malleateHeight(proofHeight, heightDiff), proof,
+				suite.chainB.GetContext(), connection, malleateHeight(proofHeight, heightDiff), channelTimeDelay, channelBlockDelay, proof,
 				packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), commitment,
 			)
 
@@ -376,6 +392,99 @@ func (suite *KeeperTestSuite) TestVerifyPacketCommitment() {
 	}
 }
 
+// TestVerifyPacketCommitments has chainB verify the packet commitments of a
+// batch of packets sent from chainA, with a proof per packet or a batch proof.
+func (suite *KeeperTestSuite) TestVerifyPacketCommitments() {
+	var (
+		path             *ibctesting.Path
+		packets          []channeltypes.Packet
+		proofs           [][]byte
+		proofBatch       []byte
+		channelTimeDelay uint64
+	)
+	cases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"verification success: proof per packet", func() {}, true},
+		{"verification success: batch proof", func() {
+			proofs = nil
+		}, true},
+		{"proof count does not match packet count", func() {
+			proofBatch = nil
+			proofs = proofs[:1]
+		}, false},
+		{"channel delay time period has not passed: batch proof", func() {
+			proofs = nil
+			channelTimeDelay = uint64(1 * time.Hour.Nanoseconds())
+		}, false},
+		{"verification failed - changed packet commitment state", func() {
+			packets[1].Data = []byte(ibctesting.InvalidID)
+		}, false},
+		{"verification failed - changed packet commitment state: batch proof", func() {
+			proofs = nil
+			packets[1].Data = []byte(ibctesting.InvalidID)
+		}, false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			var commitmentKeys [][]byte
+			packets = nil
+			for seq := uint64(1); seq <= 2; seq++ {
+				packet := channeltypes.NewPacket(ibctesting.MockPacketData, seq, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, 0)
+				err := path.EndpointA.SendPacket(packet)
+				suite.Require().NoError(err)
+
+				packets = append(packets, packet)
+				commitmentKeys = append(commitmentKeys, host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+			}
+
+			var proofHeight clienttypes.Height
+			proofs = nil
+			for _, key := range commitmentKeys {
+				var proof []byte
+				proof, proofHeight = suite.chainA.QueryProof(key)
+				proofs = append(proofs, proof)
+			}
+			proofBatch, _ = suite.chainA.QueryProofs(commitmentKeys...)
+
+			// the batch proof is only used if the proofs per packet are removed
+			channelTimeDelay = 0
+			tc.malleate()
+			if len(proofs) != 0 {
+				proofBatch = nil
+			}
+
+			sequences := make([]uint64, len(packets))
+			commitments := make([][]byte, len(packets))
+			for i, packet := range packets {
+				sequences[i] = packet.GetSequence()
+				commitments[i] = channeltypes.CommitPacket(suite.chainB.App.GetIBCKeeper().Codec(), packet)
+			}
+
+			err := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.VerifyPacketCommitments(
+				suite.chainB.GetContext(), path.EndpointB.GetConnection(), proofHeight, channelTimeDelay, 0, proofs, proofBatch,
+				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequences, commitments,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 // TestVerifyPacketAcknowledgement has chainA verify the acknowledgement on
 // channelB. The channels on chainA and chainB are fully opened and a packet
 // is sent from chainA to chainB and received.
@@ -386,6 +495,9 @@ func (suite *KeeperTestSuite) TestVerifyPacketAcknowledgement() {
 		heightDiff      uint64
 		delayTimePeriod uint64
 		timePerBlock    uint64
+
+		channelTimeDelay  uint64
+		channelBlockDelay uint64
 	)
 
 	cases := []struct {
@@ -406,6 +518,12 @@ func (suite *KeeperTestSuite) TestVerifyPacketAcknowledgement() {
 			delayTimePeriod = uint64(1 * time.Second.Nanoseconds())
 			timePerBlock = 1
 		}, false},
+		{"channel delay time period has not passed", func() {
+			channelTimeDelay = uint64(1 * time.Hour.Nanoseconds())
+		}, false},
+		{"channel delay block period has not passed", func() {
+			channelBlockDelay = 100
+		}, false},
 		{"client state not found- changed client ID", func() {
 			connection := path.EndpointA.GetConnection()
 			connection.ClientId = ibctesting.InvalidID
@@ -453,6 +571,8 @@ func (suite *KeeperTestSuite) TestVerifyPacketAcknowledgement() {
 			heightDiff = 0
 			delayTimePeriod = 0
 			timePerBlock = 0
+			channelTimeDelay = 0
+			channelBlockDelay = 0
 			tc.malleate()
 
 			connection := path.EndpointA.GetConnection()
@@ -464,7 +584,7 @@ func (suite *KeeperTestSuite) TestVerifyPacketAcknowledgement() {
 			}
 
 			err = suite.chainA.App.GetIBCKeeper().ConnectionKeeper.VerifyPacketAcknowledgement(
-				suite.chainA.GetContext(), connection, malleateHeight(proofHeight, heightDiff), proof,
+				suite.chainA.GetContext(), connection, malleateHeight(proofHeight, heightDiff), channelTimeDelay, channelBlockDelay, proof,
 				packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(), ack.Acknowledgement(),
 			)
 
@@ -487,6 +607,9 @@ func (suite *KeeperTestSuite) TestVerifyPacketReceiptAbsence() {
 		heightDiff      uint64
 		delayTimePeriod uint64
 		timePerBlock    uint64
+
+		channelTimeDelay  uint64
+		channelBlockDelay uint64
 	)
 
 	cases := []struct {
@@ -507,6 +630,12 @@ func (suite *KeeperTestSuite) TestVerifyPacketReceiptAbsence() {
 			delayTimePeriod = uint64(1 * time.Second.Nanoseconds())
 			timePerBlock = 1
 		}, false},
+		{"channel delay time period has not passed", func() {
+			channelTimeDelay = uint64(1 * time.Hour.Nanoseconds())
+		}, false},
+		{"channel delay block period has not passed", func() {
+			channelBlockDelay = 100
+		}, false},
 		{"client state not found - changed client ID", func() {
 			connection := path.EndpointA.GetConnection()
 			connection.ClientId = ibctesting.InvalidID
@@ -548,6 +677,8 @@ func (suite *KeeperTestSuite) TestVerifyPacketReceiptAbsence() {
 			heightDiff = 0
 			delayTimePeriod = 0
 			timePerBlock = 0
+			channelTimeDelay = 0
+			channelBlockDelay = 0
 			tc.malleate()
 
 			connection := path.EndpointA.GetConnection()
@@ -569,7 +700,7 @@ func (suite *KeeperTestSuite) TestVerifyPacketReceiptAbsence() {
 			}
 
 			err = suite.chainA.App.GetIBCKeeper().ConnectionKeeper.VerifyPacketReceiptAbsence(
-				suite.chainA.GetContext(), connection, malleateHeight(proofHeight, heightDiff), proof,
+				suite.chainA.GetContext(), connection, malleateHeight(proofHeight, heightDiff), channelTimeDelay, channelBlockDelay, proof,
 				packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
 			)
 
@@ -592,6 +723,9 @@ func (suite *KeeperTestSuite) TestVerifyNextSequenceRecv() {
 		delayTimePeriod uint64
 		timePerBlock    uint64
 		offsetSeq       uint64
+
+		channelTimeDelay  uint64
+		channelBlockDelay uint64
 	)
 
 	cases := []struct {
@@ -612,6 +746,12 @@ func (suite *KeeperTestSuite) TestVerifyNextSequenceRecv() {
 			delayTimePeriod = uint64(1 * time.Second.Nanoseconds())
 			timePerBlock = 1
 		}, false},
+		{"channel delay time period has not passed", func() {
+			channelTimeDelay = uint64(1 * time.Hour.Nanoseconds())
+		}, false},
+		{"channel delay block period has not passed", func() {
+			channelBlockDelay = 100
+		}, false},
 		{"client state not found- changed client ID", func() {
 			connection := path.EndpointA.GetConnection()
 			connection.ClientId = ibctesting.InvalidID
@@ -658,6 +798,8 @@ func (suite *KeeperTestSuite) TestVerifyNextSequenceRecv() {
 			heightDiff = 0
 			delayTimePeriod = 0
 			timePerBlock = 0
+			channelTimeDelay = 0
+			channelBlockDelay = 0
 			tc.malleate()
 
 			// set time per block param
@@ -668,7 +810,7 @@ func (suite *KeeperTestSuite) TestVerifyNextSequenceRecv() {
 			connection := path.EndpointA.GetConnection()
 			connection.DelayPeriod = delayTimePeriod
 			err = suite.chainA.App.GetIBCKeeper().ConnectionKeeper.VerifyNextSequenceRecv(
-				suite.chainA.GetContext(), connection, malleateHeight(proofHeight, heightDiff), proof,
+				suite.chainA.GetContext(), connection, malleateHeight(proofHeight, heightDiff), channelTimeDelay, channelBlockDelay, proof,
 				packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()+offsetSeq,
 			)
 
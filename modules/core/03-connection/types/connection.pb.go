@@ -8,6 +8,7 @@ import (
 	types "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/regen-network/cosmos-proto"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -25,7 +26,7 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // State defines if a connection is in one of the following states:
-// INIT, TRYOPEN, OPEN or UNINITIALIZED.
+// INIT, TRYOPEN, OPEN, CLOSED or UNINITIALIZED.
 type State int32
 
 const (
@@ -38,6 +39,9 @@ const (
 	TRYOPEN State = 2
 	// A connection end has completed the handshake.
 	OPEN State = 3
+	// A connection end has been closed, either by cancelling its opening
+	// handshake or by governance, and can no longer be used.
+	CLOSED State = 4
 )
 
 var State_name = map[int32]string{
@@ -45,6 +49,7 @@ var State_name = map[int32]string{
 	1: "STATE_INIT",
 	2: "STATE_TRYOPEN",
 	3: "STATE_OPEN",
+	4: "STATE_CLOSED",
 }
 
 var State_value = map[string]int32{
@@ -52,6 +57,7 @@ var State_value = map[string]int32{
 	"STATE_INIT":                      1,
 	"STATE_TRYOPEN":                   2,
 	"STATE_OPEN":                      3,
+	"STATE_CLOSED":                    4,
 }
 
 func (x State) String() string {
@@ -360,6 +366,9 @@ type Params struct {
 	// largest amount of time that the chain might reasonably take to produce the next block under normal operating
 	// conditions. A safe choice is 3-5x the expected time per block.
 	MaxExpectedTimePerBlock uint64 `protobuf:"varint,1,opt,name=max_expected_time_per_block,json=maxExpectedTimePerBlock,proto3" json:"max_expected_time_per_block,omitempty" yaml:"max_expected_time_per_block"`
+	// time (in nanoseconds) after which a connection handshake which has not completed may be cancelled. A zero value
+	// disables the cancellation of connection handshakes on timeout.
+	HandshakeTimeout uint64 `protobuf:"varint,2,opt,name=handshake_timeout,json=handshakeTimeout,proto3" json:"handshake_timeout,omitempty" yaml:"handshake_timeout"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -402,6 +411,58 @@ func (m *Params) GetMaxExpectedTimePerBlock() uint64 {
 	return 0
 }
 
+func (m *Params) GetHandshakeTimeout() uint64 {
+	if m != nil {
+		return m.HandshakeTimeout
+	}
+	return 0
+}
+
+// ConnectionCloseProposal is a governance proposal. If it passes, the connection
+// end is closed. The proposal handler fails if any channel built on the connection
+// is not closed.
+type ConnectionCloseProposal struct {
+	// the title of the close proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// the identifier of the connection to be closed if the proposal passes
+	ConnectionId string `protobuf:"bytes,3,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+}
+
+func (m *ConnectionCloseProposal) Reset()         { *m = ConnectionCloseProposal{} }
+func (m *ConnectionCloseProposal) String() string { return proto.CompactTextString(m) }
+func (*ConnectionCloseProposal) ProtoMessage()    {}
+func (*ConnectionCloseProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90572467c054e43a, []int{7}
+}
+func (m *ConnectionCloseProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConnectionCloseProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConnectionCloseProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConnectionCloseProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectionCloseProposal.Merge(m, src)
+}
+func (m *ConnectionCloseProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConnectionCloseProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectionCloseProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConnectionCloseProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("ibc.core.connection.v1.State", State_name, State_value)
 	proto.RegisterType((*ConnectionEnd)(nil), "ibc.core.connection.v1.ConnectionEnd")
@@ -411,6 +472,7 @@ func init() {
 	proto.RegisterType((*ConnectionPaths)(nil), "ibc.core.connection.v1.ConnectionPaths")
 	proto.RegisterType((*Version)(nil), "ibc.core.connection.v1.Version")
 	proto.RegisterType((*Params)(nil), "ibc.core.connection.v1.Params")
+	proto.RegisterType((*ConnectionCloseProposal)(nil), "ibc.core.connection.v1.ConnectionCloseProposal")
 }
 
 func init() {
@@ -418,52 +480,61 @@ func init() {
 }
 
 var fileDescriptor_90572467c054e43a = []byte{
-	// 716 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0xd2, 0x60,
-	0x18, 0xa7, 0xa5, 0x30, 0x78, 0x19, 0x8a, 0x95, 0xb8, 0x06, 0xb3, 0xb6, 0xa9, 0x46, 0x89, 0xc9,
-	0xa8, 0x8c, 0xc4, 0xc3, 0xd4, 0xc3, 0x60, 0x98, 0x34, 0x2a, 0x92, 0x8e, 0x2d, 0x71, 0x17, 0x52,
-	0xda, 0x77, 0xec, 0xcd, 0x68, 0x5f, 0xd2, 0xbe, 0x10, 0xf8, 0x06, 0xcb, 0x4e, 0x5e, 0x3d, 0x2c,
-	0x31, 0xf1, 0xbb, 0x98, 0xc5, 0xd3, 0x8e, 0x9e, 0x88, 0xd9, 0xae, 0x9e, 0xf8, 0x04, 0xa6, 0x7d,
-	0x0b, 0x74, 0x8b, 0x33, 0xd9, 0xf4, 0xf6, 0x3c, 0xfd, 0xfd, 0xe1, 0x79, 0x7e, 0x3c, 0x14, 0xf0,
-	0x14, 0x75, 0x4c, 0xd5, 0xc4, 0x2e, 0x54, 0x4d, 0xec, 0x38, 0xd0, 0x24, 0x08, 0x3b, 0xea, 0xb0,
-	0x1c, 0xe9, 0x4a, 0x7d, 0x17, 0x13, 0xcc, 0x3f, 0x40, 0x1d, 0xb3, 0xe4, 0x13, 0x4b, 0x11, 0x68,
-	0x58, 0x2e, 0xe4, 0xbb, 0xb8, 0x8b, 0x03, 0x8a, 0xea, 0x57, 0x94, 0x5d, 0x88, 0xda, 0xda, 0x36,
-	0x22, 0x36, 0x74, 0x08, 0xb5, 0x9d, 0x75, 0x94, 0xa8, 0x7c, 0x63, 0x41, 0xb6, 0x36, 0x37, 0xac,
-	0x3b, 0x16, 0x5f, 0x06, 0x69, 0xb3, 0x87, 0xa0, 0x43, 0xda, 0xc8, 0x12, 0x18, 0x99, 0x29, 0xa6,
-	0xab, 0xf9, 0xe9, 0x44, 0xca, 0x8d, 0x0d, 0xbb, 0xb7, 0xa1, 0xcc, 0x21, 0x45, 0x4f, 0xd1, 0x5a,
-	0xb3, 0xf8, 0x97, 0x20, 0x35, 0x84, 0xae, 0x87, 0xb0, 0xe3, 0x09, 0xac, 0x1c, 0x2f, 0x66, 0xd6,
-	0xa5, 0xd2, 0x9f, 0xc7, 0x2d, 0xed, 0x52, 0x9e, 0x3e, 0x17, 0xf0, 0x15, 0x90, 0xf0, 0x88, 0x41,
-	0xa0, 0x10, 0x97, 0x99, 0xe2, 0x9d, 0xf5, 0xd5, 0xeb, 0x94, 0xdb, 0x3e, 0x49, 0xa7, 0x5c, 0xbe,
-	0x01, 0x96, 0x4d, 0x3c, 0x70, 0x08, 0x74, 0xfb, 0x86, 0x4b, 0xc6, 0x02, 0x27, 0x33, 0xc5, 0xcc,
-	0xfa, 0xe3, 0xeb, 0xb4, 0xb5, 0x08, 0xb7, 0xca, 0x9d, 0x4e, 0xa4, 0x98, 0x7e, 0x49, 0xcf, 0x6f,
-	0x80, 0x65, 0x0b, 0xf6, 0x8c, 0x71, 0xbb, 0x0f, 0x5d, 0x84, 0x2d, 0x21, 0x21, 0x33, 0x45, 0xae,
-	0xba, 0x32, 0x9d, 0x48, 0xf7, 0xe9, 0xde, 0x51, 0x54, 0xd1, 0x33, 0x41, 0xdb, 0x0c, 0xba, 0x0d,
-	0xee, 0xe8, 0x8b, 0x14, 0x53, 0x7e, 0xb1, 0x20, 0xaf, 0x59, 0xd0, 0x21, 0x68, 0x1f, 0x41, 0x6b,
-	0x11, 0x29, 0xbf, 0x0a, 0xd8, 0x79, 0x90, 0xd9, 0xe9, 0x44, 0x4a, 0x53, 0x43, 0x3f, 0x41, 0x16,
-	0x5d, 0x89, 0x9b, 0xbd, 0x71, 0xdc, 0xf1, 0x5b, 0xc7, 0xcd, 0xfd, 0x43, 0xdc, 0x89, 0xff, 0x1c,
-	0x77, 0xf2, 0xc6, 0x71, 0x7f, 0x67, 0xc0, 0x72, 0xf4, 0x63, 0x6e, 0x73, 0xb6, 0xaf, 0x41, 0x76,
-	0x31, 0xf7, 0x22, 0x7e, 0x61, 0x3a, 0x91, 0xf2, 0xa1, 0x2c, 0x0a, 0x2b, 0xfe, 0x12, 0xb3, 0x5e,
-	0xb3, 0xf8, 0x2a, 0x48, 0xf6, 0x5d, 0xb8, 0x8f, 0x46, 0xc1, 0xe5, 0x5e, 0x89, 0x63, 0xfe, 0x33,
-	0x1b, 0x96, 0x4b, 0xef, 0xa1, 0x7b, 0xd8, 0x83, 0xcd, 0x80, 0x1b, 0xc6, 0x11, 0x2a, 0xc3, 0x65,
-	0x1e, 0x81, 0x4c, 0x2d, 0x18, 0xaa, 0x69, 0x90, 0x03, 0x8f, 0xcf, 0x83, 0x44, 0xdf, 0x2f, 0x04,
-	0x46, 0x8e, 0x17, 0xd3, 0x3a, 0x6d, 0x94, 0x3d, 0x70, 0x77, 0x71, 0x55, 0x94, 0x78, 0x8b, 0x9d,
-	0xe7, 0xde, 0x6c, 0xd4, 0xfb, 0x2d, 0x58, 0x0a, 0x2f, 0x85, 0x17, 0x01, 0x40, 0xb3, 0x33, 0x76,
-	0xa9, 0xa9, 0x1e, 0x79, 0xc2, 0x17, 0x40, 0x6a, 0x1f, 0x1a, 0x64, 0xe0, 0xc2, 0x99, 0xc7, 0xbc,
-	0x0f, 0xb7, 0x71, 0x40, 0xb2, 0x69, 0xb8, 0x86, 0xed, 0xf1, 0x16, 0x78, 0x68, 0x1b, 0xa3, 0x36,
-	0x1c, 0xf5, 0xa1, 0x49, 0xa0, 0xd5, 0x26, 0xc8, 0x86, 0xfe, 0x97, 0xda, 0xee, 0xf4, 0xb0, 0x79,
-	0x18, 0x98, 0x73, 0xd5, 0x27, 0xd3, 0x89, 0xa4, 0xd0, 0x89, 0xff, 0x42, 0x56, 0xf4, 0x15, 0xdb,
-	0x18, 0xd5, 0x43, 0xb0, 0x85, 0x6c, 0xd8, 0x84, 0x6e, 0xd5, 0x47, 0x9e, 0x7d, 0x66, 0x40, 0x22,
-	0xb8, 0x56, 0xfe, 0x05, 0x90, 0xb6, 0x5b, 0x9b, 0xad, 0x7a, 0x7b, 0xa7, 0xa1, 0x35, 0xb4, 0x96,
-	0xb6, 0xf9, 0x4e, 0xdb, 0xab, 0x6f, 0xb5, 0x77, 0x1a, 0xdb, 0xcd, 0x7a, 0x4d, 0x7b, 0xa3, 0xd5,
-	0xb7, 0x72, 0xb1, 0xc2, 0xbd, 0xe3, 0x13, 0x39, 0x7b, 0x89, 0xc0, 0x0b, 0x00, 0x50, 0x9d, 0xff,
-	0x30, 0xc7, 0x14, 0x52, 0xc7, 0x27, 0x32, 0xe7, 0xd7, 0xbc, 0x08, 0xb2, 0x14, 0x69, 0xe9, 0x1f,
-	0x3f, 0x34, 0xeb, 0x8d, 0x1c, 0x5b, 0xc8, 0x1c, 0x9f, 0xc8, 0x4b, 0x61, 0xbb, 0x50, 0x06, 0x60,
-	0x9c, 0x2a, 0xfd, 0xba, 0xc0, 0x1d, 0x7d, 0x15, 0x63, 0xd5, 0xdd, 0xd3, 0x73, 0x91, 0x39, 0x3b,
-	0x17, 0x99, 0x9f, 0xe7, 0x22, 0xf3, 0xe9, 0x42, 0x8c, 0x9d, 0x5d, 0x88, 0xb1, 0x1f, 0x17, 0x62,
-	0x6c, 0xef, 0x55, 0x17, 0x91, 0x83, 0x41, 0xc7, 0x3f, 0x15, 0xd5, 0xc4, 0x9e, 0x8d, 0x3d, 0x15,
-	0x75, 0xcc, 0xb5, 0x2e, 0x56, 0x87, 0x15, 0xd5, 0xc6, 0xd6, 0xa0, 0x07, 0x3d, 0xfa, 0x06, 0x7f,
-	0x5e, 0x59, 0x8b, 0xfc, 0x37, 0x90, 0x71, 0x1f, 0x7a, 0x9d, 0x64, 0xf0, 0xf6, 0xae, 0xfc, 0x0e,
-	0x00, 0x00, 0xff, 0xff, 0xf4, 0xbe, 0xf1, 0xb5, 0x3f, 0x06, 0x00, 0x00,
+	// 853 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xc1, 0x6e, 0xe3, 0x44,
+	0x18, 0x8e, 0x13, 0x27, 0xdb, 0x4e, 0x12, 0xc8, 0x0e, 0x11, 0x35, 0x66, 0xd7, 0xb6, 0x0c, 0x82,
+	0x08, 0xa9, 0x09, 0x69, 0x25, 0x0e, 0x05, 0x0e, 0xeb, 0xd4, 0x48, 0x16, 0x4b, 0xd6, 0x72, 0xb3,
+	0x2b, 0xd1, 0x8b, 0xe5, 0xd8, 0xd3, 0x74, 0x54, 0xdb, 0x63, 0xd9, 0x93, 0xa8, 0x7d, 0x83, 0x55,
+	0x4f, 0xbc, 0x40, 0x25, 0x24, 0x5e, 0x61, 0x25, 0xc4, 0x0b, 0xa0, 0x15, 0xa7, 0x15, 0x27, 0x4e,
+	0x11, 0x6a, 0xaf, 0x9c, 0xf2, 0x04, 0xc8, 0x1e, 0xd7, 0xf1, 0x52, 0x16, 0xa9, 0x65, 0x6f, 0xf3,
+	0xcd, 0xf7, 0x7d, 0x7f, 0xe6, 0xff, 0xfc, 0x4f, 0x06, 0x7c, 0x8a, 0xa7, 0xee, 0xc0, 0x25, 0x31,
+	0x1a, 0xb8, 0x24, 0x0c, 0x91, 0x4b, 0x31, 0x09, 0x07, 0x8b, 0x61, 0x09, 0xf5, 0xa3, 0x98, 0x50,
+	0x02, 0xdf, 0xc7, 0x53, 0xb7, 0x9f, 0x0a, 0xfb, 0x25, 0x6a, 0x31, 0x14, 0xbb, 0x33, 0x32, 0x23,
+	0x99, 0x64, 0x90, 0xae, 0x98, 0x5a, 0x2c, 0x97, 0x0d, 0x02, 0x4c, 0x03, 0x14, 0x52, 0x56, 0xf6,
+	0x1a, 0xe5, 0xc2, 0x0f, 0x5c, 0x92, 0x04, 0x24, 0xb1, 0x59, 0x05, 0x06, 0x18, 0xa5, 0xfe, 0x5a,
+	0x05, 0xed, 0x51, 0xf1, 0x5b, 0x7a, 0xe8, 0xc1, 0x21, 0xd8, 0x74, 0x7d, 0x8c, 0x42, 0x6a, 0x63,
+	0x4f, 0xe0, 0x14, 0xae, 0xb7, 0xa9, 0x75, 0x57, 0x4b, 0xb9, 0x73, 0xe6, 0x04, 0xfe, 0x9e, 0x5a,
+	0x50, 0xaa, 0xb5, 0xc1, 0xd6, 0x86, 0x07, 0xbf, 0x04, 0x1b, 0x0b, 0x14, 0x27, 0x98, 0x84, 0x89,
+	0x50, 0x55, 0x6a, 0xbd, 0xe6, 0x8e, 0xdc, 0xff, 0xf7, 0x4e, 0xfa, 0xcf, 0x98, 0xce, 0x2a, 0x0c,
+	0x70, 0x17, 0xd4, 0x13, 0xea, 0x50, 0x24, 0xd4, 0x14, 0xae, 0xf7, 0xce, 0xce, 0xc3, 0x37, 0x39,
+	0x0f, 0x52, 0x91, 0xc5, 0xb4, 0x70, 0x0c, 0x5a, 0x2e, 0x99, 0x87, 0x14, 0xc5, 0x91, 0x13, 0xd3,
+	0x33, 0x81, 0x57, 0xb8, 0x5e, 0x73, 0xe7, 0xe3, 0x37, 0x79, 0x47, 0x25, 0xad, 0xc6, 0xbf, 0x5c,
+	0xca, 0x15, 0xeb, 0x35, 0x3f, 0xdc, 0x03, 0x2d, 0x0f, 0xf9, 0xce, 0x99, 0x1d, 0xa1, 0x18, 0x13,
+	0x4f, 0xa8, 0x2b, 0x5c, 0x8f, 0xd7, 0xb6, 0x56, 0x4b, 0xf9, 0x3d, 0xd6, 0x77, 0x99, 0x55, 0xad,
+	0x66, 0x06, 0xcd, 0x0c, 0xed, 0xf1, 0xcf, 0x7f, 0x94, 0x2b, 0xea, 0x5f, 0x55, 0xd0, 0x35, 0x3c,
+	0x14, 0x52, 0x7c, 0x84, 0x91, 0xb7, 0x8e, 0x14, 0x3e, 0x04, 0xd5, 0x22, 0xc8, 0xf6, 0x6a, 0x29,
+	0x6f, 0xb2, 0x82, 0x69, 0x82, 0x55, 0xfc, 0x8f, 0xb8, 0xab, 0xb7, 0x8e, 0xbb, 0x76, 0xe7, 0xb8,
+	0xf9, 0xff, 0x11, 0x77, 0xfd, 0x2d, 0xc7, 0xdd, 0xb8, 0x75, 0xdc, 0xbf, 0x71, 0xa0, 0x55, 0xfe,
+	0x99, 0xbb, 0x8c, 0xed, 0xd7, 0xa0, 0xbd, 0x3e, 0xf7, 0x3a, 0x7e, 0x61, 0xb5, 0x94, 0xbb, 0xb9,
+	0xad, 0x4c, 0xab, 0x56, 0x6b, 0x8d, 0x0d, 0x0f, 0x6a, 0xa0, 0x11, 0xc5, 0xe8, 0x08, 0x9f, 0x0a,
+	0xb5, 0x9b, 0x71, 0x14, 0x37, 0x70, 0x31, 0xec, 0x7f, 0x87, 0xe2, 0x13, 0x1f, 0x99, 0x99, 0x36,
+	0x8f, 0x23, 0x77, 0xe6, 0xcd, 0x7c, 0x04, 0x9a, 0xa3, 0xec, 0x50, 0xa6, 0x43, 0x8f, 0x13, 0xd8,
+	0x05, 0xf5, 0x28, 0x5d, 0x08, 0x9c, 0x52, 0xeb, 0x6d, 0x5a, 0x0c, 0xa8, 0x87, 0xe0, 0xdd, 0xf5,
+	0x54, 0x31, 0xe1, 0x1d, 0x7a, 0x2e, 0x6a, 0x57, 0xcb, 0xb5, 0xbf, 0x05, 0xf7, 0xf2, 0x49, 0x81,
+	0x12, 0x00, 0xf8, 0x7a, 0x8c, 0x63, 0x56, 0xd4, 0x2a, 0xed, 0x40, 0x11, 0x6c, 0x1c, 0x21, 0x87,
+	0xce, 0x63, 0x74, 0x5d, 0xa3, 0xc0, 0x79, 0x37, 0xbf, 0x70, 0xa0, 0x61, 0x3a, 0xb1, 0x13, 0x24,
+	0xd0, 0x03, 0x1f, 0x06, 0xce, 0xa9, 0x8d, 0x4e, 0x23, 0xe4, 0x52, 0xe4, 0xd9, 0x14, 0x07, 0x28,
+	0xfd, 0xaa, 0xf6, 0xd4, 0x27, 0xee, 0x49, 0x56, 0x9d, 0xd7, 0x3e, 0x59, 0x2d, 0x65, 0x95, 0x1d,
+	0xf9, 0x3f, 0xc4, 0xaa, 0xb5, 0x15, 0x38, 0xa7, 0x7a, 0x4e, 0x4e, 0x70, 0x80, 0x4c, 0x14, 0x6b,
+	0x29, 0x03, 0x0d, 0x70, 0xff, 0xd8, 0x09, 0xbd, 0xe4, 0xd8, 0x39, 0x41, 0x99, 0x8b, 0xcc, 0x69,
+	0xf6, 0x2d, 0x79, 0xed, 0xc1, 0x6a, 0x29, 0x0b, 0xac, 0xf6, 0x0d, 0x89, 0x6a, 0x75, 0x8a, 0xbd,
+	0x49, 0xbe, 0xf5, 0x82, 0x03, 0x5b, 0xeb, 0x94, 0x47, 0x3e, 0x49, 0x90, 0x19, 0x93, 0x88, 0x24,
+	0x8e, 0x9f, 0x46, 0x47, 0x31, 0xf5, 0x51, 0x1e, 0x0a, 0x03, 0x50, 0x01, 0x4d, 0x0f, 0x25, 0x6e,
+	0x8c, 0xa3, 0xd4, 0xc1, 0x46, 0xc8, 0x2a, 0x6f, 0xdd, 0x1c, 0xb3, 0xda, 0x6d, 0xc6, 0x6c, 0x4f,
+	0x4d, 0x43, 0xfd, 0xfd, 0xc5, 0xb6, 0x98, 0xff, 0x6f, 0xcf, 0xc8, 0xa2, 0xbf, 0x18, 0x4e, 0x11,
+	0x75, 0xd2, 0x8b, 0x16, 0x52, 0x14, 0xd2, 0xcf, 0x7e, 0xe6, 0x40, 0x3d, 0xbb, 0xb0, 0xf0, 0x0b,
+	0x20, 0x1f, 0x4c, 0x1e, 0x4d, 0x74, 0xfb, 0xe9, 0xd8, 0x18, 0x1b, 0x13, 0xe3, 0xd1, 0x63, 0xe3,
+	0x50, 0xdf, 0xb7, 0x9f, 0x8e, 0x0f, 0x4c, 0x7d, 0x64, 0x7c, 0x63, 0xe8, 0xfb, 0x9d, 0x8a, 0x78,
+	0xff, 0xfc, 0x42, 0x69, 0xbf, 0x26, 0x80, 0x02, 0x00, 0xcc, 0x97, 0x6e, 0x76, 0x38, 0x71, 0xe3,
+	0xfc, 0x42, 0xe1, 0xd3, 0x35, 0x94, 0x40, 0x9b, 0x31, 0x13, 0xeb, 0xfb, 0x27, 0xa6, 0x3e, 0xee,
+	0x54, 0xc5, 0xe6, 0xf9, 0x85, 0x72, 0x2f, 0x87, 0x6b, 0x67, 0x46, 0xd6, 0x98, 0x33, 0x63, 0x1e,
+	0x80, 0x16, 0x63, 0x46, 0x8f, 0x9f, 0x1c, 0xe8, 0xfb, 0x1d, 0x5e, 0x04, 0xe7, 0x17, 0x4a, 0x83,
+	0x21, 0x91, 0x7f, 0xfe, 0x93, 0x54, 0xd1, 0x9e, 0xbd, 0xbc, 0x94, 0xb8, 0x57, 0x97, 0x12, 0xf7,
+	0xe7, 0xa5, 0xc4, 0xfd, 0x70, 0x25, 0x55, 0x5e, 0x5d, 0x49, 0x95, 0x3f, 0xae, 0xa4, 0xca, 0xe1,
+	0x57, 0x33, 0x4c, 0x8f, 0xe7, 0xd3, 0xf4, 0x2e, 0xe5, 0x4f, 0xd6, 0x00, 0x4f, 0xdd, 0xed, 0x19,
+	0x19, 0x2c, 0x76, 0x07, 0x01, 0xf1, 0xe6, 0x3e, 0x4a, 0xd8, 0xeb, 0xf7, 0xf9, 0xee, 0x76, 0xe9,
+	0x5d, 0xa5, 0x67, 0x11, 0x4a, 0xa6, 0x8d, 0xec, 0x79, 0xdb, 0xfd, 0x7b, 0x00, 0xc1, 0x58, 0x69,
+	0x4b, 0x7b, 0x07, 0x00, 0x00,
 }
 
 func (m *ConnectionEnd) Marshal() (dAtA []byte, err error) {
@@ -778,6 +849,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HandshakeTimeout != 0 {
+		i = encodeVarintConnection(dAtA, i, uint64(m.HandshakeTimeout))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.MaxExpectedTimePerBlock != 0 {
 		i = encodeVarintConnection(dAtA, i, uint64(m.MaxExpectedTimePerBlock))
 		i--
@@ -786,6 +862,50 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ConnectionCloseProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConnectionCloseProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConnectionCloseProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintConnection(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintConnection(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintConnection(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintConnection(dAtA []byte, offset int, v uint64) int {
 	offset -= sovConnection(v)
 	base := offset
@@ -936,6 +1056,30 @@ func (m *Params) Size() (n int) {
 	if m.MaxExpectedTimePerBlock != 0 {
 		n += 1 + sovConnection(uint64(m.MaxExpectedTimePerBlock))
 	}
+	if m.HandshakeTimeout != 0 {
+		n += 1 + sovConnection(uint64(m.HandshakeTimeout))
+	}
+	return n
+}
+
+func (m *ConnectionCloseProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovConnection(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovConnection(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovConnection(uint64(l))
+	}
 	return n
 }
 
@@ -1856,6 +2000,171 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HandshakeTimeout", wireType)
+			}
+			m.HandshakeTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HandshakeTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConnection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConnection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConnectionCloseProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConnection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConnectionCloseProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConnectionCloseProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConnection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConnection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConnection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConnection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConnection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConnection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConnection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConnection(dAtA[iNdEx:])
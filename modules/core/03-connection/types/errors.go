@@ -16,4 +16,6 @@ var (
 	ErrInvalidVersion                = sdkerrors.Register(SubModuleName, 9, "invalid connection version")
 	ErrVersionNegotiationFailed      = sdkerrors.Register(SubModuleName, 10, "connection version negotiation failed")
 	ErrInvalidConnectionIdentifier   = sdkerrors.Register(SubModuleName, 11, "invalid connection identifier")
+	ErrHandshakeTimeoutNotReached    = sdkerrors.Register(SubModuleName, 12, "connection handshake timeout has not been reached")
+	ErrConnectionInUse               = sdkerrors.Register(SubModuleName, 13, "connection has channels which are not closed")
 )
@@ -20,6 +20,8 @@ var (
 	EventTypeConnectionOpenTry     = "connection_open_try"
 	EventTypeConnectionOpenAck     = "connection_open_ack"
 	EventTypeConnectionOpenConfirm = "connection_open_confirm"
+	EventTypeConnectionOpenCancel  = "connection_open_cancel"
+	EventTypeConnectionClose       = "connection_close"
 
 	AttributeValueCategory = fmt.Sprintf("%s_%s", host.ModuleName, SubModuleName)
 )
@@ -13,5 +13,15 @@ type ClientKeeper interface {
 	GetSelfConsensusState(ctx sdk.Context, height exported.Height) (exported.ConsensusState, error)
 	ValidateSelfClient(ctx sdk.Context, clientState exported.ClientState) error
 	IterateClients(ctx sdk.Context, cb func(string, exported.ClientState) bool)
-	ClientStore(ctx sdk.Context, clientID string) sdk.KVStore
+	ReadOnlyClientStore(ctx sdk.Context, clientID string, writableKeys ...[]byte) sdk.KVStore
+	ProvenClientStore(clientID string, clientState exported.ClientState) sdk.KVStore
+	VerifyMembership(ctx sdk.Context, clientID string, height exported.Height, delayTimePeriod uint64, delayBlockPeriod uint64, proof []byte, path exported.Path, value []byte) error
+	VerifyNonMembership(ctx sdk.Context, clientID string, height exported.Height, delayTimePeriod uint64, delayBlockPeriod uint64, proof []byte, path exported.Path) error
+	VerifyMemberships(ctx sdk.Context, clientID string, height exported.Height, delayTimePeriod uint64, delayBlockPeriod uint64, proofs [][]byte, paths []exported.Path, values [][]byte) error
+	VerifyBatchMembership(ctx sdk.Context, clientID string, height exported.Height, delayTimePeriod uint64, delayBlockPeriod uint64, proof []byte, paths []exported.Path, values [][]byte) error
+}
+
+// ChannelKeeper expected IBC channel keeper
+type ChannelKeeper interface {
+	HasActiveChannels(ctx sdk.Context, connectionID string) bool
 }
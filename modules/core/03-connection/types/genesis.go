@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
 
 // NewConnectionPaths creates a ConnectionPaths instance.
@@ -14,16 +15,25 @@ func NewConnectionPaths(id string, paths []string) ConnectionPaths {
 	}
 }
 
+// NewHandshakeStartTime creates a HandshakeStartTime instance.
+func NewHandshakeStartTime(connectionID string, timestamp uint64) HandshakeStartTime {
+	return HandshakeStartTime{
+		ConnectionId: connectionID,
+		Timestamp:    timestamp,
+	}
+}
+
 // NewGenesisState creates a GenesisState instance.
 func NewGenesisState(
 	connections []IdentifiedConnection, connPaths []ConnectionPaths,
-	nextConnectionSequence uint64, params Params,
+	nextConnectionSequence uint64, params Params, handshakeStartTimes []HandshakeStartTime,
 ) GenesisState {
 	return GenesisState{
 		Connections:            connections,
 		ClientConnectionPaths:  connPaths,
 		NextConnectionSequence: nextConnectionSequence,
 		Params:                 params,
+		HandshakeStartTimes:    handshakeStartTimes,
 	}
 }
 
@@ -34,6 +44,7 @@ func DefaultGenesisState() GenesisState {
 		ClientConnectionPaths:  []ConnectionPaths{},
 		NextConnectionSequence: 0,
 		Params:                 DefaultParams(),
+		HandshakeStartTimes:    []HandshakeStartTime{},
 	}
 }
 
@@ -43,8 +54,23 @@ func (gs GenesisState) Validate() error {
 	// keep track of the max sequence to ensure it is less than
 	// the next sequence used in creating connection identifers.
 	var maxSequence uint64 = 0
+	// keep track of the connections whose opening handshake is in progress
+	handshakes := make(map[string]bool)
 
 	for i, conn := range gs.Connections {
+		if err := conn.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid connection %v index %d: %w", conn, i, err)
+		}
+
+		if conn.State == INIT || conn.State == TRYOPEN {
+			handshakes[conn.Id] = true
+		}
+
+		// the localhost sentinel connection identifier has no sequence
+		if conn.Id == exported.LocalhostConnectionID {
+			continue
+		}
+
 		sequence, err := ParseConnectionSequence(conn.Id)
 		if err != nil {
 			return err
@@ -53,10 +79,6 @@ func (gs GenesisState) Validate() error {
 		if sequence > maxSequence {
 			maxSequence = sequence
 		}
-
-		if err := conn.ValidateBasic(); err != nil {
-			return fmt.Errorf("invalid connection %v index %d: %w", conn, i, err)
-		}
 	}
 
 	for i, conPaths := range gs.ClientConnectionPaths {
@@ -70,6 +92,20 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	startTimes := make(map[string]bool)
+	for i, startTime := range gs.HandshakeStartTimes {
+		if err := host.ConnectionIdentifierValidator(startTime.ConnectionId); err != nil {
+			return fmt.Errorf("invalid handshake start time %d: %w", i, err)
+		}
+		if !handshakes[startTime.ConnectionId] {
+			return fmt.Errorf("handshake start time %d set for connection %s whose opening handshake is not in progress", i, startTime.ConnectionId)
+		}
+		if startTimes[startTime.ConnectionId] {
+			return fmt.Errorf("duplicate handshake start time %d for connection %s", i, startTime.ConnectionId)
+		}
+		startTimes[startTime.ConnectionId] = true
+	}
+
 	if maxSequence != 0 && maxSequence >= gs.NextConnectionSequence {
 		return fmt.Errorf("next connection sequence %d must be greater than maximum sequence used in connection identifier %d", gs.NextConnectionSequence, maxSequence)
 	}
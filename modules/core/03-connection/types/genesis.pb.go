@@ -30,6 +30,8 @@ type GenesisState struct {
 	// the sequence for the next generated connection identifier
 	NextConnectionSequence uint64 `protobuf:"varint,3,opt,name=next_connection_sequence,json=nextConnectionSequence,proto3" json:"next_connection_sequence,omitempty" yaml:"next_connection_sequence"`
 	Params                 Params `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	// the start times of the opening handshakes in progress
+	HandshakeStartTimes []HandshakeStartTime `protobuf:"bytes,5,rep,name=handshake_start_times,json=handshakeStartTimes,proto3" json:"handshake_start_times" yaml:"handshake_start_times"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -93,8 +95,70 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetHandshakeStartTimes() []HandshakeStartTime {
+	if m != nil {
+		return m.HandshakeStartTimes
+	}
+	return nil
+}
+
+// HandshakeStartTime defines the block time, in nanoseconds, at which the opening
+// handshake of a connection started.
+type HandshakeStartTime struct {
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	Timestamp    uint64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *HandshakeStartTime) Reset()         { *m = HandshakeStartTime{} }
+func (m *HandshakeStartTime) String() string { return proto.CompactTextString(m) }
+func (*HandshakeStartTime) ProtoMessage()    {}
+func (*HandshakeStartTime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1879d34bc6ac3cd7, []int{1}
+}
+func (m *HandshakeStartTime) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HandshakeStartTime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HandshakeStartTime.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HandshakeStartTime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HandshakeStartTime.Merge(m, src)
+}
+func (m *HandshakeStartTime) XXX_Size() int {
+	return m.Size()
+}
+func (m *HandshakeStartTime) XXX_DiscardUnknown() {
+	xxx_messageInfo_HandshakeStartTime.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HandshakeStartTime proto.InternalMessageInfo
+
+func (m *HandshakeStartTime) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *HandshakeStartTime) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.core.connection.v1.GenesisState")
+	proto.RegisterType((*HandshakeStartTime)(nil), "ibc.core.connection.v1.HandshakeStartTime")
 }
 
 func init() {
@@ -102,30 +166,36 @@ func init() {
 }
 
 var fileDescriptor_1879d34bc6ac3cd7 = []byte{
-	// 356 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x31, 0x4f, 0xf2, 0x40,
-	0x18, 0xc7, 0xdb, 0x17, 0xc2, 0x50, 0xde, 0xa9, 0x51, 0x6c, 0x18, 0xae, 0xa4, 0x1a, 0x61, 0x90,
-	0x3b, 0x81, 0xcd, 0x30, 0xd5, 0xc1, 0xb8, 0x11, 0x30, 0x0e, 0x26, 0x86, 0xb4, 0xc7, 0x63, 0xb9,
-	0x84, 0xde, 0x55, 0xee, 0x20, 0xf2, 0x09, 0x1c, 0x5c, 0xfc, 0x58, 0x8c, 0x8c, 0x4e, 0xc4, 0xc0,
-	0x37, 0xe0, 0x13, 0x98, 0xb6, 0xc4, 0xa2, 0xb1, 0x5b, 0xf3, 0x3c, 0xbf, 0xff, 0xef, 0x9f, 0xde,
-	0x63, 0x9c, 0x31, 0x9f, 0x12, 0x2a, 0xa6, 0x40, 0xa8, 0xe0, 0x1c, 0xa8, 0x62, 0x82, 0x93, 0x79,
-	0x8b, 0x04, 0xc0, 0x41, 0x32, 0x89, 0xa3, 0xa9, 0x50, 0xc2, 0xac, 0x30, 0x9f, 0xe2, 0x98, 0xc2,
-	0x19, 0x85, 0xe7, 0xad, 0xea, 0x51, 0x20, 0x02, 0x91, 0x20, 0x24, 0xfe, 0x4a, 0xe9, 0x6a, 0x3d,
-	0xc7, 0x79, 0x90, 0x4d, 0x40, 0xe7, 0xad, 0x60, 0xfc, 0xbf, 0x49, 0x8b, 0x06, 0xca, 0x53, 0x60,
-	0xde, 0x19, 0xe5, 0x0c, 0x92, 0x96, 0x5e, 0x2b, 0x34, 0xca, 0xed, 0x0b, 0xfc, 0x77, 0x3b, 0xbe,
-	0x1d, 0x01, 0x57, 0xec, 0x89, 0xc1, 0xe8, 0xfa, 0x7b, 0xee, 0x16, 0x97, 0x6b, 0x5b, 0xeb, 0x1f,
-	0x6a, 0xcc, 0x57, 0xdd, 0x38, 0xa1, 0x13, 0x06, 0x5c, 0x0d, 0xb3, 0xf1, 0x30, 0xf2, 0xd4, 0x58,
-	0x5a, 0xff, 0x92, 0x8a, 0x7a, 0x5e, 0x45, 0x26, 0xee, 0xc5, 0xb8, 0x7b, 0x1e, 0xdb, 0x77, 0x6b,
-	0x1b, 0x2d, 0xbc, 0x70, 0x72, 0xe5, 0xe4, 0x58, 0x9d, 0xfe, 0x71, 0xba, 0xf9, 0x15, 0x37, 0x1f,
-	0x0d, 0x8b, 0xc3, 0xcb, 0x8f, 0x80, 0x84, 0xe7, 0x19, 0x70, 0x0a, 0x56, 0xa1, 0xa6, 0x37, 0x8a,
-	0xee, 0xe9, 0x6e, 0x6d, 0xdb, 0xa9, 0x3c, 0x8f, 0x74, 0xfa, 0x95, 0x78, 0x95, 0xb9, 0x07, 0xfb,
-	0x85, 0xd9, 0x35, 0x4a, 0x91, 0x37, 0xf5, 0x42, 0x69, 0x15, 0x6b, 0x7a, 0xa3, 0xdc, 0x46, 0x79,
-	0xbf, 0xd5, 0x4b, 0xa8, 0xfd, 0x5b, 0xed, 0x33, 0xee, 0xfd, 0x72, 0x83, 0xf4, 0xd5, 0x06, 0xe9,
-	0x9f, 0x1b, 0xa4, 0xbf, 0x6f, 0x91, 0xb6, 0xda, 0x22, 0xed, 0x63, 0x8b, 0xb4, 0x87, 0x6e, 0xc0,
-	0xd4, 0x78, 0xe6, 0x63, 0x2a, 0x42, 0x42, 0x85, 0x0c, 0x85, 0x24, 0xcc, 0xa7, 0xcd, 0x40, 0x90,
-	0x79, 0x87, 0x84, 0x62, 0x34, 0x9b, 0x80, 0x4c, 0x0f, 0x7e, 0xd9, 0x69, 0x1e, 0xdc, 0x5c, 0x2d,
-	0x22, 0x90, 0x7e, 0x29, 0x39, 0x76, 0xe7, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x2d, 0x04, 0xad, 0xb7,
-	0x6b, 0x02, 0x00, 0x00,
+	// 458 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0xeb, 0xad, 0x4c, 0x9a, 0x3b, 0x2e, 0x66, 0x1b, 0xd6, 0x34, 0x25, 0x55, 0x98, 0x58,
+	0x85, 0x58, 0xcc, 0xd6, 0x1b, 0x1a, 0x97, 0x70, 0x80, 0xdd, 0xa6, 0x74, 0xe2, 0x80, 0x84, 0x2a,
+	0xc7, 0x31, 0x89, 0x45, 0x63, 0x67, 0xb5, 0x5b, 0xb1, 0x3b, 0x12, 0x57, 0xc4, 0xa7, 0xda, 0x71,
+	0x47, 0x4e, 0x11, 0x6a, 0xbf, 0x41, 0x3f, 0x01, 0xca, 0x1f, 0x48, 0xd8, 0x9a, 0x5b, 0xfb, 0xbe,
+	0xcf, 0xf3, 0x7b, 0xe4, 0x27, 0x2f, 0x3c, 0x12, 0x01, 0x23, 0x4c, 0x4d, 0x39, 0x61, 0x4a, 0x4a,
+	0xce, 0x8c, 0x50, 0x92, 0xcc, 0x4f, 0x49, 0xc4, 0x25, 0xd7, 0x42, 0xbb, 0xe9, 0x54, 0x19, 0x85,
+	0xf6, 0x45, 0xc0, 0xdc, 0x5c, 0xe5, 0xd6, 0x2a, 0x77, 0x7e, 0x7a, 0xb0, 0x1b, 0xa9, 0x48, 0x15,
+	0x12, 0x92, 0xff, 0x2a, 0xd5, 0x07, 0xc7, 0x2d, 0xcc, 0x86, 0xb7, 0x10, 0x3a, 0x3f, 0xbb, 0x70,
+	0xe7, 0x5d, 0x19, 0x34, 0x32, 0xd4, 0x70, 0x74, 0x05, 0x7b, 0xb5, 0x48, 0x63, 0xd0, 0xdf, 0x1c,
+	0xf4, 0xce, 0x5e, 0xba, 0xeb, 0xd3, 0xdd, 0x8b, 0x90, 0x4b, 0x23, 0x3e, 0x0b, 0x1e, 0xbe, 0xfd,
+	0x37, 0xf7, 0xba, 0xb7, 0x99, 0xdd, 0xf1, 0x9b, 0x18, 0xf4, 0x1d, 0xc0, 0xa7, 0x6c, 0x22, 0xb8,
+	0x34, 0xe3, 0x7a, 0x3c, 0x4e, 0xa9, 0x89, 0x35, 0xde, 0x28, 0x22, 0x8e, 0xdb, 0x22, 0x6a, 0xf0,
+	0x65, 0x2e, 0xf7, 0x9e, 0xe7, 0xf4, 0x55, 0x66, 0x5b, 0x37, 0x34, 0x99, 0xbc, 0x76, 0x5a, 0xa8,
+	0x8e, 0xbf, 0x57, 0x6e, 0xee, 0xd9, 0xd1, 0x27, 0x88, 0x25, 0xff, 0xfa, 0x9f, 0x41, 0xf3, 0xeb,
+	0x19, 0x97, 0x8c, 0xe3, 0xcd, 0x3e, 0x18, 0x74, 0xbd, 0x67, 0xab, 0xcc, 0xb6, 0x4b, 0x78, 0x9b,
+	0xd2, 0xf1, 0xf7, 0xf3, 0x55, 0xcd, 0x1e, 0x55, 0x0b, 0x74, 0x0e, 0xb7, 0x52, 0x3a, 0xa5, 0x89,
+	0xc6, 0xdd, 0x3e, 0x18, 0xf4, 0xce, 0xac, 0xb6, 0x67, 0x5d, 0x16, 0xaa, 0xaa, 0xab, 0xca, 0x83,
+	0xbe, 0x01, 0xb8, 0x17, 0x53, 0x19, 0xea, 0x98, 0x7e, 0xe1, 0x63, 0x6d, 0xe8, 0xd4, 0x8c, 0x8d,
+	0x48, 0xb8, 0xc6, 0x8f, 0x8a, 0x92, 0x5e, 0xb4, 0xd1, 0xde, 0xff, 0x35, 0x8d, 0x72, 0xcf, 0x95,
+	0x48, 0xb8, 0x77, 0x54, 0xf5, 0x74, 0x58, 0x3e, 0x65, 0x2d, 0xd6, 0xf1, 0x9f, 0xc4, 0x0f, 0x9c,
+	0xda, 0xb9, 0x86, 0xe8, 0x21, 0x10, 0xbd, 0x81, 0x8f, 0x1b, 0x55, 0x88, 0x10, 0x83, 0x3e, 0x18,
+	0x6c, 0x7b, 0x78, 0x95, 0xd9, 0xbb, 0xd5, 0xb7, 0x68, 0xae, 0x1d, 0x7f, 0xa7, 0xfe, 0x7f, 0x11,
+	0xa2, 0x43, 0xb8, 0x5d, 0x64, 0x1a, 0x9a, 0xa4, 0x78, 0x23, 0x6f, 0xda, 0xaf, 0x07, 0xde, 0x87,
+	0xdb, 0x85, 0x05, 0xee, 0x16, 0x16, 0xf8, 0xbd, 0xb0, 0xc0, 0x8f, 0xa5, 0xd5, 0xb9, 0x5b, 0x5a,
+	0x9d, 0x5f, 0x4b, 0xab, 0xf3, 0xf1, 0x3c, 0x12, 0x26, 0x9e, 0x05, 0x2e, 0x53, 0x09, 0x61, 0x4a,
+	0x27, 0x4a, 0x13, 0x11, 0xb0, 0x93, 0x48, 0x91, 0xf9, 0x90, 0x24, 0x2a, 0x9c, 0x4d, 0xb8, 0x2e,
+	0x4f, 0xfd, 0xd5, 0xf0, 0xa4, 0x71, 0xed, 0xe6, 0x26, 0xe5, 0x3a, 0xd8, 0x2a, 0xce, 0x7c, 0xf8,
+	0x67, 0x00, 0x31, 0xb4, 0x3c, 0xd8, 0x65, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -148,6 +218,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.HandshakeStartTimes) > 0 {
+		for iNdEx := len(m.HandshakeStartTimes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HandshakeStartTimes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -194,6 +278,41 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HandshakeStartTime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HandshakeStartTime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HandshakeStartTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -228,6 +347,28 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.HandshakeStartTimes) > 0 {
+		for _, e := range m.HandshakeStartTimes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HandshakeStartTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovGenesis(uint64(m.Timestamp))
+	}
 	return n
 }
 
@@ -386,6 +527,141 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HandshakeStartTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HandshakeStartTimes = append(m.HandshakeStartTimes, HandshakeStartTime{})
+			if err := m.HandshakeStartTimes[len(m.HandshakeStartTimes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HandshakeStartTime) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HandshakeStartTime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HandshakeStartTime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -7,6 +7,7 @@ import (
 
 	"github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
 
@@ -32,6 +33,22 @@ func TestValidateGenesis(t *testing.T) {
 				},
 				0,
 				types.DefaultParams(),
+				[]types.HandshakeStartTime{
+					types.NewHandshakeStartTime(connectionID, 100),
+				},
+			),
+			expPass: true,
+		},
+		{
+			name: "valid genesis with localhost sentinel connection",
+			genState: types.NewGenesisState(
+				[]types.IdentifiedConnection{
+					types.NewIdentifiedConnection(exported.LocalhostConnectionID, types.NewConnectionEnd(types.OPEN, exported.Localhost, types.Counterparty{exported.Localhost, exported.LocalhostConnectionID, commitmenttypes.NewMerklePrefix([]byte("prefix"))}, []*types.Version{ibctesting.ConnectionVersion}, 0)),
+				},
+				[]types.ConnectionPaths{},
+				0,
+				types.DefaultParams(),
+				[]types.HandshakeStartTime{},
 			),
 			expPass: true,
 		},
@@ -46,6 +63,7 @@ func TestValidateGenesis(t *testing.T) {
 				},
 				0,
 				types.DefaultParams(),
+				[]types.HandshakeStartTime{},
 			),
 			expPass: false,
 		},
@@ -60,6 +78,7 @@ func TestValidateGenesis(t *testing.T) {
 				},
 				0,
 				types.DefaultParams(),
+				[]types.HandshakeStartTime{},
 			),
 			expPass: false,
 		},
@@ -74,6 +93,7 @@ func TestValidateGenesis(t *testing.T) {
 				},
 				0,
 				types.DefaultParams(),
+				[]types.HandshakeStartTime{},
 			),
 			expPass: false,
 		},
@@ -88,6 +108,7 @@ func TestValidateGenesis(t *testing.T) {
 				},
 				0,
 				types.DefaultParams(),
+				[]types.HandshakeStartTime{},
 			),
 			expPass: false,
 		},
@@ -102,6 +123,7 @@ func TestValidateGenesis(t *testing.T) {
 				},
 				0,
 				types.DefaultParams(),
+				[]types.HandshakeStartTime{},
 			),
 			expPass: false,
 		},
@@ -116,6 +138,76 @@ func TestValidateGenesis(t *testing.T) {
 				},
 				0,
 				types.Params{},
+				[]types.HandshakeStartTime{},
+			),
+			expPass: false,
+		},
+		{
+			name: "invalid handshake start time connection identifier",
+			genState: types.NewGenesisState(
+				[]types.IdentifiedConnection{
+					types.NewIdentifiedConnection(connectionID, types.NewConnectionEnd(types.INIT, clientID, types.Counterparty{clientID2, connectionID2, commitmenttypes.NewMerklePrefix([]byte("prefix"))}, []*types.Version{ibctesting.ConnectionVersion}, 500)),
+				},
+				[]types.ConnectionPaths{
+					{clientID, []string{connectionID}},
+				},
+				0,
+				types.DefaultParams(),
+				[]types.HandshakeStartTime{
+					types.NewHandshakeStartTime("(CONNECTIONID)", 100),
+				},
+			),
+			expPass: false,
+		},
+		{
+			name: "handshake start time set for an open connection",
+			genState: types.NewGenesisState(
+				[]types.IdentifiedConnection{
+					types.NewIdentifiedConnection(connectionID, types.NewConnectionEnd(types.OPEN, clientID, types.Counterparty{clientID2, connectionID2, commitmenttypes.NewMerklePrefix([]byte("prefix"))}, []*types.Version{ibctesting.ConnectionVersion}, 500)),
+				},
+				[]types.ConnectionPaths{
+					{clientID, []string{connectionID}},
+				},
+				0,
+				types.DefaultParams(),
+				[]types.HandshakeStartTime{
+					types.NewHandshakeStartTime(connectionID, 100),
+				},
+			),
+			expPass: false,
+		},
+		{
+			name: "handshake start time set for an unknown connection",
+			genState: types.NewGenesisState(
+				[]types.IdentifiedConnection{
+					types.NewIdentifiedConnection(connectionID, types.NewConnectionEnd(types.INIT, clientID, types.Counterparty{clientID2, connectionID2, commitmenttypes.NewMerklePrefix([]byte("prefix"))}, []*types.Version{ibctesting.ConnectionVersion}, 500)),
+				},
+				[]types.ConnectionPaths{
+					{clientID, []string{connectionID}},
+				},
+				0,
+				types.DefaultParams(),
+				[]types.HandshakeStartTime{
+					types.NewHandshakeStartTime(connectionID2, 100),
+				},
+			),
+			expPass: false,
+		},
+		{
+			name: "duplicate handshake start time",
+			genState: types.NewGenesisState(
+				[]types.IdentifiedConnection{
+					types.NewIdentifiedConnection(connectionID, types.NewConnectionEnd(types.TRYOPEN, clientID, types.Counterparty{clientID2, connectionID2, commitmenttypes.NewMerklePrefix([]byte("prefix"))}, []*types.Version{ibctesting.ConnectionVersion}, 500)),
+				},
+				[]types.ConnectionPaths{
+					{clientID, []string{connectionID}},
+				},
+				0,
+				types.DefaultParams(),
+				[]types.HandshakeStartTime{
+					types.NewHandshakeStartTime(connectionID, 100),
+					types.NewHandshakeStartTime(connectionID, 200),
+				},
 			),
 			expPass: false,
 		},
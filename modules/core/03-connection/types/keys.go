@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"regexp"
+	"strings"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -28,8 +29,28 @@ const (
 
 	// ConnectionPrefix is the prefix used when creating a connection identifier
 	ConnectionPrefix = "connection-"
+
+	// KeyHandshakeStartTimePrefix is the key prefix under which the time at which the
+	// opening handshake of a connection started is stored.
+	KeyHandshakeStartTimePrefix = "handshakeStartTime"
+
+	// KeyLastHopConsensusStatePrefix is the key prefix under which the latest consensus
+	// state of the last chain of a multi-hop path verified in a multi-hop proof is stored.
+	KeyLastHopConsensusStatePrefix = "lastHopConsensusState"
 )
 
+// HandshakeStartTimeKey returns the store key under which the opening handshake start
+// time of a connection is stored.
+func HandshakeStartTimeKey(connectionID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyHandshakeStartTimePrefix, host.ConnectionPath(connectionID)))
+}
+
+// LastHopConsensusStateKey returns the store key under which the latest consensus state
+// of the last chain of the path of the given connection hops is stored.
+func LastHopConsensusStateKey(connectionHops []string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyLastHopConsensusStatePrefix, strings.Join(connectionHops, "/")))
+}
+
 // FormatConnectionIdentifier returns the connection identifier with the sequence appended.
 // This is a SDK specific format not enforced by IBC protocol.
 func FormatConnectionIdentifier(sequence uint64) string {
@@ -16,6 +16,7 @@ var (
 	_ sdk.Msg = &MsgConnectionOpenConfirm{}
 	_ sdk.Msg = &MsgConnectionOpenAck{}
 	_ sdk.Msg = &MsgConnectionOpenTry{}
+	_ sdk.Msg = &MsgConnectionOpenCancel{}
 
 	_ codectypes.UnpackInterfacesMessage = MsgConnectionOpenTry{}
 	_ codectypes.UnpackInterfacesMessage = MsgConnectionOpenAck{}
@@ -46,6 +47,9 @@ func (msg MsgConnectionOpenInit) ValidateBasic() error {
 	if err := host.ClientIdentifierValidator(msg.ClientId); err != nil {
 		return sdkerrors.Wrap(err, "invalid client ID")
 	}
+	if msg.ClientId == exported.Localhost {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidClientType, "localhost connection handshakes are disallowed")
+	}
 	if msg.Counterparty.ConnectionId != "" {
 		return sdkerrors.Wrap(ErrInvalidCounterparty, "counterparty connection identifier must be empty")
 	}
@@ -112,6 +116,9 @@ func (msg MsgConnectionOpenTry) ValidateBasic() error {
 	if err := host.ClientIdentifierValidator(msg.ClientId); err != nil {
 		return sdkerrors.Wrap(err, "invalid client ID")
 	}
+	if msg.ClientId == exported.Localhost {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidClientType, "localhost connection handshakes are disallowed")
+	}
 	// counterparty validate basic allows empty counterparty connection identifiers
 	if err := host.ConnectionIdentifierValidator(msg.Counterparty.ConnectionId); err != nil {
 		return sdkerrors.Wrap(err, "invalid counterparty connection ID")
@@ -293,3 +300,52 @@ func (msg MsgConnectionOpenConfirm) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{accAddr}
 }
+
+// NewMsgConnectionOpenCancel creates a new MsgConnectionOpenCancel instance. An empty
+// proof cancels the connection handshake on timeout.
+//
+//nolint:interfacer
+func NewMsgConnectionOpenCancel(
+	connectionID string, counterpartyConnection ConnectionEnd, proofClosed []byte,
+	proofHeight clienttypes.Height, signer string,
+) *MsgConnectionOpenCancel {
+	return &MsgConnectionOpenCancel{
+		ConnectionId:           connectionID,
+		CounterpartyConnection: counterpartyConnection,
+		ProofClosed:            proofClosed,
+		ProofHeight:            proofHeight,
+		Signer:                 signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgConnectionOpenCancel) ValidateBasic() error {
+	if !IsValidConnectionID(msg.ConnectionId) {
+		return ErrInvalidConnectionIdentifier
+	}
+	if len(msg.ProofClosed) != 0 {
+		if msg.ProofHeight.IsZero() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+		}
+		if msg.CounterpartyConnection.State != CLOSED {
+			return sdkerrors.Wrapf(ErrInvalidConnectionState, "counterparty connection state must be CLOSED, got %s", msg.CounterpartyConnection.State)
+		}
+		if err := msg.CounterpartyConnection.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "invalid counterparty connection end")
+		}
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgConnectionOpenCancel) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
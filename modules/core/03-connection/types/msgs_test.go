@@ -16,6 +16,7 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 	"github.com/cosmos/ibc-go/v3/testing/simapp"
@@ -92,6 +93,7 @@ func (suite *MsgTestSuite) TestNewMsgConnectionOpenInit() {
 		{"empty counterparty prefix", types.NewMsgConnectionOpenInit("clienttotest", "clienttotest", emptyPrefix, version, 500, signer), false},
 		{"supplied version fails basic validation", types.NewMsgConnectionOpenInit("clienttotest", "clienttotest", prefix, &types.Version{}, 500, signer), false},
 		{"empty singer", types.NewMsgConnectionOpenInit("clienttotest", "clienttotest", prefix, version, 500, ""), false},
+		{"localhost client ID", types.NewMsgConnectionOpenInit(exported.Localhost, "clienttotest", prefix, version, 500, signer), false},
 		{"success", types.NewMsgConnectionOpenInit("clienttotest", "clienttotest", prefix, version, 500, signer), true},
 	}
 
@@ -132,6 +134,7 @@ func (suite *MsgTestSuite) TestNewMsgConnectionOpenTry() {
 		{"invalid connection ID", types.NewMsgConnectionOpenTry("test/conn1", "clienttotesta", "connectiontotest", "clienttotest", clientState, prefix, []*types.Version{ibctesting.ConnectionVersion}, 500, suite.proof, suite.proof, suite.proof, clientHeight, clientHeight, signer), false},
 		{"invalid connection ID", types.NewMsgConnectionOpenTry("(invalidconnection)", "clienttotesta", "connectiontotest", "clienttotest", clientState, prefix, []*types.Version{ibctesting.ConnectionVersion}, 500, suite.proof, suite.proof, suite.proof, clientHeight, clientHeight, signer), false},
 		{"invalid client ID", types.NewMsgConnectionOpenTry(connectionID, "test/iris", "connectiontotest", "clienttotest", clientState, prefix, []*types.Version{ibctesting.ConnectionVersion}, 500, suite.proof, suite.proof, suite.proof, clientHeight, clientHeight, signer), false},
+		{"localhost client ID", types.NewMsgConnectionOpenTry(connectionID, exported.Localhost, "connectiontotest", "clienttotest", clientState, prefix, []*types.Version{ibctesting.ConnectionVersion}, 500, suite.proof, suite.proof, suite.proof, clientHeight, clientHeight, signer), false},
 		{"invalid counterparty connection ID", types.NewMsgConnectionOpenTry(connectionID, "clienttotesta", "ibc/test", "clienttotest", clientState, prefix, []*types.Version{ibctesting.ConnectionVersion}, 500, suite.proof, suite.proof, suite.proof, clientHeight, clientHeight, signer), false},
 		{"invalid counterparty client ID", types.NewMsgConnectionOpenTry(connectionID, "clienttotesta", "connectiontotest", "test/conn1", clientState, prefix, []*types.Version{ibctesting.ConnectionVersion}, 500, suite.proof, suite.proof, suite.proof, clientHeight, clientHeight, signer), false},
 		{"invalid nil counterparty client", types.NewMsgConnectionOpenTry(connectionID, "clienttotesta", "connectiontotest", "clienttotest", nil, prefix, []*types.Version{ibctesting.ConnectionVersion}, 500, suite.proof, suite.proof, suite.proof, clientHeight, clientHeight, signer), false},
@@ -236,3 +239,44 @@ func (suite *MsgTestSuite) TestNewMsgConnectionOpenConfirm() {
 		}
 	}
 }
+
+func (suite *MsgTestSuite) TestNewMsgConnectionOpenCancel() {
+	prefix := commitmenttypes.NewMerklePrefix([]byte("storePrefixKey"))
+	counterparty := types.NewCounterparty(clientID2, connectionID, prefix)
+	closedConnection := types.NewConnectionEnd(types.CLOSED, clientID, counterparty, []*types.Version{ibctesting.ConnectionVersion}, 0)
+	initConnection := types.NewConnectionEnd(types.INIT, clientID, counterparty, []*types.Version{ibctesting.ConnectionVersion}, 0)
+	invalidConnection := types.NewConnectionEnd(types.CLOSED, "", counterparty, []*types.Version{ibctesting.ConnectionVersion}, 0)
+
+	testMsgs := []*types.MsgConnectionOpenCancel{
+		types.NewMsgConnectionOpenCancel("test/conn1", closedConnection, suite.proof, clientHeight, signer),
+		types.NewMsgConnectionOpenCancel(connectionID, closedConnection, suite.proof, clienttypes.ZeroHeight(), signer),
+		types.NewMsgConnectionOpenCancel(connectionID, initConnection, suite.proof, clientHeight, signer),
+		types.NewMsgConnectionOpenCancel(connectionID, invalidConnection, suite.proof, clientHeight, signer),
+		types.NewMsgConnectionOpenCancel(connectionID, closedConnection, suite.proof, clientHeight, ""),
+		types.NewMsgConnectionOpenCancel(connectionID, closedConnection, suite.proof, clientHeight, signer),
+		types.NewMsgConnectionOpenCancel(connectionID, types.ConnectionEnd{}, emptyProof, clienttypes.ZeroHeight(), signer),
+	}
+
+	testCases := []struct {
+		msg     *types.MsgConnectionOpenCancel
+		expPass bool
+		errMsg  string
+	}{
+		{testMsgs[0], false, "invalid connection ID"},
+		{testMsgs[1], false, "invalid proofHeight"},
+		{testMsgs[2], false, "counterparty connection not CLOSED"},
+		{testMsgs[3], false, "invalid counterparty connection"},
+		{testMsgs[4], false, "empty signer"},
+		{testMsgs[5], true, "success with proof"},
+		{testMsgs[6], true, "success on timeout"},
+	}
+
+	for i, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, "Msg %d failed: %s", i, tc.errMsg)
+		} else {
+			suite.Require().Error(err, "Invalid Msg %d passed: %s", i, tc.errMsg)
+		}
+	}
+}
@@ -7,11 +7,22 @@ import (
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
-// DefaultTimePerBlock is the default value for maximum expected time per block (in nanoseconds).
-const DefaultTimePerBlock = 30 * time.Second
+const (
+	// DefaultTimePerBlock is the default value for maximum expected time per block (in nanoseconds).
+	DefaultTimePerBlock = 30 * time.Second
 
-// KeyMaxExpectedTimePerBlock is store's key for MaxExpectedTimePerBlock parameter
-var KeyMaxExpectedTimePerBlock = []byte("MaxExpectedTimePerBlock")
+	// DefaultHandshakeTimeout is the default value for the connection handshake timeout (in nanoseconds).
+	// A zero value disables the cancellation of connection handshakes on timeout.
+	DefaultHandshakeTimeout = 0
+)
+
+var (
+	// KeyMaxExpectedTimePerBlock is store's key for MaxExpectedTimePerBlock parameter
+	KeyMaxExpectedTimePerBlock = []byte("MaxExpectedTimePerBlock")
+
+	// KeyHandshakeTimeout is store's key for HandshakeTimeout parameter
+	KeyHandshakeTimeout = []byte("HandshakeTimeout")
+)
 
 // ParamKeyTable type declaration for parameters
 func ParamKeyTable() paramtypes.KeyTable {
@@ -25,9 +36,18 @@ func NewParams(timePerBlock uint64) Params {
 	}
 }
 
+// NewParamsWithHandshakeTimeout creates a new parameter configuration for the ibc connection module
+// with the provided connection handshake timeout
+func NewParamsWithHandshakeTimeout(timePerBlock, handshakeTimeout uint64) Params {
+	return Params{
+		MaxExpectedTimePerBlock: timePerBlock,
+		HandshakeTimeout:        handshakeTimeout,
+	}
+}
+
 // DefaultParams is the default parameter configuration for the ibc connection module
 func DefaultParams() Params {
-	return NewParams(uint64(DefaultTimePerBlock))
+	return NewParamsWithHandshakeTimeout(uint64(DefaultTimePerBlock), DefaultHandshakeTimeout)
 }
 
 // Validate ensures MaxExpectedTimePerBlock is non-zero
@@ -42,6 +62,7 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyMaxExpectedTimePerBlock, p.MaxExpectedTimePerBlock, validateParams),
+		paramtypes.NewParamSetPair(KeyHandshakeTimeout, p.HandshakeTimeout, validateParams),
 	}
 }
 
@@ -16,6 +16,7 @@ func TestValidateParams(t *testing.T) {
 	}{
 		{"default params", types.DefaultParams(), true},
 		{"custom params", types.NewParams(10), true},
+		{"custom params with handshake timeout", types.NewParamsWithHandshakeTimeout(10, 100), true},
 		{"blank client", types.NewParams(0), false},
 	}
 
@@ -0,0 +1,50 @@
+package types
+
+import (
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeConnectionClose defines the type for a ConnectionCloseProposal
+	ProposalTypeConnectionClose = "ConnectionClose"
+)
+
+var _ govtypes.Content = &ConnectionCloseProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeConnectionClose)
+}
+
+// NewConnectionCloseProposal creates a new connection close proposal.
+func NewConnectionCloseProposal(title, description, connectionID string) govtypes.Content {
+	return &ConnectionCloseProposal{
+		Title:        title,
+		Description:  description,
+		ConnectionId: connectionID,
+	}
+}
+
+// GetTitle returns the title of a connection close proposal.
+func (ccp *ConnectionCloseProposal) GetTitle() string { return ccp.Title }
+
+// GetDescription returns the description of a connection close proposal.
+func (ccp *ConnectionCloseProposal) GetDescription() string { return ccp.Description }
+
+// ProposalRoute returns the routing key of a connection close proposal.
+func (ccp *ConnectionCloseProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a connection close proposal.
+func (ccp *ConnectionCloseProposal) ProposalType() string { return ProposalTypeConnectionClose }
+
+// ValidateBasic runs basic stateless validity checks
+func (ccp *ConnectionCloseProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(ccp); err != nil {
+		return err
+	}
+
+	if !IsValidConnectionID(ccp.ConnectionId) {
+		return ErrInvalidConnectionIdentifier
+	}
+
+	return nil
+}
@@ -149,6 +149,8 @@ func (m *QueryConnectionResponse) GetProofHeight() types.Height {
 // method
 type QueryConnectionsRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// optional state filter, all connections are returned if unspecified
+	State State `protobuf:"varint,2,opt,name=state,proto3,enum=ibc.core.connection.v1.State" json:"state,omitempty"`
 }
 
 func (m *QueryConnectionsRequest) Reset()         { *m = QueryConnectionsRequest{} }
@@ -191,6 +193,13 @@ func (m *QueryConnectionsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryConnectionsRequest) GetState() State {
+	if m != nil {
+		return m.State
+	}
+	return UNINITIALIZED
+}
+
 // QueryConnectionsResponse is the response type for the Query/Connections RPC
 // method.
 type QueryConnectionsResponse struct {
@@ -635,63 +644,64 @@ func init() {
 }
 
 var fileDescriptor_cd8d529f8c7cd06b = []byte{
-	// 895 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x31, 0x6f, 0x23, 0x45,
-	0x14, 0xf6, 0x38, 0xb9, 0xd3, 0x65, 0x1c, 0xee, 0x60, 0xe4, 0xbb, 0x33, 0x0b, 0x38, 0x61, 0x8f,
-	0x90, 0x1c, 0x70, 0x33, 0xe7, 0x58, 0x77, 0x3a, 0x8e, 0x18, 0x81, 0xa3, 0x40, 0xd2, 0x44, 0x61,
-	0x91, 0x28, 0x68, 0xa2, 0xdd, 0xf5, 0x64, 0xbd, 0x92, 0xbd, 0xe3, 0x78, 0xd6, 0x46, 0x56, 0x64,
-	0x21, 0xf1, 0x07, 0x40, 0xa2, 0xa1, 0xa1, 0xa5, 0xe0, 0x0f, 0x50, 0xd0, 0x51, 0xa5, 0x8c, 0x44,
-	0x93, 0x2a, 0x42, 0x0e, 0x2d, 0x0d, 0xbf, 0x00, 0xed, 0xcc, 0x38, 0x3b, 0x6b, 0xaf, 0x13, 0xc7,
-	0x22, 0xdd, 0xe6, 0xcd, 0x7b, 0xf3, 0xbe, 0xef, 0x7b, 0x6f, 0x3e, 0x07, 0x9a, 0xbe, 0xe3, 0x12,
-	0x97, 0xb5, 0x29, 0x71, 0x59, 0x10, 0x50, 0x37, 0xf4, 0x59, 0x40, 0xba, 0x25, 0x72, 0xd8, 0xa1,
-	0xed, 0x1e, 0x6e, 0xb5, 0x59, 0xc8, 0xd0, 0x03, 0xdf, 0x71, 0x71, 0x94, 0x83, 0xe3, 0x1c, 0xdc,
-	0x2d, 0x19, 0x79, 0x8f, 0x79, 0x4c, 0xa4, 0x90, 0xe8, 0x4b, 0x66, 0x1b, 0xef, 0xb9, 0x8c, 0x37,
-	0x19, 0x27, 0x8e, 0xcd, 0xa9, 0xbc, 0x86, 0x74, 0x4b, 0x0e, 0x0d, 0xed, 0x12, 0x69, 0xd9, 0x9e,
-	0x1f, 0xd8, 0xa2, 0x5c, 0xe6, 0x2e, 0xc5, 0xdd, 0x1b, 0x3e, 0x0d, 0xc2, 0xa8, 0xb3, 0xfc, 0x52,
-	0x09, 0xab, 0x13, 0xe0, 0x69, 0x40, 0x64, 0xe2, 0x9b, 0x1e, 0x63, 0x5e, 0x83, 0x12, 0xbb, 0xe5,
-	0x13, 0x3b, 0x08, 0x58, 0x28, 0xda, 0x70, 0x75, 0xfa, 0xba, 0x3a, 0x15, 0x7f, 0x39, 0x9d, 0x03,
-	0x62, 0x07, 0x8a, 0x9c, 0x59, 0x81, 0x0f, 0xbe, 0x88, 0x40, 0x6e, 0x5e, 0xdc, 0x68, 0xd1, 0xc3,
-	0x0e, 0xe5, 0x21, 0x7a, 0x04, 0x5f, 0x89, 0xdb, 0xec, 0xfb, 0xb5, 0x02, 0x58, 0x06, 0x6b, 0x0b,
-	0xd6, 0x62, 0x1c, 0xdc, 0xa9, 0x99, 0xbf, 0x03, 0xf8, 0x70, 0xac, 0x9e, 0xb7, 0x58, 0xc0, 0x29,
-	0xda, 0x82, 0x30, 0xce, 0x15, 0xd5, 0xb9, 0xf5, 0x15, 0x9c, 0x2e, 0x26, 0x8e, 0xeb, 0xb7, 0x82,
-	0x9a, 0xa5, 0x15, 0xa2, 0x3c, 0xbc, 0xd5, 0x6a, 0x33, 0x76, 0x50, 0xc8, 0x2e, 0x83, 0xb5, 0x45,
-	0x4b, 0xfe, 0x81, 0x36, 0xe1, 0xa2, 0xf8, 0xd8, 0xaf, 0x53, 0xdf, 0xab, 0x87, 0x85, 0x39, 0x71,
-	0xbd, 0xa1, 0x5d, 0x2f, 0x75, 0xec, 0x96, 0xf0, 0xb6, 0xc8, 0xa8, 0xce, 0x1f, 0x9f, 0x2d, 0x65,
-	0xac, 0x9c, 0xa8, 0x92, 0x21, 0xd3, 0x1e, 0x03, 0xcf, 0x87, 0xec, 0x3f, 0x83, 0x30, 0x1e, 0x97,
-	0x02, 0xff, 0x2e, 0x96, 0xb3, 0xc5, 0xd1, 0x6c, 0xb1, 0x5c, 0x11, 0x35, 0x5b, 0xbc, 0x67, 0x7b,
-	0x54, 0xd5, 0x5a, 0x5a, 0xa5, 0xf9, 0x0f, 0x80, 0x85, 0xf1, 0x1e, 0x4a, 0xa1, 0x5d, 0x98, 0x8b,
-	0x89, 0xf2, 0x02, 0x58, 0x9e, 0x5b, 0xcb, 0xad, 0x7f, 0x30, 0x49, 0xa2, 0x9d, 0x1a, 0x0d, 0x42,
-	0xff, 0xc0, 0xa7, 0x35, 0x4d, 0x6c, 0xfd, 0x02, 0xf4, 0x79, 0x02, 0x74, 0x56, 0x80, 0x5e, 0xbd,
-	0x12, 0xb4, 0x04, 0xa3, 0xa3, 0x46, 0x2f, 0xe0, 0xed, 0x6b, 0xea, 0xaa, 0xf2, 0xcd, 0x0d, 0xf8,
-	0x96, 0xa4, 0x2b, 0xd2, 0x52, 0x84, 0x7d, 0x03, 0x2e, 0xc8, 0x2b, 0xe2, 0x95, 0xba, 0x23, 0x03,
-	0x3b, 0x35, 0xf3, 0x17, 0x00, 0x8b, 0x93, 0xca, 0x95, 0x66, 0x8f, 0xe1, 0xab, 0xda, 0x5a, 0xb6,
-	0xec, 0xb0, 0x2e, 0x85, 0x5b, 0xb0, 0xee, 0xc5, 0xf1, 0xbd, 0x28, 0x7c, 0x93, 0x9b, 0xe3, 0xc0,
-	0xb7, 0x47, 0xa6, 0x2a, 0x11, 0x7f, 0x19, 0xda, 0xe1, 0x70, 0x0f, 0x50, 0x25, 0xf5, 0x05, 0x55,
-	0x0b, 0xff, 0x9e, 0x2d, 0xe5, 0x7b, 0x76, 0xb3, 0xf1, 0xd2, 0x4c, 0x1c, 0x9b, 0x23, 0x6f, 0x6b,
-	0x00, 0xa0, 0x79, 0x59, 0x13, 0x25, 0x88, 0x0d, 0x1f, 0xfa, 0x17, 0x9b, 0xb1, 0xaf, 0xb4, 0xe5,
-	0x51, 0x8a, 0x5a, 0xdb, 0xc7, 0x69, 0xd4, 0xb4, 0x65, 0xd2, 0xee, 0xbc, 0xef, 0xa7, 0x85, 0x6f,
-	0x52, 0xc8, 0xdf, 0x00, 0x7c, 0x67, 0x94, 0x64, 0x44, 0x2b, 0xe0, 0x1d, 0xfe, 0x3f, 0x8a, 0x89,
-	0x56, 0xe1, 0xbd, 0x36, 0xed, 0xfa, 0x3c, 0x3a, 0x0d, 0x3a, 0x4d, 0x87, 0xb6, 0x05, 0x99, 0x79,
-	0xeb, 0xee, 0x30, 0xbc, 0x2b, 0xa2, 0x89, 0x44, 0x8d, 0x98, 0x96, 0xa8, 0x90, 0x9f, 0x01, 0xb8,
-	0x72, 0x05, 0x72, 0x35, 0xa1, 0x0a, 0x8c, 0x56, 0x53, 0x9e, 0x24, 0x26, 0x93, 0xc7, 0xd2, 0x98,
-	0xf1, 0xd0, 0x98, 0xf1, 0xa7, 0x41, 0xcf, 0xba, 0xeb, 0x26, 0xae, 0x49, 0xbe, 0x98, 0x6c, 0xf2,
-	0xc5, 0xc4, 0xa3, 0x99, 0xbb, 0x6c, 0x34, 0xf3, 0x33, 0x8c, 0x66, 0xfd, 0xfb, 0x3b, 0xf0, 0x96,
-	0x20, 0x88, 0x7e, 0x05, 0x10, 0xc6, 0x2c, 0x11, 0x9e, 0xe4, 0x50, 0xe9, 0xbf, 0x24, 0x06, 0x99,
-	0x3a, 0x5f, 0x0a, 0x66, 0x7e, 0xf4, 0xdd, 0x9f, 0x7f, 0xff, 0x98, 0x7d, 0x86, 0xca, 0xe4, 0xca,
-	0xdf, 0x3f, 0x4e, 0x8e, 0x12, 0x73, 0xef, 0xa3, 0x9f, 0x01, 0xcc, 0x69, 0xc6, 0x81, 0xa6, 0xed,
-	0x3e, 0x74, 0x28, 0xe3, 0xe9, 0xf4, 0x05, 0x0a, 0xef, 0xfb, 0x02, 0xef, 0x0a, 0x7a, 0x34, 0x05,
-	0x5e, 0xf4, 0x07, 0x80, 0xaf, 0x8d, 0xd9, 0x1b, 0x7a, 0x76, 0x79, 0xd3, 0x09, 0x6e, 0x6a, 0x3c,
-	0xbf, 0x6e, 0x99, 0x42, 0xfc, 0xb1, 0x40, 0xfc, 0x02, 0x3d, 0x9f, 0x88, 0x58, 0x6e, 0x5c, 0x52,
-	0xe8, 0xe1, 0x16, 0xf6, 0xd1, 0x29, 0x80, 0xf7, 0x53, 0x6d, 0x09, 0x7d, 0x38, 0xa5, 0x7a, 0xe3,
-	0x7e, 0x69, 0xbc, 0x9c, 0xa5, 0x54, 0x11, 0xda, 0x16, 0x84, 0xaa, 0xe8, 0x93, 0x19, 0x56, 0x86,
-	0xe8, 0xa6, 0x89, 0x7e, 0xca, 0xc2, 0xc2, 0xa4, 0x27, 0x8d, 0x36, 0xa6, 0x85, 0x98, 0xe6, 0x61,
-	0x46, 0x65, 0xc6, 0x6a, 0xc5, 0xf1, 0x5b, 0xc1, 0xb1, 0x87, 0xbe, 0x99, 0x89, 0x63, 0xd2, 0x81,
-	0xc8, 0xd0, 0xcd, 0xc8, 0xd1, 0x88, 0x2f, 0xf6, 0x89, 0x34, 0x0d, 0xed, 0x40, 0x06, 0xfa, 0xd5,
-	0xaf, 0x8e, 0x07, 0x45, 0x70, 0x32, 0x28, 0x82, 0xbf, 0x06, 0x45, 0xf0, 0xc3, 0x79, 0x31, 0x73,
-	0x72, 0x5e, 0xcc, 0x9c, 0x9e, 0x17, 0x33, 0x5f, 0x6f, 0x78, 0x7e, 0x58, 0xef, 0x38, 0xd8, 0x65,
-	0x4d, 0xa2, 0xfe, 0x01, 0xf6, 0x1d, 0xf7, 0x89, 0xc7, 0x48, 0xb7, 0x4c, 0x9a, 0xac, 0xd6, 0x69,
-	0x50, 0x2e, 0x11, 0x3f, 0x2d, 0x3f, 0xd1, 0x40, 0x87, 0xbd, 0x16, 0xe5, 0xce, 0x6d, 0xe1, 0x7f,
-	0xe5, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x7e, 0xd7, 0xeb, 0x28, 0x8e, 0x0b, 0x00, 0x00,
+	// 908 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x38, 0x49, 0xd5, 0x3c, 0x87, 0x04, 0x46, 0x69, 0x6b, 0x16, 0xea, 0x84, 0x2d, 0x21,
+	0x29, 0xd0, 0x99, 0x3a, 0x56, 0xab, 0x52, 0x62, 0x04, 0xae, 0x0a, 0xcd, 0xa5, 0x2a, 0x8b, 0xc4,
+	0x81, 0x4b, 0xb4, 0xbb, 0x9e, 0xac, 0x57, 0xb2, 0x77, 0x5c, 0xcf, 0xda, 0xc8, 0xaa, 0x2c, 0x24,
+	0xfe, 0x00, 0x48, 0x48, 0x88, 0x0b, 0x57, 0x0e, 0xfc, 0x01, 0x0e, 0xdc, 0x38, 0xf5, 0x58, 0x89,
+	0x4b, 0x4f, 0x11, 0x72, 0xb8, 0x72, 0xe1, 0x17, 0xa0, 0x9d, 0x19, 0x77, 0x77, 0xed, 0xdd, 0xc4,
+	0xb5, 0xc8, 0x6d, 0xf7, 0xcd, 0xf7, 0xde, 0x7c, 0xdf, 0x7b, 0x6f, 0x3f, 0x1b, 0x4c, 0xdf, 0x71,
+	0xa9, 0xcb, 0xbb, 0x8c, 0xba, 0x3c, 0x08, 0x98, 0x1b, 0xfa, 0x3c, 0xa0, 0xfd, 0x0a, 0x7d, 0xdc,
+	0x63, 0xdd, 0x01, 0xe9, 0x74, 0x79, 0xc8, 0xf1, 0x65, 0xdf, 0x71, 0x49, 0x84, 0x21, 0x31, 0x86,
+	0xf4, 0x2b, 0xc6, 0x86, 0xc7, 0x3d, 0x2e, 0x21, 0x34, 0x7a, 0x52, 0x68, 0xe3, 0x5d, 0x97, 0x8b,
+	0x36, 0x17, 0xd4, 0xb1, 0x05, 0x53, 0x65, 0x68, 0xbf, 0xe2, 0xb0, 0xd0, 0xae, 0xd0, 0x8e, 0xed,
+	0xf9, 0x81, 0x2d, 0xd3, 0x15, 0x76, 0x33, 0xbe, 0xbd, 0xe5, 0xb3, 0x20, 0x8c, 0x6e, 0x56, 0x4f,
+	0x1a, 0xb0, 0x93, 0x43, 0x2f, 0x7e, 0xd3, 0xc0, 0x37, 0x3d, 0xce, 0xbd, 0x16, 0xa3, 0x76, 0xc7,
+	0xa7, 0x76, 0x10, 0xf0, 0x50, 0x5e, 0x23, 0xf4, 0xe9, 0xeb, 0xfa, 0x54, 0xbe, 0x39, 0xbd, 0x23,
+	0x6a, 0x07, 0x5a, 0x9c, 0x59, 0x83, 0xcb, 0x9f, 0x47, 0x24, 0xef, 0xbd, 0xa8, 0x68, 0xb1, 0xc7,
+	0x3d, 0x26, 0x42, 0x7c, 0x0d, 0x5e, 0x89, 0xaf, 0x39, 0xf4, 0x1b, 0x25, 0xb4, 0x85, 0x76, 0x57,
+	0xac, 0xd5, 0x38, 0x78, 0xd0, 0x30, 0x7f, 0x47, 0x70, 0x65, 0x2a, 0x5f, 0x74, 0x78, 0x20, 0x18,
+	0xbe, 0x0f, 0x10, 0x63, 0x65, 0x76, 0x71, 0x6f, 0x9b, 0x64, 0x37, 0x93, 0xc4, 0xf9, 0xf7, 0x83,
+	0x86, 0x95, 0x48, 0xc4, 0x1b, 0xb0, 0xdc, 0xe9, 0x72, 0x7e, 0x54, 0x2a, 0x6c, 0xa1, 0xdd, 0x55,
+	0x4b, 0xbd, 0xe0, 0x7b, 0xb0, 0x2a, 0x1f, 0x0e, 0x9b, 0xcc, 0xf7, 0x9a, 0x61, 0x69, 0x51, 0x96,
+	0x37, 0x12, 0xe5, 0x55, 0x1f, 0xfb, 0x15, 0xf2, 0x40, 0x22, 0xea, 0x4b, 0x4f, 0x8f, 0x37, 0x17,
+	0xac, 0xa2, 0xcc, 0x52, 0x21, 0xf3, 0xc7, 0x69, 0xf6, 0x62, 0x2c, 0xff, 0x53, 0x80, 0x78, 0x5e,
+	0x9a, 0xfd, 0x3b, 0x44, 0x0d, 0x97, 0x44, 0xc3, 0x25, 0x6a, 0x47, 0xf4, 0x70, 0xc9, 0x23, 0xdb,
+	0x63, 0x3a, 0xd7, 0x4a, 0x64, 0xe2, 0x2a, 0x2c, 0x8b, 0xd0, 0x0e, 0x99, 0xa4, 0xbf, 0xb6, 0x77,
+	0x35, 0xaf, 0x01, 0x5f, 0x44, 0x20, 0x4b, 0x61, 0xcd, 0x7f, 0x10, 0x94, 0xa6, 0x89, 0xe9, 0xbe,
+	0x3e, 0x84, 0x62, 0x9c, 0x2a, 0x4a, 0x68, 0x6b, 0x71, 0xb7, 0xb8, 0xf7, 0x7e, 0x5e, 0xdd, 0x83,
+	0x06, 0x0b, 0x42, 0xff, 0xc8, 0x67, 0x8d, 0xc4, 0x88, 0x92, 0x05, 0xf0, 0x67, 0x29, 0xa5, 0x05,
+	0xa9, 0x74, 0xe7, 0x4c, 0xa5, 0x8a, 0x4c, 0x4a, 0xea, 0x1d, 0xb8, 0xf0, 0x92, 0xd3, 0xd0, 0x78,
+	0x73, 0x1f, 0xae, 0x2a, 0xb9, 0x12, 0x96, 0x31, 0x8d, 0x37, 0x60, 0x45, 0x95, 0x88, 0x17, 0xf1,
+	0xa2, 0x0a, 0x1c, 0x34, 0xcc, 0x5f, 0x10, 0x94, 0xf3, 0xd2, 0x75, 0xcf, 0xae, 0xc3, 0xab, 0x89,
+	0x65, 0xee, 0xd8, 0x61, 0x53, 0x35, 0x6e, 0xc5, 0x5a, 0x8f, 0xe3, 0x8f, 0xa2, 0xf0, 0x79, 0xee,
+	0x9b, 0x03, 0x6f, 0x4d, 0x4c, 0x55, 0x31, 0x56, 0xb3, 0xd7, 0x52, 0x6b, 0x99, 0xdf, 0x5d, 0xbd,
+	0xf4, 0xef, 0xf1, 0xe6, 0xc6, 0xc0, 0x6e, 0xb7, 0xee, 0x9a, 0xa9, 0x63, 0x73, 0xe2, 0x8b, 0x1c,
+	0x21, 0x30, 0x4f, 0xbb, 0x44, 0x37, 0xc4, 0x86, 0x2b, 0xfe, 0x8b, 0xcd, 0x38, 0xd4, 0xbd, 0x55,
+	0x8b, 0xaa, 0x76, 0xfd, 0x7a, 0x96, 0xb4, 0xc4, 0x32, 0x25, 0x6a, 0x5e, 0xf2, 0xb3, 0xc2, 0xe7,
+	0xd9, 0xc8, 0xdf, 0x10, 0xbc, 0x3d, 0x29, 0x32, 0x92, 0x15, 0x88, 0x9e, 0xf8, 0x1f, 0x9b, 0x89,
+	0x77, 0x60, 0xbd, 0xcb, 0xfa, 0xbe, 0x88, 0x4e, 0x83, 0x5e, 0xdb, 0x61, 0x5d, 0x29, 0x66, 0xc9,
+	0x5a, 0x1b, 0x87, 0x1f, 0xca, 0x68, 0x0a, 0x98, 0x10, 0x96, 0x00, 0x6a, 0xe6, 0xc7, 0x08, 0xb6,
+	0xcf, 0x60, 0xae, 0x27, 0x54, 0x83, 0x75, 0x77, 0x7c, 0x92, 0x9a, 0xcc, 0x06, 0x51, 0x76, 0x4e,
+	0xc6, 0x76, 0x4e, 0x3e, 0x09, 0x06, 0xd6, 0x9a, 0x9b, 0x2a, 0x93, 0xfe, 0x62, 0x0a, 0xe9, 0x2f,
+	0x26, 0x1e, 0xcd, 0xe2, 0x69, 0xa3, 0x59, 0x9a, 0x63, 0x34, 0x7b, 0xdf, 0x5d, 0x84, 0x65, 0x29,
+	0x10, 0xff, 0x8a, 0x00, 0x62, 0x95, 0x98, 0xe4, 0x39, 0x54, 0xf6, 0xef, 0x8f, 0x41, 0x67, 0xc6,
+	0xab, 0x86, 0x99, 0x1f, 0x7e, 0xfb, 0xe7, 0xdf, 0x3f, 0x14, 0x6e, 0xe1, 0x2a, 0x3d, 0xf3, 0x57,
+	0x53, 0xd0, 0x27, 0xa9, 0xb9, 0x0f, 0xf1, 0xcf, 0x08, 0x8a, 0x71, 0x4d, 0x81, 0x67, 0xbd, 0x7d,
+	0xec, 0x50, 0xc6, 0xcd, 0xd9, 0x13, 0x34, 0xdf, 0xf7, 0x24, 0xdf, 0x6d, 0x7c, 0x6d, 0x06, 0xbe,
+	0xf8, 0x0f, 0x04, 0xaf, 0x4d, 0xd9, 0x1b, 0xbe, 0x75, 0xfa, 0xa5, 0x39, 0x6e, 0x6a, 0xdc, 0x7e,
+	0xd9, 0x34, 0xcd, 0xf8, 0x23, 0xc9, 0xf8, 0x0e, 0xbe, 0x9d, 0xcb, 0x58, 0x6d, 0x5c, 0xba, 0xd1,
+	0xe3, 0x2d, 0x1c, 0xe2, 0xe7, 0x08, 0x2e, 0x65, 0xda, 0x12, 0xfe, 0x60, 0xc6, 0xee, 0x4d, 0xfb,
+	0xa5, 0x71, 0x77, 0x9e, 0x54, 0x2d, 0xe8, 0x81, 0x14, 0x54, 0xc7, 0x1f, 0xcf, 0xb1, 0x32, 0x34,
+	0x69, 0x9a, 0xf8, 0xa7, 0x02, 0x94, 0xf2, 0x3e, 0x69, 0xbc, 0x3f, 0x2b, 0xc5, 0x2c, 0x0f, 0x33,
+	0x6a, 0x73, 0x66, 0x6b, 0x8d, 0xdf, 0x48, 0x8d, 0x03, 0xfc, 0xf5, 0x5c, 0x1a, 0xd3, 0x0e, 0x44,
+	0xc7, 0x6e, 0x46, 0x9f, 0x4c, 0xf8, 0xe2, 0x90, 0x2a, 0xd3, 0x48, 0x1c, 0xa8, 0xc0, 0xb0, 0xfe,
+	0xe5, 0xd3, 0x51, 0x19, 0x3d, 0x1b, 0x95, 0xd1, 0x5f, 0xa3, 0x32, 0xfa, 0xfe, 0xa4, 0xbc, 0xf0,
+	0xec, 0xa4, 0xbc, 0xf0, 0xfc, 0xa4, 0xbc, 0xf0, 0xd5, 0xbe, 0xe7, 0x87, 0xcd, 0x9e, 0x43, 0x5c,
+	0xde, 0xa6, 0xfa, 0x6f, 0xb3, 0xef, 0xb8, 0x37, 0x3c, 0x4e, 0xfb, 0x55, 0xda, 0xe6, 0x8d, 0x5e,
+	0x8b, 0x09, 0xc5, 0xf8, 0x66, 0xf5, 0x46, 0x82, 0x74, 0x38, 0xe8, 0x30, 0xe1, 0x5c, 0x90, 0xfe,
+	0x57, 0xfd, 0x6f, 0x00, 0x22, 0x57, 0x9f, 0x72, 0xc4, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1036,6 +1046,11 @@ func (m *QueryConnectionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.State != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -1423,6 +1438,9 @@ func (m *QueryConnectionsRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.State != 0 {
+		n += 1 + sovQuery(uint64(m.State))
+	}
 	return n
 }
 
@@ -1861,6 +1879,25 @@ func (m *QueryConnectionsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -374,6 +374,91 @@ func (m *MsgConnectionOpenConfirmResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgConnectionOpenConfirmResponse proto.InternalMessageInfo
 
+// MsgConnectionOpenCancel defines a msg sent by a Relayer to cancel a connection
+// handshake which is stuck in INIT or TRYOPEN. The handshake may be cancelled by
+// proving that the counterparty connection end is CLOSED, or without a proof once
+// the handshake timeout has elapsed.
+type MsgConnectionOpenCancel struct {
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// the counterparty connection end in the CLOSED state, only required if a proof is provided
+	CounterpartyConnection ConnectionEnd `protobuf:"bytes,2,opt,name=counterparty_connection,json=counterpartyConnection,proto3" json:"counterparty_connection" yaml:"counterparty_connection"`
+	// proof of the counterparty connection end being closed, empty if cancelling on handshake timeout
+	ProofClosed []byte        `protobuf:"bytes,3,opt,name=proof_closed,json=proofClosed,proto3" json:"proof_closed,omitempty" yaml:"proof_closed"`
+	ProofHeight types1.Height `protobuf:"bytes,4,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height" yaml:"proof_height"`
+	Signer      string        `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgConnectionOpenCancel) Reset()         { *m = MsgConnectionOpenCancel{} }
+func (m *MsgConnectionOpenCancel) String() string { return proto.CompactTextString(m) }
+func (*MsgConnectionOpenCancel) ProtoMessage()    {}
+func (*MsgConnectionOpenCancel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d00fde5fc97399e, []int{8}
+}
+func (m *MsgConnectionOpenCancel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConnectionOpenCancel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConnectionOpenCancel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConnectionOpenCancel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConnectionOpenCancel.Merge(m, src)
+}
+func (m *MsgConnectionOpenCancel) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConnectionOpenCancel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConnectionOpenCancel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConnectionOpenCancel proto.InternalMessageInfo
+
+// MsgConnectionOpenCancelResponse defines the Msg/ConnectionOpenCancel response
+// type.
+type MsgConnectionOpenCancelResponse struct {
+}
+
+func (m *MsgConnectionOpenCancelResponse) Reset()         { *m = MsgConnectionOpenCancelResponse{} }
+func (m *MsgConnectionOpenCancelResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConnectionOpenCancelResponse) ProtoMessage()    {}
+func (*MsgConnectionOpenCancelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d00fde5fc97399e, []int{9}
+}
+func (m *MsgConnectionOpenCancelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConnectionOpenCancelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConnectionOpenCancelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConnectionOpenCancelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConnectionOpenCancelResponse.Merge(m, src)
+}
+func (m *MsgConnectionOpenCancelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConnectionOpenCancelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConnectionOpenCancelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConnectionOpenCancelResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgConnectionOpenInit)(nil), "ibc.core.connection.v1.MsgConnectionOpenInit")
 	proto.RegisterType((*MsgConnectionOpenInitResponse)(nil), "ibc.core.connection.v1.MsgConnectionOpenInitResponse")
@@ -383,70 +468,78 @@ func init() {
 	proto.RegisterType((*MsgConnectionOpenAckResponse)(nil), "ibc.core.connection.v1.MsgConnectionOpenAckResponse")
 	proto.RegisterType((*MsgConnectionOpenConfirm)(nil), "ibc.core.connection.v1.MsgConnectionOpenConfirm")
 	proto.RegisterType((*MsgConnectionOpenConfirmResponse)(nil), "ibc.core.connection.v1.MsgConnectionOpenConfirmResponse")
+	proto.RegisterType((*MsgConnectionOpenCancel)(nil), "ibc.core.connection.v1.MsgConnectionOpenCancel")
+	proto.RegisterType((*MsgConnectionOpenCancelResponse)(nil), "ibc.core.connection.v1.MsgConnectionOpenCancelResponse")
 }
 
 func init() { proto.RegisterFile("ibc/core/connection/v1/tx.proto", fileDescriptor_5d00fde5fc97399e) }
 
 var fileDescriptor_5d00fde5fc97399e = []byte{
-	// 927 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x31, 0x73, 0xe3, 0x44,
-	0x14, 0xb6, 0x62, 0x27, 0xb1, 0xd7, 0x86, 0xbb, 0x5b, 0x9c, 0x44, 0x98, 0x3b, 0xcb, 0xa7, 0x81,
-	0x21, 0x05, 0x91, 0xce, 0x97, 0x63, 0x06, 0x32, 0x50, 0xc4, 0x6e, 0x48, 0x71, 0x70, 0x23, 0x6e,
-	0x8e, 0x99, 0x6b, 0x3c, 0xf6, 0x7a, 0xa3, 0xec, 0xd8, 0xd6, 0x6a, 0xb4, 0xb2, 0x41, 0xb4, 0x34,
-	0x0c, 0x15, 0x0d, 0xfd, 0xfd, 0x07, 0xfe, 0xc4, 0x95, 0x57, 0x52, 0x69, 0x20, 0x69, 0xa8, 0xd5,
-	0xd1, 0x31, 0xda, 0x95, 0xe4, 0xb5, 0x23, 0x0f, 0x31, 0xce, 0x75, 0xfb, 0xf6, 0x7d, 0xef, 0xbd,
-	0xdd, 0xf7, 0xbe, 0x6f, 0x67, 0x81, 0x46, 0x06, 0xc8, 0x44, 0xd4, 0xc3, 0x26, 0xa2, 0x8e, 0x83,
-	0x91, 0x4f, 0xa8, 0x63, 0xce, 0xda, 0xa6, 0xff, 0x83, 0xe1, 0x7a, 0xd4, 0xa7, 0x70, 0x9f, 0x0c,
-	0x90, 0x11, 0x03, 0x8c, 0x39, 0xc0, 0x98, 0xb5, 0x1b, 0x75, 0x9b, 0xda, 0x94, 0x43, 0xcc, 0x78,
-	0x25, 0xd0, 0x8d, 0xf7, 0x6d, 0x4a, 0xed, 0x31, 0x36, 0xb9, 0x35, 0x98, 0x9e, 0x9b, 0x7d, 0x27,
-	0x48, 0x5c, 0x52, 0xa5, 0x31, 0xc1, 0x8e, 0x1f, 0x57, 0x11, 0xab, 0x04, 0xf0, 0xf1, 0x8a, 0xa3,
-	0x48, 0x75, 0x39, 0x50, 0xff, 0x7d, 0x0b, 0xec, 0x3d, 0x65, 0x76, 0x37, 0xdb, 0xff, 0xc6, 0xc5,
-	0xce, 0x99, 0x43, 0x7c, 0xd8, 0x06, 0x15, 0x91, 0xb2, 0x47, 0x86, 0xaa, 0xd2, 0x52, 0x0e, 0x2b,
-	0x9d, 0x7a, 0x14, 0x6a, 0x77, 0x83, 0xfe, 0x64, 0x7c, 0xa2, 0x67, 0x2e, 0xdd, 0x2a, 0x8b, 0xf5,
-	0xd9, 0x10, 0x7e, 0x0d, 0x6a, 0x88, 0x4e, 0x1d, 0x1f, 0x7b, 0x6e, 0xdf, 0xf3, 0x03, 0x75, 0xab,
-	0xa5, 0x1c, 0x56, 0x1f, 0x7f, 0x68, 0xe4, 0x5f, 0xdb, 0xe8, 0x4a, 0xd8, 0x4e, 0xe9, 0x75, 0xa8,
-	0x15, 0xac, 0x85, 0x78, 0xf8, 0x39, 0xd8, 0x9d, 0x61, 0x8f, 0x11, 0xea, 0xa8, 0x45, 0x9e, 0x4a,
-	0x5b, 0x95, 0xea, 0x85, 0x80, 0x59, 0x29, 0x1e, 0x9e, 0x80, 0xda, 0x10, 0x8f, 0xfb, 0x41, 0xcf,
-	0xc5, 0x1e, 0xa1, 0x43, 0xb5, 0xd4, 0x52, 0x0e, 0x4b, 0x9d, 0x83, 0x28, 0xd4, 0xde, 0x13, 0x17,
-	0x90, 0xbd, 0xba, 0x55, 0xe5, 0xe6, 0x33, 0x6e, 0xc1, 0x7d, 0xb0, 0xc3, 0x88, 0xed, 0x60, 0x4f,
-	0xdd, 0x8e, 0xaf, 0x6d, 0x25, 0xd6, 0x49, 0xf9, 0xe7, 0x57, 0x5a, 0xe1, 0xef, 0x57, 0x5a, 0x41,
-	0xd7, 0xc0, 0x83, 0xdc, 0xa6, 0x59, 0x98, 0xb9, 0xd4, 0x61, 0x58, 0xff, 0x6d, 0x17, 0xd4, 0xaf,
-	0x21, 0x9e, 0x7b, 0xc1, 0xff, 0xe9, 0xea, 0x77, 0x60, 0xdf, 0xf5, 0xf0, 0x8c, 0xd0, 0x29, 0xeb,
-	0xcd, 0x6f, 0x1d, 0xc7, 0x6f, 0xf1, 0xf8, 0x87, 0x51, 0xa8, 0x3d, 0x10, 0xf1, 0xf9, 0x38, 0xdd,
-	0xaa, 0xa7, 0x8e, 0xf9, 0x81, 0xce, 0x86, 0xf0, 0x19, 0xa8, 0x25, 0x05, 0x99, 0xdf, 0xf7, 0x71,
-	0xd2, 0xe3, 0xba, 0x21, 0x78, 0x67, 0xa4, 0xbc, 0x33, 0x4e, 0x9d, 0x40, 0xee, 0x9c, 0x1c, 0xa3,
-	0x5b, 0x55, 0x61, 0x7e, 0x1b, 0x5b, 0xd7, 0x08, 0x50, 0xda, 0x90, 0x00, 0xcb, 0x53, 0xdc, 0x5e,
-	0x63, 0x8a, 0x33, 0xb0, 0x27, 0xe7, 0xea, 0x25, 0xcc, 0x60, 0xea, 0x4e, 0xab, 0x78, 0x03, 0x2a,
-	0x75, 0x5a, 0x51, 0xa8, 0xdd, 0x4f, 0x6e, 0x9c, 0x97, 0x47, 0xb7, 0xea, 0xf2, 0x7e, 0x12, 0xc6,
-	0xe0, 0x4b, 0x50, 0x73, 0x3d, 0x4a, 0xcf, 0x7b, 0x17, 0x98, 0xd8, 0x17, 0xbe, 0xba, 0xcb, 0x7b,
-	0xd0, 0x90, 0xca, 0x09, 0xa1, 0xce, 0xda, 0xc6, 0x57, 0x1c, 0xd1, 0xf9, 0x20, 0xbe, 0xf9, 0xfc,
-	0x4e, 0x72, 0xb4, 0x6e, 0x55, 0xb9, 0x29, 0x90, 0xf0, 0x09, 0x00, 0xc2, 0x4b, 0x1c, 0xe2, 0xab,
-	0xe5, 0x96, 0x72, 0x58, 0xeb, 0xec, 0x45, 0xa1, 0x76, 0x4f, 0x8e, 0x8c, 0x7d, 0xba, 0x55, 0xe1,
-	0x06, 0x57, 0xf2, 0x49, 0x7a, 0x22, 0x51, 0x59, 0xad, 0xf0, 0xb8, 0x83, 0xe5, 0x8a, 0xc2, 0x9b,
-	0x56, 0xec, 0x72, 0x0b, 0x76, 0xc1, 0x9d, 0xc4, 0x1b, 0xf3, 0xda, 0x61, 0x53, 0xa6, 0x02, 0x1e,
-	0xde, 0x88, 0x42, 0x6d, 0x7f, 0x21, 0x3c, 0x05, 0xe8, 0xd6, 0xbb, 0x22, 0x43, 0xba, 0x01, 0xcf,
-	0xc1, 0xdd, 0xcc, 0x9b, 0xb6, 0xa5, 0xfa, 0x9f, 0x6d, 0xd1, 0x92, 0xb6, 0x1c, 0xa4, 0x43, 0x58,
-	0xcc, 0xa0, 0x5b, 0x77, 0xb2, 0xad, 0xa4, 0x3d, 0x73, 0xe1, 0xd6, 0x56, 0x08, 0xb7, 0x09, 0xee,
-	0xe7, 0xc9, 0x32, 0xd3, 0xed, 0x5f, 0xdb, 0x39, 0xba, 0x3d, 0x45, 0x23, 0xf8, 0x25, 0x78, 0x67,
-	0x51, 0x7b, 0x42, 0xbb, 0x6a, 0x14, 0x6a, 0xf5, 0xec, 0x7c, 0xb2, 0xe4, 0x6a, 0x48, 0x96, 0x1a,
-	0x02, 0x8d, 0x05, 0x12, 0xe5, 0xe9, 0xf8, 0xa3, 0x28, 0xd4, 0x1e, 0xe6, 0x10, 0x6e, 0x29, 0xb1,
-	0x2a, 0x3b, 0x17, 0xf4, 0xbc, 0xc1, 0x73, 0xb9, 0xfc, 0x14, 0x94, 0x36, 0x7e, 0x0a, 0x96, 0x65,
-	0xb0, 0x7d, 0x8b, 0x32, 0x68, 0x03, 0xc1, 0xee, 0x9e, 0xef, 0x05, 0xea, 0x0e, 0xa7, 0xa3, 0xf4,
-	0x88, 0x66, 0x2e, 0xdd, 0x2a, 0xf3, 0x75, 0xfc, 0xee, 0x2e, 0x6b, 0x60, 0x77, 0x33, 0x0d, 0x94,
-	0x6f, 0x45, 0x03, 0x95, 0xb7, 0xaa, 0x01, 0xb0, 0x86, 0x06, 0x4e, 0xd1, 0x28, 0xd3, 0xc0, 0x2f,
-	0x5b, 0x40, 0xbd, 0x06, 0xe8, 0x52, 0xe7, 0x9c, 0x78, 0x93, 0x4d, 0x75, 0x90, 0x4d, 0xae, 0x8f,
-	0x46, 0x9c, 0xf6, 0x39, 0x93, 0xeb, 0xa3, 0x51, 0x3a, 0xb9, 0x58, 0x79, 0xcb, 0x44, 0x2a, 0xde,
-	0x22, 0x91, 0xe6, 0xcd, 0x2a, 0xad, 0x68, 0x96, 0x0e, 0x5a, 0xab, 0x7a, 0x91, 0x36, 0xec, 0xf1,
-	0x3f, 0x45, 0x50, 0x7c, 0xca, 0x6c, 0xf8, 0x23, 0x80, 0x39, 0xff, 0xa8, 0xa3, 0x55, 0x22, 0xcc,
-	0xfd, 0x41, 0x34, 0x3e, 0x5d, 0x0b, 0x9e, 0x9e, 0x01, 0x7e, 0x0f, 0xee, 0x5d, 0xff, 0x6c, 0x7c,
-	0x72, 0xe3, 0x5c, 0xcf, 0xbd, 0xa0, 0xf1, 0x64, 0x1d, 0xf4, 0xea, 0xc2, 0xf1, 0xcc, 0x6e, 0x5e,
-	0xf8, 0x14, 0x8d, 0xd6, 0x28, 0x2c, 0xd1, 0x14, 0xfe, 0xa4, 0x80, 0xbd, 0x7c, 0x8e, 0x3e, 0xba,
-	0x71, 0xbe, 0x24, 0xa2, 0xf1, 0xd9, 0xba, 0x11, 0xe9, 0x29, 0x3a, 0x2f, 0x5e, 0x5f, 0x36, 0x95,
-	0x37, 0x97, 0x4d, 0xe5, 0xcf, 0xcb, 0xa6, 0xf2, 0xeb, 0x55, 0xb3, 0xf0, 0xe6, 0xaa, 0x59, 0xf8,
-	0xe3, 0xaa, 0x59, 0x78, 0xf9, 0x85, 0x4d, 0xfc, 0x8b, 0xe9, 0xc0, 0x40, 0x74, 0x62, 0x22, 0xca,
-	0x26, 0x94, 0x99, 0x64, 0x80, 0x8e, 0x6c, 0x6a, 0xce, 0x8e, 0xcd, 0x09, 0x1d, 0x4e, 0xc7, 0x98,
-	0x89, 0x2f, 0xfa, 0xa3, 0xe3, 0x23, 0xe9, 0x97, 0xee, 0x07, 0x2e, 0x66, 0x83, 0x1d, 0xfe, 0xe4,
-	0x1e, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xef, 0xf1, 0x60, 0x63, 0x54, 0x0c, 0x00, 0x00,
+	// 1019 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x31, 0x8f, 0xe3, 0x44,
+	0x14, 0x8e, 0x93, 0xec, 0x6e, 0x32, 0x09, 0xdc, 0x9d, 0xc9, 0x26, 0xc6, 0xdc, 0xc5, 0x59, 0x8b,
+	0x83, 0x2d, 0x58, 0xfb, 0x72, 0x7b, 0x08, 0x58, 0x41, 0xb1, 0x89, 0x90, 0xd8, 0xe2, 0xe0, 0x64,
+	0x4e, 0x87, 0x74, 0x4d, 0x94, 0x38, 0xb3, 0x5e, 0x2b, 0xc9, 0x4c, 0xe4, 0x71, 0x02, 0xa6, 0x42,
+	0x42, 0x42, 0x88, 0x8a, 0x86, 0x8a, 0xe6, 0xfe, 0x03, 0x7f, 0xe2, 0xca, 0x2b, 0xa9, 0x2c, 0xd8,
+	0x6d, 0xa8, 0x53, 0x53, 0x20, 0xcf, 0xd8, 0xce, 0x24, 0xb1, 0x21, 0x21, 0xbb, 0xdd, 0xbc, 0x79,
+	0xdf, 0x7b, 0x6f, 0xe6, 0xbd, 0xf7, 0x3d, 0x8f, 0x81, 0x62, 0xf7, 0x4c, 0xdd, 0xc4, 0x0e, 0xd4,
+	0x4d, 0x8c, 0x10, 0x34, 0x5d, 0x1b, 0x23, 0x7d, 0xda, 0xd4, 0xdd, 0x6f, 0xb4, 0xb1, 0x83, 0x5d,
+	0x2c, 0x56, 0xed, 0x9e, 0xa9, 0x05, 0x00, 0x6d, 0x0e, 0xd0, 0xa6, 0x4d, 0xb9, 0x62, 0x61, 0x0b,
+	0x53, 0x88, 0x1e, 0xac, 0x18, 0x5a, 0x7e, 0xd3, 0xc2, 0xd8, 0x1a, 0x42, 0x9d, 0x4a, 0xbd, 0xc9,
+	0xb9, 0xde, 0x45, 0x5e, 0xa8, 0xe2, 0x22, 0x0d, 0x6d, 0x88, 0xdc, 0x20, 0x0a, 0x5b, 0x85, 0x80,
+	0x77, 0x53, 0x8e, 0xc2, 0xc5, 0xa5, 0x40, 0xf5, 0xb7, 0x2c, 0xd8, 0x7f, 0x4c, 0xac, 0x76, 0xbc,
+	0xff, 0xc5, 0x18, 0xa2, 0x33, 0x64, 0xbb, 0x62, 0x13, 0x14, 0x99, 0xcb, 0x8e, 0xdd, 0x97, 0x84,
+	0x86, 0x70, 0x58, 0x6c, 0x55, 0x66, 0xbe, 0x72, 0xdb, 0xeb, 0x8e, 0x86, 0x27, 0x6a, 0xac, 0x52,
+	0x8d, 0x02, 0x5b, 0x9f, 0xf5, 0xc5, 0xcf, 0x41, 0xd9, 0xc4, 0x13, 0xe4, 0x42, 0x67, 0xdc, 0x75,
+	0x5c, 0x4f, 0xca, 0x36, 0x84, 0xc3, 0xd2, 0xc3, 0xb7, 0xb5, 0xe4, 0x6b, 0x6b, 0x6d, 0x0e, 0xdb,
+	0xca, 0xbf, 0xf4, 0x95, 0x8c, 0xb1, 0x60, 0x2f, 0x7e, 0x04, 0xf6, 0xa6, 0xd0, 0x21, 0x36, 0x46,
+	0x52, 0x8e, 0xba, 0x52, 0xd2, 0x5c, 0x3d, 0x63, 0x30, 0x23, 0xc2, 0x8b, 0x27, 0xa0, 0xdc, 0x87,
+	0xc3, 0xae, 0xd7, 0x19, 0x43, 0xc7, 0xc6, 0x7d, 0x29, 0xdf, 0x10, 0x0e, 0xf3, 0xad, 0xda, 0xcc,
+	0x57, 0xde, 0x60, 0x17, 0xe0, 0xb5, 0xaa, 0x51, 0xa2, 0xe2, 0x13, 0x2a, 0x89, 0x55, 0xb0, 0x4b,
+	0x6c, 0x0b, 0x41, 0x47, 0xda, 0x09, 0xae, 0x6d, 0x84, 0xd2, 0x49, 0xe1, 0xc7, 0x17, 0x4a, 0xe6,
+	0xaf, 0x17, 0x4a, 0x46, 0x55, 0xc0, 0xbd, 0xc4, 0xa4, 0x19, 0x90, 0x8c, 0x31, 0x22, 0x50, 0xfd,
+	0x65, 0x0f, 0x54, 0x56, 0x10, 0x4f, 0x1d, 0xef, 0xff, 0x64, 0xf5, 0x2b, 0x50, 0x1d, 0x3b, 0x70,
+	0x6a, 0xe3, 0x09, 0xe9, 0xcc, 0x6f, 0x1d, 0xd8, 0x67, 0xa9, 0xfd, 0xc1, 0xcc, 0x57, 0xee, 0x31,
+	0xfb, 0x64, 0x9c, 0x6a, 0x54, 0x22, 0xc5, 0xfc, 0x40, 0x67, 0x7d, 0xf1, 0x09, 0x28, 0x87, 0x01,
+	0x89, 0xdb, 0x75, 0x61, 0x98, 0xe3, 0x8a, 0xc6, 0xfa, 0x4e, 0x8b, 0xfa, 0x4e, 0x3b, 0x45, 0x1e,
+	0x9f, 0x39, 0xde, 0x46, 0x35, 0x4a, 0x4c, 0xfc, 0x32, 0x90, 0x56, 0x1a, 0x20, 0xbf, 0x65, 0x03,
+	0x2c, 0x57, 0x71, 0x67, 0x83, 0x2a, 0x4e, 0xc1, 0x3e, 0xef, 0xab, 0x13, 0x76, 0x06, 0x91, 0x76,
+	0x1b, 0xb9, 0x35, 0x5a, 0xa9, 0xd5, 0x98, 0xf9, 0xca, 0xdd, 0xf0, 0xc6, 0x49, 0x7e, 0x54, 0xa3,
+	0xc2, 0xef, 0x87, 0x66, 0x44, 0x7c, 0x0e, 0xca, 0x63, 0x07, 0xe3, 0xf3, 0xce, 0x05, 0xb4, 0xad,
+	0x0b, 0x57, 0xda, 0xa3, 0x39, 0x90, 0xb9, 0x70, 0x8c, 0xa8, 0xd3, 0xa6, 0xf6, 0x19, 0x45, 0xb4,
+	0xde, 0x0a, 0x6e, 0x3e, 0xbf, 0x13, 0x6f, 0xad, 0x1a, 0x25, 0x2a, 0x32, 0xa4, 0xf8, 0x08, 0x00,
+	0xa6, 0xb5, 0x91, 0xed, 0x4a, 0x85, 0x86, 0x70, 0x58, 0x6e, 0xed, 0xcf, 0x7c, 0xe5, 0x0e, 0x6f,
+	0x19, 0xe8, 0x54, 0xa3, 0x48, 0x05, 0xca, 0xe4, 0x93, 0xe8, 0x44, 0x2c, 0xb2, 0x54, 0xa4, 0x76,
+	0xb5, 0xe5, 0x88, 0x4c, 0x1b, 0x45, 0x6c, 0x53, 0x49, 0x6c, 0x83, 0x5b, 0xa1, 0x36, 0xe8, 0x6b,
+	0x44, 0x26, 0x44, 0x02, 0xd4, 0x5c, 0x9e, 0xf9, 0x4a, 0x75, 0xc1, 0x3c, 0x02, 0xa8, 0xc6, 0xeb,
+	0xcc, 0x43, 0xb4, 0x21, 0x9e, 0x83, 0xdb, 0xb1, 0x36, 0x4a, 0x4b, 0xe9, 0x3f, 0xd3, 0xa2, 0x84,
+	0x69, 0xa9, 0x45, 0x45, 0x58, 0xf4, 0xa0, 0x1a, 0xb7, 0xe2, 0xad, 0x30, 0x3d, 0x73, 0xe2, 0x96,
+	0x53, 0x88, 0x5b, 0x07, 0x77, 0x93, 0x68, 0x19, 0xf3, 0xf6, 0xcf, 0x9d, 0x04, 0xde, 0x9e, 0x9a,
+	0x03, 0xf1, 0x13, 0xf0, 0xda, 0x22, 0xf7, 0x18, 0x77, 0xa5, 0x99, 0xaf, 0x54, 0xe2, 0xf3, 0xf1,
+	0x94, 0x2b, 0x9b, 0x3c, 0xd5, 0x4c, 0x20, 0x2f, 0x34, 0x51, 0x12, 0x8f, 0xef, 0xcf, 0x7c, 0xe5,
+	0x20, 0xa1, 0xe1, 0x96, 0x1c, 0x4b, 0xbc, 0x72, 0x81, 0xcf, 0x5b, 0x8c, 0xcb, 0xe5, 0x51, 0x90,
+	0xdf, 0x7a, 0x14, 0x2c, 0xd3, 0x60, 0xe7, 0x1a, 0x69, 0xd0, 0x04, 0xac, 0xbb, 0x3b, 0xae, 0xe3,
+	0x49, 0xbb, 0xb4, 0x1d, 0xb9, 0x21, 0x1a, 0xab, 0x54, 0xa3, 0x40, 0xd7, 0xc1, 0xdc, 0x5d, 0xe6,
+	0xc0, 0xde, 0x76, 0x1c, 0x28, 0x5c, 0x0b, 0x07, 0x8a, 0x37, 0xca, 0x01, 0xb0, 0x01, 0x07, 0x4e,
+	0xcd, 0x41, 0xcc, 0x81, 0x9f, 0xb2, 0x40, 0x5a, 0x01, 0xb4, 0x31, 0x3a, 0xb7, 0x9d, 0xd1, 0xb6,
+	0x3c, 0x88, 0x2b, 0xd7, 0x35, 0x07, 0x52, 0x36, 0xb9, 0x72, 0x5d, 0x73, 0x10, 0x55, 0x2e, 0x60,
+	0xde, 0x72, 0x23, 0xe5, 0xae, 0xb1, 0x91, 0xe6, 0xc9, 0xca, 0xa7, 0x24, 0x4b, 0x05, 0x8d, 0xb4,
+	0x5c, 0xc4, 0x09, 0xfb, 0x35, 0x07, 0x6a, 0xab, 0xa0, 0x2e, 0x32, 0xe1, 0x70, 0xdb, 0x7c, 0xfd,
+	0x20, 0x80, 0x5a, 0xca, 0x30, 0x08, 0x5f, 0x57, 0xf7, 0xd3, 0x3f, 0xae, 0x91, 0xf4, 0x29, 0xea,
+	0xb7, 0xde, 0x09, 0x73, 0x52, 0xff, 0xd7, 0x01, 0xa3, 0x1a, 0xd5, 0xe4, 0xe9, 0xc2, 0xf3, 0x07,
+	0x13, 0xd8, 0x97, 0x72, 0x69, 0xfc, 0x09, 0xb4, 0x73, 0xfe, 0x04, 0xd2, 0x4a, 0x05, 0xf3, 0x37,
+	0x52, 0xc1, 0xb4, 0xb7, 0xda, 0x01, 0x50, 0x52, 0x8a, 0x13, 0x15, 0xf0, 0xe1, 0xdf, 0x79, 0x90,
+	0x7b, 0x4c, 0x2c, 0xf1, 0x5b, 0x20, 0x26, 0x3c, 0x84, 0x8f, 0xd2, 0x32, 0x9c, 0xf8, 0x04, 0x94,
+	0xdf, 0xdf, 0x08, 0x1e, 0x9d, 0x41, 0xfc, 0x1a, 0xdc, 0x59, 0x7d, 0x2d, 0xbe, 0xb7, 0xb6, 0xaf,
+	0xa7, 0x8e, 0x27, 0x3f, 0xda, 0x04, 0x9d, 0x1e, 0x38, 0x20, 0xdd, 0xfa, 0x81, 0x4f, 0xcd, 0xc1,
+	0x06, 0x81, 0xb9, 0x39, 0x23, 0x7e, 0x2f, 0x80, 0xfd, 0xe4, 0x21, 0xf3, 0x60, 0x6d, 0x7f, 0xa1,
+	0x85, 0xfc, 0xe1, 0xa6, 0x16, 0xf1, 0x29, 0xbe, 0x13, 0x40, 0x25, 0x91, 0xb9, 0xfa, 0xfa, 0x2e,
+	0xa9, 0x81, 0xfc, 0xc1, 0x86, 0x06, 0xd1, 0x11, 0x5a, 0xcf, 0x5e, 0x5e, 0xd6, 0x85, 0x57, 0x97,
+	0x75, 0xe1, 0x8f, 0xcb, 0xba, 0xf0, 0xf3, 0x55, 0x3d, 0xf3, 0xea, 0xaa, 0x9e, 0xf9, 0xfd, 0xaa,
+	0x9e, 0x79, 0xfe, 0xb1, 0x65, 0xbb, 0x17, 0x93, 0x9e, 0x66, 0xe2, 0x91, 0x6e, 0x62, 0x32, 0xc2,
+	0x44, 0xb7, 0x7b, 0xe6, 0x91, 0x85, 0xf5, 0xe9, 0xb1, 0x3e, 0xc2, 0xfd, 0xc9, 0x10, 0x12, 0xf6,
+	0x9b, 0xf7, 0xe0, 0xf8, 0x88, 0xfb, 0xd3, 0x73, 0xbd, 0x31, 0x24, 0xbd, 0x5d, 0xfa, 0xd9, 0x3e,
+	0xfe, 0x67, 0x00, 0xa1, 0x0b, 0xcf, 0xfd, 0x98, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -470,6 +563,9 @@ type MsgClient interface {
 	// ConnectionOpenConfirm defines a rpc handler method for
 	// MsgConnectionOpenConfirm.
 	ConnectionOpenConfirm(ctx context.Context, in *MsgConnectionOpenConfirm, opts ...grpc.CallOption) (*MsgConnectionOpenConfirmResponse, error)
+	// ConnectionOpenCancel defines a rpc handler method for
+	// MsgConnectionOpenCancel.
+	ConnectionOpenCancel(ctx context.Context, in *MsgConnectionOpenCancel, opts ...grpc.CallOption) (*MsgConnectionOpenCancelResponse, error)
 }
 
 type msgClient struct {
@@ -516,6 +612,15 @@ func (c *msgClient) ConnectionOpenConfirm(ctx context.Context, in *MsgConnection
 	return out, nil
 }
 
+func (c *msgClient) ConnectionOpenCancel(ctx context.Context, in *MsgConnectionOpenCancel, opts ...grpc.CallOption) (*MsgConnectionOpenCancelResponse, error) {
+	out := new(MsgConnectionOpenCancelResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Msg/ConnectionOpenCancel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// ConnectionOpenInit defines a rpc handler method for MsgConnectionOpenInit.
@@ -527,6 +632,9 @@ type MsgServer interface {
 	// ConnectionOpenConfirm defines a rpc handler method for
 	// MsgConnectionOpenConfirm.
 	ConnectionOpenConfirm(context.Context, *MsgConnectionOpenConfirm) (*MsgConnectionOpenConfirmResponse, error)
+	// ConnectionOpenCancel defines a rpc handler method for
+	// MsgConnectionOpenCancel.
+	ConnectionOpenCancel(context.Context, *MsgConnectionOpenCancel) (*MsgConnectionOpenCancelResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -545,6 +653,9 @@ func (*UnimplementedMsgServer) ConnectionOpenAck(ctx context.Context, req *MsgCo
 func (*UnimplementedMsgServer) ConnectionOpenConfirm(ctx context.Context, req *MsgConnectionOpenConfirm) (*MsgConnectionOpenConfirmResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConnectionOpenConfirm not implemented")
 }
+func (*UnimplementedMsgServer) ConnectionOpenCancel(ctx context.Context, req *MsgConnectionOpenCancel) (*MsgConnectionOpenCancelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionOpenCancel not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -622,6 +733,24 @@ func _Msg_ConnectionOpenConfirm_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ConnectionOpenCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConnectionOpenCancel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConnectionOpenCancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.connection.v1.Msg/ConnectionOpenCancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConnectionOpenCancel(ctx, req.(*MsgConnectionOpenCancel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.core.connection.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -642,6 +771,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ConnectionOpenConfirm",
 			Handler:    _Msg_ConnectionOpenConfirm_Handler,
 		},
+		{
+			MethodName: "ConnectionOpenCancel",
+			Handler:    _Msg_ConnectionOpenCancel_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/core/connection/v1/tx.proto",
@@ -1092,6 +1225,93 @@ func (m *MsgConnectionOpenConfirmResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgConnectionOpenCancel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConnectionOpenCancel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConnectionOpenCancel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ProofClosed) > 0 {
+		i -= len(m.ProofClosed)
+		copy(dAtA[i:], m.ProofClosed)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofClosed)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.CounterpartyConnection.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConnectionOpenCancelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConnectionOpenCancelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConnectionOpenCancelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1285,6 +1505,40 @@ func (m *MsgConnectionOpenConfirmResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgConnectionOpenCancel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CounterpartyConnection.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ProofClosed)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConnectionOpenCancelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2696,6 +2950,270 @@ func (m *MsgConnectionOpenConfirmResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgConnectionOpenCancel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConnectionOpenCancel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConnectionOpenCancel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyConnection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CounterpartyConnection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofClosed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofClosed = append(m.ProofClosed[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofClosed == nil {
+				m.ProofClosed = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConnectionOpenCancelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConnectionOpenCancelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConnectionOpenCancelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
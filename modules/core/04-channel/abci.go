@@ -0,0 +1,15 @@
+package channel
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/keeper"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+)
+
+// EndBlocker writes an error acknowledgement for the received packets whose
+// asynchronous acknowledgement limits have elapsed, through the application
+// stacks of the router.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper, router *porttypes.Router) {
+	k.ExpirePendingAsyncAcks(ctx, router)
+}
@@ -29,6 +29,11 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryUnreceivedPackets(),
 		GetCmdQueryUnreceivedAcks(),
 		GetCmdQueryNextSequenceReceive(),
+		GetCmdQueryPacketDelay(),
+		GetCmdQueryPacket(),
+		GetCmdQueryPendingPackets(),
+		GetCmdQueryPruningSequences(),
+		GetCmdQueryPendingAsyncAcks(),
 		// TODO: next sequence Send ?
 	)
 
@@ -455,3 +455,202 @@ func GetCmdQueryNextSequenceReceive() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryPacketDelay defines the command to query the additional packet
+// delay declared by a channel
+func GetCmdQueryPacketDelay() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet-delay [port-id] [channel-id]",
+		Short: "Query the additional packet delay of a channel",
+		Long:  "Query the additional time and block delay applied to the packet proofs of a given channel",
+		Example: fmt.Sprintf(
+			"%s query %s %s packet-delay [port-id] [channel-id]", version.AppName, host.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPacketDelayRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			res, err := queryClient.PacketDelay(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPacket defines the command to query the data of a packet sent on a
+// channel which has not yet been acknowledged or timed out
+func GetCmdQueryPacket() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet [port-id] [channel-id] [sequence]",
+		Short: "Query the data of a pending packet",
+		Long:  "Query the data of a packet sent on a channel which has not yet been acknowledged or timed out. Packet data is only stored if enabled by the channel params.",
+		Example: fmt.Sprintf(
+			"%s query %s %s packet [port-id] [channel-id] [sequence]", version.AppName, host.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			seq, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPacketRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+				Sequence:  seq,
+			}
+
+			res, err := queryClient.Packet(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPendingPackets defines the command to query the data of all packets
+// sent on a channel which have not yet been acknowledged or timed out
+func GetCmdQueryPendingPackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-packets [port-id] [channel-id]",
+		Short:   "Query all pending packets of a channel",
+		Long:    "Query the data of all packets sent on a channel which have not yet been acknowledged or timed out. Packet data is only stored if enabled by the channel params.",
+		Example: fmt.Sprintf("%s query %s %s pending-packets [port-id] [channel-id]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPendingPacketsRequest{
+				PortId:     args[0],
+				ChannelId:  args[1],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PendingPackets(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending packets of a channel")
+
+	return cmd
+}
+
+// GetCmdQueryPruningSequences defines the command to query the pruning sequences
+// of an UNORDERED channel
+func GetCmdQueryPruningSequences() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pruning-sequences [port-id] [channel-id]",
+		Short: "Query the pruning sequences of an UNORDERED channel",
+		Long:  "Query the pruning sequence start and end of an UNORDERED channel. Packets below the pruning sequence end are considered received, and their receipts and acknowledgements are pruned up to the pruning sequence start.",
+		Example: fmt.Sprintf(
+			"%s query %s %s pruning-sequences [port-id] [channel-id]", version.AppName, host.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPruningSequencesRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			res, err := queryClient.PruningSequences(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPendingAsyncAcks defines the command to query the received packets
+// of a channel pending an asynchronous acknowledgement
+func GetCmdQueryPendingAsyncAcks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-async-acks [port-id] [channel-id]",
+		Short:   "Query all packets of a channel pending an asynchronous acknowledgement",
+		Long:    "Query all packets received on a channel whose application has not yet written an asynchronous acknowledgement.",
+		Example: fmt.Sprintf("%s query %s %s pending-async-acks [port-id] [channel-id]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPendingAsyncAcksRequest{
+				PortId:     args[0],
+				ChannelId:  args[1],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PendingAsyncAcks(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending asynchronous acknowledgements of a channel")
+
+	return cmd
+}
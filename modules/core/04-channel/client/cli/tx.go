@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// NewCmdSubmitChannelHaltProposal implements a command handler for submitting a halt IBC channel proposal transaction.
+func NewCmdSubmitChannelHaltProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "halt-channel [port-id] [channel-id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a halt IBC channel proposal",
+		Long: "Submit a halt IBC channel proposal along with an initial deposit.\n" +
+			"Please specify the port and channel identifiers of the channel you want to halt.\n" +
+			"Packets can no longer be sent or received on a halted channel until it is resumed.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitChannelProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewChannelHaltProposal(title, description, args[0], args[1])
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitChannelResumeProposal implements a command handler for submitting a resume IBC channel proposal transaction.
+func NewCmdSubmitChannelResumeProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume-channel [port-id] [channel-id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a resume IBC channel proposal",
+		Long: "Submit a resume IBC channel proposal along with an initial deposit.\n" +
+			"Please specify the port and channel identifiers of the halted channel you want to resume.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitChannelProposal(cmd, func(title, description string) govtypes.Content {
+				return types.NewChannelResumeProposal(title, description, args[0], args[1])
+			})
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+func submitChannelProposal(cmd *cobra.Command, newContent func(title, description string) govtypes.Content) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return err
+	}
+
+	content := newContent(title, description)
+
+	from := clientCtx.GetFromAddress()
+
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+	if err != nil {
+		return err
+	}
+
+	if err = msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+}
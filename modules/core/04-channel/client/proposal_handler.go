@@ -0,0 +1,28 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/client/cli"
+)
+
+var (
+	// ChannelHaltProposalHandler is the halt channel proposal handler.
+	ChannelHaltProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitChannelHaltProposal, emptyRestHandler)
+	// ChannelResumeProposalHandler is the resume channel proposal handler.
+	ChannelResumeProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitChannelResumeProposal, emptyRestHandler)
+)
+
+func emptyRestHandler(client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "unsupported-ibc-channel",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Legacy REST Routes are not supported for IBC proposals")
+		},
+	}
+}
@@ -3,6 +3,7 @@ package utils
 import (
 	"context"
 	"encoding/binary"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -55,7 +56,22 @@ func queryChannelABCI(clientCtx client.Context, portID, channelID string) (*type
 		return nil, err
 	}
 
-	return types.NewQueryChannelResponse(channel, proofBz, proofHeight), nil
+	res := types.NewQueryChannelResponse(channel, proofBz, proofHeight)
+
+	// the halt flag is stored outside of the channel end, it is queried at the height of the
+	// channel proof such that both reflect the same state
+	halted, _, haltedHeight, err := ibcclient.QueryTendermintProof(
+		clientCtx.WithHeight(int64(proofHeight.RevisionHeight)), host.ChannelHaltedKey(portID, channelID),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !haltedHeight.EQ(proofHeight) {
+		return nil, fmt.Errorf("channel halt flag queried at height %s, expected channel proof height %s", haltedHeight, proofHeight)
+	}
+	res.Halted = len(halted) != 0
+
+	return res, nil
 }
 
 // QueryChannelClientState returns the ClientState of a channel end. If
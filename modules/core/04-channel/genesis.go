@@ -32,19 +32,45 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 	for _, as := range gs.AckSequences {
 		k.SetNextSequenceAck(ctx, as.PortId, as.ChannelId, as.Sequence)
 	}
+	for _, pd := range gs.PacketDelays {
+		k.SetPacketDelay(ctx, pd.PortId, pd.ChannelId, pd.PacketDelay)
+	}
+	for _, packet := range gs.Packets {
+		k.SetPacketData(ctx, packet)
+	}
+	for _, ps := range gs.PruningSequenceStarts {
+		k.SetPruningSequenceStart(ctx, ps.PortId, ps.ChannelId, ps.Sequence)
+	}
+	for _, ps := range gs.PruningSequenceEnds {
+		k.SetPruningSequenceEnd(ctx, ps.PortId, ps.ChannelId, ps.Sequence)
+	}
+	for _, hc := range gs.HaltedChannels {
+		k.SetChannelHalted(ctx, hc.PortId, hc.ChannelId, true)
+	}
+	for _, paa := range gs.PendingAsyncAcks {
+		k.SetPendingAsyncAck(ctx, paa)
+	}
 	k.SetNextChannelSequence(ctx, gs.NextChannelSequence)
+	k.SetParams(ctx, gs.Params)
 }
 
 // ExportGenesis returns the ibc channel submodule's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	return types.GenesisState{
-		Channels:            k.GetAllChannels(ctx),
-		Acknowledgements:    k.GetAllPacketAcks(ctx),
-		Commitments:         k.GetAllPacketCommitments(ctx),
-		Receipts:            k.GetAllPacketReceipts(ctx),
-		SendSequences:       k.GetAllPacketSendSeqs(ctx),
-		RecvSequences:       k.GetAllPacketRecvSeqs(ctx),
-		AckSequences:        k.GetAllPacketAckSeqs(ctx),
-		NextChannelSequence: k.GetNextChannelSequence(ctx),
+		Channels:              k.GetAllChannels(ctx),
+		Acknowledgements:      k.GetAllPacketAcks(ctx),
+		Commitments:           k.GetAllPacketCommitments(ctx),
+		Receipts:              k.GetAllPacketReceipts(ctx),
+		SendSequences:         k.GetAllPacketSendSeqs(ctx),
+		RecvSequences:         k.GetAllPacketRecvSeqs(ctx),
+		AckSequences:          k.GetAllPacketAckSeqs(ctx),
+		NextChannelSequence:   k.GetNextChannelSequence(ctx),
+		PacketDelays:          k.GetAllPacketDelays(ctx),
+		Params:                k.GetParams(ctx),
+		Packets:               k.GetAllPacketData(ctx),
+		PruningSequenceStarts: k.GetAllPruningSequenceStarts(ctx),
+		PruningSequenceEnds:   k.GetAllPruningSequenceEnds(ctx),
+		HaltedChannels:        k.GetAllHaltedChannels(ctx),
+		PendingAsyncAcks:      k.GetAllPendingAsyncAcks(ctx),
 	}
 }
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// ExpirePendingAsyncAcks writes an error acknowledgement for the received
+// packets whose application has not written its asynchronous acknowledgement
+// within the block or time limit set by the channel params. At most
+// MaxAsyncAckExpirationsPerBlock acknowledgements are expired per call, oldest
+// first, the remaining ones are expired in the following blocks. It is a no-op
+// if both limits are disabled.
+//
+// The error acknowledgement is written through the ICS4Wrapper of the
+// application stack routed for the destination port, such that middlewares
+// observe it as any asynchronous acknowledgement. The channel keeper is used if
+// the routed module neither provides an ICS4Wrapper nor is a middleware.
+func (k Keeper) ExpirePendingAsyncAcks(ctx sdk.Context, router *porttypes.Router) {
+	for _, pendingAsyncAck := range k.GetExpiredPendingAsyncAcks(ctx, types.MaxAsyncAckExpirationsPerBlock) {
+		k.expirePendingAsyncAck(ctx, router, pendingAsyncAck.Packet)
+	}
+}
+
+// GetExpiredPendingAsyncAcks returns at most limit received packets whose
+// asynchronous acknowledgement limits have elapsed, ordered by receive height
+// and then by receive time. Only the expired packets are iterated, using the
+// receive height and time indexes of the pending acknowledgements.
+func (k Keeper) GetExpiredPendingAsyncAcks(ctx sdk.Context, limit int) []types.PendingAsyncAck {
+	timeoutBlocks := k.GetAsyncAckTimeoutBlocks(ctx)
+	timeoutPeriod := k.GetAsyncAckTimeoutPeriod(ctx)
+
+	var (
+		expired []types.PendingAsyncAck
+		seen    = make(map[string]bool)
+	)
+
+	collect := func(index string, end []byte) {
+		store := ctx.KVStore(k.storeKey)
+		iterator := store.Iterator([]byte(index+"/"), end)
+		defer iterator.Close()
+
+		for ; iterator.Valid() && len(expired) < limit; iterator.Next() {
+			key := iterator.Value()
+			if seen[string(key)] {
+				continue
+			}
+			seen[string(key)] = true
+
+			var pendingAsyncAck types.PendingAsyncAck
+			k.cdc.MustUnmarshal(store.Get(key), &pendingAsyncAck)
+			expired = append(expired, pendingAsyncAck)
+		}
+	}
+
+	// packets received at or before the cutoff height or time have expired
+	height := uint64(ctx.BlockHeight())
+	if timeoutBlocks != 0 && height >= timeoutBlocks {
+		collect(host.KeyPendingAsyncAckRecvHeightIndex, host.PendingAsyncAckRecvHeightIndexPrefix(height-timeoutBlocks+1))
+	}
+
+	timestamp := uint64(ctx.BlockTime().UnixNano())
+	if timeoutPeriod != 0 && timestamp >= timeoutPeriod {
+		collect(host.KeyPendingAsyncAckRecvTimeIndex, host.PendingAsyncAckRecvTimeIndexPrefix(timestamp-timeoutPeriod+1))
+	}
+
+	return expired
+}
+
+// expirePendingAsyncAck writes an error acknowledgement for a packet pending an
+// asynchronous acknowledgement. If the acknowledgement cannot be written, for
+// instance because the channel is no longer OPEN, the record of the pending
+// acknowledgement is removed without writing any state of the application stack.
+func (k Keeper) expirePendingAsyncAck(ctx sdk.Context, router *porttypes.Router, packet types.Packet) {
+	cacheCtx, writeFn := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+	if err := k.writeExpiredAsyncAck(cacheCtx, router, packet); err != nil {
+		k.deletePendingAsyncAck(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+
+		k.Logger(ctx).Error(
+			"failed to write expired asynchronous acknowledgement",
+			"sequence", strconv.FormatUint(packet.GetSequence(), 10),
+			"dst_port", packet.GetDestPort(),
+			"dst_channel", packet.GetDestChannel(),
+			"error", err.Error(),
+		)
+		return
+	}
+
+	writeFn()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	k.Logger(ctx).Info(
+		"asynchronous acknowledgement expired",
+		"sequence", strconv.FormatUint(packet.GetSequence(), 10),
+		"dst_port", packet.GetDestPort(),
+		"dst_channel", packet.GetDestChannel(),
+	)
+
+	telemetry.IncrCounter(1, "ibc", "channel", "async-ack-expired")
+}
+
+// writeExpiredAsyncAck writes the error acknowledgement of an expired packet
+// through the application stack routed for the destination port.
+func (k Keeper) writeExpiredAsyncAck(ctx sdk.Context, router *porttypes.Router, packet types.Packet) error {
+	module, chanCap, err := k.LookupModuleByChannel(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if err != nil {
+		return err
+	}
+
+	ack := types.NewErrorAcknowledgement(types.ErrAsyncAckExpired.Error())
+
+	var ics4Wrapper porttypes.ICS4Wrapper = k
+	if router != nil {
+		cbs, ok := router.GetRoute(module)
+		if !ok {
+			return sdkerrors.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+		}
+
+		switch cbs := cbs.(type) {
+		case porttypes.ICS4WrapperProvider:
+			ics4Wrapper = cbs.ICS4Wrapper()
+		case porttypes.ICS4Wrapper:
+			ics4Wrapper = cbs
+		}
+	}
+
+	return ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
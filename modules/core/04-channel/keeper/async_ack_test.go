@@ -0,0 +1,199 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	callbackstypes "github.com/cosmos/ibc-go/v3/modules/apps/callbacks/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	ibcmock "github.com/cosmos/ibc-go/v3/testing/mock"
+)
+
+// TestExpirePendingAsyncAcks tests that an error acknowledgement is written for
+// the packets pending an asynchronous acknowledgement once the limits set by the
+// channel params have elapsed.
+func (suite *KeeperTestSuite) TestExpirePendingAsyncAcks() {
+	var (
+		path       *ibctesting.Path
+		expAck     bool
+		expPending bool
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+	}{
+		{"success: block limit elapsed", func() {
+			suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, 5, 0))
+			suite.coordinator.CommitNBlocks(suite.chainB, 5)
+		}},
+		{"success: time limit elapsed", func() {
+			suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, 0, uint64(time.Hour.Nanoseconds())))
+			suite.coordinator.IncrementTimeBy(time.Hour)
+			suite.coordinator.CommitBlock(suite.chainB)
+		}},
+		{"limits not elapsed", func() {
+			suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, 100, uint64(time.Hour.Nanoseconds())))
+			suite.coordinator.CommitNBlocks(suite.chainB, 5)
+
+			expAck = false
+			expPending = true
+		}},
+		{"limits disabled", func() {
+			suite.coordinator.CommitNBlocks(suite.chainB, 5)
+
+			expAck = false
+			expPending = true
+		}},
+		{"channel closed", func() {
+			err := path.EndpointB.SetChannelClosed()
+			suite.Require().NoError(err)
+
+			suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, 5, 0))
+			suite.coordinator.CommitNBlocks(suite.chainB, 5)
+
+			expAck = false
+		}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			packet := types.NewPacket(ibcmock.MockAsyncPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+			err = path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
+
+			expAck = true
+			expPending = false
+
+			tc.malleate()
+
+			channelKeeper := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+			channelKeeper.ExpirePendingAsyncAcks(suite.chainB.GetContext(), suite.chainB.App.GetIBCKeeper().Router)
+
+			_, pending := channelKeeper.GetPendingAsyncAck(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+			suite.Require().Equal(expPending, pending)
+
+			ack, found := channelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+			suite.Require().Equal(expAck, found)
+
+			if expAck {
+				expErrorAck := types.NewErrorAcknowledgement(types.ErrAsyncAckExpired.Error())
+				suite.Require().Equal(types.CommitAcknowledgement(expErrorAck.Acknowledgement()), ack)
+
+				// the application can no longer write its acknowledgement
+				err = path.EndpointB.WriteAcknowledgement(ibcmock.MockAcknowledgement, packet)
+				suite.Require().ErrorIs(err, types.ErrAcknowledgementExists)
+			}
+		})
+	}
+}
+
+// TestExpirePendingAsyncAcksLimit tests that at most MaxAsyncAckExpirationsPerBlock
+// acknowledgements are expired per call, oldest first, and that only the expired
+// ones are returned.
+func (suite *KeeperTestSuite) TestExpirePendingAsyncAcksLimit() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	ctx := suite.chainB.GetContext().WithBlockHeight(1000)
+	channelKeeper := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+	channelKeeper.SetParams(ctx, types.NewParams(false, 10, 0))
+
+	height := uint64(ctx.BlockHeight())
+	expired := types.MaxAsyncAckExpirationsPerBlock + 5
+	for i := 1; i <= expired+1; i++ {
+		packet := types.NewPacket(ibcmock.MockAsyncPacketData, uint64(i), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+
+		// the last packet is received too recently to expire, the others are received in
+		// decreasing order of height
+		recvHeight := height - 10 - uint64(expired-i)
+		if i == expired+1 {
+			recvHeight = height - 9
+		}
+		channelKeeper.SetPendingAsyncAck(ctx, types.NewPendingAsyncAck(packet, recvHeight, 0))
+	}
+
+	pendingAsyncAcks := channelKeeper.GetExpiredPendingAsyncAcks(ctx, types.MaxAsyncAckExpirationsPerBlock)
+	suite.Require().Len(pendingAsyncAcks, types.MaxAsyncAckExpirationsPerBlock)
+	suite.Require().Equal(uint64(1), pendingAsyncAcks[0].Packet.GetSequence())
+
+	channelKeeper.ExpirePendingAsyncAcks(ctx, suite.chainB.App.GetIBCKeeper().Router)
+	suite.Require().Len(channelKeeper.GetAllPendingAsyncAcks(ctx), 6)
+	suite.Require().Len(channelKeeper.GetExpiredPendingAsyncAcks(ctx, types.MaxAsyncAckExpirationsPerBlock), 5)
+
+	channelKeeper.ExpirePendingAsyncAcks(ctx, suite.chainB.App.GetIBCKeeper().Router)
+	pendingAsyncAcks = channelKeeper.GetAllPendingAsyncAcks(ctx)
+	suite.Require().Len(pendingAsyncAcks, 1)
+	suite.Require().Equal(uint64(expired+1), pendingAsyncAcks[0].Packet.GetSequence())
+	suite.Require().Empty(channelKeeper.GetExpiredPendingAsyncAcks(ctx, types.MaxAsyncAckExpirationsPerBlock))
+
+	for i := 1; i <= expired; i++ {
+		suite.Require().True(channelKeeper.HasPacketAcknowledgement(ctx, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, uint64(i)))
+	}
+}
+
+// TestExpirePendingAsyncAcksMiddleware tests that the error acknowledgement of an
+// expired packet is written through the middleware of the application stack.
+func (suite *KeeperTestSuite) TestExpirePendingAsyncAcksMiddleware() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	path.EndpointB.ChannelConfig.Version = transfertypes.Version
+	suite.coordinator.SetupConnections(path)
+	suite.coordinator.CreateTransferChannels(path)
+
+	packetData := transfertypes.NewFungibleTokenPacketData(sdk.DefaultBondDenom, "100", suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String())
+	packetData.Memo = fmt.Sprintf(`{"%s": {"address": "%s"}}`, callbackstypes.DestinationCallbackKey, ibcmock.MockCallbackAddress)
+	packet := types.NewPacket(packetData.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+
+	ctx := suite.chainB.GetContext()
+	channelKeeper := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+	channelKeeper.SetParams(ctx, types.NewParams(false, 1, 0))
+	channelKeeper.SetPendingAsyncAck(ctx, types.NewPendingAsyncAck(packet, uint64(ctx.BlockHeight())-1, 0))
+
+	channelKeeper.ExpirePendingAsyncAcks(ctx, suite.chainB.App.GetIBCKeeper().Router)
+
+	suite.Require().True(channelKeeper.HasPacketAcknowledgement(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+
+	var callbackEvent bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == callbackstypes.EventTypeDestinationCallback {
+			callbackEvent = true
+		}
+	}
+	suite.Require().True(callbackEvent, "destination callback of the callbacks middleware not executed")
+}
+
+// TestWriteAcknowledgementRemovesPendingAsyncAck tests that writing an
+// asynchronous acknowledgement removes the record of the pending acknowledgement.
+func (suite *KeeperTestSuite) TestWriteAcknowledgementRemovesPendingAsyncAck() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	packet := types.NewPacket(ibcmock.MockAsyncPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+	err := path.EndpointA.SendPacket(packet)
+	suite.Require().NoError(err)
+	err = path.EndpointB.RecvPacket(packet)
+	suite.Require().NoError(err)
+
+	channelKeeper := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+	pendingAsyncAck, found := channelKeeper.GetPendingAsyncAck(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().True(found)
+	suite.Require().Equal(packet, pendingAsyncAck.Packet)
+
+	err = path.EndpointB.WriteAcknowledgement(ibcmock.MockAcknowledgement, packet)
+	suite.Require().NoError(err)
+
+	_, found = channelKeeper.GetPendingAsyncAck(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().False(found)
+}
@@ -0,0 +1,199 @@
+package keeper
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// RecvPackets is called by core IBC to receive a batch of packets sent to the
+// same channel, whose commitments are proven at the same height. The channel,
+// its connection and the light client are loaded and validated once for the
+// whole batch. The commitments are verified with a single batch proof if
+// proofBatch is set, or with the proof at the same index in proofs otherwise.
+//
+// Each packet is then received as in RecvPacket, in order, and onRecv is called
+// with the index of the packet and the result of its reception, nil or
+// ErrNoOpMsg if the packet was already received, before the next packet is
+// received. Any other error, including one returned by onRecv, aborts the batch.
+func (k Keeper) RecvPackets(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packets []types.Packet,
+	proofs [][]byte,
+	proofBatch []byte,
+	proofHeight exported.Height,
+	onRecv func(i int, err error) error,
+) error {
+	if len(packets) == 0 {
+		return sdkerrors.Wrap(types.ErrInvalidPacket, "batch cannot be empty")
+	}
+
+	if len(proofBatch) == 0 && len(proofs) != len(packets) {
+		return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "expected %d proofs, got %d", len(packets), len(proofs))
+	}
+
+	portID, channelID := packets[0].GetDestPort(), packets[0].GetDestChannel()
+	channel, connectionEnd, err := k.getOpenPacketChannel(ctx, chanCap, portID, channelID)
+	if err != nil {
+		return err
+	}
+
+	if k.IsChannelHalted(ctx, portID, channelID) {
+		return sdkerrors.Wrapf(types.ErrChannelHalted, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	sequences := make([]uint64, len(packets))
+	commitments := make([][]byte, len(packets))
+	for i, packet := range packets {
+		if packet.GetDestPort() != portID || packet.GetDestChannel() != channelID {
+			return sdkerrors.Wrapf(types.ErrInvalidPacket, "packet at index %d is not sent to port ID (%s) channel ID (%s)", i, portID, channelID)
+		}
+
+		if err := validateRecvPacket(ctx, channel, packet); err != nil {
+			return sdkerrors.Wrapf(err, "packet at index %d", i)
+		}
+
+		sequences[i] = packet.GetSequence()
+		commitments[i] = types.CommitPacket(k.cdc, packet)
+	}
+
+	// verify that the counterparty did commit to sending these packets, applying
+	// the additional packet delay declared by the channel
+	packetDelay := k.GetPacketDelay(ctx, portID, channelID)
+	if err := k.verifyPacketCommitments(
+		ctx, connectionEnd, channel.ConnectionHops, proofHeight, packetDelay, proofs, proofBatch,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId, sequences, commitments,
+	); err != nil {
+		return sdkerrors.Wrap(err, "couldn't verify counterparty packet commitments")
+	}
+
+	for i, packet := range packets {
+		err := k.receivePacket(ctx, channel, packet)
+		if err != nil && err != types.ErrNoOpMsg {
+			return sdkerrors.Wrapf(err, "packet at index %d", i)
+		}
+
+		if err := onRecv(i, err); err != nil {
+			return sdkerrors.Wrapf(err, "packet at index %d", i)
+		}
+	}
+
+	return nil
+}
+
+// AcknowledgePackets is called by core IBC to process the acknowledgements of a
+// batch of packets sent from the same channel, which are proven at the same
+// height. The channel, its connection and the light client are loaded and
+// validated once for the whole batch. The acknowledgements of the packets whose
+// commitment is still stored are verified with a single batch proof if
+// proofBatch is set, or with the proof at the same index in proofs otherwise.
+//
+// Each acknowledgement is then processed as in AcknowledgePacket, in order, and
+// onAck is called with the index of the packet and the result of the processing,
+// nil or ErrNoOpMsg if the acknowledgement was already processed, before the
+// next acknowledgement is processed. Any other error, including one returned by
+// onAck, aborts the batch.
+func (k Keeper) AcknowledgePackets(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packets []types.Packet,
+	acknowledgements [][]byte,
+	proofs [][]byte,
+	proofBatch []byte,
+	proofHeight exported.Height,
+	onAck func(i int, err error) error,
+) error {
+	if len(packets) == 0 {
+		return sdkerrors.Wrap(types.ErrInvalidPacket, "batch cannot be empty")
+	}
+
+	if len(acknowledgements) != len(packets) {
+		return sdkerrors.Wrapf(types.ErrInvalidAcknowledgement, "expected %d acknowledgements, got %d", len(packets), len(acknowledgements))
+	}
+
+	if len(proofBatch) == 0 && len(proofs) != len(packets) {
+		return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "expected %d proofs, got %d", len(packets), len(proofs))
+	}
+
+	portID, channelID := packets[0].GetSourcePort(), packets[0].GetSourceChannel()
+	channel, connectionEnd, err := k.getOpenPacketChannel(ctx, chanCap, portID, channelID)
+	if err != nil {
+		return err
+	}
+
+	// only the acknowledgements of packets whose commitment is stored are verified,
+	// the others have already been processed
+	var (
+		pending             = make([]bool, len(packets))
+		sequences           []uint64
+		pendingAcks         [][]byte
+		pendingPacketProofs [][]byte
+	)
+	for i, packet := range packets {
+		if packet.GetSourcePort() != portID || packet.GetSourceChannel() != channelID {
+			return sdkerrors.Wrapf(types.ErrInvalidPacket, "packet at index %d is not sent from port ID (%s) channel ID (%s)", i, portID, channelID)
+		}
+
+		if err := validateAcknowledgedPacket(channel, packet); err != nil {
+			return sdkerrors.Wrapf(err, "packet at index %d", i)
+		}
+
+		commitment := k.GetPacketCommitment(ctx, portID, channelID, packet.GetSequence())
+		if len(commitment) == 0 {
+			continue
+		}
+
+		// verify we sent the packet and haven't cleared it out yet
+		packetCommitment := types.CommitPacket(k.cdc, packet)
+		if !bytes.Equal(commitment, packetCommitment) {
+			return sdkerrors.Wrapf(types.ErrInvalidPacket, "packet at index %d commitment bytes are not equal: got (%v), expected (%v)", i, packetCommitment, commitment)
+		}
+
+		pending[i] = true
+		sequences = append(sequences, packet.GetSequence())
+		pendingAcks = append(pendingAcks, acknowledgements[i])
+		if len(proofBatch) == 0 {
+			pendingPacketProofs = append(pendingPacketProofs, proofs[i])
+		}
+	}
+
+	if len(sequences) != 0 {
+		packetDelay := k.GetPacketDelay(ctx, portID, channelID)
+		if err := k.verifyPacketAcknowledgements(
+			ctx, connectionEnd, channel.ConnectionHops, proofHeight, packetDelay, pendingPacketProofs, proofBatch,
+			channel.Counterparty.PortId, channel.Counterparty.ChannelId, sequences, pendingAcks,
+		); err != nil {
+			return err
+		}
+	}
+
+	for i, packet := range packets {
+		// the commitment is checked again in case the same packet is acknowledged
+		// twice within the batch
+		err = types.ErrNoOpMsg
+		if pending[i] && k.HasPacketCommitment(ctx, portID, channelID, packet.GetSequence()) {
+			err = k.acknowledgePacket(ctx, channel, packet)
+		}
+
+		switch err {
+		case nil:
+		case types.ErrNoOpMsg:
+			EmitAcknowledgePacketEvent(ctx, packet, channel)
+		default:
+			return sdkerrors.Wrapf(err, "packet at index %d", i)
+		}
+
+		if err := onAck(i, err); err != nil {
+			return sdkerrors.Wrapf(err, "packet at index %d", i)
+		}
+	}
+
+	return nil
+}
@@ -125,6 +125,69 @@ func EmitChannelCloseConfirmEvent(ctx sdk.Context, portID string, channelID stri
 	})
 }
 
+// EmitChannelCloseFrozenEvent emits a channel close frozen event
+func EmitChannelCloseFrozenEvent(ctx sdk.Context, portID string, channelID string, channel types.Channel) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeChannelCloseFrozen,
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeCounterpartyPortID, channel.Counterparty.PortId),
+			sdk.NewAttribute(types.AttributeCounterpartyChannelID, channel.Counterparty.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyConnectionID, channel.ConnectionHops[0]),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// EmitPruneAcknowledgementsEvent emits an event when the packet receipts and
+// acknowledgements of a channel are pruned
+func EmitPruneAcknowledgementsEvent(ctx sdk.Context, portID, channelID string, pruningSequenceStart, pruningSequenceEnd uint64) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePruneAcknowledgements,
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyPruningSequenceStart, fmt.Sprintf("%d", pruningSequenceStart)),
+			sdk.NewAttribute(types.AttributeKeyPruningSequenceEnd, fmt.Sprintf("%d", pruningSequenceEnd)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// EmitChannelHaltEvent emits a channel halt event
+func EmitChannelHaltEvent(ctx sdk.Context, portID, channelID string, channel types.Channel) {
+	emitChannelHaltStatusEvent(ctx, types.EventTypeChannelHalt, portID, channelID, channel)
+}
+
+// EmitChannelResumeEvent emits a channel resume event
+func EmitChannelResumeEvent(ctx sdk.Context, portID, channelID string, channel types.Channel) {
+	emitChannelHaltStatusEvent(ctx, types.EventTypeChannelResume, portID, channelID, channel)
+}
+
+func emitChannelHaltStatusEvent(ctx sdk.Context, eventType, portID, channelID string, channel types.Channel) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeCounterpartyPortID, channel.Counterparty.PortId),
+			sdk.NewAttribute(types.AttributeCounterpartyChannelID, channel.Counterparty.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyConnectionID, channel.ConnectionHops[0]),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
 // EmitSendPacketEvent emits an event with packet data along with other packet information for relayer
 // to pick up and relay to other chain
 func EmitSendPacketEvent(ctx sdk.Context, packet exported.PacketI, channel types.Channel, timeoutHeight exported.Height) {
@@ -40,7 +40,10 @@ func (q Keeper) Channel(c context.Context, req *types.QueryChannelRequest) (*typ
 	}
 
 	selfHeight := clienttypes.GetSelfHeight(ctx)
-	return types.NewQueryChannelResponse(channel, nil, selfHeight), nil
+	res := types.NewQueryChannelResponse(channel, nil, selfHeight)
+	res.Halted = q.IsChannelHalted(ctx, req.PortId, req.ChannelId)
+
+	return res, nil
 }
 
 // Channels implements the Query/Channels gRPC method
@@ -278,7 +281,10 @@ func (q Keeper) PacketReceipt(c context.Context, req *types.QueryPacketReceiptRe
 
 	ctx := sdk.UnwrapSDKContext(c)
 
+	// Packets below the pruning sequence end are considered received, their receipts
+	// may have been pruned.
 	_, recvd := q.GetPacketReceipt(ctx, req.PortId, req.ChannelId, req.Sequence)
+	recvd = recvd || q.isPruned(ctx, req.PortId, req.ChannelId, req.Sequence)
 
 	selfHeight := clienttypes.GetSelfHeight(ctx)
 	return types.NewQueryPacketReceiptResponse(recvd, nil, selfHeight), nil
@@ -404,8 +410,10 @@ func (q Keeper) UnreceivedPackets(c context.Context, req *types.QueryUnreceivedP
 			return nil, status.Errorf(codes.InvalidArgument, "packet sequence %d cannot be 0", i)
 		}
 
-		// if packet receipt exists on the receiving chain, then packet has already been received
-		if _, found := q.GetPacketReceipt(ctx, req.PortId, req.ChannelId, seq); !found {
+		// if packet receipt exists on the receiving chain, then packet has already been received.
+		// Packets below the pruning sequence end are considered received, their receipts
+		// may have been pruned.
+		if _, found := q.GetPacketReceipt(ctx, req.PortId, req.ChannelId, seq); !found && !q.isPruned(ctx, req.PortId, req.ChannelId, seq) {
 			unreceivedSequences = append(unreceivedSequences, seq)
 		}
 
@@ -491,6 +499,136 @@ func (q Keeper) NextSequenceReceive(c context.Context, req *types.QueryNextSeque
 	return types.NewQueryNextSequenceReceiveResponse(sequence, nil, selfHeight), nil
 }
 
+// PacketDelay implements the Query/PacketDelay gRPC method
+func (q Keeper) PacketDelay(c context.Context, req *types.QueryPacketDelayRequest) (*types.QueryPacketDelayResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, found := q.GetChannel(ctx, req.PortId, req.ChannelId); !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrChannelNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	return &types.QueryPacketDelayResponse{
+		PacketDelay: q.GetPacketDelay(ctx, req.PortId, req.ChannelId),
+	}, nil
+}
+
+// Packet implements the Query/Packet gRPC method
+func (q Keeper) Packet(c context.Context, req *types.QueryPacketRequest) (*types.QueryPacketResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	if req.Sequence == 0 {
+		return nil, status.Error(codes.InvalidArgument, "packet sequence cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	packet, found := q.GetPacketData(ctx, req.PortId, req.ChannelId, req.Sequence)
+	if !found {
+		return nil, status.Error(codes.NotFound, "packet data not found")
+	}
+
+	return &types.QueryPacketResponse{
+		Packet: packet,
+	}, nil
+}
+
+// PendingPackets implements the Query/PendingPackets gRPC method
+func (q Keeper) PendingPackets(c context.Context, req *types.QueryPendingPacketsRequest) (*types.QueryPendingPacketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	packets := []types.Packet{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), []byte(host.PacketDataPrefixPath(req.PortId, req.ChannelId)+"/"))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var packet types.Packet
+		if err := q.cdc.Unmarshal(value, &packet); err != nil {
+			return err
+		}
+
+		packets = append(packets, packet)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryPendingPacketsResponse{
+		Packets:    packets,
+		Pagination: pageRes,
+		Height:     selfHeight,
+	}, nil
+}
+
+// PruningSequences implements the Query/PruningSequences gRPC method
+func (q Keeper) PruningSequences(c context.Context, req *types.QueryPruningSequencesRequest) (*types.QueryPruningSequencesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	channel, found := q.GetChannel(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrChannelNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	if channel.Ordering != types.UNORDERED {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			sdkerrors.Wrapf(types.ErrInvalidChannelOrdering, "expected %s channel, got %s", types.UNORDERED, channel.Ordering).Error(),
+		)
+	}
+
+	pruningSequenceStart, found := q.GetPruningSequenceStart(ctx, req.PortId, req.ChannelId)
+	if !found {
+		pruningSequenceStart = 1
+	}
+
+	pruningSequenceEnd, found := q.GetPruningSequenceEnd(ctx, req.PortId, req.ChannelId)
+	if !found {
+		pruningSequenceEnd = 1
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryPruningSequencesResponse{
+		PruningSequenceStart: pruningSequenceStart,
+		PruningSequenceEnd:   pruningSequenceEnd,
+		Height:               selfHeight,
+	}, nil
+}
+
 func validategRPCRequest(portID, channelID string) error {
 	if err := host.PortIdentifierValidator(portID); err != nil {
 		return status.Error(codes.InvalidArgument, err.Error())
@@ -502,3 +640,39 @@ func validategRPCRequest(portID, channelID string) error {
 
 	return nil
 }
+
+// PendingAsyncAcks implements the Query/PendingAsyncAcks gRPC method
+func (q Keeper) PendingAsyncAcks(c context.Context, req *types.QueryPendingAsyncAcksRequest) (*types.QueryPendingAsyncAcksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pendingAsyncAcks := []types.PendingAsyncAck{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), []byte(host.PendingAsyncAckPrefixPath(req.PortId, req.ChannelId)+"/"))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var pendingAsyncAck types.PendingAsyncAck
+		if err := q.cdc.Unmarshal(value, &pendingAsyncAck); err != nil {
+			return err
+		}
+
+		pendingAsyncAcks = append(pendingAsyncAcks, pendingAsyncAck)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryPendingAsyncAcksResponse{
+		PendingAsyncAcks: pendingAsyncAcks,
+		Pagination:       pageRes,
+		Height:           selfHeight,
+	}, nil
+}
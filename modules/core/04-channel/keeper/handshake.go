@@ -33,6 +33,13 @@ func (k Keeper) ChanOpenInit(
 		return "", nil, sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, connectionHops[0])
 	}
 
+	if connectionEnd.GetState() == int32(connectiontypes.CLOSED) {
+		return "", nil, sdkerrors.Wrapf(
+			connectiontypes.ErrInvalidConnectionState,
+			"cannot open a channel on a CLOSED connection: %s", connectionHops[0],
+		)
+	}
+
 	getVersions := connectionEnd.GetVersions()
 	if len(getVersions) != 1 {
 		return "", nil, sdkerrors.Wrapf(
@@ -113,11 +120,6 @@ func (k Keeper) ChanOpenTry(
 
 	channelID := previousChannelID
 
-	// connection hops only supports a single connection
-	if len(connectionHops) != 1 {
-		return "", nil, sdkerrors.Wrapf(types.ErrTooManyConnectionHops, "expected 1, got %d", len(connectionHops))
-	}
-
 	// empty channel identifier indicates continuing a previous channel handshake
 	if previousChannelID != "" {
 		// channel identifier and connection hop length checked on msg.ValidateBasic()
@@ -130,7 +132,7 @@ func (k Keeper) ChanOpenTry(
 		if !(previousChannel.Ordering == order &&
 			previousChannel.Counterparty.PortId == counterparty.PortId &&
 			previousChannel.Counterparty.ChannelId == "" &&
-			previousChannel.ConnectionHops[0] == connectionHops[0] && // ChanOpenInit will only set a single connection hop
+			connectionHopsEqual(previousChannel.ConnectionHops, connectionHops) &&
 			previousChannel.Version == counterpartyVersion) {
 			return "", nil, sdkerrors.Wrap(types.ErrInvalidChannel, "channel fields mismatch previous channel fields")
 		}
@@ -177,7 +179,10 @@ func (k Keeper) ChanOpenTry(
 		)
 	}
 
-	counterpartyHops := []string{connectionEnd.GetCounterparty().GetConnectionID()}
+	counterpartyHops, err := k.getCounterpartyHops(connectionEnd, connectionHops, order, proofInit)
+	if err != nil {
+		return "", nil, err
+	}
 
 	// expectedCounterpaty is the counterparty of the counterparty's channel end
 	// (i.e self)
@@ -187,17 +192,14 @@ func (k Keeper) ChanOpenTry(
 		counterpartyHops, counterpartyVersion,
 	)
 
-	if err := k.connectionKeeper.VerifyChannelState(
-		ctx, connectionEnd, proofHeight, proofInit,
+	if err := k.verifyChannelState(
+		ctx, connectionEnd, connectionHops, proofHeight, proofInit,
 		counterparty.PortId, counterparty.ChannelId, expectedChannel,
 	); err != nil {
 		return "", nil, err
 	}
 
-	var (
-		capKey *capabilitytypes.Capability
-		err    error
-	)
+	var capKey *capabilitytypes.Capability
 
 	if !previousChannelFound {
 		capKey, err = k.scopedKeeper.NewCapability(ctx, host.ChannelCapabilityPath(portID, channelID))
@@ -290,7 +292,10 @@ func (k Keeper) ChanOpenAck(
 		)
 	}
 
-	counterpartyHops := []string{connectionEnd.GetCounterparty().GetConnectionID()}
+	counterpartyHops, err := k.getCounterpartyHops(connectionEnd, channel.ConnectionHops, channel.Ordering, proofTry)
+	if err != nil {
+		return err
+	}
 
 	// counterparty of the counterparty channel end (i.e self)
 	expectedCounterparty := types.NewCounterparty(portID, channelID)
@@ -299,8 +304,8 @@ func (k Keeper) ChanOpenAck(
 		counterpartyHops, counterpartyVersion,
 	)
 
-	if err := k.connectionKeeper.VerifyChannelState(
-		ctx, connectionEnd, proofHeight, proofTry,
+	if err := k.verifyChannelState(
+		ctx, connectionEnd, channel.ConnectionHops, proofHeight, proofTry,
 		channel.Counterparty.PortId, counterpartyChannelID,
 		expectedChannel,
 	); err != nil {
@@ -376,7 +381,10 @@ func (k Keeper) ChanOpenConfirm(
 		)
 	}
 
-	counterpartyHops := []string{connectionEnd.GetCounterparty().GetConnectionID()}
+	counterpartyHops, err := k.getCounterpartyHops(connectionEnd, channel.ConnectionHops, channel.Ordering, proofAck)
+	if err != nil {
+		return err
+	}
 
 	counterparty := types.NewCounterparty(portID, channelID)
 	expectedChannel := types.NewChannel(
@@ -384,8 +392,8 @@ func (k Keeper) ChanOpenConfirm(
 		counterpartyHops, channel.Version,
 	)
 
-	if err := k.connectionKeeper.VerifyChannelState(
-		ctx, connectionEnd, proofHeight, proofAck,
+	if err := k.verifyChannelState(
+		ctx, connectionEnd, channel.ConnectionHops, proofHeight, proofAck,
 		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
 		expectedChannel,
 	); err != nil {
@@ -505,7 +513,10 @@ func (k Keeper) ChanCloseConfirm(
 		)
 	}
 
-	counterpartyHops := []string{connectionEnd.GetCounterparty().GetConnectionID()}
+	counterpartyHops, err := k.getCounterpartyHops(connectionEnd, channel.ConnectionHops, channel.Ordering, proofInit)
+	if err != nil {
+		return err
+	}
 
 	counterparty := types.NewCounterparty(portID, channelID)
 	expectedChannel := types.NewChannel(
@@ -513,8 +524,8 @@ func (k Keeper) ChanCloseConfirm(
 		counterpartyHops, channel.Version,
 	)
 
-	if err := k.connectionKeeper.VerifyChannelState(
-		ctx, connectionEnd, proofHeight, proofInit,
+	if err := k.verifyChannelState(
+		ctx, connectionEnd, channel.ConnectionHops, proofHeight, proofInit,
 		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
 		expectedChannel,
 	); err != nil {
@@ -534,3 +545,59 @@ func (k Keeper) ChanCloseConfirm(
 
 	return nil
 }
+
+// ChanCloseFrozen is called by the module to close its end of a multi-hop channel
+// once the client of one of the connections along the channel path has been frozen.
+// Packets can no longer be proven over the channel path, so the channel is closed
+// without the involvement of the counterparty channel end.
+func (k Keeper) ChanCloseFrozen(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	proofConnection []byte,
+	proofClientState []byte,
+	proofHeight exported.Height,
+) error {
+	if !k.scopedKeeper.AuthenticateCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)) {
+		return sdkerrors.Wrapf(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State == types.CLOSED {
+		return sdkerrors.Wrap(types.ErrInvalidChannelState, "channel is already CLOSED")
+	}
+
+	if !isMultihop(channel.ConnectionHops) {
+		return sdkerrors.Wrapf(types.ErrInvalidChannel, "channel is not a multi-hop channel, port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	if !found {
+		return sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
+	}
+
+	if err := k.connectionKeeper.VerifyMultihopClientFrozen(
+		ctx, connectionEnd, channel.ConnectionHops, proofHeight,
+		proofConnection, proofClientState,
+	); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", channel.State.String(), "new-state", "CLOSED")
+
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "channel", "close-frozen")
+	}()
+
+	channel.State = types.CLOSED
+	k.SetChannel(ctx, portID, channelID, channel)
+
+	EmitChannelCloseFrozenEvent(ctx, portID, channelID, channel)
+
+	return nil
+}
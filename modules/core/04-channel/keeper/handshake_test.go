@@ -85,6 +85,17 @@ func (suite *KeeperTestSuite) TestChanOpenInit() {
 			suite.chainA.CreatePortCapability(suite.chainA.GetSimApp().ScopedIBCMockKeeper, ibctesting.MockPort)
 			portCap = suite.chainA.GetPortCapability(ibctesting.MockPort)
 		}, true},
+		{"connection is closed", func() {
+			suite.coordinator.SetupConnections(path)
+
+			conn := path.EndpointA.GetConnection()
+			conn.State = connectiontypes.CLOSED
+			path.EndpointA.SetConnection(conn)
+
+			features = []string{"ORDER_ORDERED", "ORDER_UNORDERED"}
+			suite.chainA.CreatePortCapability(suite.chainA.GetSimApp().ScopedIBCMockKeeper, ibctesting.MockPort)
+			portCap = suite.chainA.GetPortCapability(ibctesting.MockPort)
+		}, false},
 	}
 
 	for _, tc := range testCases {
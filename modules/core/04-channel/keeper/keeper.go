@@ -9,6 +9,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
 	db "github.com/tendermint/tm-db"
 
@@ -28,6 +29,7 @@ type Keeper struct {
 	types.QueryServer
 
 	storeKey         sdk.StoreKey
+	paramSpace       paramtypes.Subspace
 	cdc              codec.BinaryCodec
 	clientKeeper     types.ClientKeeper
 	connectionKeeper types.ConnectionKeeper
@@ -37,12 +39,18 @@ type Keeper struct {
 
 // NewKeeper creates a new IBC channel Keeper instance
 func NewKeeper(
-	cdc codec.BinaryCodec, key sdk.StoreKey,
+	cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
 	clientKeeper types.ClientKeeper, connectionKeeper types.ConnectionKeeper,
 	portKeeper types.PortKeeper, scopedKeeper capabilitykeeper.ScopedKeeper,
 ) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
 		storeKey:         key,
+		paramSpace:       paramSpace,
 		cdc:              cdc,
 		clientKeeper:     clientKeeper,
 		connectionKeeper: connectionKeeper,
@@ -175,6 +183,11 @@ func (k Keeper) SetPacketReceipt(ctx sdk.Context, portID, channelID string, sequ
 	store.Set(host.PacketReceiptKey(portID, channelID, sequence), []byte{byte(1)})
 }
 
+func (k Keeper) deletePacketReceipt(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.PacketReceiptKey(portID, channelID, sequence))
+}
+
 // GetPacketCommitment gets the packet commitment hash from the store
 func (k Keeper) GetPacketCommitment(ctx sdk.Context, portID, channelID string, sequence uint64) []byte {
 	store := ctx.KVStore(k.storeKey)
@@ -221,6 +234,49 @@ func (k Keeper) HasPacketAcknowledgement(ctx sdk.Context, portID, channelID stri
 	return store.Has(host.PacketAcknowledgementKey(portID, channelID, sequence))
 }
 
+func (k Keeper) deletePacketAcknowledgement(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.PacketAcknowledgementKey(portID, channelID, sequence))
+}
+
+// GetPruningSequenceStart gets a channel's next sequence whose packet receipt and
+// acknowledgement have not yet been pruned from the store
+func (k Keeper) GetPruningSequenceStart(ctx sdk.Context, portID, channelID string) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.PruningSequenceStartKey(portID, channelID))
+	if bz == nil {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// SetPruningSequenceStart sets a channel's pruning sequence start to the store
+func (k Keeper) SetPruningSequenceStart(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := sdk.Uint64ToBigEndian(sequence)
+	store.Set(host.PruningSequenceStartKey(portID, channelID), bz)
+}
+
+// GetPruningSequenceEnd gets a channel's sequence below which packet receipts and
+// acknowledgements may be pruned from the store
+func (k Keeper) GetPruningSequenceEnd(ctx sdk.Context, portID, channelID string) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.PruningSequenceEndKey(portID, channelID))
+	if bz == nil {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// SetPruningSequenceEnd sets a channel's pruning sequence end to the store
+func (k Keeper) SetPruningSequenceEnd(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := sdk.Uint64ToBigEndian(sequence)
+	store.Set(host.PruningSequenceEndKey(portID, channelID), bz)
+}
+
 // IteratePacketSequence provides an iterator over all send, receive or ack sequences.
 // For each sequence, cb will be called. If the cb returns true, the iterator
 // will close and stop.
@@ -277,6 +333,30 @@ func (k Keeper) GetAllPacketAckSeqs(ctx sdk.Context) (seqs []types.PacketSequenc
 	return seqs
 }
 
+// GetAllPruningSequenceStarts returns all stored pruning sequence starts.
+func (k Keeper) GetAllPruningSequenceStarts(ctx sdk.Context) (seqs []types.PacketSequence) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(host.KeyPruningSequenceStart))
+	k.IteratePacketSequence(ctx, iterator, func(portID, channelID string, sequence uint64) bool {
+		ps := types.NewPacketSequence(portID, channelID, sequence)
+		seqs = append(seqs, ps)
+		return false
+	})
+	return seqs
+}
+
+// GetAllPruningSequenceEnds returns all stored pruning sequence ends.
+func (k Keeper) GetAllPruningSequenceEnds(ctx sdk.Context) (seqs []types.PacketSequence) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(host.KeyPruningSequenceEnd))
+	k.IteratePacketSequence(ctx, iterator, func(portID, channelID string, sequence uint64) bool {
+		ps := types.NewPacketSequence(portID, channelID, sequence)
+		seqs = append(seqs, ps)
+		return false
+	})
+	return seqs
+}
+
 // IteratePacketCommitment provides an iterator over all PacketCommitment objects. For each
 // packet commitment, cb will be called. If the cb returns true, the iterator will close
 // and stop.
@@ -383,6 +463,241 @@ func (k Keeper) GetAllChannels(ctx sdk.Context) (channels []types.IdentifiedChan
 	return channels
 }
 
+// HasActiveChannels returns true if at least one channel built on the given
+// connection is not in the CLOSED state.
+func (k Keeper) HasActiveChannels(ctx sdk.Context, connectionID string) bool {
+	var active bool
+	k.IterateChannels(ctx, func(channel types.IdentifiedChannel) bool {
+		if len(channel.ConnectionHops) > 0 && channel.ConnectionHops[0] == connectionID && channel.State != types.CLOSED {
+			active = true
+			return true
+		}
+		return false
+	})
+	return active
+}
+
+// GetPacketDelay returns the additional packet delay declared by a channel. A
+// zero delay is returned if the channel did not declare any.
+func (k Keeper) GetPacketDelay(ctx sdk.Context, portID, channelID string) types.PacketDelay {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.PacketDelayKey(portID, channelID))
+	if bz == nil {
+		return types.PacketDelay{}
+	}
+
+	var packetDelay types.PacketDelay
+	k.cdc.MustUnmarshal(bz, &packetDelay)
+	return packetDelay
+}
+
+// SetPacketDelay sets the additional packet delay of a channel. A zero delay
+// removes any previously stored delay.
+func (k Keeper) SetPacketDelay(ctx sdk.Context, portID, channelID string, packetDelay types.PacketDelay) {
+	store := ctx.KVStore(k.storeKey)
+	if packetDelay.IsZero() {
+		store.Delete(host.PacketDelayKey(portID, channelID))
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&packetDelay)
+	store.Set(host.PacketDelayKey(portID, channelID), bz)
+}
+
+// IteratePacketDelays provides an iterator over all the additional packet
+// delays declared by channels. For each delay, cb will be called. If the cb
+// returns true, the iterator will close and stop.
+func (k Keeper) IteratePacketDelays(ctx sdk.Context, cb func(portID, channelID string, packetDelay types.PacketDelay) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(host.KeyPacketDelayPrefix))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var packetDelay types.PacketDelay
+		k.cdc.MustUnmarshal(iterator.Value(), &packetDelay)
+
+		portID, channelID := host.MustParseChannelPath(string(iterator.Key()))
+		if cb(portID, channelID, packetDelay) {
+			break
+		}
+	}
+}
+
+// GetAllPacketDelays returns all stored additional packet delays.
+func (k Keeper) GetAllPacketDelays(ctx sdk.Context) (packetDelays []types.IdentifiedPacketDelay) {
+	k.IteratePacketDelays(ctx, func(portID, channelID string, packetDelay types.PacketDelay) bool {
+		packetDelays = append(packetDelays, types.NewIdentifiedPacketDelay(portID, channelID, packetDelay))
+		return false
+	})
+	return packetDelays
+}
+
+// IsChannelHalted returns true if the channel has been halted by governance.
+func (k Keeper) IsChannelHalted(ctx sdk.Context, portID, channelID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(host.ChannelHaltedKey(portID, channelID))
+}
+
+// SetChannelHalted sets or removes the halt flag of a channel.
+func (k Keeper) SetChannelHalted(ctx sdk.Context, portID, channelID string, halted bool) {
+	store := ctx.KVStore(k.storeKey)
+	if !halted {
+		store.Delete(host.ChannelHaltedKey(portID, channelID))
+		return
+	}
+
+	store.Set(host.ChannelHaltedKey(portID, channelID), []byte{byte(1)})
+}
+
+// IterateHaltedChannels provides an iterator over all the channels halted by
+// governance. For each halted channel, cb will be called. If the cb returns
+// true, the iterator will close and stop.
+func (k Keeper) IterateHaltedChannels(ctx sdk.Context, cb func(portID, channelID string) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(host.KeyChannelHaltedPrefix))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		portID, channelID := host.MustParseChannelPath(string(iterator.Key()))
+		if cb(portID, channelID) {
+			break
+		}
+	}
+}
+
+// GetAllHaltedChannels returns all the channels halted by governance.
+func (k Keeper) GetAllHaltedChannels(ctx sdk.Context) (haltedChannels []types.HaltedChannel) {
+	k.IterateHaltedChannels(ctx, func(portID, channelID string) bool {
+		haltedChannels = append(haltedChannels, types.NewHaltedChannel(portID, channelID))
+		return false
+	})
+	return haltedChannels
+}
+
+// GetPacketData returns the full data of a sent packet pending acknowledgement
+// or timeout. Packet data is only recorded when enabled by the channel params.
+func (k Keeper) GetPacketData(ctx sdk.Context, portID, channelID string, sequence uint64) (types.Packet, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.PacketDataKey(portID, channelID, sequence))
+	if bz == nil {
+		return types.Packet{}, false
+	}
+
+	var packet types.Packet
+	k.cdc.MustUnmarshal(bz, &packet)
+	return packet, true
+}
+
+// SetPacketData sets the full data of a sent packet to the store
+func (k Keeper) SetPacketData(ctx sdk.Context, packet types.Packet) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&packet)
+	store.Set(host.PacketDataKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()), bz)
+}
+
+func (k Keeper) deletePacketData(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.PacketDataKey(portID, channelID, sequence))
+}
+
+// IteratePacketData provides an iterator over the full data of all the sent
+// packets pending acknowledgement or timeout. For each packet, cb will be called.
+// If the cb returns true, the iterator will close and stop.
+func (k Keeper) IteratePacketData(ctx sdk.Context, cb func(packet types.Packet) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(host.KeyPacketDataPrefix+"/"))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var packet types.Packet
+		k.cdc.MustUnmarshal(iterator.Value(), &packet)
+
+		if cb(packet) {
+			break
+		}
+	}
+}
+
+// GetAllPacketData returns the full data of all the stored sent packets.
+func (k Keeper) GetAllPacketData(ctx sdk.Context) (packets []types.Packet) {
+	k.IteratePacketData(ctx, func(packet types.Packet) bool {
+		packets = append(packets, packet)
+		return false
+	})
+	return packets
+}
+
+// GetPendingAsyncAck returns a received packet whose application has not yet
+// written its asynchronous acknowledgement.
+func (k Keeper) GetPendingAsyncAck(ctx sdk.Context, portID, channelID string, sequence uint64) (types.PendingAsyncAck, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.PendingAsyncAckKey(portID, channelID, sequence))
+	if bz == nil {
+		return types.PendingAsyncAck{}, false
+	}
+
+	var pendingAsyncAck types.PendingAsyncAck
+	k.cdc.MustUnmarshal(bz, &pendingAsyncAck)
+	return pendingAsyncAck, true
+}
+
+// SetPendingAsyncAck records a received packet pending an asynchronous
+// acknowledgement. The record is removed once the acknowledgement is written.
+// The packet is indexed by its receive height and time, such that expired
+// acknowledgements can be found without iterating all pending ones.
+func (k Keeper) SetPendingAsyncAck(ctx sdk.Context, pendingAsyncAck types.PendingAsyncAck) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&pendingAsyncAck)
+	packet := pendingAsyncAck.Packet
+	key := host.PendingAsyncAckKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	store.Set(key, bz)
+
+	store.Set(host.PendingAsyncAckRecvHeightIndexKey(pendingAsyncAck.RecvHeight, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()), key)
+	store.Set(host.PendingAsyncAckRecvTimeIndexKey(pendingAsyncAck.RecvTimestamp, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()), key)
+}
+
+// deletePendingAsyncAck removes the record of a packet pending an asynchronous
+// acknowledgement along with its indexes.
+func (k Keeper) deletePendingAsyncAck(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	pendingAsyncAck, found := k.GetPendingAsyncAck(ctx, portID, channelID, sequence)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.PendingAsyncAckKey(portID, channelID, sequence))
+	store.Delete(host.PendingAsyncAckRecvHeightIndexKey(pendingAsyncAck.RecvHeight, portID, channelID, sequence))
+	store.Delete(host.PendingAsyncAckRecvTimeIndexKey(pendingAsyncAck.RecvTimestamp, portID, channelID, sequence))
+}
+
+// IteratePendingAsyncAcks provides an iterator over all the received packets
+// pending an asynchronous acknowledgement. For each pending acknowledgement, cb
+// will be called. If the cb returns true, the iterator will close and stop.
+func (k Keeper) IteratePendingAsyncAcks(ctx sdk.Context, cb func(pendingAsyncAck types.PendingAsyncAck) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(host.KeyPendingAsyncAckPrefix+"/"))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var pendingAsyncAck types.PendingAsyncAck
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingAsyncAck)
+
+		if cb(pendingAsyncAck) {
+			break
+		}
+	}
+}
+
+// GetAllPendingAsyncAcks returns all the received packets pending an
+// asynchronous acknowledgement.
+func (k Keeper) GetAllPendingAsyncAcks(ctx sdk.Context) (pendingAsyncAcks []types.PendingAsyncAck) {
+	k.IteratePendingAsyncAcks(ctx, func(pendingAsyncAck types.PendingAsyncAck) bool {
+		pendingAsyncAcks = append(pendingAsyncAcks, pendingAsyncAck)
+		return false
+	})
+	return pendingAsyncAcks
+}
+
 // GetChannelClientState returns the associated client state with its ID, from a port and channel identifier.
 func (k Keeper) GetChannelClientState(ctx sdk.Context, portID, channelID string) (string, exported.ClientState, error) {
 	channel, found := k.GetChannel(ctx, portID, channelID)
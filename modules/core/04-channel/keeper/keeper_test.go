@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -364,3 +365,51 @@ func (suite *KeeperTestSuite) TestSetPacketAcknowledgement() {
 	suite.Require().Equal(ackHash, storedAckHash)
 	suite.Require().True(suite.chainA.App.GetIBCKeeper().ChannelKeeper.HasPacketAcknowledgement(ctxA, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, seq))
 }
+
+// TestSetPacketDelay verifies that the additional packet delays of channels are
+// correctly set, deleted and exported by the keeper.
+func (suite *KeeperTestSuite) TestSetPacketDelay() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	ctxA := suite.chainA.GetContext()
+	keeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+
+	// channels do not declare any packet delay by default
+	suite.Require().True(keeper.GetPacketDelay(ctxA, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID).IsZero())
+	suite.Require().Empty(keeper.GetAllPacketDelays(ctxA))
+
+	packetDelay := types.NewPacketDelay(uint64(time.Hour.Nanoseconds()), 10)
+	keeper.SetPacketDelay(ctxA, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, packetDelay)
+
+	suite.Require().Equal(packetDelay, keeper.GetPacketDelay(ctxA, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+	suite.Require().Equal(
+		[]types.IdentifiedPacketDelay{types.NewIdentifiedPacketDelay(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, packetDelay)},
+		keeper.GetAllPacketDelays(ctxA),
+	)
+
+	// setting a zero packet delay removes the stored delay
+	keeper.SetPacketDelay(ctxA, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, types.PacketDelay{})
+	suite.Require().True(keeper.GetPacketDelay(ctxA, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID).IsZero())
+	suite.Require().Empty(keeper.GetAllPacketDelays(ctxA))
+}
+
+// TestHasActiveChannels verifies that only channels which are not CLOSED are
+// considered to be using their connection.
+func (suite *KeeperTestSuite) TestHasActiveChannels() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	keeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+
+	// no channels built on the connection
+	suite.Require().False(keeper.HasActiveChannels(suite.chainA.GetContext(), path.EndpointA.ConnectionID))
+
+	suite.coordinator.CreateMockChannels(path)
+	suite.Require().True(keeper.HasActiveChannels(suite.chainA.GetContext(), path.EndpointA.ConnectionID))
+	suite.Require().False(keeper.HasActiveChannels(suite.chainA.GetContext(), ibctesting.InvalidID))
+
+	err := path.EndpointA.SetChannelClosed()
+	suite.Require().NoError(err)
+	suite.Require().False(keeper.HasActiveChannels(suite.chainA.GetContext(), path.EndpointA.ConnectionID))
+}
@@ -0,0 +1,37 @@
+package keeper_test
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+// TestLocalhostPacketFlow opens a channel between two ports of chainA over the localhost
+// sentinel connection and relays a packet and its acknowledgement.
+func (suite *KeeperTestSuite) TestLocalhostPacketFlow() {
+	path := ibctesting.NewLocalhostPath(suite.chainA)
+	suite.coordinator.CreateMockChannels(path)
+
+	channelA := path.EndpointA.GetChannel()
+	suite.Require().Equal(types.OPEN, channelA.State)
+	suite.Require().Equal([]string{exported.LocalhostConnectionID}, channelA.ConnectionHops)
+	suite.Require().Equal(types.OPEN, path.EndpointB.GetChannel().State)
+
+	selfHeight := clienttypes.GetSelfHeight(suite.chainA.GetContext())
+	timeoutHeight := clienttypes.NewHeight(selfHeight.RevisionNumber, selfHeight.RevisionHeight+100)
+	packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+
+	err := path.EndpointA.SendPacket(packet)
+	suite.Require().NoError(err)
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	// the packet is received and the acknowledgement processed on chainA
+	_, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(suite.chainA.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().True(found)
+
+	commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().Nil(commitment)
+}
@@ -0,0 +1,354 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	multihoptypes "github.com/cosmos/ibc-go/v3/modules/core/33-multihop/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// isMultihop returns true if the connection hops describe a multi-hop channel.
+func isMultihop(connectionHops []string) bool {
+	return len(connectionHops) > 1
+}
+
+// connectionHopsEqual returns true if both channel ends use the same connection hops.
+func connectionHopsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// getCounterpartyHops returns the connection hops expected on the counterparty
+// channel end. For multi-hop channels they are derived from the connection ends
+// proven along the channel path, all of which must support the channel ordering.
+func (k Keeper) getCounterpartyHops(
+	connectionEnd connectiontypes.ConnectionEnd,
+	connectionHops []string,
+	order types.Order,
+	proof []byte,
+) ([]string, error) {
+	if !isMultihop(connectionHops) {
+		return []string{connectionEnd.GetCounterparty().GetConnectionID()}, nil
+	}
+
+	var multihopProof multihoptypes.MultihopProof
+	if err := k.cdc.Unmarshal(proof, &multihopProof); err != nil {
+		return nil, sdkerrors.Wrapf(multihoptypes.ErrInvalidMultihopProof, "failed to unmarshal multi-hop proof: %v", err)
+	}
+
+	if len(multihopProof.Hops) != len(connectionHops)-1 {
+		return nil, sdkerrors.Wrapf(multihoptypes.ErrInvalidMultihopProof, "expected %d hop proofs, got %d", len(connectionHops)-1, len(multihopProof.Hops))
+	}
+
+	for i, hop := range multihopProof.Hops {
+		versions := hop.Connection.GetVersions()
+		if len(versions) != 1 {
+			return nil, sdkerrors.Wrapf(
+				connectiontypes.ErrInvalidVersion,
+				"single version must be negotiated on connection %s before opening channel, got: %v",
+				connectionHops[i+1], versions,
+			)
+		}
+
+		if !connectiontypes.VerifySupportedFeature(versions[0], order.String()) {
+			return nil, sdkerrors.Wrapf(
+				connectiontypes.ErrInvalidVersion,
+				"connection %s version %s does not support channel ordering: %s",
+				connectionHops[i+1], versions[0], order.String(),
+			)
+		}
+	}
+
+	return multihopProof.CounterpartyConnectionHops(connectionEnd), nil
+}
+
+// getCounterpartyTimeoutHeightAndTimestamp returns the height and timestamp of
+// the counterparty chain to be compared against the timeout of a packet. For
+// multi-hop channels they are taken from the consensus state of the last chain
+// of the path, which is verified along with the packet proof.
+func (k Keeper) getCounterpartyTimeoutHeightAndTimestamp(
+	ctx sdk.Context,
+	connectionEnd connectiontypes.ConnectionEnd,
+	connectionHops []string,
+	proofHeight exported.Height,
+	proof []byte,
+) (exported.Height, uint64, error) {
+	if !isMultihop(connectionHops) {
+		proofTimestamp, err := k.connectionKeeper.GetTimestampAtHeight(ctx, connectionEnd, proofHeight)
+		if err != nil {
+			return nil, 0, err
+		}
+		return proofHeight, proofTimestamp, nil
+	}
+
+	var multihopProof multihoptypes.MultihopProof
+	if err := k.cdc.Unmarshal(proof, &multihopProof); err != nil {
+		return nil, 0, sdkerrors.Wrapf(multihoptypes.ErrInvalidMultihopProof, "failed to unmarshal multi-hop proof: %v", err)
+	}
+
+	consensusState, consensusHeight, err := multihopProof.LastConsensusState()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return consensusHeight, consensusState.GetTimestamp(), nil
+}
+
+// verifyChannelState verifies the counterparty channel end over the connection
+// hops of the channel.
+func (k Keeper) verifyChannelState(
+	ctx sdk.Context,
+	connectionEnd connectiontypes.ConnectionEnd,
+	connectionHops []string,
+	proofHeight exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	channel exported.ChannelI,
+) error {
+	if isMultihop(connectionHops) {
+		return k.connectionKeeper.VerifyMultihopChannelState(
+			ctx, connectionEnd, connectionHops, proofHeight, proof,
+			portID, channelID, channel,
+		)
+	}
+
+	return k.connectionKeeper.VerifyChannelState(
+		ctx, connectionEnd, proofHeight, proof,
+		portID, channelID, channel,
+	)
+}
+
+// verifyPacketCommitment verifies the packet commitment stored by the
+// counterparty over the connection hops of the channel.
+func (k Keeper) verifyPacketCommitment(
+	ctx sdk.Context,
+	connectionEnd connectiontypes.ConnectionEnd,
+	connectionHops []string,
+	proofHeight exported.Height,
+	packetDelay types.PacketDelay,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+	commitmentBytes []byte,
+) error {
+	if isMultihop(connectionHops) {
+		return k.connectionKeeper.VerifyMultihopPacketCommitment(
+			ctx, connectionEnd, connectionHops, proofHeight,
+			packetDelay.TimeDelay, packetDelay.BlockDelay, proof,
+			portID, channelID, sequence, commitmentBytes,
+		)
+	}
+
+	return k.connectionKeeper.VerifyPacketCommitment(
+		ctx, connectionEnd, proofHeight,
+		packetDelay.TimeDelay, packetDelay.BlockDelay, proof,
+		portID, channelID, sequence, commitmentBytes,
+	)
+}
+
+// verifyPacketAcknowledgement verifies the packet acknowledgement written by
+// the counterparty over the connection hops of the channel.
+func (k Keeper) verifyPacketAcknowledgement(
+	ctx sdk.Context,
+	connectionEnd connectiontypes.ConnectionEnd,
+	connectionHops []string,
+	proofHeight exported.Height,
+	packetDelay types.PacketDelay,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+	acknowledgement []byte,
+) error {
+	if isMultihop(connectionHops) {
+		return k.connectionKeeper.VerifyMultihopPacketAcknowledgement(
+			ctx, connectionEnd, connectionHops, proofHeight,
+			packetDelay.TimeDelay, packetDelay.BlockDelay, proof,
+			portID, channelID, sequence, acknowledgement,
+		)
+	}
+
+	return k.connectionKeeper.VerifyPacketAcknowledgement(
+		ctx, connectionEnd, proofHeight,
+		packetDelay.TimeDelay, packetDelay.BlockDelay, proof,
+		portID, channelID, sequence, acknowledgement,
+	)
+}
+
+// verifyPacketCommitments verifies the commitments stored by the counterparty
+// for a batch of packets sent on the same channel. A batch proof is only
+// supported on single-hop channels, the proofs of a multi-hop channel are
+// verified one by one.
+func (k Keeper) verifyPacketCommitments(
+	ctx sdk.Context,
+	connectionEnd connectiontypes.ConnectionEnd,
+	connectionHops []string,
+	proofHeight exported.Height,
+	packetDelay types.PacketDelay,
+	proofs [][]byte,
+	proofBatch []byte,
+	portID,
+	channelID string,
+	sequences []uint64,
+	commitments [][]byte,
+) error {
+	if isMultihop(connectionHops) {
+		if len(proofBatch) != 0 || len(proofs) != len(sequences) {
+			return sdkerrors.Wrapf(multihoptypes.ErrInvalidMultihopProof, "expected a multi-hop proof for each of the %d packets", len(sequences))
+		}
+
+		for i := range sequences {
+			if err := k.verifyPacketCommitment(
+				ctx, connectionEnd, connectionHops, proofHeight, packetDelay, proofs[i],
+				portID, channelID, sequences[i], commitments[i],
+			); err != nil {
+				return sdkerrors.Wrapf(err, "proof at index %d", i)
+			}
+		}
+
+		return nil
+	}
+
+	return k.connectionKeeper.VerifyPacketCommitments(
+		ctx, connectionEnd, proofHeight,
+		packetDelay.TimeDelay, packetDelay.BlockDelay, proofs, proofBatch,
+		portID, channelID, sequences, commitments,
+	)
+}
+
+// verifyPacketAcknowledgements verifies the acknowledgements written by the
+// counterparty for a batch of packets sent on the same channel. A batch proof
+// is only supported on single-hop channels, the proofs of a multi-hop channel
+// are verified one by one.
+func (k Keeper) verifyPacketAcknowledgements(
+	ctx sdk.Context,
+	connectionEnd connectiontypes.ConnectionEnd,
+	connectionHops []string,
+	proofHeight exported.Height,
+	packetDelay types.PacketDelay,
+	proofs [][]byte,
+	proofBatch []byte,
+	portID,
+	channelID string,
+	sequences []uint64,
+	acknowledgements [][]byte,
+) error {
+	if isMultihop(connectionHops) {
+		if len(proofBatch) != 0 || len(proofs) != len(sequences) {
+			return sdkerrors.Wrapf(multihoptypes.ErrInvalidMultihopProof, "expected a multi-hop proof for each of the %d packets", len(sequences))
+		}
+
+		for i := range sequences {
+			if err := k.verifyPacketAcknowledgement(
+				ctx, connectionEnd, connectionHops, proofHeight, packetDelay, proofs[i],
+				portID, channelID, sequences[i], acknowledgements[i],
+			); err != nil {
+				return sdkerrors.Wrapf(err, "proof at index %d", i)
+			}
+		}
+
+		return nil
+	}
+
+	return k.connectionKeeper.VerifyPacketAcknowledgements(
+		ctx, connectionEnd, proofHeight,
+		packetDelay.TimeDelay, packetDelay.BlockDelay, proofs, proofBatch,
+		portID, channelID, sequences, acknowledgements,
+	)
+}
+
+// verifyPacketReceiptAbsence verifies the absence of the packet receipt on the
+// counterparty over the connection hops of the channel.
+func (k Keeper) verifyPacketReceiptAbsence(
+	ctx sdk.Context,
+	connectionEnd connectiontypes.ConnectionEnd,
+	connectionHops []string,
+	proofHeight exported.Height,
+	packetDelay types.PacketDelay,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+) error {
+	if isMultihop(connectionHops) {
+		return k.connectionKeeper.VerifyMultihopPacketReceiptAbsence(
+			ctx, connectionEnd, connectionHops, proofHeight,
+			packetDelay.TimeDelay, packetDelay.BlockDelay, proof,
+			portID, channelID, sequence,
+		)
+	}
+
+	return k.connectionKeeper.VerifyPacketReceiptAbsence(
+		ctx, connectionEnd, proofHeight,
+		packetDelay.TimeDelay, packetDelay.BlockDelay, proof,
+		portID, channelID, sequence,
+	)
+}
+
+// verifyNextSequenceRecv verifies the next receive sequence of the counterparty
+// over the connection hops of the channel.
+func (k Keeper) verifyNextSequenceRecv(
+	ctx sdk.Context,
+	connectionEnd connectiontypes.ConnectionEnd,
+	connectionHops []string,
+	proofHeight exported.Height,
+	packetDelay types.PacketDelay,
+	proof []byte,
+	portID,
+	channelID string,
+	nextSequenceRecv uint64,
+) error {
+	if isMultihop(connectionHops) {
+		return k.connectionKeeper.VerifyMultihopNextSequenceRecv(
+			ctx, connectionEnd, connectionHops, proofHeight,
+			packetDelay.TimeDelay, packetDelay.BlockDelay, proof,
+			portID, channelID, nextSequenceRecv,
+		)
+	}
+
+	return k.connectionKeeper.VerifyNextSequenceRecv(
+		ctx, connectionEnd, proofHeight,
+		packetDelay.TimeDelay, packetDelay.BlockDelay, proof,
+		portID, channelID, nextSequenceRecv,
+	)
+}
+
+// verifyNextSequenceAck verifies the next acknowledgement sequence of the
+// counterparty over the connection hops of the channel.
+func (k Keeper) verifyNextSequenceAck(
+	ctx sdk.Context,
+	connectionEnd connectiontypes.ConnectionEnd,
+	connectionHops []string,
+	proofHeight exported.Height,
+	packetDelay types.PacketDelay,
+	proof []byte,
+	portID,
+	channelID string,
+	nextSequenceAck uint64,
+) error {
+	if isMultihop(connectionHops) {
+		return k.connectionKeeper.VerifyMultihopNextSequenceAck(
+			ctx, connectionEnd, connectionHops, proofHeight,
+			packetDelay.TimeDelay, packetDelay.BlockDelay, proof,
+			portID, channelID, nextSequenceAck,
+		)
+	}
+
+	return k.connectionKeeper.VerifyNextSequenceAck(
+		ctx, connectionEnd, proofHeight,
+		packetDelay.TimeDelay, packetDelay.BlockDelay, proof,
+		portID, channelID, nextSequenceAck,
+	)
+}
@@ -0,0 +1,381 @@
+package keeper_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	multihoptypes "github.com/cosmos/ibc-go/v3/modules/core/33-multihop/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+// MultihopTestSuite is a testing suite to test multi-hop channels opened between
+// chainA and chainC over chainB.
+type MultihopTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+	chainC *ibctesting.TestChain
+}
+
+// TestMultihopTestSuite runs all the multi-hop tests within this package.
+func TestMultihopTestSuite(t *testing.T) {
+	suite.Run(t, new(MultihopTestSuite))
+}
+
+// SetupTest creates a coordinator with 3 test chains.
+func (suite *MultihopTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 3)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+	suite.chainC = suite.coordinator.GetChain(ibctesting.GetChainID(3))
+	// commit some blocks so that QueryProof returns valid proof (cannot return valid query if height <= 1)
+	suite.coordinator.CommitNBlocks(suite.chainA, 2)
+	suite.coordinator.CommitNBlocks(suite.chainB, 2)
+	suite.coordinator.CommitNBlocks(suite.chainC, 2)
+}
+
+func (suite *MultihopTestSuite) TestMultihopChannelHandshake() {
+	for _, order := range []types.Order{types.UNORDERED, types.ORDERED} {
+		suite.Run(order.String(), func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewMultihopPath(suite.chainA, suite.chainB, suite.chainC)
+			path.EndpointA.ChannelConfig.Order = order
+			path.EndpointZ.ChannelConfig.Order = order
+			suite.coordinator.SetupMultihop(path)
+
+			channelA := path.EndpointA.GetChannel()
+			suite.Require().Equal(types.OPEN, channelA.State)
+			suite.Require().Equal([]string{path.Paths[0].EndpointA.ConnectionID, path.Paths[1].EndpointA.ConnectionID}, channelA.ConnectionHops)
+			suite.Require().Equal(path.EndpointZ.ChannelID, channelA.Counterparty.ChannelId)
+
+			channelZ := path.EndpointZ.GetChannel()
+			suite.Require().Equal(types.OPEN, channelZ.State)
+			suite.Require().Equal([]string{path.Paths[1].EndpointB.ConnectionID, path.Paths[0].EndpointB.ConnectionID}, channelZ.ConnectionHops)
+			suite.Require().Equal(path.EndpointA.ChannelID, channelZ.Counterparty.ChannelId)
+		})
+	}
+}
+
+func (suite *MultihopTestSuite) TestMultihopChanOpenTry() {
+	var (
+		path           *ibctesting.MultihopPath
+		connectionHops []string
+		channelKey     []byte
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"connection hops do not lead to the counterparty", func() {
+			// reverse the connection hops of chainC
+			path.EndpointZ.Hops[0], path.EndpointZ.Hops[1] = path.EndpointZ.Hops[1], path.EndpointZ.Hops[0]
+			connectionHops = path.EndpointZ.ConnectionHops()
+		}, false},
+		{"missing connection hop", func() {
+			connectionHops = connectionHops[:1]
+		}, false},
+		{"counterparty channel is not proven", func() {
+			channelKey = host.ChannelKey(path.EndpointA.ChannelConfig.PortID, ibctesting.InvalidID)
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewMultihopPath(suite.chainA, suite.chainB, suite.chainC)
+			for _, p := range path.Paths {
+				suite.coordinator.SetupConnections(p)
+			}
+
+			err := path.EndpointA.ChanOpenInit()
+			suite.Require().NoError(err)
+
+			connectionHops = path.EndpointZ.ConnectionHops()
+			channelKey = host.ChannelKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+			tc.malleate()
+
+			proof, proofHeight := path.EndpointZ.QueryMultihopProof(channelKey)
+			portCap := suite.chainC.GetPortCapability(path.EndpointZ.ChannelConfig.PortID)
+			counterparty := types.NewCounterparty(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+			channelID, capability, err := suite.chainC.App.GetIBCKeeper().ChannelKeeper.ChanOpenTry(
+				suite.chainC.GetContext(), types.UNORDERED, connectionHops, path.EndpointZ.ChannelConfig.PortID, "",
+				portCap, counterparty, path.EndpointA.ChannelConfig.Version, proof, proofHeight,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotEmpty(channelID)
+				suite.Require().NotNil(capability)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MultihopTestSuite) TestMultihopPacketFlow() {
+	for _, order := range []types.Order{types.UNORDERED, types.ORDERED} {
+		suite.Run(order.String(), func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewMultihopPath(suite.chainA, suite.chainB, suite.chainC)
+			path.EndpointA.ChannelConfig.Order = order
+			path.EndpointZ.ChannelConfig.Order = order
+			suite.coordinator.SetupMultihop(path)
+
+			timeoutHeight := clienttypes.NewHeight(0, 1000)
+			packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointZ.ChannelConfig.PortID, path.EndpointZ.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+
+			err = path.EndpointZ.RecvPacket(packet)
+			suite.Require().NoError(err)
+
+			ack, found := suite.chainC.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainC.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+			suite.Require().True(found)
+			suite.Require().Equal(types.CommitAcknowledgement(ibctesting.MockAcknowledgement), ack)
+
+			err = path.EndpointA.AcknowledgePacket(packet, ibctesting.MockAcknowledgement)
+			suite.Require().NoError(err)
+
+			commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+			suite.Require().Empty(commitment)
+		})
+	}
+}
+
+// TestMultihopSendPacketTimeout tests that a packet is not sent on a multi-hop channel
+// once it is timed out according to the latest consensus state of the receiving chain
+// verified in a multi-hop proof.
+func (suite *MultihopTestSuite) TestMultihopSendPacketTimeout() {
+	var (
+		path             *ibctesting.MultihopPath
+		timeoutHeight    clienttypes.Height
+		timeoutTimestamp uint64
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func(consensusHeight exported.Height, consensusTimestamp uint64)
+		expError error
+	}{
+		{"success", func(consensusHeight exported.Height, consensusTimestamp uint64) {
+			timeoutHeight = clienttypes.NewHeight(consensusHeight.GetRevisionNumber(), consensusHeight.GetRevisionHeight()+1)
+			timeoutTimestamp = consensusTimestamp + 1
+		}, nil},
+		{"timeout height reached", func(consensusHeight exported.Height, _ uint64) {
+			timeoutHeight = clienttypes.NewHeight(consensusHeight.GetRevisionNumber(), consensusHeight.GetRevisionHeight())
+		}, types.ErrPacketTimeout},
+		{"timeout timestamp reached", func(_ exported.Height, consensusTimestamp uint64) {
+			timeoutTimestamp = consensusTimestamp
+		}, types.ErrPacketTimeout},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewMultihopPath(suite.chainA, suite.chainB, suite.chainC)
+			suite.coordinator.SetupMultihop(path)
+
+			// the consensus state of chainC was verified in the proof of the channel handshake
+			consensusState, consensusHeight, found := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetLastHopConsensusState(
+				suite.chainA.GetContext(), path.EndpointA.GetChannel().ConnectionHops,
+			)
+			suite.Require().True(found)
+
+			timeoutHeight = clienttypes.NewHeight(0, 1000)
+			timeoutTimestamp = disabledTimeoutTimestamp
+			tc.malleate(consensusHeight, consensusState.GetTimestamp())
+
+			packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointZ.ChannelConfig.PortID, path.EndpointZ.ChannelID, timeoutHeight, timeoutTimestamp)
+
+			err := path.EndpointA.SendPacket(packet)
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *MultihopTestSuite) TestMultihopRecvPacketInvalidProof() {
+	path := ibctesting.NewMultihopPath(suite.chainA, suite.chainB, suite.chainC)
+	suite.coordinator.SetupMultihop(path)
+
+	timeoutHeight := clienttypes.NewHeight(0, 1000)
+	packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointZ.ChannelConfig.PortID, path.EndpointZ.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+
+	err := path.EndpointA.SendPacket(packet)
+	suite.Require().NoError(err)
+
+	packetKey := []byte("invalid key")
+	proof, proofHeight := path.EndpointZ.QueryMultihopProof(packetKey)
+
+	channelCap := suite.chainC.GetChannelCapability(packet.GetDestPort(), packet.GetDestChannel())
+	err = suite.chainC.App.GetIBCKeeper().ChannelKeeper.RecvPacket(suite.chainC.GetContext(), channelCap, packet, proof, proofHeight)
+	suite.Require().Error(err)
+}
+
+func (suite *MultihopTestSuite) TestMultihopRecvPacketDelayOverflow() {
+	path := ibctesting.NewMultihopPath(suite.chainA, suite.chainB, suite.chainC)
+	suite.coordinator.SetupMultihop(path)
+
+	// the channel delay overflows the connection delay period
+	connection := path.Paths[1].EndpointB.GetConnection()
+	connection.DelayPeriod = 1
+	path.Paths[1].EndpointB.SetConnection(connection)
+	suite.chainC.App.GetIBCKeeper().ChannelKeeper.SetPacketDelay(
+		suite.chainC.GetContext(), path.EndpointZ.ChannelConfig.PortID, path.EndpointZ.ChannelID, types.PacketDelay{TimeDelay: math.MaxUint64},
+	)
+
+	timeoutHeight := clienttypes.NewHeight(0, 1000)
+	packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointZ.ChannelConfig.PortID, path.EndpointZ.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+
+	err := path.EndpointA.SendPacket(packet)
+	suite.Require().NoError(err)
+
+	packetKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	proof, proofHeight := path.EndpointZ.QueryMultihopProof(packetKey)
+
+	channelCap := suite.chainC.GetChannelCapability(packet.GetDestPort(), packet.GetDestChannel())
+	err = suite.chainC.App.GetIBCKeeper().ChannelKeeper.RecvPacket(suite.chainC.GetContext(), channelCap, packet, proof, proofHeight)
+	suite.Require().ErrorIs(err, types.ErrInvalidPacketDelay)
+}
+
+func (suite *MultihopTestSuite) TestMultihopTimeoutPacket() {
+	for _, order := range []types.Order{types.UNORDERED, types.ORDERED} {
+		suite.Run(order.String(), func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewMultihopPath(suite.chainA, suite.chainB, suite.chainC)
+			path.EndpointA.ChannelConfig.Order = order
+			path.EndpointZ.ChannelConfig.Order = order
+			suite.coordinator.SetupMultihop(path)
+
+			// the timeout height is a height of chainC, which is not tracked by chainA
+			timeoutHeight := clienttypes.GetSelfHeight(suite.chainC.GetContext())
+			packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointZ.ChannelConfig.PortID, path.EndpointZ.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+
+			err = path.EndpointA.TimeoutPacket(packet)
+			suite.Require().NoError(err)
+
+			commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+			suite.Require().Empty(commitment)
+
+			if order == types.ORDERED {
+				suite.Require().Equal(types.CLOSED, path.EndpointA.GetChannel().State)
+			}
+		})
+	}
+}
+
+func (suite *MultihopTestSuite) TestMultihopTimeoutPacketNotReached() {
+	path := ibctesting.NewMultihopPath(suite.chainA, suite.chainB, suite.chainC)
+	suite.coordinator.SetupMultihop(path)
+
+	timeoutHeight := clienttypes.NewHeight(0, 1000)
+	packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointZ.ChannelConfig.PortID, path.EndpointZ.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+
+	err := path.EndpointA.SendPacket(packet)
+	suite.Require().NoError(err)
+
+	packetKey := host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	proof, proofHeight := path.EndpointA.QueryMultihopProof(packetKey)
+
+	err = suite.chainA.App.GetIBCKeeper().ChannelKeeper.TimeoutPacket(suite.chainA.GetContext(), packet, proof, proofHeight, 1)
+	suite.Require().ErrorIs(err, types.ErrPacketTimeout)
+}
+
+func (suite *MultihopTestSuite) TestChanCloseFrozen() {
+	var path *ibctesting.MultihopPath
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expError error
+	}{
+		{"success", func() {
+			// freeze the client of chainB tracking chainC
+			endpoint := path.Paths[1].EndpointA
+			cs, ok := endpoint.GetClientState().(*ibctmtypes.ClientState)
+			suite.Require().True(ok)
+
+			cs.FrozenHeight = clienttypes.NewHeight(0, 1)
+			endpoint.SetClientState(cs)
+			suite.coordinator.CommitBlock(suite.chainB)
+		}, nil},
+		{"client is not frozen", func() {}, multihoptypes.ErrClientNotFrozen},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewMultihopPath(suite.chainA, suite.chainB, suite.chainC)
+			suite.coordinator.SetupMultihop(path)
+
+			tc.malleate()
+
+			proofConnection, proofClientState, proofHeight := path.EndpointA.QueryClientFrozenProofs(1)
+
+			channelCap := suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.ChanCloseFrozen(
+				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, channelCap,
+				proofConnection, proofClientState, proofHeight,
+			)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(types.CLOSED, path.EndpointA.GetChannel().State)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Equal(types.OPEN, path.EndpointA.GetChannel().State)
+			}
+		})
+	}
+}
+
+func (suite *MultihopTestSuite) TestChanCloseFrozenMsg() {
+	path := ibctesting.NewMultihopPath(suite.chainA, suite.chainB, suite.chainC)
+	suite.coordinator.SetupMultihop(path)
+
+	// freeze the client of chainB tracking chainC
+	endpoint := path.Paths[1].EndpointA
+	cs, ok := endpoint.GetClientState().(*ibctmtypes.ClientState)
+	suite.Require().True(ok)
+
+	cs.FrozenHeight = clienttypes.NewHeight(0, 1)
+	endpoint.SetClientState(cs)
+	suite.coordinator.CommitBlock(suite.chainB)
+
+	err := path.EndpointA.ChanCloseFrozen(1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.CLOSED, path.EndpointA.GetChannel().State)
+}
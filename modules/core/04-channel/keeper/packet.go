@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"bytes"
+	"math"
 	"strconv"
 	"time"
 
@@ -40,6 +41,10 @@ func (k Keeper) SendPacket(
 		)
 	}
 
+	if k.IsChannelHalted(ctx, packet.GetSourcePort(), packet.GetSourceChannel()) {
+		return sdkerrors.Wrapf(types.ErrChannelHalted, "port ID (%s) channel ID (%s)", packet.GetSourcePort(), packet.GetSourceChannel())
+	}
+
 	if !k.scopedKeeper.AuthenticateCapability(ctx, channelCap, host.ChannelCapabilityPath(packet.GetSourcePort(), packet.GetSourceChannel())) {
 		return sdkerrors.Wrapf(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)", packet.GetSourcePort(), packet.GetSourceChannel())
 	}
@@ -69,39 +74,36 @@ func (k Keeper) SendPacket(
 	}
 
 	// prevent accidental sends with clients that cannot be updated
-	clientStore := k.clientKeeper.ClientStore(ctx, connectionEnd.GetClientID())
+	clientStore := k.clientKeeper.ReadOnlyClientStore(ctx, connectionEnd.GetClientID())
 	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
 		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "cannot send packet using client (%s) with status %s", connectionEnd.GetClientID(), status)
 	}
 
-	// check if packet is timed out on the receiving chain
-	latestHeight := clientState.GetLatestHeight()
+	// check if packet is timed out on the receiving chain. The client of a
+	// multi-hop channel tracks the first chain of the path rather than the
+	// receiving chain, the latest consensus state of the receiving chain
+	// verified in a multi-hop proof is used instead.
 	timeoutHeight := packet.GetTimeoutHeight()
-	if !timeoutHeight.IsZero() && latestHeight.GTE(timeoutHeight) {
-		return sdkerrors.Wrapf(
-			types.ErrPacketTimeout,
-			"receiving chain block height >= packet timeout height (%s >= %s)", latestHeight, timeoutHeight,
-		)
-	}
-
-	clientType, _, err := clienttypes.ParseClientIdentifier(connectionEnd.GetClientID())
-	if err != nil {
-		return err
-	}
+	if !isMultihop(channel.ConnectionHops) {
+		latestHeight := clientState.GetLatestHeight()
+
+		// NOTE: this is a temporary fix. Solo machine does not support usage of 'GetTimestampAtHeight'
+		// A future change should move this function to be a ClientState callback.
+		var latestTimestamp uint64
+		if clientState.ClientType() != exported.Solomachine {
+			var err error
+			latestTimestamp, err = k.connectionKeeper.GetTimestampAtHeight(ctx, connectionEnd, latestHeight)
+			if err != nil {
+				return err
+			}
+		}
 
-	// NOTE: this is a temporary fix. Solo machine does not support usage of 'GetTimestampAtHeight'
-	// A future change should move this function to be a ClientState callback.
-	if clientType != exported.Solomachine {
-		latestTimestamp, err := k.connectionKeeper.GetTimestampAtHeight(ctx, connectionEnd, latestHeight)
-		if err != nil {
+		if err := checkPacketTimeout(packet, latestHeight, latestTimestamp); err != nil {
 			return err
 		}
-
-		if packet.GetTimeoutTimestamp() != 0 && latestTimestamp >= packet.GetTimeoutTimestamp() {
-			return sdkerrors.Wrapf(
-				types.ErrPacketTimeout,
-				"receiving chain block timestamp >= packet timeout timestamp (%s >= %s)", time.Unix(0, int64(latestTimestamp)), time.Unix(0, int64(packet.GetTimeoutTimestamp())),
-			)
+	} else if consensusState, latestHeight, found := k.connectionKeeper.GetLastHopConsensusState(ctx, channel.ConnectionHops); found {
+		if err := checkPacketTimeout(packet, latestHeight, consensusState.GetTimestamp()); err != nil {
+			return err
 		}
 	}
 
@@ -126,6 +128,16 @@ func (k Keeper) SendPacket(
 	k.SetNextSequenceSend(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), nextSequenceSend)
 	k.SetPacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), commitment)
 
+	// record the full packet data until the packet is acknowledged or timed out
+	if k.GetStorePacketData(ctx) {
+		k.SetPacketData(ctx, types.NewPacket(
+			packet.GetData(), packet.GetSequence(),
+			packet.GetSourcePort(), packet.GetSourceChannel(),
+			packet.GetDestPort(), packet.GetDestChannel(),
+			clienttypes.NewHeight(timeoutHeight.GetRevisionNumber(), timeoutHeight.GetRevisionHeight()), packet.GetTimeoutTimestamp(),
+		))
+	}
+
 	EmitSendPacketEvent(ctx, packet, channel, timeoutHeight)
 
 	k.Logger(ctx).Info(
@@ -140,6 +152,111 @@ func (k Keeper) SendPacket(
 	return nil
 }
 
+// checkPacketTimeout returns an error if the packet is timed out on the receiving chain
+// given the latest known height and timestamp of the receiving chain. A zero latest
+// timestamp is not checked.
+func checkPacketTimeout(packet exported.PacketI, latestHeight exported.Height, latestTimestamp uint64) error {
+	timeoutHeight := packet.GetTimeoutHeight()
+	if !timeoutHeight.IsZero() && latestHeight.GTE(timeoutHeight) {
+		return sdkerrors.Wrapf(
+			types.ErrPacketTimeout,
+			"receiving chain block height >= packet timeout height (%s >= %s)", latestHeight, timeoutHeight,
+		)
+	}
+
+	if latestTimestamp != 0 && packet.GetTimeoutTimestamp() != 0 && latestTimestamp >= packet.GetTimeoutTimestamp() {
+		return sdkerrors.Wrapf(
+			types.ErrPacketTimeout,
+			"receiving chain block timestamp >= packet timeout timestamp (%s >= %s)", time.Unix(0, int64(latestTimestamp)), time.Unix(0, int64(packet.GetTimeoutTimestamp())),
+		)
+	}
+
+	return nil
+}
+
+// ResolveRelativeTimeout converts a timeout height and timestamp relative to the
+// latest consensus state of the channel's client into the absolute timeouts to be
+// set on a packet sent over the channel. The relative timeout height is added to the
+// latest height of the client. The relative timeout timestamp is added to the later
+// of the current block time and the timestamp of the latest consensus state. A
+// relative timeout set to 0 remains disabled. An error is returned if an absolute
+// timeout overflows.
+func (k Keeper) ResolveRelativeTimeout(
+	ctx sdk.Context,
+	portID, channelID string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) (clienttypes.Height, uint64, error) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return clienttypes.ZeroHeight(), 0, sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	// the client of a multi-hop channel tracks the first chain of the path rather
+	// than the receiving chain, so its height cannot be used as a reference
+	if !timeoutHeight.IsZero() && isMultihop(channel.ConnectionHops) {
+		return clienttypes.ZeroHeight(), 0, sdkerrors.Wrapf(
+			types.ErrInvalidChannel,
+			"relative timeout height is not supported for multi-hop channels, port ID (%s) channel ID (%s)", portID, channelID,
+		)
+	}
+
+	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	if !found {
+		return clienttypes.ZeroHeight(), 0, sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
+	}
+
+	clientState, found := k.clientKeeper.GetClientState(ctx, connectionEnd.GetClientID())
+	if !found {
+		return clienttypes.ZeroHeight(), 0, sdkerrors.Wrap(clienttypes.ErrClientNotFound, connectionEnd.GetClientID())
+	}
+
+	latestHeight := clientState.GetLatestHeight()
+
+	absoluteHeight := clienttypes.ZeroHeight()
+	if !timeoutHeight.IsZero() {
+		if timeoutHeight.RevisionNumber > math.MaxUint64-latestHeight.GetRevisionNumber() ||
+			timeoutHeight.RevisionHeight > math.MaxUint64-latestHeight.GetRevisionHeight() {
+			return clienttypes.ZeroHeight(), 0, sdkerrors.Wrapf(
+				types.ErrInvalidPacket, "relative timeout height %s overflows the latest client height %s", timeoutHeight, latestHeight,
+			)
+		}
+
+		absoluteHeight = clienttypes.NewHeight(
+			latestHeight.GetRevisionNumber()+timeoutHeight.RevisionNumber,
+			latestHeight.GetRevisionHeight()+timeoutHeight.RevisionHeight,
+		)
+	}
+
+	var absoluteTimestamp uint64
+	if timeoutTimestamp != 0 {
+		referenceTimestamp := uint64(ctx.BlockTime().UnixNano())
+
+		// NOTE: Solo machine does not support usage of 'GetTimestampAtHeight',
+		// the block time is used as the only reference instead.
+		if clientState.ClientType() != exported.Solomachine {
+			latestTimestamp, err := k.connectionKeeper.GetTimestampAtHeight(ctx, connectionEnd, latestHeight)
+			if err != nil {
+				return clienttypes.ZeroHeight(), 0, err
+			}
+
+			if latestTimestamp > referenceTimestamp {
+				referenceTimestamp = latestTimestamp
+			}
+		}
+
+		if timeoutTimestamp > math.MaxUint64-referenceTimestamp {
+			return clienttypes.ZeroHeight(), 0, sdkerrors.Wrapf(
+				types.ErrInvalidPacket, "relative timeout timestamp %d overflows the reference timestamp %d", timeoutTimestamp, referenceTimestamp,
+			)
+		}
+
+		absoluteTimestamp = referenceTimestamp + timeoutTimestamp
+	}
+
+	return absoluteHeight, absoluteTimestamp, nil
+}
+
 // RecvPacket is called by a module in order to receive & process an IBC packet
 // sent on the corresponding channel end on the counterparty chain.
 func (k Keeper) RecvPacket(
@@ -149,27 +266,89 @@ func (k Keeper) RecvPacket(
 	proof []byte,
 	proofHeight exported.Height,
 ) error {
-	channel, found := k.GetChannel(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	channel, connectionEnd, err := k.getOpenPacketChannel(ctx, chanCap, packet.GetDestPort(), packet.GetDestChannel())
+	if err != nil {
+		return err
+	}
+
+	if k.IsChannelHalted(ctx, packet.GetDestPort(), packet.GetDestChannel()) {
+		return sdkerrors.Wrapf(types.ErrChannelHalted, "port ID (%s) channel ID (%s)", packet.GetDestPort(), packet.GetDestChannel())
+	}
+
+	if err := validateRecvPacket(ctx, channel, packet); err != nil {
+		return err
+	}
+
+	commitment := types.CommitPacket(k.cdc, packet)
+
+	// verify that the counterparty did commit to sending this packet, applying
+	// the additional packet delay declared by the channel
+	packetDelay := k.GetPacketDelay(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if err := k.verifyPacketCommitment(
+		ctx, connectionEnd, channel.ConnectionHops, proofHeight, packetDelay, proof,
+		packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(),
+		commitment,
+	); err != nil {
+		return sdkerrors.Wrap(err, "couldn't verify counterparty packet commitment")
+	}
+
+	return k.receivePacket(ctx, channel, packet)
+}
+
+// getOpenPacketChannel returns a channel on which packets are processed and its
+// connection end. Both must be OPEN and the capability must authenticate the
+// channel.
+func (k Keeper) getOpenPacketChannel(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	portID,
+	channelID string,
+) (types.Channel, connectiontypes.ConnectionEnd, error) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
 	if !found {
-		return sdkerrors.Wrap(types.ErrChannelNotFound, packet.GetDestChannel())
+		return types.Channel{}, connectiontypes.ConnectionEnd{}, sdkerrors.Wrapf(
+			types.ErrChannelNotFound,
+			"port ID (%s) channel ID (%s)", portID, channelID,
+		)
 	}
 
 	if channel.State != types.OPEN {
-		return sdkerrors.Wrapf(
+		return types.Channel{}, connectiontypes.ConnectionEnd{}, sdkerrors.Wrapf(
 			types.ErrInvalidChannelState,
 			"channel state is not OPEN (got %s)", channel.State.String(),
 		)
 	}
 
-	// Authenticate capability to ensure caller has authority to receive packet on this channel
-	capName := host.ChannelCapabilityPath(packet.GetDestPort(), packet.GetDestChannel())
+	// Authenticate capability to ensure caller has authority to process packets on this channel
+	capName := host.ChannelCapabilityPath(portID, channelID)
 	if !k.scopedKeeper.AuthenticateCapability(ctx, chanCap, capName) {
-		return sdkerrors.Wrapf(
+		return types.Channel{}, connectiontypes.ConnectionEnd{}, sdkerrors.Wrapf(
 			types.ErrInvalidChannelCapability,
 			"channel capability failed authentication for capability name %s", capName,
 		)
 	}
 
+	// Connection must be OPEN to process a packet. It is possible for connection to not yet be open if packet was
+	// sent optimistically before connection and channel handshake completed. However, to receive a packet,
+	// connection and channel must both be open
+	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	if !found {
+		return types.Channel{}, connectiontypes.ConnectionEnd{}, sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
+	}
+
+	if connectionEnd.GetState() != int32(connectiontypes.OPEN) {
+		return types.Channel{}, connectiontypes.ConnectionEnd{}, sdkerrors.Wrapf(
+			connectiontypes.ErrInvalidConnectionState,
+			"connection state is not OPEN (got %s)", connectiontypes.State(connectionEnd.GetState()).String(),
+		)
+	}
+
+	return channel, connectionEnd, nil
+}
+
+// validateRecvPacket checks that a packet received on the given channel comes
+// from the counterparty of the channel and has not timed out.
+func validateRecvPacket(ctx sdk.Context, channel types.Channel, packet exported.PacketI) error {
 	// packet must come from the channel's counterparty
 	if packet.GetSourcePort() != channel.Counterparty.PortId {
 		return sdkerrors.Wrapf(
@@ -185,21 +364,6 @@ func (k Keeper) RecvPacket(
 		)
 	}
 
-	// Connection must be OPEN to receive a packet. It is possible for connection to not yet be open if packet was
-	// sent optimistically before connection and channel handshake completed. However, to receive a packet,
-	// connection and channel must both be open
-	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
-	if !found {
-		return sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
-	}
-
-	if connectionEnd.GetState() != int32(connectiontypes.OPEN) {
-		return sdkerrors.Wrapf(
-			connectiontypes.ErrInvalidConnectionState,
-			"connection state is not OPEN (got %s)", connectiontypes.State(connectionEnd.GetState()).String(),
-		)
-	}
-
 	// check if packet timeouted by comparing it with the latest height of the chain
 	selfHeight := clienttypes.GetSelfHeight(ctx)
 	timeoutHeight := packet.GetTimeoutHeight()
@@ -218,22 +382,20 @@ func (k Keeper) RecvPacket(
 		)
 	}
 
-	commitment := types.CommitPacket(k.cdc, packet)
-
-	// verify that the counterparty did commit to sending this packet
-	if err := k.connectionKeeper.VerifyPacketCommitment(
-		ctx, connectionEnd, proofHeight, proof,
-		packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(),
-		commitment,
-	); err != nil {
-		return sdkerrors.Wrap(err, "couldn't verify counterparty packet commitment")
-	}
+	return nil
+}
 
+// receivePacket writes the receipt or increments the next receive sequence of a
+// packet whose commitment has been verified. ErrNoOpMsg is returned if the packet
+// has already been received.
+func (k Keeper) receivePacket(ctx sdk.Context, channel types.Channel, packet exported.PacketI) error {
 	switch channel.Ordering {
 	case types.UNORDERED:
-		// check if the packet receipt has been received already for unordered channels
+		// check if the packet receipt has been received already for unordered channels.
+		// Packets below the pruning sequence end have been received or timed out, and
+		// their receipts may have been pruned.
 		_, found := k.GetPacketReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
-		if found {
+		if found || k.isPruned(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()) {
 			EmitRecvPacketEvent(ctx, packet, channel)
 			// This error indicates that the packet has already been relayed. Core IBC will
 			// treat this error as a no-op in order to prevent an entire relay transaction
@@ -305,7 +467,9 @@ func (k Keeper) RecvPacket(
 //
 // 1) For synchronous execution, this function is be called in the IBC handler .
 // For async handling, it needs to be called directly by the module which originally
-// processed the packet.
+// processed the packet. If the asynchronous acknowledgement limits of the channel
+// params have elapsed, an error acknowledgement has already been written and
+// ErrAcknowledgementExists is returned.
 //
 // 2) Assumes that packet receipt has been written (unordered), or nextSeqRecv was incremented (ordered)
 // previously by RecvPacket.
@@ -336,6 +500,18 @@ func (k Keeper) WriteAcknowledgement(
 		)
 	}
 
+	return k.writeAcknowledgement(ctx, packet, channel, acknowledgement)
+}
+
+// writeAcknowledgement writes the acknowledgement of a received packet once the
+// caller has been authenticated, and removes any record of the packet pending
+// an asynchronous acknowledgement.
+func (k Keeper) writeAcknowledgement(
+	ctx sdk.Context,
+	packet exported.PacketI,
+	channel types.Channel,
+	acknowledgement exported.Acknowledgement,
+) error {
 	// NOTE: IBC app modules might have written the acknowledgement synchronously on
 	// the OnRecvPacket callback so we need to check if the acknowledgement is already
 	// set on the store and return an error if so.
@@ -357,6 +533,7 @@ func (k Keeper) WriteAcknowledgement(
 		ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
 		types.CommitAcknowledgement(bz),
 	)
+	k.deletePendingAsyncAck(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
 
 	// log that a packet acknowledgement has been written
 	k.Logger(ctx).Info(
@@ -378,7 +555,8 @@ func (k Keeper) WriteAcknowledgement(
 // module on the counterparty chain. Its intended usage is within the ante
 // handler. AcknowledgePacket will clean up the packet commitment,
 // which is no longer necessary since the packet has been received and acted upon.
-// It will also increment NextSequenceAck in case of ORDERED channels.
+// It will also increment NextSequenceAck in case of ORDERED channels, and advance it
+// past the acknowledged and timed out packets in case of UNORDERED channels.
 func (k Keeper) AcknowledgePacket(
 	ctx sdk.Context,
 	chanCap *capabilitytypes.Capability,
@@ -387,55 +565,13 @@ func (k Keeper) AcknowledgePacket(
 	proof []byte,
 	proofHeight exported.Height,
 ) error {
-	channel, found := k.GetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
-	if !found {
-		return sdkerrors.Wrapf(
-			types.ErrChannelNotFound,
-			"port ID (%s) channel ID (%s)", packet.GetSourcePort(), packet.GetSourceChannel(),
-		)
-	}
-
-	if channel.State != types.OPEN {
-		return sdkerrors.Wrapf(
-			types.ErrInvalidChannelState,
-			"channel state is not OPEN (got %s)", channel.State.String(),
-		)
-	}
-
-	// Authenticate capability to ensure caller has authority to receive packet on this channel
-	capName := host.ChannelCapabilityPath(packet.GetSourcePort(), packet.GetSourceChannel())
-	if !k.scopedKeeper.AuthenticateCapability(ctx, chanCap, capName) {
-		return sdkerrors.Wrapf(
-			types.ErrInvalidChannelCapability,
-			"channel capability failed authentication for capability name %s", capName,
-		)
-	}
-
-	// packet must have been sent to the channel's counterparty
-	if packet.GetDestPort() != channel.Counterparty.PortId {
-		return sdkerrors.Wrapf(
-			types.ErrInvalidPacket,
-			"packet destination port doesn't match the counterparty's port (%s ≠ %s)", packet.GetDestPort(), channel.Counterparty.PortId,
-		)
-	}
-
-	if packet.GetDestChannel() != channel.Counterparty.ChannelId {
-		return sdkerrors.Wrapf(
-			types.ErrInvalidPacket,
-			"packet destination channel doesn't match the counterparty's channel (%s ≠ %s)", packet.GetDestChannel(), channel.Counterparty.ChannelId,
-		)
-	}
-
-	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
-	if !found {
-		return sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
+	channel, connectionEnd, err := k.getOpenPacketChannel(ctx, chanCap, packet.GetSourcePort(), packet.GetSourceChannel())
+	if err != nil {
+		return err
 	}
 
-	if connectionEnd.GetState() != int32(connectiontypes.OPEN) {
-		return sdkerrors.Wrapf(
-			connectiontypes.ErrInvalidConnectionState,
-			"connection state is not OPEN (got %s)", connectiontypes.State(connectionEnd.GetState()).String(),
-		)
+	if err := validateAcknowledgedPacket(channel, packet); err != nil {
+		return err
 	}
 
 	commitment := k.GetPacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
@@ -456,13 +592,42 @@ func (k Keeper) AcknowledgePacket(
 		return sdkerrors.Wrapf(types.ErrInvalidPacket, "commitment bytes are not equal: got (%v), expected (%v)", packetCommitment, commitment)
 	}
 
-	if err := k.connectionKeeper.VerifyPacketAcknowledgement(
-		ctx, connectionEnd, proofHeight, proof, packet.GetDestPort(), packet.GetDestChannel(),
+	packetDelay := k.GetPacketDelay(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if err := k.verifyPacketAcknowledgement(
+		ctx, connectionEnd, channel.ConnectionHops, proofHeight, packetDelay, proof,
+		packet.GetDestPort(), packet.GetDestChannel(),
 		packet.GetSequence(), acknowledgement,
 	); err != nil {
 		return err
 	}
 
+	return k.acknowledgePacket(ctx, channel, packet)
+}
+
+// validateAcknowledgedPacket checks that a packet acknowledged on the given
+// channel was sent to the counterparty of the channel.
+func validateAcknowledgedPacket(channel types.Channel, packet exported.PacketI) error {
+	// packet must have been sent to the channel's counterparty
+	if packet.GetDestPort() != channel.Counterparty.PortId {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidPacket,
+			"packet destination port doesn't match the counterparty's port (%s ≠ %s)", packet.GetDestPort(), channel.Counterparty.PortId,
+		)
+	}
+
+	if packet.GetDestChannel() != channel.Counterparty.ChannelId {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidPacket,
+			"packet destination channel doesn't match the counterparty's channel (%s ≠ %s)", packet.GetDestChannel(), channel.Counterparty.ChannelId,
+		)
+	}
+
+	return nil
+}
+
+// acknowledgePacket deletes the commitment of a packet whose acknowledgement has
+// been verified, and advances the next acknowledgement sequence of the channel.
+func (k Keeper) acknowledgePacket(ctx sdk.Context, channel types.Channel, packet exported.PacketI) error {
 	// assert packets acknowledged in order
 	if channel.Ordering == types.ORDERED {
 		nextSequenceAck, found := k.GetNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
@@ -491,6 +656,11 @@ func (k Keeper) AcknowledgePacket(
 
 	// Delete packet commitment, since the packet has been acknowledged, the commitement is no longer necessary
 	k.deletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	k.deletePacketData(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	if channel.Ordering == types.UNORDERED {
+		k.advanceNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	}
 
 	// log that a packet has been acknowledged
 	k.Logger(ctx).Info(
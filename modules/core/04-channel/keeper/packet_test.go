@@ -3,7 +3,10 @@ package keeper_test
 import (
 	"errors"
 	"fmt"
+	"math"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
@@ -109,6 +112,13 @@ func (suite *KeeperTestSuite) TestSendPacket() {
 			err := path.EndpointA.SetChannelClosed()
 			suite.Require().NoError(err)
 		}, false},
+		{"channel halted", func() {
+			suite.coordinator.Setup(path)
+			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetChannelHalted(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, true)
+		}, false},
 		{"packet dest port ≠ channel counterparty port", func() {
 			suite.coordinator.Setup(path)
 			// use wrong port for dest
@@ -222,6 +232,101 @@ func (suite *KeeperTestSuite) TestSendPacket() {
 	}
 }
 
+// TestResolveRelativeTimeout tests ResolveRelativeTimeout against the client of
+// the channel on chainA.
+func (suite *KeeperTestSuite) TestResolveRelativeTimeout() {
+	var (
+		path                     *ibctesting.Path
+		ctx                      sdk.Context
+		channelID                string
+		relativeTimeoutHeight    clienttypes.Height
+		relativeTimeoutTimestamp uint64
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"success: timeouts disabled", func() {
+			relativeTimeoutHeight = disabledTimeoutHeight
+			relativeTimeoutTimestamp = disabledTimeoutTimestamp
+		}, true},
+		{"success: consensus state timestamp ahead of block time", func() {
+			consensusState := path.EndpointA.GetConsensusState(path.EndpointA.GetClientState().GetLatestHeight())
+			ctx = ctx.WithBlockTime(time.Unix(0, int64(consensusState.GetTimestamp())).Add(-time.Hour))
+		}, true},
+		{"success: multi-hop channel with relative timeout timestamp", func() {
+			relativeTimeoutHeight = disabledTimeoutHeight
+
+			channel := path.EndpointA.GetChannel()
+			channel.ConnectionHops = append(channel.ConnectionHops, ibctesting.FirstConnectionID)
+			path.EndpointA.SetChannel(channel)
+		}, true},
+		{"multi-hop channel with relative timeout height", func() {
+			channel := path.EndpointA.GetChannel()
+			channel.ConnectionHops = append(channel.ConnectionHops, ibctesting.FirstConnectionID)
+			path.EndpointA.SetChannel(channel)
+		}, false},
+		{"channel not found", func() {
+			channelID = ibctesting.InvalidID
+		}, false},
+		{"connection not found", func() {
+			channel := path.EndpointA.GetChannel()
+			channel.ConnectionHops = []string{ibctesting.InvalidID}
+			path.EndpointA.SetChannel(channel)
+		}, false},
+		{"relative timeout timestamp overflows", func() {
+			relativeTimeoutTimestamp = math.MaxUint64
+		}, false},
+		{"relative timeout height overflows", func() {
+			relativeTimeoutHeight = clienttypes.NewHeight(0, math.MaxUint64)
+		}, false},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s, %d/%d tests", tc.msg, i, len(testCases)), func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			channelID = path.EndpointA.ChannelID
+			relativeTimeoutHeight = clienttypes.NewHeight(0, 100)
+			relativeTimeoutTimestamp = uint64(time.Minute.Nanoseconds())
+			ctx = suite.chainA.GetContext()
+
+			tc.malleate()
+
+			timeoutHeight, timeoutTimestamp, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.ResolveRelativeTimeout(ctx, path.EndpointA.ChannelConfig.PortID, channelID, relativeTimeoutHeight, relativeTimeoutTimestamp)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				expTimeoutHeight := disabledTimeoutHeight
+				if !relativeTimeoutHeight.IsZero() {
+					latestHeight := path.EndpointA.GetClientState().GetLatestHeight()
+					expTimeoutHeight = clienttypes.NewHeight(latestHeight.GetRevisionNumber(), latestHeight.GetRevisionHeight()+relativeTimeoutHeight.RevisionHeight)
+				}
+				suite.Require().Equal(expTimeoutHeight, timeoutHeight)
+
+				expTimeoutTimestamp := disabledTimeoutTimestamp
+				if relativeTimeoutTimestamp != 0 {
+					referenceTimestamp := uint64(ctx.BlockTime().UnixNano())
+					if consensusTimestamp := path.EndpointA.GetConsensusState(path.EndpointA.GetClientState().GetLatestHeight()).GetTimestamp(); consensusTimestamp > referenceTimestamp {
+						referenceTimestamp = consensusTimestamp
+					}
+					expTimeoutTimestamp = referenceTimestamp + relativeTimeoutTimestamp
+				}
+				suite.Require().Equal(expTimeoutTimestamp, timeoutTimestamp)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 // TestRecvPacket test RecvPacket on chainB. Since packet commitment verification will always
 // occur last (resource instensive), only tests expected to succeed and packet commitment
 // verification tests need to simulate sending a packet from chainA to chainB.
@@ -266,6 +371,25 @@ func (suite *KeeperTestSuite) TestRecvPacket() {
 			// attempts to receive packet 2 without receiving packet 1
 			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
 		}, true},
+		{"success: packet delay passed", func() {
+			suite.coordinator.Setup(path)
+			suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPacketDelay(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, types.NewPacketDelay(uint64(time.Second.Nanoseconds()), 0))
+
+			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+		}, true},
+		{"packet delay has not passed", func() {
+			// skip error code check, downstream error code is used from light-client implementations
+			suite.coordinator.Setup(path)
+			suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPacketDelay(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, types.NewPacketDelay(uint64(time.Hour.Nanoseconds()), 0))
+
+			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+		}, false},
 		{"packet already relayed ORDERED channel (no-op)", func() {
 			expError = types.ErrNoOpMsg
 
@@ -318,6 +442,17 @@ func (suite *KeeperTestSuite) TestRecvPacket() {
 			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, ibctesting.InvalidID, ibctesting.InvalidID, timeoutHeight, disabledTimeoutTimestamp)
 			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
 		}, false},
+		{"channel halted", func() {
+			expError = types.ErrChannelHalted
+
+			suite.coordinator.Setup(path)
+			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+
+			suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetChannelHalted(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, true)
+		}, false},
 		{"channel not open", func() {
 			expError = types.ErrInvalidChannelState
 
@@ -625,6 +760,22 @@ func (suite *KeeperTestSuite) TestAcknowledgePacket() {
 
 			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
 		}, true},
+		{"packet delay has not passed", func() {
+			// skip error code check, downstream error code is used from light-client implementations
+			suite.coordinator.Setup(path)
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketDelay(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, types.NewPacketDelay(0, 100))
+			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+
+			// create packet commitment
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+
+			// create packet receipt and acknowledgement
+			err = path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
+
+			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		}, false},
 		{"packet already acknowledged ordered channel (no-op)", func() {
 			expError = types.ErrNoOpMsg
 
@@ -856,7 +1007,7 @@ func (suite *KeeperTestSuite) TestAcknowledgePacket() {
 				if channelA.Ordering == types.ORDERED {
 					suite.Require().Equal(packet.GetSequence()+1, sequenceAck, "sequence not incremented in ordered channel")
 				} else {
-					suite.Require().Equal(uint64(1), sequenceAck, "sequence incremented for UNORDERED channel")
+					suite.Require().Equal(packet.GetSequence()+1, sequenceAck, "sequence not advanced past acknowledged packet in UNORDERED channel")
 				}
 			} else {
 				suite.Error(err)
@@ -868,3 +1019,76 @@ func (suite *KeeperTestSuite) TestAcknowledgePacket() {
 		})
 	}
 }
+
+// TestPacketDataStorage tests that the data of sent packets is only stored if
+// enabled by the channel params and that it is removed once the packet lifecycle
+// completes.
+func (suite *KeeperTestSuite) TestPacketDataStorage() {
+	var (
+		path           *ibctesting.Path
+		packet         types.Packet
+		storeEnabled   bool
+		completePacket func()
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+	}{
+		{"storage disabled", func() {
+			storeEnabled = false
+			completePacket = func() {}
+		}},
+		{"stored data removed on acknowledgement", func() {
+			storeEnabled = true
+			completePacket = func() {
+				err := path.EndpointB.RecvPacket(packet)
+				suite.Require().NoError(err)
+
+				err = path.EndpointA.AcknowledgePacket(packet, ibctesting.MockAcknowledgement)
+				suite.Require().NoError(err)
+			}
+		}},
+		{"stored data removed on timeout", func() {
+			storeEnabled = true
+			packet.TimeoutHeight = clienttypes.GetSelfHeight(suite.chainB.GetContext())
+			completePacket = func() {
+				err := path.EndpointA.UpdateClient()
+				suite.Require().NoError(err)
+
+				err = path.EndpointA.TimeoutPacket(packet)
+				suite.Require().NoError(err)
+			}
+		}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+
+			tc.malleate()
+
+			channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+			channelKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(storeEnabled, 0, 0))
+
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+
+			storedPacket, found := channelKeeper.GetPacketData(suite.chainA.GetContext(), packet.SourcePort, packet.SourceChannel, packet.Sequence)
+			suite.Require().Equal(storeEnabled, found)
+			if storeEnabled {
+				suite.Require().Equal(packet, storedPacket)
+			}
+
+			completePacket()
+
+			_, found = channelKeeper.GetPacketData(suite.chainA.GetContext(), packet.SourcePort, packet.SourceChannel, packet.Sequence)
+			suite.Require().False(found)
+		})
+	}
+}
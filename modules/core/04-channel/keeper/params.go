@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// GetStorePacketData retrieves from the paramstore whether the full data of sent
+// packets is recorded. False is returned if the parameter has not been set.
+func (k Keeper) GetStorePacketData(ctx sdk.Context) bool {
+	var res bool
+	k.paramSpace.GetIfExists(ctx, types.KeyStorePacketData, &res)
+	return res
+}
+
+// GetAsyncAckTimeoutBlocks retrieves from the paramstore the number of blocks
+// after which an error acknowledgement is written for a pending asynchronous
+// acknowledgement. Zero is returned if the parameter has not been set.
+func (k Keeper) GetAsyncAckTimeoutBlocks(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.GetIfExists(ctx, types.KeyAsyncAckTimeoutBlocks, &res)
+	return res
+}
+
+// GetAsyncAckTimeoutPeriod retrieves from the paramstore the time after which
+// an error acknowledgement is written for a pending asynchronous acknowledgement.
+// Zero is returned if the parameter has not been set.
+func (k Keeper) GetAsyncAckTimeoutPeriod(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.GetIfExists(ctx, types.KeyAsyncAckTimeoutPeriod, &res)
+	return res
+}
+
+// GetParams returns the total set of ibc-channel parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	return types.NewParams(k.GetStorePacketData(ctx), k.GetAsyncAckTimeoutBlocks(ctx), k.GetAsyncAckTimeoutPeriod(ctx))
+}
+
+// SetParams sets the total set of ibc-channel parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
@@ -0,0 +1,18 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+func (suite *KeeperTestSuite) TestParams() {
+	expParams := types.DefaultParams()
+
+	params := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainA.GetContext())
+	suite.Require().Equal(expParams, params)
+
+	expParams.StorePacketData = true
+	suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), expParams)
+	params = suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainA.GetContext())
+	suite.Require().Equal(expParams, params)
+	suite.Require().True(suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetStorePacketData(suite.chainA.GetContext()))
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// ChannelHaltProposal will halt the channel end referenced by the proposal.
+// Unlike closing a channel, halting is reversible: packets can no longer be sent
+// or received on a halted channel, but acknowledgements and timeouts of the
+// packets already in flight are still processed.
+func (k Keeper) ChannelHaltProposal(ctx sdk.Context, p *types.ChannelHaltProposal) error {
+	channel, found := k.GetChannel(ctx, p.PortId, p.ChannelId)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", p.PortId, p.ChannelId)
+	}
+
+	if channel.State == types.CLOSED {
+		return sdkerrors.Wrap(types.ErrInvalidChannelState, "channel is already CLOSED")
+	}
+
+	if k.IsChannelHalted(ctx, p.PortId, p.ChannelId) {
+		return sdkerrors.Wrapf(types.ErrChannelHalted, "port ID (%s) channel ID (%s)", p.PortId, p.ChannelId)
+	}
+
+	k.Logger(ctx).Info("channel halted", "port-id", p.PortId, "channel-id", p.ChannelId)
+
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "channel", "halt")
+	}()
+
+	k.SetChannelHalted(ctx, p.PortId, p.ChannelId, true)
+
+	EmitChannelHaltEvent(ctx, p.PortId, p.ChannelId, channel)
+
+	return nil
+}
+
+// ChannelResumeProposal will resume the halted channel end referenced by the
+// proposal.
+func (k Keeper) ChannelResumeProposal(ctx sdk.Context, p *types.ChannelResumeProposal) error {
+	channel, found := k.GetChannel(ctx, p.PortId, p.ChannelId)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", p.PortId, p.ChannelId)
+	}
+
+	if !k.IsChannelHalted(ctx, p.PortId, p.ChannelId) {
+		return sdkerrors.Wrapf(types.ErrInvalidChannelState, "channel is not halted, port ID (%s) channel ID (%s)", p.PortId, p.ChannelId)
+	}
+
+	k.Logger(ctx).Info("channel resumed", "port-id", p.PortId, "channel-id", p.ChannelId)
+
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "channel", "resume")
+	}()
+
+	k.SetChannelHalted(ctx, p.PortId, p.ChannelId, false)
+
+	EmitChannelResumeEvent(ctx, p.PortId, p.ChannelId, channel)
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestChannelHaltProposal() {
+	var (
+		path      *ibctesting.Path
+		channelID string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"channel not found", func() {
+				channelID = ibctesting.InvalidID
+			}, false,
+		},
+		{
+			"channel is CLOSED", func() {
+				err := path.EndpointA.SetChannelClosed()
+				suite.Require().NoError(err)
+			}, false,
+		},
+		{
+			"channel already halted", func() {
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetChannelHalted(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, true)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			channelID = path.EndpointA.ChannelID
+
+			tc.malleate()
+
+			content := types.NewChannelHaltProposal(ibctesting.Title, ibctesting.Description, path.EndpointA.ChannelConfig.PortID, channelID)
+			proposal, ok := content.(*types.ChannelHaltProposal)
+			suite.Require().True(ok)
+
+			channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+			err := channelKeeper.ChannelHaltProposal(suite.chainA.GetContext(), proposal)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().True(channelKeeper.IsChannelHalted(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, channelID))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestChannelResumeProposal() {
+	var (
+		path      *ibctesting.Path
+		channelID string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetChannelHalted(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, true)
+			}, true,
+		},
+		{
+			"channel not found", func() {
+				channelID = ibctesting.InvalidID
+			}, false,
+		},
+		{
+			"channel not halted", func() {}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+			channelID = path.EndpointA.ChannelID
+
+			tc.malleate()
+
+			content := types.NewChannelResumeProposal(ibctesting.Title, ibctesting.Description, path.EndpointA.ChannelConfig.PortID, channelID)
+			proposal, ok := content.(*types.ChannelResumeProposal)
+			suite.Require().True(ok)
+
+			channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+			err := channelKeeper.ChannelResumeProposal(suite.chainA.GetContext(), proposal)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().False(channelKeeper.IsChannelHalted(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, channelID))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestHaltedChannelPacketFlow tests that the packets in flight on a halted
+// channel are still acknowledged and timed out, and that packets can be sent
+// again once the channel is resumed.
+func (suite *KeeperTestSuite) TestHaltedChannelPacketFlow() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+	err := path.EndpointA.SendPacket(packet)
+	suite.Require().NoError(err)
+
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	haltProposal := types.NewChannelHaltProposal(ibctesting.Title, ibctesting.Description, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	err = channelKeeper.ChannelHaltProposal(suite.chainA.GetContext(), haltProposal.(*types.ChannelHaltProposal))
+	suite.Require().NoError(err)
+
+	// the packet sent before the halt is received and acknowledged
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	// new packets cannot be sent
+	packet = types.NewPacket(ibctesting.MockPacketData, 2, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+	err = path.EndpointA.SendPacket(packet)
+	suite.Require().ErrorIs(err, types.ErrChannelHalted)
+
+	resumeProposal := types.NewChannelResumeProposal(ibctesting.Title, ibctesting.Description, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	err = channelKeeper.ChannelResumeProposal(suite.chainA.GetContext(), resumeProposal.(*types.ChannelResumeProposal))
+	suite.Require().NoError(err)
+
+	err = path.EndpointA.SendPacket(packet)
+	suite.Require().NoError(err)
+}
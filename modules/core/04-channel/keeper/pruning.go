@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// maxNextSequenceAckSteps is the maximum number of sequences the next
+// acknowledgement sequence of an UNORDERED channel is advanced by when a packet
+// is acknowledged or timed out. It bounds the gas consumed by a single packet
+// message, the sequence catching up over subsequent messages.
+const maxNextSequenceAckSteps = 100
+
+// advanceNextSequenceAck advances the next acknowledgement sequence of an
+// UNORDERED channel past all the sent packets which have been acknowledged or
+// timed out. For UNORDERED channels the next acknowledgement sequence is the
+// lowest sequence whose packet is still pending, allowing the counterparty to
+// prove that no packet below it may be received anymore.
+func (k Keeper) advanceNextSequenceAck(ctx sdk.Context, portID, channelID string) {
+	nextSequenceAck, found := k.GetNextSequenceAck(ctx, portID, channelID)
+	if !found {
+		return
+	}
+
+	nextSequenceSend, found := k.GetNextSequenceSend(ctx, portID, channelID)
+	if !found {
+		return
+	}
+
+	for i := 0; i < maxNextSequenceAckSteps && nextSequenceAck < nextSequenceSend; i++ {
+		if k.HasPacketCommitment(ctx, portID, channelID, nextSequenceAck) {
+			break
+		}
+		nextSequenceAck++
+	}
+
+	k.SetNextSequenceAck(ctx, portID, channelID, nextSequenceAck)
+}
+
+// isPruned returns true if the packet with the given sequence is below the
+// pruning sequence end of the channel. Such packets have been acknowledged or
+// timed out by the counterparty and are considered received.
+func (k Keeper) isPruned(ctx sdk.Context, portID, channelID string, sequence uint64) bool {
+	pruningSequenceEnd, found := k.GetPruningSequenceEnd(ctx, portID, channelID)
+	return found && sequence < pruningSequenceEnd
+}
+
+// PruneAcknowledgements prunes the packet receipts and acknowledgements of an
+// UNORDERED channel, up to limit sequences at a time. If nextSequenceAck is
+// non-zero, it must be the next acknowledgement sequence of the counterparty
+// channel end proven at the proof height. All the packets sent by the
+// counterparty below it have been acknowledged or timed out, so the pruning
+// sequence end is raised to it: the receipts and acknowledgements of these
+// packets are no longer needed, and the packets are considered received. The
+// number of sequences pruned and left to prune is returned.
+func (k Keeper) PruneAcknowledgements(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	nextSequenceAck uint64,
+	proof []byte,
+	proofHeight exported.Height,
+	limit uint64,
+) (uint64, uint64, error) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return 0, 0, sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.Ordering != types.UNORDERED {
+		return 0, 0, sdkerrors.Wrapf(types.ErrInvalidChannelOrdering, "expected %s channel, got %s", types.UNORDERED, channel.Ordering)
+	}
+
+	pruningSequenceEnd, found := k.GetPruningSequenceEnd(ctx, portID, channelID)
+	if !found {
+		pruningSequenceEnd = 1
+	}
+
+	if nextSequenceAck > pruningSequenceEnd {
+		connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+		if !found {
+			return 0, 0, sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
+		}
+
+		if connectionEnd.GetState() != int32(connectiontypes.OPEN) {
+			return 0, 0, sdkerrors.Wrapf(
+				connectiontypes.ErrInvalidConnectionState,
+				"connection state is not OPEN (got %s)", connectiontypes.State(connectionEnd.GetState()).String(),
+			)
+		}
+
+		packetDelay := k.GetPacketDelay(ctx, portID, channelID)
+		if err := k.verifyNextSequenceAck(
+			ctx, connectionEnd, channel.ConnectionHops, proofHeight, packetDelay, proof,
+			channel.Counterparty.PortId, channel.Counterparty.ChannelId, nextSequenceAck,
+		); err != nil {
+			return 0, 0, err
+		}
+
+		pruningSequenceEnd = nextSequenceAck
+		k.SetPruningSequenceEnd(ctx, portID, channelID, pruningSequenceEnd)
+	}
+
+	pruningSequenceStart, found := k.GetPruningSequenceStart(ctx, portID, channelID)
+	if !found {
+		pruningSequenceStart = 1
+	}
+
+	var pruned uint64
+	for ; pruningSequenceStart < pruningSequenceEnd && pruned < limit; pruningSequenceStart++ {
+		k.deletePacketReceipt(ctx, portID, channelID, pruningSequenceStart)
+		k.deletePacketAcknowledgement(ctx, portID, channelID, pruningSequenceStart)
+		pruned++
+	}
+
+	k.SetPruningSequenceStart(ctx, portID, channelID, pruningSequenceStart)
+
+	k.Logger(ctx).Info(
+		"packet acknowledgements pruned",
+		"port-id", portID,
+		"channel-id", channelID,
+		"pruning-sequence-start", strconv.FormatUint(pruningSequenceStart, 10),
+		"pruning-sequence-end", strconv.FormatUint(pruningSequenceEnd, 10),
+	)
+
+	EmitPruneAcknowledgementsEvent(ctx, portID, channelID, pruningSequenceStart, pruningSequenceEnd)
+
+	return pruned, pruningSequenceEnd - pruningSequenceStart, nil
+}
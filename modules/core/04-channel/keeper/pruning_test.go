@@ -0,0 +1,190 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+// TestAdvanceNextSequenceAck tests that the next acknowledgement sequence of an
+// UNORDERED channel is advanced past the packets which have been acknowledged or
+// timed out, stopping at the lowest pending packet.
+func (suite *KeeperTestSuite) TestAdvanceNextSequenceAck() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	packets := make([]types.Packet, 3)
+	for i := range packets {
+		timeoutHeight := clienttypes.NewHeight(0, 100)
+		if i == 2 {
+			// the third packet is timed out
+			timeoutHeight = clienttypes.GetSelfHeight(suite.chainB.GetContext())
+		}
+
+		packets[i] = types.NewPacket(ibctesting.MockPacketData, uint64(i+1), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+		err := path.EndpointA.SendPacket(packets[i])
+		suite.Require().NoError(err)
+	}
+
+	nextSequenceAck := func() uint64 {
+		sequence, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		suite.Require().True(found)
+		return sequence
+	}
+
+	// acknowledging the second packet leaves the first one pending
+	err := path.RelayPacket(packets[1])
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), nextSequenceAck())
+
+	// acknowledging the first packet advances past the second one
+	err = path.RelayPacket(packets[0])
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(3), nextSequenceAck())
+
+	// timing out the third packet advances past all sent packets
+	err = path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+	err = path.EndpointA.TimeoutPacket(packets[2])
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(4), nextSequenceAck())
+}
+
+// TestPruneAcknowledgements tests the pruning of the packet receipts and
+// acknowledgements written by chainB for the packets sent by chainA.
+func (suite *KeeperTestSuite) TestPruneAcknowledgements() {
+	var (
+		path            *ibctesting.Path
+		nextSequenceAck uint64
+		proof           []byte
+		proofHeight     clienttypes.Height
+		limit           uint64
+		expPruned       uint64
+		expRemaining    uint64
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"success: limit reached", func() {
+			limit = 2
+			expPruned = 2
+			expRemaining = 1
+		}, true},
+		{"success: pruning sequence end unchanged", func() {
+			nextSequenceAck = 0
+			proof = nil
+			expPruned = 0
+			expRemaining = 0
+		}, true},
+		{"success: pruning continues up to the stored pruning sequence end", func() {
+			_, _, err := suite.chainB.App.GetIBCKeeper().ChannelKeeper.PruneAcknowledgements(
+				suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, nextSequenceAck, proof, proofHeight, 1,
+			)
+			suite.Require().NoError(err)
+
+			nextSequenceAck = 0
+			proof = nil
+			expPruned = 2
+		}, true},
+		{"channel not found", func() {
+			path.EndpointB.ChannelID = ibctesting.InvalidID
+		}, false},
+		{"channel is ORDERED", func() {
+			channel := path.EndpointB.GetChannel()
+			channel.Ordering = types.ORDERED
+			path.EndpointB.SetChannel(channel)
+		}, false},
+		{"next sequence acknowledgement verification failed", func() {
+			nextSequenceAck++
+		}, false},
+		{"invalid proof", func() {
+			proof = []byte("invalid proof")
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			for sequence := uint64(1); sequence <= 3; sequence++ {
+				packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+				err := path.EndpointA.SendPacket(packet)
+				suite.Require().NoError(err)
+				err = path.RelayPacket(packet)
+				suite.Require().NoError(err)
+			}
+
+			// prove the next sequence acknowledgement of chainA on chainB
+			err := path.EndpointB.UpdateClient()
+			suite.Require().NoError(err)
+
+			nextSequenceAck = 4
+			proof, proofHeight = path.EndpointA.QueryProof(host.NextSequenceAckKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+			limit = 10
+			expPruned = 3
+			expRemaining = 0
+
+			tc.malleate()
+
+			channelKeeper := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+			pruned, remaining, err := channelKeeper.PruneAcknowledgements(
+				suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, nextSequenceAck, proof, proofHeight, limit,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expPruned, pruned)
+				suite.Require().Equal(expRemaining, remaining)
+
+				pruningSequenceStart, found := channelKeeper.GetPruningSequenceStart(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+				suite.Require().True(found)
+
+				for sequence := uint64(1); sequence <= 3; sequence++ {
+					_, receiptFound := channelKeeper.GetPacketReceipt(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sequence)
+					ackFound := channelKeeper.HasPacketAcknowledgement(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sequence)
+					suite.Require().Equal(sequence >= pruningSequenceStart, receiptFound)
+					suite.Require().Equal(sequence >= pruningSequenceStart, ackFound)
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestRecvPacketBelowPruningSequenceEnd tests that packets below the pruning
+// sequence end of an UNORDERED channel are considered received.
+func (suite *KeeperTestSuite) TestRecvPacketBelowPruningSequenceEnd() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+	err := path.EndpointA.SendPacket(packet)
+	suite.Require().NoError(err)
+
+	err = path.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	packetKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	proof, proofHeight := path.EndpointA.QueryProof(packetKey)
+
+	channelKeeper := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+	channelKeeper.SetPruningSequenceEnd(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 2)
+
+	channelCap := suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+	err = channelKeeper.RecvPacket(suite.chainB.GetContext(), channelCap, packet, proof, proofHeight)
+	suite.Require().ErrorIs(err, types.ErrNoOpMsg)
+
+	_, found := channelKeeper.GetPacketReceipt(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, packet.GetSequence())
+	suite.Require().False(found)
+}
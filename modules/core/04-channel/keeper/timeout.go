@@ -61,13 +61,15 @@ func (k Keeper) TimeoutPacket(
 	}
 
 	// check that timeout height or timeout timestamp has passed on the other end
-	proofTimestamp, err := k.connectionKeeper.GetTimestampAtHeight(ctx, connectionEnd, proofHeight)
+	counterpartyHeight, proofTimestamp, err := k.getCounterpartyTimeoutHeightAndTimestamp(
+		ctx, connectionEnd, channel.ConnectionHops, proofHeight, proof,
+	)
 	if err != nil {
 		return err
 	}
 
 	timeoutHeight := packet.GetTimeoutHeight()
-	if (timeoutHeight.IsZero() || proofHeight.LT(timeoutHeight)) &&
+	if (timeoutHeight.IsZero() || counterpartyHeight.LT(timeoutHeight)) &&
 		(packet.GetTimeoutTimestamp() == 0 || proofTimestamp < packet.GetTimeoutTimestamp()) {
 		return sdkerrors.Wrap(types.ErrPacketTimeout, "packet timeout has not been reached for height or timestamp")
 	}
@@ -97,6 +99,7 @@ func (k Keeper) TimeoutPacket(
 		return sdkerrors.Wrapf(types.ErrInvalidPacket, "packet commitment bytes are not equal: got (%v), expected (%v)", commitment, packetCommitment)
 	}
 
+	packetDelay := k.GetPacketDelay(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
 	switch channel.Ordering {
 	case types.ORDERED:
 		// check that packet has not been received
@@ -108,13 +111,13 @@ func (k Keeper) TimeoutPacket(
 		}
 
 		// check that the recv sequence is as claimed
-		err = k.connectionKeeper.VerifyNextSequenceRecv(
-			ctx, connectionEnd, proofHeight, proof,
+		err = k.verifyNextSequenceRecv(
+			ctx, connectionEnd, channel.ConnectionHops, proofHeight, packetDelay, proof,
 			packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv,
 		)
 	case types.UNORDERED:
-		err = k.connectionKeeper.VerifyPacketReceiptAbsence(
-			ctx, connectionEnd, proofHeight, proof,
+		err = k.verifyPacketReceiptAbsence(
+			ctx, connectionEnd, channel.ConnectionHops, proofHeight, packetDelay, proof,
 			packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
 		)
 	default:
@@ -152,10 +155,13 @@ func (k Keeper) TimeoutExecuted(
 	}
 
 	k.deletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	k.deletePacketData(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
 
 	if channel.Ordering == types.ORDERED {
 		channel.State = types.CLOSED
 		k.SetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), channel)
+	} else {
+		k.advanceNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
 	}
 
 	k.Logger(ctx).Info(
@@ -239,7 +245,10 @@ func (k Keeper) TimeoutOnClose(
 		return sdkerrors.Wrapf(types.ErrInvalidPacket, "packet commitment bytes are not equal: got (%v), expected (%v)", commitment, packetCommitment)
 	}
 
-	counterpartyHops := []string{connectionEnd.GetCounterparty().GetConnectionID()}
+	counterpartyHops, err := k.getCounterpartyHops(connectionEnd, channel.ConnectionHops, channel.Ordering, proofClosed)
+	if err != nil {
+		return err
+	}
 
 	counterparty := types.NewCounterparty(packet.GetSourcePort(), packet.GetSourceChannel())
 	expectedChannel := types.NewChannel(
@@ -247,15 +256,15 @@ func (k Keeper) TimeoutOnClose(
 	)
 
 	// check that the opposing channel end has closed
-	if err := k.connectionKeeper.VerifyChannelState(
-		ctx, connectionEnd, proofHeight, proofClosed,
+	if err := k.verifyChannelState(
+		ctx, connectionEnd, channel.ConnectionHops, proofHeight, proofClosed,
 		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
 		expectedChannel,
 	); err != nil {
 		return err
 	}
 
-	var err error
+	packetDelay := k.GetPacketDelay(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
 	switch channel.Ordering {
 	case types.ORDERED:
 		// check that packet has not been received
@@ -264,13 +273,13 @@ func (k Keeper) TimeoutOnClose(
 		}
 
 		// check that the recv sequence is as claimed
-		err = k.connectionKeeper.VerifyNextSequenceRecv(
-			ctx, connectionEnd, proofHeight, proof,
+		err = k.verifyNextSequenceRecv(
+			ctx, connectionEnd, channel.ConnectionHops, proofHeight, packetDelay, proof,
 			packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv,
 		)
 	case types.UNORDERED:
-		err = k.connectionKeeper.VerifyPacketReceiptAbsence(
-			ctx, connectionEnd, proofHeight, proof,
+		err = k.verifyPacketReceiptAbsence(
+			ctx, connectionEnd, channel.ConnectionHops, proofHeight, packetDelay, proof,
 			packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
 		)
 	default:
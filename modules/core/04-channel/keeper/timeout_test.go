@@ -10,8 +10,10 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	solomachinetypes "github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine/types"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
 
@@ -210,6 +212,66 @@ func (suite *KeeperTestSuite) TestTimeoutPacket() {
 	}
 }
 
+// TestTimeoutPacketSolomachineSignatureWindow tests that a proof of the absence of a packet
+// receipt signed by a solo machine with a signature window before the packet timeout cannot
+// be replayed against a later proof height whose timestamp is past the packet timeout.
+func (suite *KeeperTestSuite) TestTimeoutPacketSolomachineSignatureWindow() {
+	testCases := []struct {
+		name          string
+		signatureTime uint64
+		expPass       bool
+	}{
+		{"success: proof of absence signed at the proof timestamp", 30, true},
+		{"proof of absence signed before the packet timeout is replayed", 10, false},
+		{"proof of absence signed after the packet timeout but before the proof timestamp", 25, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			// swap client with a solo machine using a signature window
+			solomachine := ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, "solomachinesingle", "testing", 1)
+			solomachine.SignatureWindow = 100
+			path.EndpointA.ClientID = clienttypes.FormatClientIdentifier(exported.Solomachine, 10)
+			path.EndpointA.SetClientState(solomachine.ClientState())
+			path.EndpointA.SetConsensusState(solomachine.ConsensusState(), solomachine.GetHeight())
+			connection := path.EndpointA.GetConnection()
+			connection.ClientId = path.EndpointA.ClientID
+			path.EndpointA.SetConnection(connection)
+
+			// the packet times out after the solo machine time
+			packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), 20)
+			path.EndpointA.SendPacket(packet)
+
+			// a later consensus state is past the packet timeout
+			proofHeight := clienttypes.NewHeight(0, solomachine.Sequence+1)
+			consensusState := solomachine.ConsensusState()
+			consensusState.Timestamp = 30
+			path.EndpointA.SetConsensusState(consensusState, proofHeight)
+
+			receiptPath, err := commitmenttypes.ApplyPrefix(connection.Counterparty.Prefix, commitmenttypes.NewMerklePath(host.PacketReceiptPath(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())))
+			suite.Require().NoError(err)
+
+			solomachine.Time = tc.signatureTime
+			proof := solomachine.GenerateProof(solomachinetypes.NONMEMBERSHIP, receiptPath, nil)
+
+			err = suite.chainA.App.GetIBCKeeper().ChannelKeeper.TimeoutPacket(suite.chainA.GetContext(), packet, proof, proofHeight, 1)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 // TestTimeoutExectued verifies that packet commitments are deleted on chainA after the
 // channel capabilities are verified.
 func (suite *KeeperTestSuite) TestTimeoutExecuted() {
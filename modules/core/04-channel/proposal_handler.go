@@ -0,0 +1,26 @@
+package channel
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// NewChannelProposalHandler defines the 04-channel proposal handler
+func NewChannelProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.ChannelHaltProposal:
+			return k.ChannelHaltProposal(ctx, c)
+
+		case *types.ChannelResumeProposal:
+			return k.ChannelResumeProposal(ctx, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized ibc channel proposal content type: %T", c)
+		}
+	}
+}
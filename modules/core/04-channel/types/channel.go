@@ -1,12 +1,22 @@
 package types
 
 import (
+	"time"
+
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
 
+const (
+	// MaxPacketTimeDelay is the maximum additional time delay, in nanoseconds, a channel may declare.
+	MaxPacketTimeDelay = uint64(365 * 24 * time.Hour)
+
+	// MaxPacketBlockDelay is the maximum additional block delay a channel may declare.
+	MaxPacketBlockDelay = uint64(365 * 24 * 60 * 60)
+)
+
 var (
 	_ exported.ChannelI             = (*Channel)(nil)
 	_ exported.CounterpartyChannelI = (*Counterparty)(nil)
@@ -59,18 +69,29 @@ func (ch Channel) ValidateBasic() error {
 	if !(ch.Ordering == ORDERED || ch.Ordering == UNORDERED) {
 		return sdkerrors.Wrap(ErrInvalidChannelOrdering, ch.Ordering.String())
 	}
-	if len(ch.ConnectionHops) != 1 {
-		return sdkerrors.Wrap(
-			ErrTooManyConnectionHops,
-			"current IBC version only supports one connection hop",
-		)
-	}
-	if err := host.ConnectionIdentifierValidator(ch.ConnectionHops[0]); err != nil {
-		return sdkerrors.Wrap(err, "invalid connection hop ID")
+	if err := ValidateConnectionHops(ch.ConnectionHops); err != nil {
+		return err
 	}
 	return ch.Counterparty.ValidateBasic()
 }
 
+// ValidateConnectionHops validates the connection hops of a channel. Channels
+// spanning several connection hops are multi-hop channels, as defined in ICS-33.
+// Connection identifiers are local to the chain of each hop, so the same
+// identifier may appear more than once along the path.
+func ValidateConnectionHops(connectionHops []string) error {
+	if len(connectionHops) == 0 {
+		return sdkerrors.Wrap(ErrInvalidChannel, "channel must have at least one connection hop")
+	}
+
+	for _, connectionID := range connectionHops {
+		if err := host.ConnectionIdentifierValidator(connectionID); err != nil {
+			return sdkerrors.Wrap(err, "invalid connection hop ID")
+		}
+	}
+	return nil
+}
+
 // NewCounterparty returns a new Counterparty instance
 func NewCounterparty(portID, channelID string) Counterparty {
 	return Counterparty{
@@ -126,3 +147,28 @@ func (ic IdentifiedChannel) ValidateBasic() error {
 	channel := NewChannel(ic.State, ic.Ordering, ic.Counterparty, ic.ConnectionHops, ic.Version)
 	return channel.ValidateBasic()
 }
+
+// NewPacketDelay creates a new PacketDelay instance
+func NewPacketDelay(timeDelay, blockDelay uint64) PacketDelay {
+	return PacketDelay{
+		TimeDelay:  timeDelay,
+		BlockDelay: blockDelay,
+	}
+}
+
+// IsZero returns true if the packet delay does not add any time or block delay.
+func (pd PacketDelay) IsZero() bool {
+	return pd.TimeDelay == 0 && pd.BlockDelay == 0
+}
+
+// ValidateBasic checks that the packet delay does not exceed MaxPacketTimeDelay and
+// MaxPacketBlockDelay, which bounds the delays applied on top of the connection delay.
+func (pd PacketDelay) ValidateBasic() error {
+	if pd.TimeDelay > MaxPacketTimeDelay {
+		return sdkerrors.Wrapf(ErrInvalidPacketDelay, "time delay %d exceeds the maximum of %d", pd.TimeDelay, MaxPacketTimeDelay)
+	}
+	if pd.BlockDelay > MaxPacketBlockDelay {
+		return sdkerrors.Wrapf(ErrInvalidPacketDelay, "block delay %d exceeds the maximum of %d", pd.BlockDelay, MaxPacketBlockDelay)
+	}
+	return nil
+}
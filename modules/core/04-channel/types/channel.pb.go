@@ -8,6 +8,7 @@ import (
 	types "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/regen-network/cosmos-proto"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -347,6 +348,163 @@ func (m *PacketState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PacketState proto.InternalMessageInfo
 
+// PacketDelay defines an additional delay period, declared when a channel is
+// opened, which must pass on top of the connection delay period before packet
+// proofs submitted by the counterparty of the channel are accepted.
+type PacketDelay struct {
+	// time delay in nanoseconds.
+	TimeDelay uint64 `protobuf:"varint,1,opt,name=time_delay,json=timeDelay,proto3" json:"time_delay,omitempty" yaml:"time_delay"`
+	// number of blocks.
+	BlockDelay uint64 `protobuf:"varint,2,opt,name=block_delay,json=blockDelay,proto3" json:"block_delay,omitempty" yaml:"block_delay"`
+}
+
+func (m *PacketDelay) Reset()         { *m = PacketDelay{} }
+func (m *PacketDelay) String() string { return proto.CompactTextString(m) }
+func (*PacketDelay) ProtoMessage()    {}
+func (*PacketDelay) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{5}
+}
+func (m *PacketDelay) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketDelay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketDelay.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketDelay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketDelay.Merge(m, src)
+}
+func (m *PacketDelay) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketDelay) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketDelay.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketDelay proto.InternalMessageInfo
+
+// Params defines the set of IBC channel parameters.
+type Params struct {
+	// store_packet_data enables recording the full data of sent packets until
+	// they are acknowledged or timed out.
+	StorePacketData bool `protobuf:"varint,1,opt,name=store_packet_data,json=storePacketData,proto3" json:"store_packet_data,omitempty" yaml:"store_packet_data"`
+	// number of blocks after which an error acknowledgement is written for a
+	// received packet whose application has not written its asynchronous
+	// acknowledgement. A zero value disables the block limit.
+	AsyncAckTimeoutBlocks uint64 `protobuf:"varint,2,opt,name=async_ack_timeout_blocks,json=asyncAckTimeoutBlocks,proto3" json:"async_ack_timeout_blocks,omitempty" yaml:"async_ack_timeout_blocks"`
+	// time (in nanoseconds) after which an error acknowledgement is written for a
+	// received packet whose application has not written its asynchronous
+	// acknowledgement. A zero value disables the time limit.
+	AsyncAckTimeoutPeriod uint64 `protobuf:"varint,3,opt,name=async_ack_timeout_period,json=asyncAckTimeoutPeriod,proto3" json:"async_ack_timeout_period,omitempty" yaml:"async_ack_timeout_period"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{6}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetStorePacketData() bool {
+	if m != nil {
+		return m.StorePacketData
+	}
+	return false
+}
+
+func (m *Params) GetAsyncAckTimeoutBlocks() uint64 {
+	if m != nil {
+		return m.AsyncAckTimeoutBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetAsyncAckTimeoutPeriod() uint64 {
+	if m != nil {
+		return m.AsyncAckTimeoutPeriod
+	}
+	return 0
+}
+
+// PendingAsyncAck records a received packet whose application returned no
+// acknowledgement from its OnRecvPacket callback and has not yet written an
+// asynchronous acknowledgement.
+type PendingAsyncAck struct {
+	// the received packet
+	Packet Packet `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet"`
+	// block height at which the packet was received
+	RecvHeight uint64 `protobuf:"varint,2,opt,name=recv_height,json=recvHeight,proto3" json:"recv_height,omitempty" yaml:"recv_height"`
+	// block time (in nanoseconds) at which the packet was received
+	RecvTimestamp uint64 `protobuf:"varint,3,opt,name=recv_timestamp,json=recvTimestamp,proto3" json:"recv_timestamp,omitempty" yaml:"recv_timestamp"`
+}
+
+func (m *PendingAsyncAck) Reset()         { *m = PendingAsyncAck{} }
+func (m *PendingAsyncAck) String() string { return proto.CompactTextString(m) }
+func (*PendingAsyncAck) ProtoMessage()    {}
+func (*PendingAsyncAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{7}
+}
+func (m *PendingAsyncAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingAsyncAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingAsyncAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingAsyncAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingAsyncAck.Merge(m, src)
+}
+func (m *PendingAsyncAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingAsyncAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingAsyncAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingAsyncAck proto.InternalMessageInfo
+
 // Acknowledgement is the recommended acknowledgement format to be used by
 // app-specific protocols.
 // NOTE: The field numbers 21 and 22 were explicitly chosen to avoid accidental
@@ -367,7 +525,7 @@ func (m *Acknowledgement) Reset()         { *m = Acknowledgement{} }
 func (m *Acknowledgement) String() string { return proto.CompactTextString(m) }
 func (*Acknowledgement) ProtoMessage()    {}
 func (*Acknowledgement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3a07336710636a0, []int{5}
+	return fileDescriptor_c3a07336710636a0, []int{8}
 }
 func (m *Acknowledgement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -441,6 +599,100 @@ func (*Acknowledgement) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// ChannelHaltProposal is a governance proposal. If it passes, the channel end is
+// halted: packets can no longer be sent or received on the channel until it is
+// resumed, while acknowledgements and timeouts of in-flight packets are still
+// processed.
+type ChannelHaltProposal struct {
+	// the title of the halt proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// the port identifier of the channel to be halted if the proposal passes
+	PortId string `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// the identifier of the channel to be halted if the proposal passes
+	ChannelId string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+}
+
+func (m *ChannelHaltProposal) Reset()         { *m = ChannelHaltProposal{} }
+func (m *ChannelHaltProposal) String() string { return proto.CompactTextString(m) }
+func (*ChannelHaltProposal) ProtoMessage()    {}
+func (*ChannelHaltProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{9}
+}
+func (m *ChannelHaltProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelHaltProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelHaltProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelHaltProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelHaltProposal.Merge(m, src)
+}
+func (m *ChannelHaltProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelHaltProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelHaltProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelHaltProposal proto.InternalMessageInfo
+
+// ChannelResumeProposal is a governance proposal. If it passes, a halted channel
+// end is resumed and packets may be sent and received on it again.
+type ChannelResumeProposal struct {
+	// the title of the resume proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// the port identifier of the channel to be resumed if the proposal passes
+	PortId string `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// the identifier of the channel to be resumed if the proposal passes
+	ChannelId string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+}
+
+func (m *ChannelResumeProposal) Reset()         { *m = ChannelResumeProposal{} }
+func (m *ChannelResumeProposal) String() string { return proto.CompactTextString(m) }
+func (*ChannelResumeProposal) ProtoMessage()    {}
+func (*ChannelResumeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{10}
+}
+func (m *ChannelResumeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelResumeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelResumeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelResumeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelResumeProposal.Merge(m, src)
+}
+func (m *ChannelResumeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelResumeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelResumeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelResumeProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("ibc.core.channel.v1.State", State_name, State_value)
 	proto.RegisterEnum("ibc.core.channel.v1.Order", Order_name, Order_value)
@@ -449,70 +701,95 @@ func init() {
 	proto.RegisterType((*Counterparty)(nil), "ibc.core.channel.v1.Counterparty")
 	proto.RegisterType((*Packet)(nil), "ibc.core.channel.v1.Packet")
 	proto.RegisterType((*PacketState)(nil), "ibc.core.channel.v1.PacketState")
+	proto.RegisterType((*PacketDelay)(nil), "ibc.core.channel.v1.PacketDelay")
+	proto.RegisterType((*Params)(nil), "ibc.core.channel.v1.Params")
+	proto.RegisterType((*PendingAsyncAck)(nil), "ibc.core.channel.v1.PendingAsyncAck")
 	proto.RegisterType((*Acknowledgement)(nil), "ibc.core.channel.v1.Acknowledgement")
+	proto.RegisterType((*ChannelHaltProposal)(nil), "ibc.core.channel.v1.ChannelHaltProposal")
+	proto.RegisterType((*ChannelResumeProposal)(nil), "ibc.core.channel.v1.ChannelResumeProposal")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v1/channel.proto", fileDescriptor_c3a07336710636a0) }
 
 var fileDescriptor_c3a07336710636a0 = []byte{
-	// 911 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0xcd, 0x8e, 0xda, 0x56,
-	0x14, 0xc6, 0x83, 0xf9, 0x3b, 0x0c, 0x0c, 0x73, 0xd3, 0x21, 0xae, 0x9b, 0x60, 0x62, 0x75, 0x31,
-	0x4a, 0x15, 0xc8, 0x24, 0x51, 0xab, 0x66, 0xd5, 0xe1, 0x27, 0x1a, 0xab, 0x11, 0x8c, 0x0c, 0xb3,
-	0x68, 0x36, 0x14, 0xec, 0x5b, 0xb0, 0x02, 0xbe, 0xd4, 0xbe, 0x30, 0x9a, 0x37, 0x88, 0x58, 0xf5,
-	0x05, 0x90, 0x2a, 0x55, 0xed, 0x2b, 0xf4, 0x15, 0xb2, 0xcc, 0xb2, 0x2b, 0xab, 0x9a, 0x59, 0x74,
-	0xcf, 0x0b, 0xb4, 0xf2, 0xbd, 0xd7, 0xfc, 0x4c, 0xa2, 0x2c, 0xbb, 0xca, 0x8a, 0x7b, 0xbe, 0xef,
-	0x3b, 0x3f, 0x3e, 0xe7, 0x70, 0x2f, 0x3c, 0x70, 0x06, 0x56, 0xd5, 0x22, 0x1e, 0xae, 0x5a, 0xa3,
-	0xbe, 0xeb, 0xe2, 0x71, 0x75, 0x7e, 0x12, 0x1d, 0x2b, 0x53, 0x8f, 0x50, 0x82, 0xee, 0x38, 0x03,
-	0xab, 0x12, 0x4a, 0x2a, 0x11, 0x3e, 0x3f, 0x51, 0x3f, 0x1b, 0x92, 0x21, 0x61, 0x7c, 0x35, 0x3c,
-	0x71, 0xa9, 0xaa, 0x6d, 0xa2, 0x8d, 0x1d, 0xec, 0x52, 0x16, 0x8c, 0x9d, 0xb8, 0x40, 0xff, 0x7d,
-	0x0f, 0x52, 0x75, 0x1e, 0x05, 0x3d, 0x86, 0x84, 0x4f, 0xfb, 0x14, 0x2b, 0x52, 0x59, 0x3a, 0xce,
-	0x3f, 0x51, 0x2b, 0x1f, 0xc8, 0x53, 0xe9, 0x84, 0x0a, 0x93, 0x0b, 0xd1, 0xd7, 0x90, 0x26, 0x9e,
-	0x8d, 0x3d, 0xc7, 0x1d, 0x2a, 0x7b, 0x1f, 0x71, 0x6a, 0x87, 0x22, 0x73, 0xad, 0x45, 0xdf, 0xc3,
-	0xbe, 0x45, 0x66, 0x2e, 0xc5, 0xde, 0xb4, 0xef, 0xd1, 0x2b, 0x25, 0x5e, 0x96, 0x8e, 0xb3, 0x4f,
-	0x1e, 0x7c, 0xd0, 0xb7, 0xbe, 0x25, 0xac, 0xc9, 0x6f, 0x03, 0x2d, 0x66, 0xee, 0x38, 0xa3, 0x3a,
-	0x1c, 0x58, 0xc4, 0x75, 0xb1, 0x45, 0x1d, 0xe2, 0xf6, 0x46, 0x64, 0xea, 0x2b, 0x72, 0x39, 0x7e,
-	0x9c, 0xa9, 0xa9, 0xab, 0x40, 0x2b, 0x5e, 0xf5, 0x27, 0xe3, 0xe7, 0xfa, 0x2d, 0x81, 0x6e, 0xe6,
-	0x37, 0xc8, 0x19, 0x99, 0xfa, 0x48, 0x81, 0xd4, 0x1c, 0x7b, 0xbe, 0x43, 0x5c, 0x25, 0x51, 0x96,
-	0x8e, 0x33, 0x66, 0x64, 0x3e, 0x97, 0xdf, 0xfc, 0xaa, 0xc5, 0xf4, 0x7f, 0xf6, 0xe0, 0xd0, 0xb0,
-	0xb1, 0x4b, 0x9d, 0x9f, 0x1c, 0x6c, 0x7f, 0xea, 0xd8, 0x47, 0x3a, 0x86, 0xee, 0x42, 0x6a, 0x4a,
-	0x3c, 0xda, 0x73, 0x6c, 0x25, 0xc9, 0x98, 0x64, 0x68, 0x1a, 0x36, 0xba, 0x0f, 0x20, 0xca, 0x0c,
-	0xb9, 0x14, 0xe3, 0x32, 0x02, 0x31, 0x6c, 0xd1, 0xe9, 0x4b, 0xd8, 0xdf, 0xfe, 0x00, 0xf4, 0xd5,
-	0x26, 0x5a, 0xd8, 0xe5, 0x4c, 0x0d, 0xad, 0x02, 0x2d, 0xcf, 0x8b, 0x14, 0x84, 0xbe, 0xce, 0xf0,
-	0x6c, 0x27, 0xc3, 0x1e, 0xd3, 0x1f, 0xad, 0x02, 0xed, 0x50, 0x7c, 0xd4, 0x9a, 0xd3, 0xdf, 0x4f,
-	0xfc, 0x6f, 0x1c, 0x92, 0xe7, 0x7d, 0xeb, 0x35, 0xa6, 0x48, 0x85, 0xb4, 0x8f, 0x7f, 0x9e, 0x61,
-	0xd7, 0xe2, 0xa3, 0x95, 0xcd, 0xb5, 0x8d, 0xbe, 0x81, 0xac, 0x4f, 0x66, 0x9e, 0x85, 0x7b, 0x61,
-	0x4e, 0x91, 0xa3, 0xb8, 0x0a, 0x34, 0xc4, 0x73, 0x6c, 0x91, 0xba, 0x09, 0xdc, 0x3a, 0x27, 0x1e,
-	0x45, 0xdf, 0x41, 0x5e, 0x70, 0x22, 0x33, 0x1b, 0x62, 0xa6, 0xf6, 0xf9, 0x2a, 0xd0, 0x8e, 0x76,
-	0x7c, 0x05, 0xaf, 0x9b, 0x39, 0x0e, 0x44, 0xeb, 0xf6, 0x02, 0x0a, 0x36, 0xf6, 0xa9, 0xe3, 0xf6,
-	0xd9, 0x5c, 0x58, 0x7e, 0x99, 0xc5, 0xf8, 0x62, 0x15, 0x68, 0x77, 0x79, 0x8c, 0xdb, 0x0a, 0xdd,
-	0x3c, 0xd8, 0x82, 0x58, 0x25, 0x6d, 0xb8, 0xb3, 0xad, 0x8a, 0xca, 0x61, 0x63, 0xac, 0x95, 0x56,
-	0x81, 0xa6, 0xbe, 0x1f, 0x6a, 0x5d, 0x13, 0xda, 0x42, 0xa3, 0xc2, 0x10, 0xc8, 0x76, 0x9f, 0xf6,
-	0xd9, 0xb8, 0xf7, 0x4d, 0x76, 0x46, 0x3f, 0x42, 0x9e, 0x3a, 0x13, 0x4c, 0x66, 0xb4, 0x37, 0xc2,
-	0xce, 0x70, 0x44, 0xd9, 0xc0, 0xb3, 0x3b, 0xfb, 0xce, 0x6f, 0xa2, 0xf9, 0x49, 0xe5, 0x8c, 0x29,
-	0x6a, 0xf7, 0xc3, 0x65, 0xdd, 0xb4, 0x63, 0xd7, 0x5f, 0x37, 0x73, 0x02, 0xe0, 0x6a, 0x64, 0xc0,
-	0x61, 0xa4, 0x08, 0x7f, 0x7d, 0xda, 0x9f, 0x4c, 0x95, 0x74, 0x38, 0xae, 0xda, 0xbd, 0x55, 0xa0,
-	0x29, 0xbb, 0x41, 0xd6, 0x12, 0xdd, 0x2c, 0x08, 0xac, 0x1b, 0x41, 0x62, 0x03, 0xfe, 0x90, 0x20,
-	0xcb, 0x37, 0x80, 0xfd, 0x67, 0xff, 0x87, 0xd5, 0xdb, 0xd9, 0xb4, 0xf8, 0xad, 0x4d, 0x8b, 0xba,
-	0x2a, 0x6f, 0xba, 0x2a, 0x0a, 0x6d, 0xc3, 0xc1, 0xa9, 0xf5, 0xda, 0x25, 0x97, 0x63, 0x6c, 0x0f,
-	0xf1, 0x04, 0xbb, 0x14, 0x29, 0x90, 0xf4, 0xb0, 0x3f, 0x1b, 0x53, 0xe5, 0x28, 0x94, 0x9f, 0xc5,
-	0x4c, 0x61, 0xa3, 0x22, 0x24, 0xb0, 0xe7, 0x11, 0x4f, 0x29, 0x86, 0x35, 0x9d, 0xc5, 0x4c, 0x6e,
-	0xd6, 0x00, 0xd2, 0x1e, 0xf6, 0xa7, 0xc4, 0xf5, 0xf1, 0xc3, 0x3f, 0x25, 0x48, 0x74, 0xc4, 0x05,
-	0xa5, 0x75, 0xba, 0xa7, 0xdd, 0x66, 0xef, 0xa2, 0x65, 0xb4, 0x8c, 0xae, 0x71, 0xfa, 0xd2, 0x78,
-	0xd5, 0x6c, 0xf4, 0x2e, 0x5a, 0x9d, 0xf3, 0x66, 0xdd, 0x78, 0x61, 0x34, 0x1b, 0x85, 0x98, 0x7a,
-	0xb8, 0x58, 0x96, 0x73, 0x3b, 0x02, 0xa4, 0x00, 0x70, 0xbf, 0x10, 0x2c, 0x48, 0x6a, 0x7a, 0xb1,
-	0x2c, 0xcb, 0xe1, 0x19, 0x95, 0x20, 0xc7, 0x99, 0xae, 0xf9, 0x43, 0xfb, 0xbc, 0xd9, 0x2a, 0xec,
-	0xa9, 0xd9, 0xc5, 0xb2, 0x9c, 0x12, 0xe6, 0xc6, 0x93, 0x91, 0x71, 0xee, 0xc9, 0x98, 0x7b, 0xb0,
-	0xcf, 0x99, 0xfa, 0xcb, 0x76, 0xa7, 0xd9, 0x28, 0xc8, 0x2a, 0x2c, 0x96, 0xe5, 0x24, 0xb7, 0x54,
-	0xf9, 0xcd, 0x6f, 0xa5, 0xd8, 0xc3, 0x4b, 0x48, 0xb0, 0xbb, 0x12, 0x7d, 0x09, 0xc5, 0xb6, 0xd9,
-	0x68, 0x9a, 0xbd, 0x56, 0xbb, 0xd5, 0xbc, 0x55, 0x2f, 0x0b, 0x19, 0xe2, 0x48, 0x87, 0x03, 0xae,
-	0xba, 0x68, 0xb1, 0xdf, 0x66, 0xa3, 0x20, 0xa9, 0xb9, 0xc5, 0xb2, 0x9c, 0x59, 0x03, 0x61, 0xc1,
-	0x5c, 0x13, 0x29, 0x44, 0xc1, 0xc2, 0xe4, 0x89, 0x6b, 0x9d, 0xb7, 0xd7, 0x25, 0xe9, 0xdd, 0x75,
-	0x49, 0xfa, 0xfb, 0xba, 0x24, 0xfd, 0x72, 0x53, 0x8a, 0xbd, 0xbb, 0x29, 0xc5, 0xfe, 0xba, 0x29,
-	0xc5, 0x5e, 0x7d, 0x3b, 0x74, 0xe8, 0x68, 0x36, 0xa8, 0x58, 0x64, 0x52, 0xb5, 0x88, 0x3f, 0x21,
-	0x7e, 0xd5, 0x19, 0x58, 0x8f, 0x86, 0xa4, 0x3a, 0x7f, 0x5a, 0x9d, 0x10, 0x7b, 0x36, 0xc6, 0x3e,
-	0x7f, 0x94, 0x1f, 0x3f, 0x7b, 0x14, 0xbd, 0xf2, 0xf4, 0x6a, 0x8a, 0xfd, 0x41, 0x92, 0xbd, 0xca,
-	0x4f, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xa8, 0x31, 0x31, 0xe5, 0x06, 0x08, 0x00, 0x00,
+	// 1228 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcd, 0x6e, 0xdb, 0xc6,
+	0x13, 0x17, 0x2d, 0x59, 0xb6, 0x46, 0xfe, 0x90, 0x37, 0xb1, 0xc3, 0x30, 0x89, 0xa8, 0xf0, 0xff,
+	0x3f, 0x18, 0x29, 0x22, 0xc5, 0x49, 0xd0, 0x20, 0x39, 0xc5, 0xb2, 0x15, 0x58, 0x68, 0x20, 0x09,
+	0x6b, 0xe7, 0xd0, 0xa0, 0x00, 0x4b, 0x91, 0x5b, 0x99, 0xb0, 0xc4, 0x55, 0xc9, 0x95, 0x02, 0x5f,
+	0x7b, 0x0a, 0x7c, 0x28, 0xfa, 0x02, 0x06, 0x0a, 0x14, 0xed, 0x13, 0x14, 0xe8, 0x2b, 0xe4, 0x52,
+	0x20, 0x28, 0x8a, 0xa2, 0x27, 0xa1, 0x48, 0x0e, 0xbd, 0xeb, 0x05, 0x5a, 0xec, 0x07, 0x25, 0xca,
+	0x76, 0xd3, 0xf6, 0xd2, 0x43, 0xd1, 0x93, 0x76, 0xe6, 0xf7, 0x9b, 0x8f, 0x9d, 0x99, 0x5d, 0xae,
+	0xe0, 0xa6, 0xdf, 0x76, 0x2b, 0x2e, 0x0d, 0x49, 0xc5, 0x3d, 0x74, 0x82, 0x80, 0x74, 0x2b, 0xc3,
+	0xad, 0x78, 0x59, 0xee, 0x87, 0x94, 0x51, 0x74, 0xc9, 0x6f, 0xbb, 0x65, 0x4e, 0x29, 0xc7, 0xfa,
+	0xe1, 0x96, 0x71, 0xb9, 0x43, 0x3b, 0x54, 0xe0, 0x15, 0xbe, 0x92, 0x54, 0xc3, 0x9c, 0x7a, 0xeb,
+	0xfa, 0x24, 0x60, 0xc2, 0x99, 0x58, 0x29, 0xc2, 0x55, 0x97, 0x46, 0x3d, 0x1a, 0xd9, 0xd2, 0x52,
+	0x0a, 0x12, 0xb2, 0xbe, 0x9e, 0x83, 0x85, 0x1d, 0x19, 0x00, 0xdd, 0x81, 0xf9, 0x88, 0x39, 0x8c,
+	0xe8, 0x5a, 0x49, 0xdb, 0x5c, 0xb9, 0x6b, 0x94, 0x2f, 0x48, 0xa1, 0xbc, 0xcf, 0x19, 0x58, 0x12,
+	0xd1, 0xfb, 0xb0, 0x48, 0x43, 0x8f, 0x84, 0x7e, 0xd0, 0xd1, 0xe7, 0xde, 0x61, 0xd4, 0xe4, 0x24,
+	0x3c, 0xe1, 0xa2, 0x0f, 0x60, 0xc9, 0xa5, 0x83, 0x80, 0x91, 0xb0, 0xef, 0x84, 0xec, 0x58, 0x4f,
+	0x97, 0xb4, 0xcd, 0xfc, 0xdd, 0x9b, 0x17, 0xda, 0xee, 0x24, 0x88, 0xd5, 0xcc, 0xab, 0x91, 0x99,
+	0xc2, 0x33, 0xc6, 0x68, 0x07, 0x56, 0x5d, 0x1a, 0x04, 0xc4, 0x65, 0x3e, 0x0d, 0xec, 0x43, 0xda,
+	0x8f, 0xf4, 0x4c, 0x29, 0xbd, 0x99, 0xab, 0x1a, 0xe3, 0x91, 0xb9, 0x71, 0xec, 0xf4, 0xba, 0x8f,
+	0xac, 0x33, 0x04, 0x0b, 0xaf, 0x4c, 0x35, 0x7b, 0xb4, 0x1f, 0x21, 0x1d, 0x16, 0x86, 0x24, 0x8c,
+	0x7c, 0x1a, 0xe8, 0xf3, 0x25, 0x6d, 0x33, 0x87, 0x63, 0xf1, 0x51, 0xe6, 0xe5, 0x97, 0x66, 0xca,
+	0xfa, 0x75, 0x0e, 0xd6, 0xea, 0x1e, 0x09, 0x98, 0xff, 0x89, 0x4f, 0xbc, 0xff, 0x2a, 0xf6, 0x8e,
+	0x8a, 0xa1, 0x2b, 0xb0, 0xd0, 0xa7, 0x21, 0xb3, 0x7d, 0x4f, 0xcf, 0x0a, 0x24, 0xcb, 0xc5, 0xba,
+	0x87, 0x6e, 0x00, 0xa8, 0x34, 0x39, 0xb6, 0x20, 0xb0, 0x9c, 0xd2, 0xd4, 0x3d, 0x55, 0xe9, 0x17,
+	0xb0, 0x94, 0xdc, 0x00, 0x7a, 0x6f, 0xea, 0x8d, 0x57, 0x39, 0x57, 0x45, 0xe3, 0x91, 0xb9, 0x22,
+	0x93, 0x54, 0x80, 0x35, 0x89, 0x70, 0x7f, 0x26, 0xc2, 0x9c, 0xe0, 0xaf, 0x8f, 0x47, 0xe6, 0x9a,
+	0xda, 0xd4, 0x04, 0xb3, 0xce, 0x07, 0xfe, 0x2d, 0x0d, 0xd9, 0x96, 0xe3, 0x1e, 0x11, 0x86, 0x0c,
+	0x58, 0x8c, 0xc8, 0xa7, 0x03, 0x12, 0xb8, 0xb2, 0xb5, 0x19, 0x3c, 0x91, 0xd1, 0x03, 0xc8, 0x47,
+	0x74, 0x10, 0xba, 0xc4, 0xe6, 0x31, 0x55, 0x8c, 0x8d, 0xf1, 0xc8, 0x44, 0x32, 0x46, 0x02, 0xb4,
+	0x30, 0x48, 0xa9, 0x45, 0x43, 0x86, 0x1e, 0xc3, 0x8a, 0xc2, 0x54, 0x64, 0xd1, 0xc4, 0x5c, 0xf5,
+	0xea, 0x78, 0x64, 0xae, 0xcf, 0xd8, 0x2a, 0xdc, 0xc2, 0xcb, 0x52, 0x11, 0x8f, 0xdb, 0x13, 0x28,
+	0x78, 0x24, 0x62, 0x7e, 0xe0, 0x88, 0xbe, 0x88, 0xf8, 0x19, 0xe1, 0xe3, 0xda, 0x78, 0x64, 0x5e,
+	0x91, 0x3e, 0xce, 0x32, 0x2c, 0xbc, 0x9a, 0x50, 0x89, 0x4c, 0x9a, 0x70, 0x29, 0xc9, 0x8a, 0xd3,
+	0x11, 0x6d, 0xac, 0x16, 0xc7, 0x23, 0xd3, 0x38, 0xef, 0x6a, 0x92, 0x13, 0x4a, 0x68, 0xe3, 0xc4,
+	0x10, 0x64, 0x3c, 0x87, 0x39, 0xa2, 0xdd, 0x4b, 0x58, 0xac, 0xd1, 0xc7, 0xb0, 0xc2, 0xfc, 0x1e,
+	0xa1, 0x03, 0x66, 0x1f, 0x12, 0xbf, 0x73, 0xc8, 0x44, 0xc3, 0xf3, 0x33, 0xf3, 0x2e, 0x2f, 0xa9,
+	0xe1, 0x56, 0x79, 0x4f, 0x30, 0xaa, 0x37, 0xf8, 0xb0, 0x4e, 0xcb, 0x31, 0x6b, 0x6f, 0xe1, 0x65,
+	0xa5, 0x90, 0x6c, 0x54, 0x87, 0xb5, 0x98, 0xc1, 0x7f, 0x23, 0xe6, 0xf4, 0xfa, 0xfa, 0x22, 0x6f,
+	0x57, 0xf5, 0xfa, 0x78, 0x64, 0xea, 0xb3, 0x4e, 0x26, 0x14, 0x0b, 0x17, 0x94, 0xee, 0x20, 0x56,
+	0xa9, 0x09, 0xf8, 0x46, 0x83, 0xbc, 0x9c, 0x00, 0x71, 0x66, 0xff, 0x81, 0xd1, 0x9b, 0x99, 0xb4,
+	0xf4, 0x99, 0x49, 0x8b, 0xab, 0x9a, 0x99, 0x56, 0x55, 0x25, 0xfa, 0xd9, 0x24, 0xd1, 0x5d, 0xd2,
+	0x75, 0x8e, 0x79, 0x6c, 0xbe, 0x25, 0xdb, 0xe3, 0x92, 0x9c, 0xd8, 0x64, 0xec, 0x29, 0x66, 0xe1,
+	0x1c, 0x17, 0xa4, 0xd5, 0x03, 0xc8, 0xb7, 0xbb, 0xd4, 0x3d, 0x52, 0x66, 0x73, 0xc2, 0x2c, 0x31,
+	0xc9, 0x09, 0xd0, 0xc2, 0x20, 0x24, 0x61, 0xa8, 0x92, 0xf8, 0x7c, 0x8e, 0x9f, 0x97, 0xd0, 0xe9,
+	0x45, 0x68, 0x0f, 0xd6, 0x22, 0x46, 0x43, 0x62, 0xf7, 0x45, 0x52, 0xb6, 0x48, 0x9b, 0xa7, 0xb1,
+	0x98, 0xec, 0xc4, 0x39, 0x8a, 0x85, 0x57, 0x85, 0x4e, 0x6d, 0x85, 0x4f, 0xcd, 0x47, 0xa0, 0x3b,
+	0xd1, 0x71, 0xe0, 0xda, 0x8e, 0x7b, 0x64, 0xc7, 0xad, 0x13, 0xa1, 0x23, 0x95, 0xe0, 0xff, 0xc6,
+	0x23, 0xd3, 0x94, 0x0e, 0xff, 0x88, 0x69, 0xe1, 0x75, 0x01, 0x6d, 0xbb, 0x47, 0x07, 0x12, 0xa8,
+	0x0a, 0xfd, 0xc5, 0xde, 0xfb, 0x24, 0xf4, 0xa9, 0xa7, 0xa7, 0xff, 0xdc, 0xbb, 0x64, 0x9e, 0xf7,
+	0xde, 0x92, 0xfa, 0xef, 0x35, 0x58, 0x6d, 0x91, 0xc0, 0xf3, 0x83, 0xce, 0xb6, 0x22, 0xa0, 0x87,
+	0x90, 0x95, 0x1b, 0x16, 0xe5, 0xc8, 0xdf, 0xbd, 0x76, 0xe1, 0x8d, 0x2d, 0x0b, 0xa0, 0xee, 0x6a,
+	0x65, 0xc0, 0xdb, 0x13, 0x12, 0x77, 0x18, 0x9f, 0x9e, 0x73, 0xed, 0x49, 0x80, 0x16, 0x06, 0x2e,
+	0xa9, 0x73, 0xf1, 0x18, 0x56, 0x04, 0x36, 0x3d, 0x14, 0x72, 0x6f, 0x89, 0x8b, 0x66, 0x16, 0xb7,
+	0xf0, 0x32, 0x57, 0x9c, 0x3d, 0x0e, 0x4d, 0x58, 0xdd, 0x76, 0x8f, 0x02, 0xfa, 0xa2, 0x4b, 0xbc,
+	0x0e, 0xe9, 0x91, 0x80, 0x21, 0x1d, 0xb2, 0x21, 0x89, 0x06, 0x5d, 0xa6, 0xaf, 0xf3, 0xa1, 0xdc,
+	0x4b, 0x61, 0x25, 0xa3, 0x0d, 0x98, 0x27, 0x61, 0x48, 0x43, 0x7d, 0x83, 0x4f, 0xfe, 0x5e, 0x0a,
+	0x4b, 0xb1, 0x0a, 0xb0, 0x18, 0x92, 0xa8, 0x4f, 0x83, 0x88, 0x58, 0x3f, 0x6a, 0x70, 0x49, 0x5d,
+	0x19, 0x7b, 0x4e, 0x97, 0xb5, 0x42, 0xda, 0xa7, 0x91, 0xd3, 0x45, 0x97, 0x61, 0x9e, 0xf9, 0xac,
+	0x2b, 0xef, 0xda, 0x1c, 0x96, 0x02, 0x2a, 0x41, 0xde, 0x23, 0x91, 0x1b, 0xfa, 0x7d, 0x7e, 0xd5,
+	0xc8, 0x13, 0x85, 0x93, 0xaa, 0xe4, 0xf9, 0x4c, 0xff, 0xcd, 0xf3, 0x99, 0xf9, 0x8b, 0x9f, 0x06,
+	0x8b, 0x57, 0xe2, 0x87, 0x6f, 0x6f, 0x1b, 0xea, 0xd5, 0xd4, 0xa1, 0xc3, 0xf2, 0x70, 0xab, 0x4d,
+	0x98, 0xc3, 0xbf, 0xb3, 0x01, 0x23, 0x01, 0xb3, 0x7e, 0xd2, 0x60, 0x5d, 0x6d, 0x0b, 0x93, 0x68,
+	0xd0, 0x23, 0xff, 0x92, 0x8d, 0xdd, 0xfa, 0x4e, 0x83, 0xf9, 0x7d, 0xf5, 0x6c, 0x31, 0xf7, 0x0f,
+	0xb6, 0x0f, 0x6a, 0xf6, 0xb3, 0x46, 0xbd, 0x51, 0x3f, 0xa8, 0x6f, 0x3f, 0xad, 0x3f, 0xaf, 0xed,
+	0xda, 0xcf, 0x1a, 0xfb, 0xad, 0xda, 0x4e, 0xfd, 0x49, 0xbd, 0xb6, 0x5b, 0x48, 0x19, 0x6b, 0x27,
+	0xa7, 0xa5, 0xe5, 0x19, 0x02, 0xd2, 0x01, 0xa4, 0x1d, 0x57, 0x16, 0x34, 0x63, 0xf1, 0xe4, 0xb4,
+	0x94, 0xe1, 0x6b, 0x54, 0x84, 0x65, 0x89, 0x1c, 0xe0, 0x0f, 0x9b, 0xad, 0x5a, 0xa3, 0x30, 0x67,
+	0xe4, 0x4f, 0x4e, 0x4b, 0x0b, 0x4a, 0x9c, 0x5a, 0x0a, 0x30, 0x2d, 0x2d, 0x05, 0x72, 0x1d, 0x96,
+	0x24, 0xb2, 0xf3, 0xb4, 0xb9, 0x5f, 0xdb, 0x2d, 0x64, 0x0c, 0x38, 0x39, 0x2d, 0x65, 0xa5, 0x64,
+	0x64, 0x5e, 0x7e, 0x55, 0x4c, 0xdd, 0x7a, 0x01, 0xf3, 0xe2, 0x05, 0x85, 0xfe, 0x0f, 0x1b, 0x4d,
+	0xbc, 0x5b, 0xc3, 0x76, 0xa3, 0xd9, 0xa8, 0x9d, 0xc9, 0x57, 0xb8, 0xe4, 0x7a, 0x64, 0xc1, 0xaa,
+	0x64, 0x3d, 0x6b, 0x88, 0xdf, 0xda, 0x6e, 0x41, 0x33, 0x96, 0x4f, 0x4e, 0x4b, 0xb9, 0x89, 0x82,
+	0x27, 0x2c, 0x39, 0x31, 0x43, 0x25, 0xac, 0x44, 0x19, 0xb8, 0xba, 0xff, 0xea, 0x4d, 0x51, 0x7b,
+	0xfd, 0xa6, 0xa8, 0xfd, 0xf2, 0xa6, 0xa8, 0x7d, 0xf1, 0xb6, 0x98, 0x7a, 0xfd, 0xb6, 0x98, 0xfa,
+	0xf9, 0x6d, 0x31, 0xf5, 0xfc, 0x61, 0xc7, 0x67, 0x87, 0x83, 0x76, 0xd9, 0xa5, 0x3d, 0xf5, 0x04,
+	0xaf, 0xf8, 0x6d, 0xf7, 0x76, 0x87, 0x56, 0x86, 0xf7, 0x2a, 0x3d, 0xea, 0x0d, 0xba, 0x24, 0x92,
+	0xaf, 0xf8, 0x3b, 0xf7, 0x6f, 0xc7, 0x7f, 0x0b, 0xd8, 0x71, 0x9f, 0x44, 0xed, 0xac, 0x78, 0xab,
+	0xdf, 0xfb, 0x7d, 0x00, 0xe4, 0x3e, 0x77, 0xb1, 0x37, 0x0c, 0x00, 0x00,
 }
 
 func (m *Channel) Marshal() (dAtA []byte, err error) {
@@ -811,6 +1088,125 @@ func (m *PacketState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PacketDelay) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketDelay) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketDelay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockDelay != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.BlockDelay))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TimeDelay != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.TimeDelay))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AsyncAckTimeoutPeriod != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.AsyncAckTimeoutPeriod))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AsyncAckTimeoutBlocks != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.AsyncAckTimeoutBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StorePacketData {
+		i--
+		if m.StorePacketData {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingAsyncAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingAsyncAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingAsyncAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecvTimestamp != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.RecvTimestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RecvHeight != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.RecvHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintChannel(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Acknowledgement) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -877,33 +1273,135 @@ func (m *Acknowledgement_Error) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	dAtA[i] = 0xb2
 	return len(dAtA) - i, nil
 }
-func encodeVarintChannel(dAtA []byte, offset int, v uint64) int {
-	offset -= sovChannel(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ChannelHaltProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Channel) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ChannelHaltProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelHaltProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.State != 0 {
-		n += 1 + sovChannel(uint64(m.State))
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Ordering != 0 {
-		n += 1 + sovChannel(uint64(m.Ordering))
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = m.Counterparty.Size()
-	n += 1 + l + sovChannel(uint64(l))
-	if len(m.ConnectionHops) > 0 {
-		for _, s := range m.ConnectionHops {
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelResumeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelResumeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelResumeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintChannel(dAtA []byte, offset int, v uint64) int {
+	offset -= sovChannel(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Channel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.State != 0 {
+		n += 1 + sovChannel(uint64(m.State))
+	}
+	if m.Ordering != 0 {
+		n += 1 + sovChannel(uint64(m.Ordering))
+	}
+	l = m.Counterparty.Size()
+	n += 1 + l + sovChannel(uint64(l))
+	if len(m.ConnectionHops) > 0 {
+		for _, s := range m.ConnectionHops {
 			l = len(s)
 			n += 1 + l + sovChannel(uint64(l))
 		}
@@ -1028,6 +1526,56 @@ func (m *PacketState) Size() (n int) {
 	return n
 }
 
+func (m *PacketDelay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TimeDelay != 0 {
+		n += 1 + sovChannel(uint64(m.TimeDelay))
+	}
+	if m.BlockDelay != 0 {
+		n += 1 + sovChannel(uint64(m.BlockDelay))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StorePacketData {
+		n += 2
+	}
+	if m.AsyncAckTimeoutBlocks != 0 {
+		n += 1 + sovChannel(uint64(m.AsyncAckTimeoutBlocks))
+	}
+	if m.AsyncAckTimeoutPeriod != 0 {
+		n += 1 + sovChannel(uint64(m.AsyncAckTimeoutPeriod))
+	}
+	return n
+}
+
+func (m *PendingAsyncAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
+	n += 1 + l + sovChannel(uint64(l))
+	if m.RecvHeight != 0 {
+		n += 1 + sovChannel(uint64(m.RecvHeight))
+	}
+	if m.RecvTimestamp != 0 {
+		n += 1 + sovChannel(uint64(m.RecvTimestamp))
+	}
+	return n
+}
+
 func (m *Acknowledgement) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1062,6 +1610,55 @@ func (m *Acknowledgement_Error) Size() (n int) {
 	n += 2 + l + sovChannel(uint64(l))
 	return n
 }
+func (m *ChannelHaltProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	return n
+}
+
+func (m *ChannelResumeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	return n
+}
 
 func sovChannel(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
@@ -2067,7 +2664,7 @@ func (m *PacketState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Acknowledgement) Unmarshal(dAtA []byte) error {
+func (m *PacketDelay) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2090,17 +2687,17 @@ func (m *Acknowledgement) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Acknowledgement: wiretype end group for non-group")
+			return fmt.Errorf("proto: PacketDelay: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Acknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PacketDelay: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 21:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeDelay", wireType)
 			}
-			var byteLen int
+			m.TimeDelay = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowChannel
@@ -2110,30 +2707,85 @@ func (m *Acknowledgement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.TimeDelay |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthChannel
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockDelay", wireType)
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			m.BlockDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthChannel
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.Response = &Acknowledgement_Result{v}
-			iNdEx = postIndex
-		case 22:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
 			}
-			var stringLen uint64
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorePacketData", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowChannel
@@ -2143,20 +2795,282 @@ func (m *Acknowledgement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthChannel
+			m.StorePacketData = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AsyncAckTimeoutBlocks", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthChannel
+			m.AsyncAckTimeoutBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AsyncAckTimeoutBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AsyncAckTimeoutPeriod", wireType)
+			}
+			m.AsyncAckTimeoutPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AsyncAckTimeoutPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingAsyncAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingAsyncAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingAsyncAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvHeight", wireType)
+			}
+			m.RecvHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecvHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvTimestamp", wireType)
+			}
+			m.RecvTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecvTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Acknowledgement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Acknowledgement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Acknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Response = &Acknowledgement_Result{v}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
 			m.Response = &Acknowledgement_Error{string(dAtA[iNdEx:postIndex])}
@@ -2182,6 +3096,362 @@ func (m *Acknowledgement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ChannelHaltProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelHaltProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelHaltProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelResumeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelResumeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelResumeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipChannel(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,6 +5,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
@@ -35,10 +36,19 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgChannelOpenConfirm{},
 		&MsgChannelCloseInit{},
 		&MsgChannelCloseConfirm{},
+		&MsgChannelCloseFrozen{},
 		&MsgRecvPacket{},
 		&MsgAcknowledgement{},
 		&MsgTimeout{},
 		&MsgTimeoutOnClose{},
+		&MsgPruneAcknowledgements{},
+		&MsgRecvPackets{},
+		&MsgAcknowledgements{},
+	)
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&ChannelHaltProposal{},
+		&ChannelResumeProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
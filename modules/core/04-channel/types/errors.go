@@ -38,4 +38,7 @@ var (
 	ErrNoOpMsg = sdkerrors.Register(SubModuleName, 23, "message is redundant, no-op will be performed")
 
 	ErrInvalidChannelVersion = sdkerrors.Register(SubModuleName, 24, "invalid channel version")
+	ErrChannelHalted         = sdkerrors.Register(SubModuleName, 25, "channel is halted")
+	ErrAsyncAckExpired       = sdkerrors.Register(SubModuleName, 26, "asynchronous acknowledgement expired")
+	ErrInvalidPacketDelay    = sdkerrors.Register(SubModuleName, 27, "invalid packet delay")
 )
@@ -38,6 +38,9 @@ const (
 	AttributeKeyDstChannel       = "packet_dst_channel"
 	AttributeKeyChannelOrdering  = "packet_channel_ordering"
 	AttributeKeyConnection       = "packet_connection"
+
+	AttributeKeyPruningSequenceStart = "pruning_sequence_start"
+	AttributeKeyPruningSequenceEnd   = "pruning_sequence_end"
 )
 
 // IBC channel events vars
@@ -48,7 +51,13 @@ var (
 	EventTypeChannelOpenConfirm  = "channel_open_confirm"
 	EventTypeChannelCloseInit    = "channel_close_init"
 	EventTypeChannelCloseConfirm = "channel_close_confirm"
+	EventTypeChannelCloseFrozen  = "channel_close_frozen"
 	EventTypeChannelClosed       = "channel_close"
 
+	EventTypePruneAcknowledgements = "prune_acknowledgements"
+
+	EventTypeChannelHalt   = "channel_halt"
+	EventTypeChannelResume = "channel_resume"
+
 	AttributeValueCategory = fmt.Sprintf("%s_%s", host.ModuleName, SubModuleName)
 )
@@ -12,7 +12,7 @@ import (
 type ClientKeeper interface {
 	GetClientState(ctx sdk.Context, clientID string) (exported.ClientState, bool)
 	GetClientConsensusState(ctx sdk.Context, clientID string, height exported.Height) (exported.ConsensusState, bool)
-	ClientStore(ctx sdk.Context, clientID string) sdk.KVStore
+	ReadOnlyClientStore(ctx sdk.Context, clientID string, writableKeys ...[]byte) sdk.KVStore
 }
 
 // ConnectionKeeper expected account IBC connection keeper
@@ -23,6 +23,7 @@ type ConnectionKeeper interface {
 		connection connectiontypes.ConnectionEnd,
 		height exported.Height,
 	) (uint64, error)
+	GetLastHopConsensusState(ctx sdk.Context, connectionHops []string) (exported.ConsensusState, exported.Height, bool)
 	VerifyChannelState(
 		ctx sdk.Context,
 		connection exported.ConnectionI,
@@ -36,6 +37,8 @@ type ConnectionKeeper interface {
 		ctx sdk.Context,
 		connection exported.ConnectionI,
 		height exported.Height,
+		channelTimeDelay uint64,
+		channelBlockDelay uint64,
 		proof []byte,
 		portID,
 		channelID string,
@@ -46,16 +49,46 @@ type ConnectionKeeper interface {
 		ctx sdk.Context,
 		connection exported.ConnectionI,
 		height exported.Height,
+		channelTimeDelay uint64,
+		channelBlockDelay uint64,
 		proof []byte,
 		portID,
 		channelID string,
 		sequence uint64,
 		acknowledgement []byte,
 	) error
+	VerifyPacketCommitments(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		channelTimeDelay uint64,
+		channelBlockDelay uint64,
+		proofs [][]byte,
+		proofBatch []byte,
+		portID,
+		channelID string,
+		sequences []uint64,
+		commitments [][]byte,
+	) error
+	VerifyPacketAcknowledgements(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		channelTimeDelay uint64,
+		channelBlockDelay uint64,
+		proofs [][]byte,
+		proofBatch []byte,
+		portID,
+		channelID string,
+		sequences []uint64,
+		acknowledgements [][]byte,
+	) error
 	VerifyPacketReceiptAbsence(
 		ctx sdk.Context,
 		connection exported.ConnectionI,
 		height exported.Height,
+		channelTimeDelay uint64,
+		channelBlockDelay uint64,
 		proof []byte,
 		portID,
 		channelID string,
@@ -65,11 +98,104 @@ type ConnectionKeeper interface {
 		ctx sdk.Context,
 		connection exported.ConnectionI,
 		height exported.Height,
+		channelTimeDelay uint64,
+		channelBlockDelay uint64,
+		proof []byte,
+		portID,
+		channelID string,
+		nextSequenceRecv uint64,
+	) error
+	VerifyNextSequenceAck(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		channelTimeDelay uint64,
+		channelBlockDelay uint64,
+		proof []byte,
+		portID,
+		channelID string,
+		nextSequenceAck uint64,
+	) error
+	VerifyMultihopChannelState(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		connectionHops []string,
+		height exported.Height,
+		proof []byte,
+		portID,
+		channelID string,
+		channel exported.ChannelI,
+	) error
+	VerifyMultihopPacketCommitment(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		connectionHops []string,
+		height exported.Height,
+		channelTimeDelay uint64,
+		channelBlockDelay uint64,
+		proof []byte,
+		portID,
+		channelID string,
+		sequence uint64,
+		commitmentBytes []byte,
+	) error
+	VerifyMultihopPacketAcknowledgement(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		connectionHops []string,
+		height exported.Height,
+		channelTimeDelay uint64,
+		channelBlockDelay uint64,
+		proof []byte,
+		portID,
+		channelID string,
+		sequence uint64,
+		acknowledgement []byte,
+	) error
+	VerifyMultihopPacketReceiptAbsence(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		connectionHops []string,
+		height exported.Height,
+		channelTimeDelay uint64,
+		channelBlockDelay uint64,
+		proof []byte,
+		portID,
+		channelID string,
+		sequence uint64,
+	) error
+	VerifyMultihopNextSequenceRecv(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		connectionHops []string,
+		height exported.Height,
+		channelTimeDelay uint64,
+		channelBlockDelay uint64,
 		proof []byte,
 		portID,
 		channelID string,
 		nextSequenceRecv uint64,
 	) error
+	VerifyMultihopNextSequenceAck(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		connectionHops []string,
+		height exported.Height,
+		channelTimeDelay uint64,
+		channelBlockDelay uint64,
+		proof []byte,
+		portID,
+		channelID string,
+		nextSequenceAck uint64,
+	) error
+	VerifyMultihopClientFrozen(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		connectionHops []string,
+		height exported.Height,
+		proofConnection []byte,
+		proofClientState []byte,
+	) error
 }
 
 // PortKeeper expected account IBC port keeper
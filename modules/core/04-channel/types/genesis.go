@@ -41,6 +41,53 @@ func (ps PacketSequence) Validate() error {
 	return validateGenFields(ps.PortId, ps.ChannelId, ps.Sequence)
 }
 
+// NewIdentifiedPacketDelay creates a new IdentifiedPacketDelay instance.
+func NewIdentifiedPacketDelay(portID, channelID string, packetDelay PacketDelay) IdentifiedPacketDelay {
+	return IdentifiedPacketDelay{
+		PortId:      portID,
+		ChannelId:   channelID,
+		PacketDelay: packetDelay,
+	}
+}
+
+// Validate performs basic validation of fields returning an error upon any
+// failure.
+func (ipd IdentifiedPacketDelay) Validate() error {
+	if err := host.PortIdentifierValidator(ipd.PortId); err != nil {
+		return fmt.Errorf("invalid port Id: %w", err)
+	}
+	if err := host.ChannelIdentifierValidator(ipd.ChannelId); err != nil {
+		return fmt.Errorf("invalid channel Id: %w", err)
+	}
+	if ipd.PacketDelay.IsZero() {
+		return errors.New("packet delay cannot be zero")
+	}
+	if err := ipd.PacketDelay.ValidateBasic(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// NewHaltedChannel creates a new HaltedChannel instance.
+func NewHaltedChannel(portID, channelID string) HaltedChannel {
+	return HaltedChannel{
+		PortId:    portID,
+		ChannelId: channelID,
+	}
+}
+
+// Validate performs basic validation of fields returning an error upon any
+// failure.
+func (hc HaltedChannel) Validate() error {
+	if err := host.PortIdentifierValidator(hc.PortId); err != nil {
+		return fmt.Errorf("invalid port Id: %w", err)
+	}
+	if err := host.ChannelIdentifierValidator(hc.ChannelId); err != nil {
+		return fmt.Errorf("invalid channel Id: %w", err)
+	}
+	return nil
+}
+
 // NewGenesisState creates a GenesisState instance.
 func NewGenesisState(
 	channels []IdentifiedChannel, acks, receipts, commitments []PacketState,
@@ -60,14 +107,21 @@ func NewGenesisState(
 // DefaultGenesisState returns the ibc channel submodule's default genesis state.
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Channels:            []IdentifiedChannel{},
-		Acknowledgements:    []PacketState{},
-		Receipts:            []PacketState{},
-		Commitments:         []PacketState{},
-		SendSequences:       []PacketSequence{},
-		RecvSequences:       []PacketSequence{},
-		AckSequences:        []PacketSequence{},
-		NextChannelSequence: 0,
+		Channels:              []IdentifiedChannel{},
+		Acknowledgements:      []PacketState{},
+		Receipts:              []PacketState{},
+		Commitments:           []PacketState{},
+		SendSequences:         []PacketSequence{},
+		RecvSequences:         []PacketSequence{},
+		AckSequences:          []PacketSequence{},
+		NextChannelSequence:   0,
+		PacketDelays:          []IdentifiedPacketDelay{},
+		Params:                DefaultParams(),
+		Packets:               []Packet{},
+		PruningSequenceStarts: []PacketSequence{},
+		PruningSequenceEnds:   []PacketSequence{},
+		HaltedChannels:        []HaltedChannel{},
+		PendingAsyncAcks:      []PendingAsyncAck{},
 	}
 }
 
@@ -139,9 +193,88 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for i, pd := range gs.PacketDelays {
+		if err := pd.Validate(); err != nil {
+			return fmt.Errorf("invalid packet delay %v index %d: %w", pd, i, err)
+		}
+	}
+
+	for i, packet := range gs.Packets {
+		if err := packet.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid packet %v index %d: %w", packet, i, err)
+		}
+	}
+
+	if err := gs.validatePruningSequences(); err != nil {
+		return err
+	}
+
+	for i, hc := range gs.HaltedChannels {
+		if err := hc.Validate(); err != nil {
+			return fmt.Errorf("invalid halted channel %v index %d: %w", hc, i, err)
+		}
+	}
+
+	for i, paa := range gs.PendingAsyncAcks {
+		if err := paa.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid pending asynchronous acknowledgement %v index %d: %w", paa, i, err)
+		}
+	}
+
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// validatePruningSequences ensures that the pruning sequence start of a channel
+// does not exceed its pruning sequence end, and that no packet receipt or
+// acknowledgement below the pruning sequence start remains.
+func (gs GenesisState) validatePruningSequences() error {
+	pruningSequenceEnds := make(map[string]uint64)
+	for i, ps := range gs.PruningSequenceEnds {
+		if err := ps.Validate(); err != nil {
+			return fmt.Errorf("invalid pruning sequence end %v index %d: %w", ps, i, err)
+		}
+		pruningSequenceEnds[channelKey(ps.PortId, ps.ChannelId)] = ps.Sequence
+	}
+
+	pruningSequenceStarts := make(map[string]uint64)
+	for i, ps := range gs.PruningSequenceStarts {
+		if err := ps.Validate(); err != nil {
+			return fmt.Errorf("invalid pruning sequence start %v index %d: %w", ps, i, err)
+		}
+
+		pruningSequenceEnd, found := pruningSequenceEnds[channelKey(ps.PortId, ps.ChannelId)]
+		if !found {
+			pruningSequenceEnd = 1
+		}
+		if ps.Sequence > pruningSequenceEnd {
+			return fmt.Errorf("pruning sequence start %v index %d exceeds pruning sequence end %d", ps, i, pruningSequenceEnd)
+		}
+		pruningSequenceStarts[channelKey(ps.PortId, ps.ChannelId)] = ps.Sequence
+	}
+
+	for i, receipt := range gs.Receipts {
+		if receipt.Sequence < pruningSequenceStarts[channelKey(receipt.PortId, receipt.ChannelId)] {
+			return fmt.Errorf("packet receipt %v index %d is below the pruning sequence start", receipt, i)
+		}
+	}
+
+	for i, ack := range gs.Acknowledgements {
+		if ack.Sequence < pruningSequenceStarts[channelKey(ack.PortId, ack.ChannelId)] {
+			return fmt.Errorf("acknowledgement %v index %d is below the pruning sequence start", ack, i)
+		}
+	}
+
+	return nil
+}
+
+func channelKey(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s", portID, channelID)
+}
+
 func validateGenFields(portID, channelID string, sequence uint64) error {
 	if err := host.PortIdentifierValidator(portID); err != nil {
 		return fmt.Errorf("invalid port Id: %w", err)
@@ -34,6 +34,21 @@ type GenesisState struct {
 	AckSequences     []PacketSequence    `protobuf:"bytes,7,rep,name=ack_sequences,json=ackSequences,proto3" json:"ack_sequences" yaml:"ack_sequences"`
 	// the sequence for the next generated channel identifier
 	NextChannelSequence uint64 `protobuf:"varint,8,opt,name=next_channel_sequence,json=nextChannelSequence,proto3" json:"next_channel_sequence,omitempty" yaml:"next_channel_sequence"`
+	// the additional packet delays declared by channels
+	PacketDelays []IdentifiedPacketDelay `protobuf:"bytes,9,rep,name=packet_delays,json=packetDelays,proto3" json:"packet_delays" yaml:"packet_delays"`
+	Params       Params                  `protobuf:"bytes,10,opt,name=params,proto3" json:"params"`
+	// the full data of the sent packets pending acknowledgement or timeout
+	Packets []Packet `protobuf:"bytes,11,rep,name=packets,proto3" json:"packets"`
+	// the next sequences whose packet receipts and acknowledgements have not yet
+	// been pruned
+	PruningSequenceStarts []PacketSequence `protobuf:"bytes,12,rep,name=pruning_sequence_starts,json=pruningSequenceStarts,proto3" json:"pruning_sequence_starts" yaml:"pruning_sequence_starts"`
+	// the sequences below which packet receipts and acknowledgements may be
+	// pruned
+	PruningSequenceEnds []PacketSequence `protobuf:"bytes,13,rep,name=pruning_sequence_ends,json=pruningSequenceEnds,proto3" json:"pruning_sequence_ends" yaml:"pruning_sequence_ends"`
+	// the channels halted by governance
+	HaltedChannels []HaltedChannel `protobuf:"bytes,14,rep,name=halted_channels,json=haltedChannels,proto3" json:"halted_channels" yaml:"halted_channels"`
+	// the received packets pending an asynchronous acknowledgement
+	PendingAsyncAcks []PendingAsyncAck `protobuf:"bytes,15,rep,name=pending_async_acks,json=pendingAsyncAcks,proto3" json:"pending_async_acks" yaml:"pending_async_acks"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -125,6 +140,55 @@ func (m *GenesisState) GetNextChannelSequence() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetPacketDelays() []IdentifiedPacketDelay {
+	if m != nil {
+		return m.PacketDelays
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetPackets() []Packet {
+	if m != nil {
+		return m.Packets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPruningSequenceStarts() []PacketSequence {
+	if m != nil {
+		return m.PruningSequenceStarts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPruningSequenceEnds() []PacketSequence {
+	if m != nil {
+		return m.PruningSequenceEnds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHaltedChannels() []HaltedChannel {
+	if m != nil {
+		return m.HaltedChannels
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingAsyncAcks() []PendingAsyncAck {
+	if m != nil {
+		return m.PendingAsyncAcks
+	}
+	return nil
+}
+
 // PacketSequence defines the genesis type necessary to retrieve and store
 // next send and receive sequences.
 type PacketSequence struct {
@@ -187,47 +251,182 @@ func (m *PacketSequence) GetSequence() uint64 {
 	return 0
 }
 
+// IdentifiedPacketDelay defines the genesis type necessary to retrieve and
+// store the additional packet delay of a channel.
+type IdentifiedPacketDelay struct {
+	PortId      string      `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	ChannelId   string      `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	PacketDelay PacketDelay `protobuf:"bytes,3,opt,name=packet_delay,json=packetDelay,proto3" json:"packet_delay" yaml:"packet_delay"`
+}
+
+func (m *IdentifiedPacketDelay) Reset()         { *m = IdentifiedPacketDelay{} }
+func (m *IdentifiedPacketDelay) String() string { return proto.CompactTextString(m) }
+func (*IdentifiedPacketDelay) ProtoMessage()    {}
+func (*IdentifiedPacketDelay) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb06ec201f452595, []int{2}
+}
+func (m *IdentifiedPacketDelay) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IdentifiedPacketDelay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IdentifiedPacketDelay.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IdentifiedPacketDelay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdentifiedPacketDelay.Merge(m, src)
+}
+func (m *IdentifiedPacketDelay) XXX_Size() int {
+	return m.Size()
+}
+func (m *IdentifiedPacketDelay) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdentifiedPacketDelay.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdentifiedPacketDelay proto.InternalMessageInfo
+
+func (m *IdentifiedPacketDelay) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *IdentifiedPacketDelay) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *IdentifiedPacketDelay) GetPacketDelay() PacketDelay {
+	if m != nil {
+		return m.PacketDelay
+	}
+	return PacketDelay{}
+}
+
+// HaltedChannel defines the genesis type necessary to retrieve and store the
+// channels halted by governance.
+type HaltedChannel struct {
+	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+}
+
+func (m *HaltedChannel) Reset()         { *m = HaltedChannel{} }
+func (m *HaltedChannel) String() string { return proto.CompactTextString(m) }
+func (*HaltedChannel) ProtoMessage()    {}
+func (*HaltedChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb06ec201f452595, []int{3}
+}
+func (m *HaltedChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HaltedChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HaltedChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HaltedChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HaltedChannel.Merge(m, src)
+}
+func (m *HaltedChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *HaltedChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_HaltedChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HaltedChannel proto.InternalMessageInfo
+
+func (m *HaltedChannel) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *HaltedChannel) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.core.channel.v1.GenesisState")
 	proto.RegisterType((*PacketSequence)(nil), "ibc.core.channel.v1.PacketSequence")
+	proto.RegisterType((*IdentifiedPacketDelay)(nil), "ibc.core.channel.v1.IdentifiedPacketDelay")
+	proto.RegisterType((*HaltedChannel)(nil), "ibc.core.channel.v1.HaltedChannel")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v1/genesis.proto", fileDescriptor_cb06ec201f452595) }
 
 var fileDescriptor_cb06ec201f452595 = []byte{
-	// 506 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0x87, 0xe3, 0x36, 0x4d, 0xd3, 0x6d, 0x13, 0xd1, 0x6d, 0x23, 0x99, 0xa8, 0xd8, 0xc6, 0x48,
-	0x28, 0x12, 0xaa, 0x4d, 0x69, 0x2f, 0x70, 0x34, 0x07, 0xc8, 0x0d, 0x6d, 0x39, 0x21, 0xa1, 0xc8,
-	0x5e, 0x4f, 0xdd, 0x55, 0x62, 0x6f, 0xf0, 0x6e, 0x02, 0x7d, 0x0a, 0x78, 0xac, 0x1e, 0x7b, 0xe4,
-	0x64, 0xa1, 0xe4, 0x0d, 0x72, 0xe4, 0x84, 0xfc, 0x37, 0x89, 0x1a, 0x21, 0xca, 0xcd, 0x3b, 0xf3,
-	0x9b, 0xef, 0x9b, 0x83, 0x07, 0x3d, 0x65, 0x1e, 0xb5, 0x29, 0x8f, 0xc1, 0xa6, 0xd7, 0x6e, 0x14,
-	0xc1, 0xc8, 0x9e, 0x9e, 0xd9, 0x01, 0x44, 0x20, 0x98, 0xb0, 0xc6, 0x31, 0x97, 0x1c, 0x1f, 0x31,
-	0x8f, 0x5a, 0x69, 0xc4, 0x2a, 0x22, 0xd6, 0xf4, 0xac, 0x7b, 0x1c, 0xf0, 0x80, 0x67, 0x7d, 0x3b,
-	0xfd, 0xca, 0xa3, 0xdd, 0x8d, 0xb4, 0x72, 0x2a, 0x8b, 0x98, 0xf3, 0x1d, 0x74, 0xf0, 0x2e, 0xe7,
-	0x5f, 0x4a, 0x57, 0x02, 0xfe, 0x8c, 0x9a, 0x45, 0x42, 0xa8, 0x8a, 0xb1, 0xdd, 0xdb, 0x7f, 0xf5,
-	0xdc, 0xda, 0x60, 0xb4, 0xfa, 0x3e, 0x44, 0x92, 0x5d, 0x31, 0xf0, 0xdf, 0xe6, 0x45, 0xe7, 0xf1,
-	0x6d, 0xa2, 0xd7, 0x7e, 0x27, 0xfa, 0xe1, 0xbd, 0x16, 0xa9, 0x90, 0x98, 0xa0, 0x47, 0x2e, 0x1d,
-	0x46, 0xfc, 0xeb, 0x08, 0xfc, 0x00, 0x42, 0x88, 0xa4, 0x50, 0xb7, 0x32, 0x8d, 0xb1, 0x51, 0xf3,
-	0xc1, 0xa5, 0x43, 0x90, 0xd9, 0x6a, 0x4e, 0x3d, 0x15, 0x90, 0x7b, 0xf3, 0xf8, 0x3d, 0xda, 0xa7,
-	0x3c, 0x0c, 0x99, 0xcc, 0x71, 0xdb, 0x0f, 0xc2, 0xad, 0x8e, 0x62, 0x07, 0x35, 0x63, 0xa0, 0xc0,
-	0xc6, 0x52, 0xa8, 0xf5, 0x07, 0x61, 0xaa, 0x39, 0xcc, 0x50, 0x5b, 0x40, 0xe4, 0x0f, 0x04, 0x7c,
-	0x99, 0x40, 0x44, 0x41, 0xa8, 0x3b, 0x19, 0xe9, 0xd9, 0xdf, 0x48, 0x45, 0xd6, 0x79, 0x92, 0xc2,
-	0x16, 0x89, 0xde, 0xb9, 0x71, 0xc3, 0xd1, 0x1b, 0x73, 0x1d, 0x64, 0x92, 0x56, 0x5a, 0x28, 0xc3,
-	0x99, 0x2a, 0x06, 0x3a, 0x5d, 0x51, 0x35, 0xfe, 0x5b, 0xb5, 0x0e, 0x32, 0x49, 0x2b, 0x2d, 0x2c,
-	0x55, 0x57, 0xa8, 0xe5, 0xd2, 0xe1, 0x8a, 0x69, 0xf7, 0xdf, 0x4d, 0x27, 0x85, 0xe9, 0x38, 0x37,
-	0xad, 0x71, 0x4c, 0x72, 0xe0, 0xd2, 0xe1, 0xd2, 0xf3, 0x11, 0x75, 0x22, 0xf8, 0x26, 0x07, 0x05,
-	0xad, 0x0a, 0xaa, 0x4d, 0x43, 0xe9, 0xd5, 0x1d, 0x63, 0x91, 0xe8, 0x27, 0x39, 0x66, 0x63, 0xcc,
-	0x24, 0x47, 0x69, 0xbd, 0xf8, 0xef, 0x4a, 0xac, 0xf9, 0x5d, 0x41, 0xed, 0xf5, 0xa5, 0xf0, 0x0b,
-	0xb4, 0x3b, 0xe6, 0xb1, 0x1c, 0x30, 0x5f, 0x55, 0x0c, 0xa5, 0xb7, 0xe7, 0xe0, 0x45, 0xa2, 0xb7,
-	0x73, 0x74, 0xd1, 0x30, 0x49, 0x23, 0xfd, 0xea, 0xfb, 0xf8, 0x02, 0xa1, 0xd2, 0xc4, 0x7c, 0x75,
-	0x2b, 0xcb, 0x77, 0x16, 0x89, 0x7e, 0x98, 0xe7, 0x97, 0x3d, 0x93, 0xec, 0x15, 0x8f, 0xbe, 0x8f,
-	0xbb, 0xa8, 0x59, 0xad, 0xbf, 0x9d, 0xae, 0x4f, 0xaa, 0xb7, 0x73, 0x79, 0x3b, 0xd3, 0x94, 0xbb,
-	0x99, 0xa6, 0xfc, 0x9a, 0x69, 0xca, 0x8f, 0xb9, 0x56, 0xbb, 0x9b, 0x6b, 0xb5, 0x9f, 0x73, 0xad,
-	0xf6, 0xe9, 0x75, 0xc0, 0xe4, 0xf5, 0xc4, 0xb3, 0x28, 0x0f, 0x6d, 0xca, 0x45, 0xc8, 0x85, 0xcd,
-	0x3c, 0x7a, 0x1a, 0x70, 0x7b, 0x7a, 0x6e, 0x87, 0xdc, 0x9f, 0x8c, 0x40, 0xe4, 0x47, 0xfd, 0xf2,
-	0xe2, 0xb4, 0xbc, 0x6b, 0x79, 0x33, 0x06, 0xe1, 0x35, 0xb2, 0x9b, 0x3e, 0xff, 0x13, 0x00, 0x00,
-	0xff, 0xff, 0x29, 0xa1, 0xc4, 0xd2, 0x46, 0x04, 0x00, 0x00,
+	// 780 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0xcb, 0x6e, 0xd3, 0x4a,
+	0x18, 0xc7, 0xe3, 0xa6, 0x27, 0x4d, 0x27, 0x97, 0xb6, 0x93, 0xe6, 0x1c, 0xf7, 0x72, 0x9c, 0x74,
+	0xa8, 0xaa, 0x08, 0xd4, 0x98, 0x5e, 0x36, 0x85, 0x55, 0x0d, 0x88, 0x76, 0x87, 0xa6, 0xac, 0x90,
+	0x50, 0x70, 0xc6, 0xd3, 0xc4, 0x4a, 0x7c, 0xc1, 0xe3, 0x04, 0xb2, 0x60, 0xc5, 0x03, 0xc0, 0xbb,
+	0xf0, 0x12, 0x5d, 0x76, 0xc9, 0x02, 0x45, 0xa8, 0x7d, 0x83, 0x2c, 0x59, 0x21, 0x8f, 0xc7, 0xb9,
+	0x34, 0x6e, 0x69, 0x59, 0x74, 0x17, 0xcf, 0xfc, 0xff, 0xbf, 0xff, 0xf7, 0x4d, 0xe6, 0xb3, 0xc1,
+	0x86, 0x59, 0x27, 0x2a, 0x71, 0x3c, 0xaa, 0x92, 0xa6, 0x6e, 0xdb, 0xb4, 0xad, 0x76, 0x77, 0xd4,
+	0x06, 0xb5, 0x29, 0x33, 0x59, 0xd5, 0xf5, 0x1c, 0xdf, 0x81, 0x05, 0xb3, 0x4e, 0xaa, 0x81, 0xa4,
+	0x2a, 0x24, 0xd5, 0xee, 0xce, 0xea, 0x72, 0xc3, 0x69, 0x38, 0x7c, 0x5f, 0x0d, 0x7e, 0x85, 0xd2,
+	0xd5, 0x58, 0x5a, 0xe4, 0xe2, 0x12, 0xf4, 0x2d, 0x03, 0xb2, 0x2f, 0x43, 0xfe, 0x89, 0xaf, 0xfb,
+	0x14, 0xbe, 0x05, 0x69, 0xa1, 0x60, 0xb2, 0x54, 0x4e, 0x56, 0x32, 0xbb, 0x5b, 0xd5, 0x98, 0xc4,
+	0xea, 0xb1, 0x41, 0x6d, 0xdf, 0x3c, 0x35, 0xa9, 0xf1, 0x2c, 0x5c, 0xd4, 0x56, 0xce, 0xfa, 0xa5,
+	0xc4, 0xaf, 0x7e, 0x69, 0x69, 0x6a, 0x0b, 0x0f, 0x91, 0x10, 0x83, 0x45, 0x9d, 0xb4, 0x6c, 0xe7,
+	0x43, 0x9b, 0x1a, 0x0d, 0x6a, 0x51, 0xdb, 0x67, 0xf2, 0x0c, 0x8f, 0x29, 0xc7, 0xc6, 0xbc, 0xd2,
+	0x49, 0x8b, 0xfa, 0xbc, 0x34, 0x6d, 0x36, 0x08, 0xc0, 0x53, 0x7e, 0x78, 0x04, 0x32, 0xc4, 0xb1,
+	0x2c, 0xd3, 0x0f, 0x71, 0xc9, 0x3b, 0xe1, 0xc6, 0xad, 0x50, 0x03, 0x69, 0x8f, 0x12, 0x6a, 0xba,
+	0x3e, 0x93, 0x67, 0xef, 0x84, 0x19, 0xfa, 0xa0, 0x09, 0xf2, 0x8c, 0xda, 0x46, 0x8d, 0xd1, 0xf7,
+	0x1d, 0x6a, 0x13, 0xca, 0xe4, 0x7f, 0x38, 0xe9, 0xc1, 0x4d, 0x24, 0xa1, 0xd5, 0xfe, 0x0f, 0x60,
+	0x83, 0x7e, 0xa9, 0xd8, 0xd3, 0xad, 0xf6, 0x13, 0x34, 0x09, 0x42, 0x38, 0x17, 0x2c, 0x44, 0x62,
+	0x1e, 0xe5, 0x51, 0xd2, 0x1d, 0x8b, 0x4a, 0xfd, 0x75, 0xd4, 0x24, 0x08, 0xe1, 0x5c, 0xb0, 0x30,
+	0x8a, 0x3a, 0x05, 0x39, 0x9d, 0xb4, 0xc6, 0x92, 0xe6, 0x6e, 0x9f, 0xb4, 0x2e, 0x92, 0x96, 0xc3,
+	0xa4, 0x09, 0x0e, 0xc2, 0x59, 0x9d, 0xb4, 0x46, 0x39, 0xaf, 0x41, 0xd1, 0xa6, 0x1f, 0xfd, 0x9a,
+	0xa0, 0x0d, 0x85, 0x72, 0xba, 0x2c, 0x55, 0x66, 0xb5, 0xf2, 0xa0, 0x5f, 0x5a, 0x0f, 0x31, 0xb1,
+	0x32, 0x84, 0x0b, 0xc1, 0xba, 0xb8, 0x77, 0x11, 0x16, 0x5a, 0x20, 0xe7, 0xf2, 0x9a, 0x6a, 0x06,
+	0x6d, 0xeb, 0x3d, 0x26, 0xcf, 0xf3, 0xea, 0x1f, 0xfe, 0xe1, 0x66, 0x87, 0x7d, 0x3c, 0x0f, 0x2c,
+	0x57, 0x9b, 0x98, 0xc0, 0x21, 0x9c, 0x75, 0x47, 0x52, 0x06, 0x0f, 0x40, 0xca, 0xd5, 0x3d, 0xdd,
+	0x62, 0x32, 0x28, 0x4b, 0x95, 0xcc, 0xee, 0xda, 0x35, 0xa7, 0x14, 0x48, 0xc4, 0xfd, 0x11, 0x06,
+	0xf8, 0x14, 0xcc, 0x85, 0x28, 0x26, 0x67, 0xca, 0xc9, 0x1b, 0xbc, 0x81, 0x46, 0x78, 0x23, 0x07,
+	0xfc, 0x2c, 0x81, 0xff, 0x5c, 0xaf, 0x63, 0x9b, 0x76, 0x63, 0x78, 0x22, 0x35, 0xe6, 0xeb, 0x9e,
+	0xcf, 0xe4, 0xec, 0xed, 0xff, 0xaf, 0x2d, 0xd1, 0xaa, 0x22, 0x5a, 0x8d, 0x27, 0x22, 0x5c, 0x14,
+	0x3b, 0x91, 0xf1, 0x84, 0xaf, 0xc3, 0x4f, 0xa0, 0x38, 0x65, 0xa1, 0xb6, 0xc1, 0xe4, 0xdc, 0xed,
+	0x4b, 0xd8, 0x14, 0x25, 0xac, 0x5f, 0x53, 0x42, 0xc0, 0x43, 0xb8, 0x70, 0xa5, 0x80, 0x17, 0xb6,
+	0xc1, 0x60, 0x0b, 0x2c, 0x34, 0xf5, 0xb6, 0x4f, 0x8d, 0xda, 0xf0, 0x3d, 0x96, 0xe7, 0xc1, 0x28,
+	0x36, 0xf8, 0x88, 0x6b, 0xa3, 0x77, 0x98, 0x22, 0x72, 0xff, 0x0d, 0x73, 0xaf, 0x80, 0x10, 0xce,
+	0x37, 0xc7, 0xe5, 0x0c, 0x76, 0x00, 0x74, 0xa9, 0x6d, 0x04, 0xb5, 0xe9, 0xac, 0x67, 0x93, 0x9a,
+	0x4e, 0x5a, 0x4c, 0x5e, 0xe0, 0x79, 0x9b, 0xf1, 0x8d, 0x86, 0xf2, 0xc3, 0x40, 0x7d, 0x48, 0x5a,
+	0xda, 0x86, 0x48, 0x5c, 0x11, 0x9d, 0x4e, 0xd1, 0x10, 0x5e, 0x74, 0x27, 0x3d, 0x0c, 0x7d, 0x91,
+	0x40, 0x7e, 0xf2, 0xc4, 0xe0, 0x23, 0x30, 0xe7, 0x3a, 0x9e, 0x5f, 0x33, 0x0d, 0x59, 0x2a, 0x4b,
+	0x95, 0x79, 0x0d, 0x0e, 0xfa, 0xa5, 0xbc, 0x80, 0x86, 0x1b, 0x08, 0xa7, 0x82, 0x5f, 0xc7, 0x06,
+	0xdc, 0x07, 0x20, 0x9a, 0x1c, 0xd3, 0x90, 0x67, 0xb8, 0xbe, 0x38, 0xe8, 0x97, 0x96, 0x42, 0xfd,
+	0x68, 0x0f, 0xe1, 0x79, 0xf1, 0x70, 0x6c, 0xc0, 0x55, 0x90, 0x1e, 0x8e, 0x63, 0x32, 0x18, 0x47,
+	0x3c, 0x7c, 0x46, 0x3f, 0x24, 0x50, 0x8c, 0x1d, 0x9c, 0xfb, 0x28, 0xec, 0x1d, 0xc8, 0x8e, 0xcf,
+	0x23, 0x2f, 0xee, 0xe6, 0x57, 0x77, 0x38, 0xd3, 0x6b, 0xe2, 0xec, 0x0b, 0xd3, 0x33, 0x8d, 0x70,
+	0x66, 0x6c, 0xa4, 0x91, 0x07, 0x72, 0x13, 0x17, 0xe5, 0x1e, 0xba, 0xd2, 0x4e, 0xce, 0x2e, 0x14,
+	0xe9, 0xfc, 0x42, 0x91, 0x7e, 0x5e, 0x28, 0xd2, 0xd7, 0x4b, 0x25, 0x71, 0x7e, 0xa9, 0x24, 0xbe,
+	0x5f, 0x2a, 0x89, 0x37, 0x07, 0x0d, 0xd3, 0x6f, 0x76, 0xea, 0x55, 0xe2, 0x58, 0x2a, 0x71, 0x98,
+	0xe5, 0x30, 0xd5, 0xac, 0x93, 0xed, 0x86, 0xa3, 0x76, 0xf7, 0x54, 0xcb, 0x31, 0x3a, 0x6d, 0xca,
+	0xc2, 0xef, 0xfe, 0xe3, 0xfd, 0xed, 0xe8, 0xd3, 0xef, 0xf7, 0x5c, 0xca, 0xea, 0x29, 0xfe, 0xd9,
+	0xdf, 0xfb, 0x3d, 0x00, 0xd0, 0xbc, 0x68, 0x74, 0x69, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -250,6 +449,100 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingAsyncAcks) > 0 {
+		for iNdEx := len(m.PendingAsyncAcks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingAsyncAcks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.HaltedChannels) > 0 {
+		for iNdEx := len(m.HaltedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HaltedChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.PruningSequenceEnds) > 0 {
+		for iNdEx := len(m.PruningSequenceEnds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PruningSequenceEnds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.PruningSequenceStarts) > 0 {
+		for iNdEx := len(m.PruningSequenceStarts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PruningSequenceStarts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.PacketDelays) > 0 {
+		for iNdEx := len(m.PacketDelays) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PacketDelays[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.NextChannelSequence != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextChannelSequence))
 		i--
@@ -398,6 +691,90 @@ func (m *PacketSequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *IdentifiedPacketDelay) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IdentifiedPacketDelay) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdentifiedPacketDelay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PacketDelay.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HaltedChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HaltedChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HaltedChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -460,20 +837,58 @@ func (m *GenesisState) Size() (n int) {
 	if m.NextChannelSequence != 0 {
 		n += 1 + sovGenesis(uint64(m.NextChannelSequence))
 	}
-	return n
-}
-
-func (m *PacketSequence) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PacketDelays) > 0 {
+		for _, e := range m.PacketDelays {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
 	}
-	l = len(m.ChannelId)
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PruningSequenceStarts) > 0 {
+		for _, e := range m.PruningSequenceStarts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PruningSequenceEnds) > 0 {
+		for _, e := range m.PruningSequenceEnds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HaltedChannels) > 0 {
+		for _, e := range m.HaltedChannels {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingAsyncAcks) > 0 {
+		for _, e := range m.PendingAsyncAcks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PacketSequence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
@@ -483,6 +898,42 @@ func (m *PacketSequence) Size() (n int) {
 	return n
 }
 
+func (m *IdentifiedPacketDelay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.PacketDelay.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *HaltedChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -520,7 +971,331 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, IdentifiedChannel{})
+			if err := m.Channels[len(m.Channels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Acknowledgements = append(m.Acknowledgements, PacketState{})
+			if err := m.Acknowledgements[len(m.Acknowledgements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitments = append(m.Commitments, PacketState{})
+			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receipts = append(m.Receipts, PacketState{})
+			if err := m.Receipts[len(m.Receipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendSequences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendSequences = append(m.SendSequences, PacketSequence{})
+			if err := m.SendSequences[len(m.SendSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvSequences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecvSequences = append(m.RecvSequences, PacketSequence{})
+			if err := m.RecvSequences[len(m.RecvSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckSequences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AckSequences = append(m.AckSequences, PacketSequence{})
+			if err := m.AckSequences[len(m.AckSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextChannelSequence", wireType)
+			}
+			m.NextChannelSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextChannelSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketDelays", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketDelays = append(m.PacketDelays, IdentifiedPacketDelay{})
+			if err := m.PacketDelays[len(m.PacketDelays)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -547,14 +1322,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Channels = append(m.Channels, IdentifiedChannel{})
-			if err := m.Channels[len(m.Channels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Packets = append(m.Packets, Packet{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgements", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PruningSequenceStarts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -581,14 +1356,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Acknowledgements = append(m.Acknowledgements, PacketState{})
-			if err := m.Acknowledgements[len(m.Acknowledgements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PruningSequenceStarts = append(m.PruningSequenceStarts, PacketSequence{})
+			if err := m.PruningSequenceStarts[len(m.PruningSequenceStarts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PruningSequenceEnds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -615,14 +1390,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commitments = append(m.Commitments, PacketState{})
-			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PruningSequenceEnds = append(m.PruningSequenceEnds, PacketSequence{})
+			if err := m.PruningSequenceEnds[len(m.PruningSequenceEnds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltedChannels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -649,14 +1424,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receipts = append(m.Receipts, PacketState{})
-			if err := m.Receipts[len(m.Receipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HaltedChannels = append(m.HaltedChannels, HaltedChannel{})
+			if err := m.HaltedChannels[len(m.HaltedChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendSequences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAsyncAcks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -683,16 +1458,66 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SendSequences = append(m.SendSequences, PacketSequence{})
-			if err := m.SendSequences[len(m.SendSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingAsyncAcks = append(m.PendingAsyncAcks, PendingAsyncAck{})
+			if err := m.PendingAsyncAcks[len(m.PendingAsyncAcks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketSequence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketSequence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketSequence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecvSequences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -702,31 +1527,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecvSequences = append(m.RecvSequences, PacketSequence{})
-			if err := m.RecvSequences[len(m.RecvSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AckSequences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -736,31 +1559,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AckSequences = append(m.AckSequences, PacketSequence{})
-			if err := m.AckSequences[len(m.AckSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextChannelSequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			m.NextChannelSequence = 0
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -770,7 +1591,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextChannelSequence |= uint64(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -796,7 +1617,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PacketSequence) Unmarshal(dAtA []byte) error {
+func (m *IdentifiedPacketDelay) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -819,10 +1640,10 @@ func (m *PacketSequence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PacketSequence: wiretype end group for non-group")
+			return fmt.Errorf("proto: IdentifiedPacketDelay: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PacketSequence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IdentifiedPacketDelay: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -890,10 +1711,10 @@ func (m *PacketSequence) Unmarshal(dAtA []byte) error {
 			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketDelay", wireType)
 			}
-			m.Sequence = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -903,11 +1724,139 @@ func (m *PacketSequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketDelay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HaltedChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HaltedChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HaltedChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 )
 
@@ -119,6 +120,144 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid packet delay",
+			genState: types.GenesisState{
+				PacketDelays: []types.IdentifiedPacketDelay{
+					types.NewIdentifiedPacketDelay(testPort1, testChannel1, types.NewPacketDelay(100, 0)),
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid packet delay port",
+			genState: types.GenesisState{
+				PacketDelays: []types.IdentifiedPacketDelay{
+					types.NewIdentifiedPacketDelay("(testPort1)", testChannel1, types.NewPacketDelay(100, 10)),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "zero packet delay",
+			genState: types.GenesisState{
+				PacketDelays: []types.IdentifiedPacketDelay{
+					types.NewIdentifiedPacketDelay(testPort1, testChannel1, types.PacketDelay{}),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid pending packet",
+			genState: types.GenesisState{
+				Packets: []types.Packet{
+					types.NewPacket([]byte("data"), 1, testPort1, testChannel1, testPort2, testChannel2, clienttypes.NewHeight(0, 100), 0),
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid pending packet",
+			genState: types.GenesisState{
+				Packets: []types.Packet{
+					types.NewPacket([]byte("data"), 0, testPort1, testChannel1, testPort2, testChannel2, clienttypes.NewHeight(0, 100), 0),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid pruning sequences",
+			genState: types.GenesisState{
+				Receipts: []types.PacketState{
+					types.NewPacketState(testPort1, testChannel1, 5, []byte{1}),
+				},
+				PruningSequenceStarts: []types.PacketSequence{
+					types.NewPacketSequence(testPort1, testChannel1, 5),
+				},
+				PruningSequenceEnds: []types.PacketSequence{
+					types.NewPacketSequence(testPort1, testChannel1, 10),
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "pruning sequence start exceeds pruning sequence end",
+			genState: types.GenesisState{
+				PruningSequenceStarts: []types.PacketSequence{
+					types.NewPacketSequence(testPort1, testChannel1, 5),
+				},
+				PruningSequenceEnds: []types.PacketSequence{
+					types.NewPacketSequence(testPort1, testChannel1, 4),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "packet receipt below pruning sequence start",
+			genState: types.GenesisState{
+				Receipts: []types.PacketState{
+					types.NewPacketState(testPort1, testChannel1, 4, []byte{1}),
+				},
+				PruningSequenceStarts: []types.PacketSequence{
+					types.NewPacketSequence(testPort1, testChannel1, 5),
+				},
+				PruningSequenceEnds: []types.PacketSequence{
+					types.NewPacketSequence(testPort1, testChannel1, 10),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "acknowledgement below pruning sequence start",
+			genState: types.GenesisState{
+				Acknowledgements: []types.PacketState{
+					types.NewPacketState(testPort1, testChannel1, 4, []byte("ack")),
+				},
+				PruningSequenceStarts: []types.PacketSequence{
+					types.NewPacketSequence(testPort1, testChannel1, 5),
+				},
+				PruningSequenceEnds: []types.PacketSequence{
+					types.NewPacketSequence(testPort1, testChannel1, 10),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid halted channel",
+			genState: types.GenesisState{
+				HaltedChannels: []types.HaltedChannel{
+					types.NewHaltedChannel(testPort1, testChannel1),
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid halted channel",
+			genState: types.GenesisState{
+				HaltedChannels: []types.HaltedChannel{
+					types.NewHaltedChannel(testPort1, "(testChannel1)"),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid pending asynchronous acknowledgement",
+			genState: types.GenesisState{
+				PendingAsyncAcks: []types.PendingAsyncAck{
+					types.NewPendingAsyncAck(types.NewPacket([]byte("data"), 1, testPort1, testChannel1, testPort2, testChannel2, clienttypes.NewHeight(0, 100), 0), 10, 0),
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid pending asynchronous acknowledgement",
+			genState: types.GenesisState{
+				PendingAsyncAcks: []types.PendingAsyncAck{
+					types.NewPendingAsyncAck(types.NewPacket([]byte("data"), 1, testPort1, testChannel1, testPort2, testChannel2, clienttypes.NewHeight(0, 100), 0), 0, 0),
+				},
+			},
+			expPass: false,
+		},
 		{
 			name: "invalid recv seq 2",
 			genState: types.GenesisState{
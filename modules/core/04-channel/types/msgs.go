@@ -47,6 +47,9 @@ func (msg MsgChannelOpenInit) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
 	}
+	if err := msg.PacketDelay.ValidateBasic(); err != nil {
+		return err
+	}
 	return msg.Channel.ValidateBasic()
 }
 
@@ -114,6 +117,9 @@ func (msg MsgChannelOpenTry) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
 	}
+	if err := msg.PacketDelay.ValidateBasic(); err != nil {
+		return err
+	}
 	return msg.Channel.ValidateBasic()
 }
 
@@ -310,6 +316,57 @@ func (msg MsgChannelCloseConfirm) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{signer}
 }
 
+var _ sdk.Msg = &MsgChannelCloseFrozen{}
+
+// NewMsgChannelCloseFrozen creates a new MsgChannelCloseFrozen instance
+// nolint:interfacer
+func NewMsgChannelCloseFrozen(
+	portID, channelID string, proofConnection, proofClientState []byte, proofHeight clienttypes.Height,
+	signer string,
+) *MsgChannelCloseFrozen {
+	return &MsgChannelCloseFrozen{
+		PortId:           portID,
+		ChannelId:        channelID,
+		ProofConnection:  proofConnection,
+		ProofClientState: proofClientState,
+		ProofHeight:      proofHeight,
+		Signer:           signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgChannelCloseFrozen) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	if len(msg.ProofConnection) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof connection")
+	}
+	if len(msg.ProofClientState) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof client state")
+	}
+	if msg.ProofHeight.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgChannelCloseFrozen) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
 var _ sdk.Msg = &MsgRecvPacket{}
 
 // NewMsgRecvPacket constructs new MsgRecvPacket
@@ -493,3 +550,227 @@ func (msg MsgAcknowledgement) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{signer}
 }
+
+var _ sdk.Msg = &MsgRecvPackets{}
+
+// NewMsgRecvPackets constructs a new MsgRecvPackets with a proof per packet
+// nolint:interfacer
+func NewMsgRecvPackets(
+	packets []Packet, proofCommitments [][]byte, proofHeight clienttypes.Height,
+	signer string,
+) *MsgRecvPackets {
+	return &MsgRecvPackets{
+		Packets:          packets,
+		ProofCommitments: proofCommitments,
+		ProofHeight:      proofHeight,
+		Signer:           signer,
+	}
+}
+
+// NewMsgRecvPacketsWithProofBatch constructs a new MsgRecvPackets with a single
+// batch proof of all the packet commitments
+// nolint:interfacer
+func NewMsgRecvPacketsWithProofBatch(
+	packets []Packet, proofBatch []byte, proofHeight clienttypes.Height,
+	signer string,
+) *MsgRecvPackets {
+	return &MsgRecvPackets{
+		Packets:     packets,
+		ProofHeight: proofHeight,
+		Signer:      signer,
+		ProofBatch:  proofBatch,
+	}
+}
+
+// ValidateBasic implements sdk.Msg. All packets must be sent to the same
+// destination channel.
+func (msg MsgRecvPackets) ValidateBasic() error {
+	if len(msg.Packets) == 0 {
+		return sdkerrors.Wrap(ErrInvalidPacket, "batch cannot be empty")
+	}
+	if err := validateBatchProofs(len(msg.Packets), msg.ProofCommitments, msg.ProofBatch); err != nil {
+		return err
+	}
+	if msg.ProofHeight.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	for i, packet := range msg.Packets {
+		if err := packet.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "invalid packet at index %d", i)
+		}
+		if packet.DestinationPort != msg.Packets[0].DestinationPort || packet.DestinationChannel != msg.Packets[0].DestinationChannel {
+			return sdkerrors.Wrapf(ErrInvalidPacket, "packet at index %d is not sent to the destination channel of the batch", i)
+		}
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRecvPackets) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+var _ sdk.Msg = &MsgAcknowledgements{}
+
+// NewMsgAcknowledgements constructs a new MsgAcknowledgements with a proof per
+// acknowledgement
+// nolint:interfacer
+func NewMsgAcknowledgements(
+	packets []Packet,
+	acks, proofsAcked [][]byte,
+	proofHeight clienttypes.Height,
+	signer string,
+) *MsgAcknowledgements {
+	return &MsgAcknowledgements{
+		Packets:          packets,
+		Acknowledgements: acks,
+		ProofsAcked:      proofsAcked,
+		ProofHeight:      proofHeight,
+		Signer:           signer,
+	}
+}
+
+// NewMsgAcknowledgementsWithProofBatch constructs a new MsgAcknowledgements
+// with a single batch proof of all the acknowledgements
+// nolint:interfacer
+func NewMsgAcknowledgementsWithProofBatch(
+	packets []Packet,
+	acks [][]byte,
+	proofBatch []byte,
+	proofHeight clienttypes.Height,
+	signer string,
+) *MsgAcknowledgements {
+	return &MsgAcknowledgements{
+		Packets:          packets,
+		Acknowledgements: acks,
+		ProofHeight:      proofHeight,
+		Signer:           signer,
+		ProofBatch:       proofBatch,
+	}
+}
+
+// ValidateBasic implements sdk.Msg. All packets must be sent from the same
+// source channel.
+func (msg MsgAcknowledgements) ValidateBasic() error {
+	if len(msg.Packets) == 0 {
+		return sdkerrors.Wrap(ErrInvalidPacket, "batch cannot be empty")
+	}
+	if len(msg.Acknowledgements) != len(msg.Packets) {
+		return sdkerrors.Wrapf(ErrInvalidAcknowledgement, "expected %d acknowledgements, got %d", len(msg.Packets), len(msg.Acknowledgements))
+	}
+	if err := validateBatchProofs(len(msg.Packets), msg.ProofsAcked, msg.ProofBatch); err != nil {
+		return err
+	}
+	if msg.ProofHeight.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	for i, packet := range msg.Packets {
+		if len(msg.Acknowledgements[i]) == 0 {
+			return sdkerrors.Wrapf(ErrInvalidAcknowledgement, "ack bytes cannot be empty at index %d", i)
+		}
+		if err := packet.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "invalid packet at index %d", i)
+		}
+		if packet.SourcePort != msg.Packets[0].SourcePort || packet.SourceChannel != msg.Packets[0].SourceChannel {
+			return sdkerrors.Wrapf(ErrInvalidPacket, "packet at index %d is not sent from the source channel of the batch", i)
+		}
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgAcknowledgements) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// validateBatchProofs checks that the proofs of a batch of packets are either a
+// non-empty proof per packet or a single non-empty batch proof.
+func validateBatchProofs(packets int, proofs [][]byte, proofBatch []byte) error {
+	if len(proofBatch) != 0 {
+		if len(proofs) != 0 {
+			return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit both a batch proof and a proof per packet")
+		}
+		return nil
+	}
+
+	if len(proofs) != packets {
+		return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "expected %d proofs, got %d", packets, len(proofs))
+	}
+	for i, proof := range proofs {
+		if len(proof) == 0 {
+			return sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof at index %d", i)
+		}
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgPruneAcknowledgements{}
+
+// NewMsgPruneAcknowledgements creates a new MsgPruneAcknowledgements instance
+// nolint:interfacer
+func NewMsgPruneAcknowledgements(
+	portID, channelID string, nextSequenceAck uint64, proofNextSequenceAck []byte, proofHeight clienttypes.Height,
+	limit uint64, signer string,
+) *MsgPruneAcknowledgements {
+	return &MsgPruneAcknowledgements{
+		PortId:               portID,
+		ChannelId:            channelID,
+		NextSequenceAck:      nextSequenceAck,
+		ProofNextSequenceAck: proofNextSequenceAck,
+		ProofHeight:          proofHeight,
+		Limit:                limit,
+		Signer:               signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg. The proof of the counterparty next
+// acknowledgement sequence is only required if the sequence is set.
+func (msg MsgPruneAcknowledgements) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+	if msg.NextSequenceAck != 0 {
+		if len(msg.ProofNextSequenceAck) == 0 {
+			return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty proof")
+		}
+		if msg.ProofHeight.IsZero() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "proof height must be non-zero")
+		}
+	}
+	if msg.Limit == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "limit must be greater than zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgPruneAcknowledgements) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
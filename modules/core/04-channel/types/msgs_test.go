@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/store/iavl"
@@ -64,7 +65,7 @@ var (
 	emptyAddr string
 
 	connHops             = []string{"testconnection"}
-	invalidConnHops      = []string{"testconnection", "testconnection"}
+	multihopConnHops     = []string{"testconnection", "testconnectiontwo"}
 	invalidShortConnHops = []string{invalidShortConnection}
 	invalidLongConnHops  = []string{invalidLongConnection}
 )
@@ -120,13 +121,15 @@ func (suite *TypesTestSuite) TestMsgChannelOpenInitValidateBasic() {
 		{"too long port id", types.NewMsgChannelOpenInit(invalidLongPort, version, types.ORDERED, connHops, cpportid, addr), false},
 		{"port id contains non-alpha", types.NewMsgChannelOpenInit(invalidPort, version, types.ORDERED, connHops, cpportid, addr), false},
 		{"invalid channel order", types.NewMsgChannelOpenInit(portid, version, types.Order(3), connHops, cpportid, addr), false},
-		{"connection hops more than 1 ", types.NewMsgChannelOpenInit(portid, version, types.ORDERED, invalidConnHops, cpportid, addr), false},
+		{"multi-hop connection hops", types.NewMsgChannelOpenInit(portid, version, types.ORDERED, multihopConnHops, cpportid, addr), true},
 		{"too short connection id", types.NewMsgChannelOpenInit(portid, version, types.UNORDERED, invalidShortConnHops, cpportid, addr), false},
 		{"too long connection id", types.NewMsgChannelOpenInit(portid, version, types.UNORDERED, invalidLongConnHops, cpportid, addr), false},
 		{"connection id contains non-alpha", types.NewMsgChannelOpenInit(portid, version, types.UNORDERED, []string{invalidConnection}, cpportid, addr), false},
 		{"", types.NewMsgChannelOpenInit(portid, "", types.UNORDERED, connHops, cpportid, addr), true},
 		{"invalid counterparty port id", types.NewMsgChannelOpenInit(portid, version, types.UNORDERED, connHops, invalidPort, addr), false},
-		{"channel not in INIT state", &types.MsgChannelOpenInit{portid, tryOpenChannel, addr}, false},
+		{"channel not in INIT state", &types.MsgChannelOpenInit{portid, tryOpenChannel, addr, types.PacketDelay{}}, false},
+		{"packet time delay exceeds maximum", &types.MsgChannelOpenInit{portid, types.NewChannel(types.INIT, types.ORDERED, types.NewCounterparty(cpportid, ""), connHops, version), addr, types.NewPacketDelay(types.MaxPacketTimeDelay+1, 0)}, false},
+		{"packet block delay exceeds maximum", &types.MsgChannelOpenInit{portid, types.NewChannel(types.INIT, types.ORDERED, types.NewCounterparty(cpportid, ""), connHops, version), addr, types.NewPacketDelay(0, types.MaxPacketBlockDelay+1)}, false},
 	}
 
 	for _, tc := range testCases {
@@ -162,7 +165,7 @@ func (suite *TypesTestSuite) TestMsgChannelOpenTryValidateBasic() {
 		{"", types.NewMsgChannelOpenTry(portid, chanid, version, types.ORDERED, connHops, cpportid, cpchanid, "", suite.proof, height, addr), true},
 		{"proof height is zero", types.NewMsgChannelOpenTry(portid, chanid, version, types.ORDERED, connHops, cpportid, cpchanid, version, suite.proof, clienttypes.ZeroHeight(), addr), false},
 		{"invalid channel order", types.NewMsgChannelOpenTry(portid, chanid, version, types.Order(4), connHops, cpportid, cpchanid, version, suite.proof, height, addr), false},
-		{"connection hops more than 1 ", types.NewMsgChannelOpenTry(portid, chanid, version, types.UNORDERED, invalidConnHops, cpportid, cpchanid, version, suite.proof, height, addr), false},
+		{"multi-hop connection hops", types.NewMsgChannelOpenTry(portid, chanid, version, types.UNORDERED, multihopConnHops, cpportid, cpchanid, version, suite.proof, height, addr), true},
 		{"too short connection id", types.NewMsgChannelOpenTry(portid, chanid, version, types.UNORDERED, invalidShortConnHops, cpportid, cpchanid, version, suite.proof, height, addr), false},
 		{"too long connection id", types.NewMsgChannelOpenTry(portid, chanid, version, types.UNORDERED, invalidLongConnHops, cpportid, cpchanid, version, suite.proof, height, addr), false},
 		{"connection id contains non-alpha", types.NewMsgChannelOpenTry(portid, chanid, version, types.UNORDERED, []string{invalidConnection}, cpportid, cpchanid, version, suite.proof, height, addr), false},
@@ -170,7 +173,8 @@ func (suite *TypesTestSuite) TestMsgChannelOpenTryValidateBasic() {
 		{"invalid counterparty port id", types.NewMsgChannelOpenTry(portid, chanid, version, types.UNORDERED, connHops, invalidPort, cpchanid, version, suite.proof, height, addr), false},
 		{"invalid counterparty channel id", types.NewMsgChannelOpenTry(portid, chanid, version, types.UNORDERED, connHops, cpportid, invalidChannel, version, suite.proof, height, addr), false},
 		{"empty proof", types.NewMsgChannelOpenTry(portid, chanid, version, types.UNORDERED, connHops, cpportid, cpchanid, version, emptyProof, height, addr), false},
-		{"channel not in TRYOPEN state", &types.MsgChannelOpenTry{portid, chanid, initChannel, version, suite.proof, height, addr}, false},
+		{"channel not in TRYOPEN state", &types.MsgChannelOpenTry{portid, chanid, initChannel, version, suite.proof, height, addr, types.PacketDelay{}}, false},
+		{"packet time delay exceeds maximum", &types.MsgChannelOpenTry{portid, chanid, types.NewChannel(types.TRYOPEN, types.ORDERED, types.NewCounterparty(cpportid, cpchanid), connHops, version), version, suite.proof, height, addr, types.NewPacketDelay(math.MaxUint64, 0)}, false},
 	}
 
 	for _, tc := range testCases {
@@ -316,6 +320,36 @@ func (suite *TypesTestSuite) TestMsgChannelCloseConfirmValidateBasic() {
 	}
 }
 
+func (suite *TypesTestSuite) TestMsgChannelCloseFrozenValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgChannelCloseFrozen
+		expPass bool
+	}{
+		{"", types.NewMsgChannelCloseFrozen(portid, chanid, suite.proof, suite.proof, height, addr), true},
+		{"too short port id", types.NewMsgChannelCloseFrozen(invalidShortPort, chanid, suite.proof, suite.proof, height, addr), false},
+		{"channel id contains non-alpha", types.NewMsgChannelCloseFrozen(portid, invalidChannel, suite.proof, suite.proof, height, addr), false},
+		{"empty proof connection", types.NewMsgChannelCloseFrozen(portid, chanid, emptyProof, suite.proof, height, addr), false},
+		{"empty proof client state", types.NewMsgChannelCloseFrozen(portid, chanid, suite.proof, emptyProof, height, addr), false},
+		{"proof height is zero", types.NewMsgChannelCloseFrozen(portid, chanid, suite.proof, suite.proof, clienttypes.ZeroHeight(), addr), false},
+		{"missing signer address", types.NewMsgChannelCloseFrozen(portid, chanid, suite.proof, suite.proof, height, emptyAddr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *TypesTestSuite) TestMsgRecvPacketValidateBasic() {
 	testCases := []struct {
 		name    string
@@ -352,6 +386,44 @@ func (suite *TypesTestSuite) TestMsgRecvPacketGetSigners() {
 	suite.Equal(expected, fmt.Sprintf("%v", res))
 }
 
+func (suite *TypesTestSuite) TestMsgRecvPacketsValidateBasic() {
+	packet2 := types.NewPacket(validPacketData, 2, portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp)
+	packets := []types.Packet{packet, packet2}
+	proofs := [][]byte{suite.proof, suite.proof}
+	otherChannelPacket := types.NewPacket(validPacketData, 2, portid, "channel-1", cpportid, "channel-1", timeoutHeight, timeoutTimestamp)
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgRecvPackets
+		expPass bool
+	}{
+		{"success", types.NewMsgRecvPackets(packets, proofs, height, addr), true},
+		{"empty batch", types.NewMsgRecvPackets(nil, nil, height, addr), false},
+		{"proof count does not match packet count", types.NewMsgRecvPackets(packets, proofs[:1], height, addr), false},
+		{"proof height is zero", types.NewMsgRecvPackets(packets, proofs, clienttypes.ZeroHeight(), addr), false},
+		{"proof contain empty proof", types.NewMsgRecvPackets(packets, [][]byte{suite.proof, emptyProof}, height, addr), false},
+		{"missing signer address", types.NewMsgRecvPackets(packets, proofs, height, emptyAddr), false},
+		{"invalid packet", types.NewMsgRecvPackets([]types.Packet{packet, invalidPacket}, proofs, height, addr), false},
+		{"success: batch proof", types.NewMsgRecvPacketsWithProofBatch(packets, suite.proof, height, addr), true},
+		{"batch proof and proof per packet", &types.MsgRecvPackets{Packets: packets, ProofCommitments: proofs, ProofHeight: height, Signer: addr, ProofBatch: suite.proof}, false},
+		{"packets sent to different channels", types.NewMsgRecvPackets([]types.Packet{packet, otherChannelPacket}, proofs, height, addr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *TypesTestSuite) TestMsgTimeoutValidateBasic() {
 	testCases := []struct {
 		name    string
@@ -439,3 +511,75 @@ func (suite *TypesTestSuite) TestMsgAcknowledgementValidateBasic() {
 		})
 	}
 }
+
+func (suite *TypesTestSuite) TestMsgAcknowledgementsValidateBasic() {
+	packet2 := types.NewPacket(validPacketData, 2, portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp)
+	packets := []types.Packet{packet, packet2}
+	acks := [][]byte{packet.GetData(), packet2.GetData()}
+	proofs := [][]byte{suite.proof, suite.proof}
+	otherChannelPacket := types.NewPacket(validPacketData, 2, "otherport", chanid, "othercpport", cpchanid, timeoutHeight, timeoutTimestamp)
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgAcknowledgements
+		expPass bool
+	}{
+		{"success", types.NewMsgAcknowledgements(packets, acks, proofs, height, addr), true},
+		{"empty batch", types.NewMsgAcknowledgements(nil, nil, nil, height, addr), false},
+		{"ack count does not match packet count", types.NewMsgAcknowledgements(packets, acks[:1], proofs, height, addr), false},
+		{"proof count does not match packet count", types.NewMsgAcknowledgements(packets, acks, proofs[:1], height, addr), false},
+		{"proof height must be > 0", types.NewMsgAcknowledgements(packets, acks, proofs, clienttypes.ZeroHeight(), addr), false},
+		{"empty ack", types.NewMsgAcknowledgements(packets, [][]byte{packet.GetData(), nil}, proofs, height, addr), false},
+		{"missing signer address", types.NewMsgAcknowledgements(packets, acks, proofs, height, emptyAddr), false},
+		{"invalid packet", types.NewMsgAcknowledgements([]types.Packet{packet, invalidPacket}, acks, proofs, height, addr), false},
+		{"success: batch proof", types.NewMsgAcknowledgementsWithProofBatch(packets, acks, suite.proof, height, addr), true},
+		{"batch proof and proof per acknowledgement", &types.MsgAcknowledgements{Packets: packets, Acknowledgements: acks, ProofsAcked: proofs, ProofHeight: height, Signer: addr, ProofBatch: suite.proof}, false},
+		{"empty batch proof", types.NewMsgAcknowledgementsWithProofBatch(packets, acks, emptyProof, height, addr), false},
+		{"packets sent from different channels", types.NewMsgAcknowledgements([]types.Packet{packet, otherChannelPacket}, acks, proofs, height, addr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgPruneAcknowledgementsValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgPruneAcknowledgements
+		expPass bool
+	}{
+		{"success", types.NewMsgPruneAcknowledgements(portid, chanid, 10, suite.proof, height, 100, addr), true},
+		{"success: no next sequence acknowledgement", types.NewMsgPruneAcknowledgements(portid, chanid, 0, emptyProof, clienttypes.ZeroHeight(), 100, addr), true},
+		{"too short port id", types.NewMsgPruneAcknowledgements(invalidShortPort, chanid, 10, suite.proof, height, 100, addr), false},
+		{"channel id contains non-alpha", types.NewMsgPruneAcknowledgements(portid, invalidChannel, 10, suite.proof, height, 100, addr), false},
+		{"empty proof", types.NewMsgPruneAcknowledgements(portid, chanid, 10, emptyProof, height, 100, addr), false},
+		{"proof height is zero", types.NewMsgPruneAcknowledgements(portid, chanid, 10, suite.proof, clienttypes.ZeroHeight(), 100, addr), false},
+		{"limit is zero", types.NewMsgPruneAcknowledgements(portid, chanid, 10, suite.proof, height, 0, addr), false},
+		{"missing signer address", types.NewMsgPruneAcknowledgements(portid, chanid, 10, suite.proof, height, 100, emptyAddr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -111,3 +111,37 @@ func (p Packet) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewPendingAsyncAck creates a new PendingAsyncAck instance for a packet
+// received at the given block height and time.
+func NewPendingAsyncAck(packet Packet, recvHeight, recvTimestamp uint64) PendingAsyncAck {
+	return PendingAsyncAck{
+		Packet:        packet,
+		RecvHeight:    recvHeight,
+		RecvTimestamp: recvTimestamp,
+	}
+}
+
+// IsExpired returns true if the block or time limit of an asynchronous
+// acknowledgement has elapsed at the given block height and time. A zero limit
+// is disabled.
+func (paa PendingAsyncAck) IsExpired(height, timestamp, timeoutBlocks, timeoutPeriod uint64) bool {
+	if timeoutBlocks != 0 && height >= paa.RecvHeight+timeoutBlocks {
+		return true
+	}
+	if timeoutPeriod != 0 && timestamp >= paa.RecvTimestamp+timeoutPeriod {
+		return true
+	}
+	return false
+}
+
+// ValidateBasic performs basic validation of a pending asynchronous acknowledgement.
+func (paa PendingAsyncAck) ValidateBasic() error {
+	if err := paa.Packet.ValidateBasic(); err != nil {
+		return err
+	}
+	if paa.RecvHeight == 0 {
+		return sdkerrors.Wrap(ErrInvalidPacket, "receive height cannot be 0")
+	}
+	return nil
+}
@@ -51,3 +51,28 @@ func TestPacketValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestPendingAsyncAckIsExpired(t *testing.T) {
+	packet := types.NewPacket(validPacketData, 1, portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp)
+	pendingAsyncAck := types.NewPendingAsyncAck(packet, 10, 1000)
+
+	testCases := []struct {
+		name          string
+		height        uint64
+		timestamp     uint64
+		timeoutBlocks uint64
+		timeoutPeriod uint64
+		expExpired    bool
+	}{
+		{"limits disabled", 100, 100000, 0, 0, false},
+		{"block limit elapsed", 15, 1000, 5, 0, true},
+		{"block limit not elapsed", 14, 1000, 5, 0, false},
+		{"time limit elapsed", 10, 1500, 0, 500, true},
+		{"time limit not elapsed", 10, 1499, 0, 500, false},
+		{"time limit elapsed before block limit", 11, 1500, 5, 500, true},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expExpired, pendingAsyncAck.IsExpired(tc.height, tc.timestamp, tc.timeoutBlocks, tc.timeoutPeriod), tc.name)
+	}
+}
@@ -0,0 +1,88 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+const (
+	// DefaultStorePacketData is the default value for recording the full data of sent packets.
+	DefaultStorePacketData = false
+
+	// DefaultAsyncAckTimeoutBlocks is the default block limit for asynchronous acknowledgements.
+	// The block limit is disabled by default.
+	DefaultAsyncAckTimeoutBlocks uint64 = 0
+
+	// DefaultAsyncAckTimeoutPeriod is the default time limit for asynchronous acknowledgements.
+	// The time limit is disabled by default.
+	DefaultAsyncAckTimeoutPeriod uint64 = 0
+
+	// MaxAsyncAckExpirationsPerBlock is the maximum number of expired asynchronous
+	// acknowledgements written in a single block.
+	MaxAsyncAckExpirationsPerBlock = 100
+)
+
+var (
+	// KeyStorePacketData is store's key for StorePacketData parameter
+	KeyStorePacketData = []byte("StorePacketData")
+	// KeyAsyncAckTimeoutBlocks is store's key for AsyncAckTimeoutBlocks parameter
+	KeyAsyncAckTimeoutBlocks = []byte("AsyncAckTimeoutBlocks")
+	// KeyAsyncAckTimeoutPeriod is store's key for AsyncAckTimeoutPeriod parameter
+	KeyAsyncAckTimeoutPeriod = []byte("AsyncAckTimeoutPeriod")
+)
+
+// ParamKeyTable type declaration for parameters
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new parameter configuration for the ibc channel module
+func NewParams(storePacketData bool, asyncAckTimeoutBlocks, asyncAckTimeoutPeriod uint64) Params {
+	return Params{
+		StorePacketData:       storePacketData,
+		AsyncAckTimeoutBlocks: asyncAckTimeoutBlocks,
+		AsyncAckTimeoutPeriod: asyncAckTimeoutPeriod,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the ibc channel module
+func DefaultParams() Params {
+	return NewParams(DefaultStorePacketData, DefaultAsyncAckTimeoutBlocks, DefaultAsyncAckTimeoutPeriod)
+}
+
+// Validate performs basic validation of the channel params
+func (p Params) Validate() error {
+	if err := validateStorePacketData(p.StorePacketData); err != nil {
+		return err
+	}
+	if err := validateAsyncAckTimeout(p.AsyncAckTimeoutBlocks); err != nil {
+		return err
+	}
+	return validateAsyncAckTimeout(p.AsyncAckTimeoutPeriod)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyStorePacketData, p.StorePacketData, validateStorePacketData),
+		paramtypes.NewParamSetPair(KeyAsyncAckTimeoutBlocks, p.AsyncAckTimeoutBlocks, validateAsyncAckTimeout),
+		paramtypes.NewParamSetPair(KeyAsyncAckTimeoutPeriod, p.AsyncAckTimeoutPeriod, validateAsyncAckTimeout),
+	}
+}
+
+func validateStorePacketData(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter. expected %T, got type: %T", false, i)
+	}
+	return nil
+}
+
+func validateAsyncAckTimeout(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter. expected %T, got type: %T", uint64(0), i)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+const (
+	// ProposalTypeChannelHalt defines the type for a ChannelHaltProposal
+	ProposalTypeChannelHalt = "ChannelHalt"
+
+	// ProposalTypeChannelResume defines the type for a ChannelResumeProposal
+	ProposalTypeChannelResume = "ChannelResume"
+)
+
+var (
+	_ govtypes.Content = &ChannelHaltProposal{}
+	_ govtypes.Content = &ChannelResumeProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeChannelHalt)
+	govtypes.RegisterProposalType(ProposalTypeChannelResume)
+}
+
+// NewChannelHaltProposal creates a new channel halt proposal.
+func NewChannelHaltProposal(title, description, portID, channelID string) govtypes.Content {
+	return &ChannelHaltProposal{
+		Title:       title,
+		Description: description,
+		PortId:      portID,
+		ChannelId:   channelID,
+	}
+}
+
+// GetTitle returns the title of a channel halt proposal.
+func (chp *ChannelHaltProposal) GetTitle() string { return chp.Title }
+
+// GetDescription returns the description of a channel halt proposal.
+func (chp *ChannelHaltProposal) GetDescription() string { return chp.Description }
+
+// ProposalRoute returns the routing key of a channel halt proposal.
+func (chp *ChannelHaltProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a channel halt proposal.
+func (chp *ChannelHaltProposal) ProposalType() string { return ProposalTypeChannelHalt }
+
+// ValidateBasic runs basic stateless validity checks
+func (chp *ChannelHaltProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(chp); err != nil {
+		return err
+	}
+
+	return validateProposalChannel(chp.PortId, chp.ChannelId)
+}
+
+// NewChannelResumeProposal creates a new channel resume proposal.
+func NewChannelResumeProposal(title, description, portID, channelID string) govtypes.Content {
+	return &ChannelResumeProposal{
+		Title:       title,
+		Description: description,
+		PortId:      portID,
+		ChannelId:   channelID,
+	}
+}
+
+// GetTitle returns the title of a channel resume proposal.
+func (crp *ChannelResumeProposal) GetTitle() string { return crp.Title }
+
+// GetDescription returns the description of a channel resume proposal.
+func (crp *ChannelResumeProposal) GetDescription() string { return crp.Description }
+
+// ProposalRoute returns the routing key of a channel resume proposal.
+func (crp *ChannelResumeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a channel resume proposal.
+func (crp *ChannelResumeProposal) ProposalType() string { return ProposalTypeChannelResume }
+
+// ValidateBasic runs basic stateless validity checks
+func (crp *ChannelResumeProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(crp); err != nil {
+		return err
+	}
+
+	return validateProposalChannel(crp.PortId, crp.ChannelId)
+}
+
+func validateProposalChannel(portID, channelID string) error {
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+
+	if !IsValidChannelID(channelID) {
+		return ErrInvalidChannelIdentifier
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+)
+
+// GetQueryCmd returns the query commands for IBC ports
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.SubModuleName,
+		Short:                      "IBC port query subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdQueryPorts(),
+		GetCmdQueryPort(),
+		GetCmdQueryPortChannels(),
+	)
+
+	return queryCmd
+}
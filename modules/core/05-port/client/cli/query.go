@@ -0,0 +1,125 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// GetCmdQueryPorts defines the command to query all the bound ports of a chain.
+func GetCmdQueryPorts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "ports",
+		Short:   "Query all bound ports",
+		Long:    "Query all bound ports of a chain along with the module owning each port capability",
+		Example: fmt.Sprintf("%s query %s %s ports", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPortsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Ports(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "ports")
+
+	return cmd
+}
+
+// GetCmdQueryPort defines the command to query a bound port.
+func GetCmdQueryPort() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "port [port-id]",
+		Short:   "Query a bound port",
+		Long:    "Query a bound port along with the module owning the port capability",
+		Example: fmt.Sprintf("%s query %s %s port [port-id]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPortRequest{
+				PortId: args[0],
+			}
+
+			res, err := queryClient.Port(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPortChannels defines the command to query the channel capabilities of a bound port.
+func GetCmdQueryPortChannels() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "channels [port-id]",
+		Short:   "Query the channel capabilities of a port",
+		Long:    "Query the channel capabilities of a bound port along with the modules owning them",
+		Example: fmt.Sprintf("%s query %s %s channels [port-id]", version.AppName, host.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPortChannelsRequest{
+				PortId:     args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PortChannels(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "port channels")
+
+	return cmd
+}
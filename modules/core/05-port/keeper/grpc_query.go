@@ -0,0 +1,231 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+var _ types.QueryServer = (*Keeper)(nil)
+
+// AppVersion implements the Query/AppVersion gRPC method
+func (q Keeper) AppVersion(c context.Context, req *types.QueryAppVersionRequest) (*types.QueryAppVersionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "application version negotiation is not supported by IBC modules")
+}
+
+// Ports implements the Query/Ports gRPC method
+func (q Keeper) Ports(c context.Context, req *types.QueryPortsRequest) (*types.QueryPortsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	ports := []types.IdentifiedPort{}
+	prefix := fmt.Sprintf("%s/", host.KeyPortPrefix)
+
+	pageRes, err := q.paginateCapabilities(ctx, req.Pagination, prefix, func(ownership types.CapabilityOwnership) {
+		portID := strings.TrimPrefix(ownership.Name, prefix)
+		ports = append(ports, q.identifiedPort(portID, ownership))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryPortsResponse{
+		Ports:      ports,
+		Pagination: pageRes,
+		Height:     selfHeight,
+	}, nil
+}
+
+// Port implements the Query/Port gRPC method
+func (q Keeper) Port(c context.Context, req *types.QueryPortRequest) (*types.QueryPortResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// the owners are read from the persistent capability store, a port bound after the
+	// queried height is not found
+	name := host.PortPath(req.PortId)
+	modules, cap, err := q.scopedKeeper.LookupModules(ctx, name)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	ownership := types.CapabilityOwnership{
+		Index:  cap.GetIndex(),
+		Name:   name,
+		Owners: modules,
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryPortResponse{
+		Port:   q.identifiedPort(req.PortId, ownership),
+		Height: selfHeight,
+	}, nil
+}
+
+// PortChannels implements the Query/PortChannels gRPC method
+func (q Keeper) PortChannels(c context.Context, req *types.QueryPortChannelsRequest) (*types.QueryPortChannelsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	channels := []types.IdentifiedChannelCapability{}
+	prefix := fmt.Sprintf("%s/%s/%s/%s/", host.KeyChannelCapabilityPrefix, host.KeyPortPrefix, req.PortId, host.KeyChannelPrefix)
+
+	pageRes, err := q.paginateCapabilities(ctx, req.Pagination, prefix, func(ownership types.CapabilityOwnership) {
+		channels = append(channels, types.IdentifiedChannelCapability{
+			PortId:     req.PortId,
+			ChannelId:  strings.TrimPrefix(ownership.Name, prefix),
+			Module:     moduleOwner(ownership.Owners),
+			Capability: ownership,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryPortChannelsResponse{
+		Channels:   channels,
+		Pagination: pageRes,
+		Height:     selfHeight,
+	}, nil
+}
+
+// identifiedPort returns the IdentifiedPort for the given port capability ownership along
+// with the middleware of the stack registered on the router for the owning module.
+func (q Keeper) identifiedPort(portID string, ownership types.CapabilityOwnership) types.IdentifiedPort {
+	module := moduleOwner(ownership.Owners)
+
+	var middlewares []string
+	if q.Router != nil {
+		middlewares, _ = q.Router.GetMiddlewares(module)
+	}
+
+	return types.IdentifiedPort{
+		PortId:      portID,
+		Module:      module,
+		Middlewares: middlewares,
+		Capability:  ownership,
+	}
+}
+
+// moduleOwner returns the module owning a capability alongside the ibc module. Unlike
+// GetModuleOwner it does not panic and returns an empty string if there is no single owner.
+func moduleOwner(owners []string) string {
+	var modules []string
+	for _, owner := range owners {
+		if owner != host.ModuleName {
+			modules = append(modules, owner)
+		}
+	}
+
+	if len(modules) != 1 {
+		return ""
+	}
+
+	return modules[0]
+}
+
+// paginateCapabilities paginates over the capabilities owned by the ibc module whose name
+// starts with the given prefix, in name order. Only the reverse name to index lookups of
+// the ibc module with the given prefix are iterated in the capability memory store, the
+// ownership of each capability of the page is then loaded from the capability keeper.
+// Pagination keys are the capability names stripped of the prefix.
+//
+// The memory store only holds the latest capabilities, whilst the ownership is read from
+// the persistent capability store at the queried height. As the ibc module never releases
+// its capabilities and capability indices are never reused, the capabilities whose
+// ownership is not found at the queried height were created afterwards and are skipped.
+// Queries at a past height thereby return the capabilities and owners of that height.
+func (q Keeper) paginateCapabilities(
+	ctx sdk.Context,
+	pageRequest *query.PageRequest,
+	namePrefix string,
+	onResult func(ownership types.CapabilityOwnership),
+) (*query.PageResponse, error) {
+	if q.capabilityKeeper == nil || q.capabilityMemKey == nil {
+		return nil, status.Error(codes.Unavailable, "capability keeper is not set")
+	}
+
+	store := prefix.NewStore(ctx.KVStore(q.capabilityMemKey), capabilitytypes.RevCapabilityKey(host.ModuleName, namePrefix))
+
+	pageRes, err := query.FilteredPaginate(store, pageRequest, func(key, value []byte, accumulate bool) (bool, error) {
+		index := sdk.BigEndianToUint64(value)
+
+		owners, found := q.capabilityKeeper.GetOwners(ctx, index)
+		if !found {
+			// the capability was created after the queried height
+			return false, nil
+		}
+
+		ownership, ok := capabilityOwnership(index, owners)
+		if !ok {
+			return false, status.Errorf(codes.Internal, "capability %s%s is not owned by the %s module", namePrefix, key, host.ModuleName)
+		}
+
+		if accumulate {
+			onResult(ownership)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return pageRes, nil
+}
+
+// capabilityOwnership returns the ownership of a capability owned by the ibc module. It
+// returns false if the capability is not owned by the ibc module.
+func capabilityOwnership(index uint64, owners capabilitytypes.CapabilityOwners) (types.CapabilityOwnership, bool) {
+	var (
+		name    string
+		modules []string
+	)
+	for _, owner := range owners.Owners {
+		if owner.Module == host.ModuleName {
+			name = owner.Name
+		}
+
+		modules = append(modules, owner.Module)
+	}
+
+	if name == "" {
+		return types.CapabilityOwnership{}, false
+	}
+
+	return types.CapabilityOwnership{
+		Index:  index,
+		Name:   name,
+		Owners: modules,
+	}, true
+}
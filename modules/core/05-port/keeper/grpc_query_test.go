@@ -0,0 +1,359 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/suite"
+
+	ibccallbackstypes "github.com/cosmos/ibc-go/v3/modules/apps/callbacks/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+type QueryTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+}
+
+func (suite *QueryTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+}
+
+func TestQueryTestSuite(t *testing.T) {
+	suite.Run(t, new(QueryTestSuite))
+}
+
+func (suite *QueryTestSuite) TestQueryPorts() {
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+	res, err := suite.chainA.QueryServer.Ports(ctx, &types.QueryPortsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().NotEmpty(res.Ports)
+	suite.Require().Equal(uint64(len(res.Ports)), res.Pagination.Total)
+
+	ports := make(map[string]types.IdentifiedPort)
+	for _, port := range res.Ports {
+		ports[port.PortId] = port
+	}
+
+	transferPort, found := ports[transfertypes.PortID]
+	suite.Require().True(found)
+	suite.Require().Equal(transfertypes.ModuleName, transferPort.Module)
+	suite.Require().Equal([]string{ibccallbackstypes.ModuleName}, transferPort.Middlewares)
+	suite.Require().Equal(host.PortPath(transfertypes.PortID), transferPort.Capability.Name)
+	suite.Require().ElementsMatch([]string{host.ModuleName, transfertypes.ModuleName}, transferPort.Capability.Owners)
+
+	mockPort, found := ports[ibctesting.MockPort]
+	suite.Require().True(found)
+	suite.Require().Equal(ibctesting.MockPort, mockPort.Module)
+	suite.Require().Empty(mockPort.Middlewares)
+
+	// paginate over the ports one at a time
+	var (
+		paginated []types.IdentifiedPort
+		nextKey   []byte
+	)
+	for {
+		pageRes, err := suite.chainA.QueryServer.Ports(ctx, &types.QueryPortsRequest{
+			Pagination: &query.PageRequest{Key: nextKey, Limit: 1},
+		})
+		suite.Require().NoError(err)
+		suite.Require().Len(pageRes.Ports, 1)
+
+		paginated = append(paginated, pageRes.Ports...)
+		nextKey = pageRes.Pagination.NextKey
+		if nextKey == nil {
+			break
+		}
+	}
+	suite.Require().Equal(res.Ports, paginated)
+
+	// offset pagination
+	pageRes, err := suite.chainA.QueryServer.Ports(ctx, &types.QueryPortsRequest{
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(res.Ports[1:2], pageRes.Ports)
+	suite.Require().Equal(res.Pagination.Total, pageRes.Pagination.Total)
+
+	// key and offset cannot be used together
+	_, err = suite.chainA.QueryServer.Ports(ctx, &types.QueryPortsRequest{
+		Pagination: &query.PageRequest{Key: []byte(transfertypes.PortID), Offset: 1},
+	})
+	suite.Require().Error(err)
+}
+
+func (suite *QueryTestSuite) TestQueryPort() {
+	var (
+		req         *types.QueryPortRequest
+		expResponse types.IdentifiedPort
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req = &types.QueryPortRequest{PortId: ""}
+			},
+			false,
+		},
+		{
+			"port not bound",
+			func() {
+				req = &types.QueryPortRequest{PortId: "unbound"}
+			},
+			false,
+		},
+		{
+			"success",
+			func() {
+				cap, found := suite.chainA.App.GetScopedIBCKeeper().GetCapability(suite.chainA.GetContext(), host.PortPath(transfertypes.PortID))
+				suite.Require().True(found)
+
+				req = &types.QueryPortRequest{PortId: transfertypes.PortID}
+				expResponse = types.IdentifiedPort{
+					PortId:      transfertypes.PortID,
+					Module:      transfertypes.ModuleName,
+					Middlewares: []string{ibccallbackstypes.ModuleName},
+					Capability: types.CapabilityOwnership{
+						Index:  cap.GetIndex(),
+						Name:   host.PortPath(transfertypes.PortID),
+						Owners: []string{host.ModuleName, transfertypes.ModuleName},
+					},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.QueryServer.Port(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expResponse, res.Port)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *QueryTestSuite) TestQueryPortChannels() {
+	var (
+		req         *types.QueryPortChannelsRequest
+		expChannels []types.IdentifiedChannelCapability
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req = &types.QueryPortChannelsRequest{PortId: ""}
+			},
+			false,
+		},
+		{
+			"success with no channels",
+			func() {
+				req = &types.QueryPortChannelsRequest{PortId: transfertypes.PortID}
+				expChannels = []types.IdentifiedChannelCapability{}
+			},
+			true,
+		},
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+				path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+				path.EndpointA.ChannelConfig.Version = transfertypes.Version
+				path.EndpointB.ChannelConfig.Version = transfertypes.Version
+				suite.coordinator.Setup(path)
+
+				// a channel on another port is not returned
+				mockPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(mockPath)
+
+				name := host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				cap, found := suite.chainA.App.GetScopedIBCKeeper().GetCapability(suite.chainA.GetContext(), name)
+				suite.Require().True(found)
+
+				req = &types.QueryPortChannelsRequest{PortId: transfertypes.PortID}
+				expChannels = []types.IdentifiedChannelCapability{
+					{
+						PortId:    transfertypes.PortID,
+						ChannelId: path.EndpointA.ChannelID,
+						Module:    transfertypes.ModuleName,
+						Capability: types.CapabilityOwnership{
+							Index:  cap.GetIndex(),
+							Name:   name,
+							Owners: []string{host.ModuleName, transfertypes.ModuleName},
+						},
+					},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.QueryServer.PortChannels(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expChannels, res.Channels)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *QueryTestSuite) TestQueryPortChannelsPagination() {
+	var channelIDs []string
+	for i := 0; i < 3; i++ {
+		path := ibctesting.NewPath(suite.chainA, suite.chainB)
+		path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+		path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+		path.EndpointA.ChannelConfig.Version = transfertypes.Version
+		path.EndpointB.ChannelConfig.Version = transfertypes.Version
+		suite.coordinator.Setup(path)
+
+		channelIDs = append(channelIDs, path.EndpointA.ChannelID)
+	}
+
+	// channels on another port are not counted
+	mockPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(mockPath)
+
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+	res, err := suite.chainA.QueryServer.PortChannels(ctx, &types.QueryPortChannelsRequest{PortId: transfertypes.PortID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(len(channelIDs)), res.Pagination.Total)
+
+	// paginate over the channels one at a time, the pagination key is the channel identifier
+	var (
+		paginated []string
+		nextKey   []byte
+	)
+	for {
+		pageRes, err := suite.chainA.QueryServer.PortChannels(ctx, &types.QueryPortChannelsRequest{
+			PortId:     transfertypes.PortID,
+			Pagination: &query.PageRequest{Key: nextKey, Limit: 1},
+		})
+		suite.Require().NoError(err)
+		suite.Require().Len(pageRes.Channels, 1)
+
+		paginated = append(paginated, pageRes.Channels[0].ChannelId)
+		nextKey = pageRes.Pagination.NextKey
+		if nextKey == nil {
+			break
+		}
+
+		suite.Require().Equal(channelIDs[len(paginated)], string(nextKey))
+	}
+	suite.Require().Equal(channelIDs, paginated)
+}
+
+func (suite *QueryTestSuite) TestQueryAtPastHeight() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	path.EndpointB.ChannelConfig.Version = transfertypes.Version
+	suite.coordinator.Setup(path)
+
+	height := suite.chainA.App.LastBlockHeight()
+
+	// bind a port and open a channel after the queried height
+	portID := "pastheight"
+	suite.chainA.App.GetIBCKeeper().PortKeeper.BindPort(suite.chainA.GetContext(), portID)
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	newPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+	newPath.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	newPath.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	newPath.EndpointA.ChannelConfig.Version = transfertypes.Version
+	newPath.EndpointB.ChannelConfig.Version = transfertypes.Version
+	suite.coordinator.Setup(newPath)
+
+	latestCtx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+	cms, err := suite.chainA.App.GetBaseApp().CommitMultiStore().CacheMultiStoreWithVersion(height)
+	suite.Require().NoError(err)
+	pastCtx := sdk.WrapSDKContext(suite.chainA.GetContext().WithMultiStore(cms).WithBlockHeight(height))
+
+	// the port bound after the queried height is not found
+	_, err = suite.chainA.QueryServer.Port(latestCtx, &types.QueryPortRequest{PortId: portID})
+	suite.Require().NoError(err)
+	_, err = suite.chainA.QueryServer.Port(pastCtx, &types.QueryPortRequest{PortId: portID})
+	suite.Require().Error(err)
+
+	latestPorts, err := suite.chainA.QueryServer.Ports(latestCtx, &types.QueryPortsRequest{})
+	suite.Require().NoError(err)
+	pastPorts, err := suite.chainA.QueryServer.Ports(pastCtx, &types.QueryPortsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(latestPorts.Pagination.Total-1, pastPorts.Pagination.Total)
+	for _, port := range pastPorts.Ports {
+		suite.Require().NotEqual(portID, port.PortId)
+	}
+
+	// the channel opened after the queried height is not returned
+	res, err := suite.chainA.QueryServer.PortChannels(latestCtx, &types.QueryPortChannelsRequest{PortId: transfertypes.PortID})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Channels, 2)
+
+	res, err = suite.chainA.QueryServer.PortChannels(pastCtx, &types.QueryPortChannelsRequest{PortId: transfertypes.PortID})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Channels, 1)
+	suite.Require().Equal(path.EndpointA.ChannelID, res.Channels[0].ChannelId)
+	suite.Require().Equal(uint64(1), res.Pagination.Total)
+}
@@ -16,7 +16,9 @@ import (
 type Keeper struct {
 	Router *types.Router
 
-	scopedKeeper capabilitykeeper.ScopedKeeper
+	scopedKeeper     capabilitykeeper.ScopedKeeper
+	capabilityKeeper types.CapabilityKeeper
+	capabilityMemKey sdk.StoreKey
 }
 
 // NewKeeper creates a new IBC connection Keeper instance
@@ -31,6 +33,13 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+host.ModuleName+"/"+types.SubModuleName)
 }
 
+// SetCapabilityKeeper sets the capability keeper and the capability memory store key
+// used to query the ownership of the port and channel capabilities.
+func (k *Keeper) SetCapabilityKeeper(capabilityKeeper types.CapabilityKeeper, memKey sdk.StoreKey) {
+	k.capabilityKeeper = capabilityKeeper
+	k.capabilityMemKey = memKey
+}
+
 // IsBound checks a given port ID is already bounded.
 func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
 	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
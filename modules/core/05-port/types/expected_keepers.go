@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+)
+
+// CapabilityKeeper defines the expected capability keeper used to query the ownership
+// of the port and channel capabilities.
+type CapabilityKeeper interface {
+	GetOwners(ctx sdk.Context, index uint64) (capabilitytypes.CapabilityOwners, bool)
+}
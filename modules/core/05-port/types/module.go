@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
@@ -113,6 +114,45 @@ type ICS4Wrapper interface {
 		packet exported.PacketI,
 		ack exported.Acknowledgement,
 	) error
+
+	// ResolveRelativeTimeout resolves a timeout height and timestamp relative to the
+	// latest consensus state of the channel's client into absolute packet timeouts.
+	ResolveRelativeTimeout(
+		ctx sdk.Context,
+		portID,
+		channelID string,
+		timeoutHeight clienttypes.Height,
+		timeoutTimestamp uint64,
+	) (clienttypes.Height, uint64, error)
+}
+
+// PacketDataUnmarshaler defines an optional interface which an IBC application may
+// implement to allow middleware to unmarshal its packet data into the application
+// specific packet data structure.
+type PacketDataUnmarshaler interface {
+	// UnmarshalPacketData unmarshals the packet data into the concrete packet data type.
+	UnmarshalPacketData(bz []byte) (interface{}, error)
+}
+
+// RecvPacketCharger defines an optional interface which an IBC application may implement
+// to charge for the execution of the packets it receives. Core IBC calls ChargeRecvPacket
+// before OnRecvPacket and, unlike the state changes of OnRecvPacket, writes the state
+// changes of a successful charge regardless of the acknowledgement. If the charge fails,
+// OnRecvPacket is not called and the packet is acknowledged with an error.
+type RecvPacketCharger interface {
+	ChargeRecvPacket(
+		ctx sdk.Context,
+		packet channeltypes.Packet,
+		relayer sdk.AccAddress,
+	) error
+}
+
+// ICS4WrapperProvider defines an optional interface which an application stack routed
+// on a port, such as Stack, may implement to provide the ICS4Wrapper its base
+// application uses. Core IBC uses it to write acknowledgements on behalf of the
+// application through the middleware of the stack.
+type ICS4WrapperProvider interface {
+	ICS4Wrapper() ICS4Wrapper
 }
 
 // Middleware must implement IBCModule to wrap communication from core IBC to underlying application
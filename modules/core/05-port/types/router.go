@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -63,3 +64,31 @@ func (rtr *Router) GetRoute(module string) (IBCModule, bool) {
 	}
 	return rtr.routes[module], true
 }
+
+// Modules returns the sorted names of the modules registered on the Router.
+func (rtr *Router) Modules() []string {
+	modules := make([]string, 0, len(rtr.routes))
+	for module := range rtr.routes {
+		modules = append(modules, module)
+	}
+
+	sort.Strings(modules)
+	return modules
+}
+
+// GetMiddlewares returns the names of the middleware of the stack registered for a
+// given module, from the base application upwards. The list is empty if the module
+// was not registered as a Stack.
+func (rtr *Router) GetMiddlewares(module string) ([]string, bool) {
+	cbs, ok := rtr.GetRoute(module)
+	if !ok {
+		return nil, false
+	}
+
+	stack, ok := cbs.(Stack)
+	if !ok {
+		return []string{}, true
+	}
+
+	return stack.Middlewares(), true
+}
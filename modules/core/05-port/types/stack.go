@@ -0,0 +1,211 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// MiddlewareConstructor creates a middleware wrapping the provided application. The
+// middleware must send packets and acknowledgements through the provided ICS4Wrapper.
+type MiddlewareConstructor func(app IBCModule, ics4Wrapper ICS4Wrapper) Middleware
+
+// namedMiddleware is a middleware constructor registered on a StackBuilder.
+type namedMiddleware struct {
+	name        string
+	constructor MiddlewareConstructor
+}
+
+// StackBuilder builds a Stack from a base application and an ordered list of
+// middleware. Middleware are listed from the base application upwards: the first
+// middleware wraps the base application and the last middleware is the one called
+// by core IBC.
+type StackBuilder struct {
+	ics4Wrapper ICS4Wrapper
+	baseName    string
+	base        IBCModule
+	middlewares []namedMiddleware
+}
+
+// NewStackBuilder returns a StackBuilder whose stack sends packets and acknowledgements
+// through the provided ICS4Wrapper, which is usually the channel keeper.
+func NewStackBuilder(ics4Wrapper ICS4Wrapper) *StackBuilder {
+	return &StackBuilder{
+		ics4Wrapper: ics4Wrapper,
+	}
+}
+
+// Base sets the base application of the stack. It returns the StackBuilder so calls can be linked.
+func (sb *StackBuilder) Base(name string, app IBCModule) *StackBuilder {
+	sb.baseName = name
+	sb.base = app
+	return sb
+}
+
+// Use appends a middleware on top of the stack. It returns the StackBuilder so calls can be linked.
+func (sb *StackBuilder) Use(name string, constructor MiddlewareConstructor) *StackBuilder {
+	sb.middlewares = append(sb.middlewares, namedMiddleware{
+		name:        name,
+		constructor: constructor,
+	})
+	return sb
+}
+
+// Build constructs the middleware from the base application upwards, so that IBCModule
+// callbacks travel top-down from core IBC to the base application. The ICS4Wrapper of
+// each middleware is the middleware above it, and the ICS4Wrapper of the top middleware
+// is the ICS4Wrapper of the builder, so that packets travel bottom-up from the base
+// application to core IBC. Build panics if the base application is not set or if a
+// middleware name is empty or registered twice.
+func (sb *StackBuilder) Build() Stack {
+	if sb.base == nil {
+		panic("stack base application is not set")
+	}
+
+	if sb.baseName == "" {
+		panic("stack base application name cannot be empty")
+	}
+
+	names := map[string]bool{sb.baseName: true}
+
+	var (
+		app         = sb.base
+		proxies     = make([]*ics4WrapperProxy, len(sb.middlewares))
+		middlewares = make([]Middleware, len(sb.middlewares))
+		stackNames  = make([]string, len(sb.middlewares))
+	)
+	for i, mw := range sb.middlewares {
+		if mw.name == "" {
+			panic("stack middleware name cannot be empty")
+		}
+		if names[mw.name] {
+			panic(fmt.Sprintf("stack middleware %s has already been registered", mw.name))
+		}
+		names[mw.name] = true
+
+		// the ICS4Wrapper above the middleware does not exist yet and is set once the stack is built
+		proxies[i] = &ics4WrapperProxy{}
+		middlewares[i] = mw.constructor(app, proxies[i])
+		stackNames[i] = mw.name
+
+		app = middlewares[i]
+	}
+
+	// wire the ICS4Wrapper of each middleware to the middleware above it
+	for i, proxy := range proxies {
+		if i == len(proxies)-1 {
+			proxy.ics4Wrapper = sb.ics4Wrapper
+		} else {
+			proxy.ics4Wrapper = middlewares[i+1]
+		}
+	}
+
+	ics4Wrapper := sb.ics4Wrapper
+	if len(middlewares) > 0 {
+		ics4Wrapper = middlewares[0]
+	}
+
+	return Stack{
+		IBCModule:   app,
+		ics4Wrapper: ics4Wrapper,
+		base:        sb.baseName,
+		middlewares: stackNames,
+	}
+}
+
+// Stack is an IBCModule composed of a base application wrapped by an ordered list of
+// middleware. It is registered on the Router with AddRoute like any other IBCModule.
+type Stack struct {
+	IBCModule
+
+	ics4Wrapper ICS4Wrapper
+	base        string
+	middlewares []string
+}
+
+var (
+	_ ICS4WrapperProvider = Stack{}
+	_ RecvPacketCharger   = Stack{}
+)
+
+// ICS4Wrapper returns the ICS4Wrapper the base application must use to send packets
+// and acknowledgements, which is the bottom middleware of the stack.
+func (s Stack) ICS4Wrapper() ICS4Wrapper {
+	return s.ics4Wrapper
+}
+
+// Base returns the name of the base application of the stack.
+func (s Stack) Base() string {
+	return s.base
+}
+
+// Middlewares returns the names of the middleware of the stack, from the base
+// application upwards.
+func (s Stack) Middlewares() []string {
+	return append([]string(nil), s.middlewares...)
+}
+
+// UnmarshalPacketData implements the PacketDataUnmarshaler interface by forwarding the call to
+// the top of the stack. It returns an error if the top of the stack does not implement the
+// PacketDataUnmarshaler interface.
+func (s Stack) UnmarshalPacketData(bz []byte) (interface{}, error) {
+	unmarshaler, ok := s.IBCModule.(PacketDataUnmarshaler)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "stack for %s does not implement %T", s.base, (*PacketDataUnmarshaler)(nil))
+	}
+
+	return unmarshaler.UnmarshalPacketData(bz)
+}
+
+// ChargeRecvPacket implements the RecvPacketCharger interface by forwarding the call to the
+// top of the stack. Nothing is charged if the top of the stack does not implement the
+// RecvPacketCharger interface.
+func (s Stack) ChargeRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	charger, ok := s.IBCModule.(RecvPacketCharger)
+	if !ok {
+		return nil
+	}
+
+	return charger.ChargeRecvPacket(ctx, packet, relayer)
+}
+
+// ics4WrapperProxy forwards ICS4Wrapper calls to an ICS4Wrapper set after construction.
+type ics4WrapperProxy struct {
+	ics4Wrapper ICS4Wrapper
+}
+
+// SendPacket implements the ICS4Wrapper interface
+func (p *ics4WrapperProxy) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet exported.PacketI,
+) error {
+	return p.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface
+func (p *ics4WrapperProxy) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet exported.PacketI,
+	ack exported.Acknowledgement,
+) error {
+	return p.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// ResolveRelativeTimeout implements the ICS4Wrapper interface
+func (p *ics4WrapperProxy) ResolveRelativeTimeout(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) (clienttypes.Height, uint64, error) {
+	return p.ics4Wrapper.ResolveRelativeTimeout(ctx, portID, channelID, timeoutHeight, timeoutTimestamp)
+}
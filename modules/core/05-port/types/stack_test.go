@@ -0,0 +1,172 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/stretchr/testify/require"
+
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// recordingModule records the order in which OnRecvPacket travels down the stack.
+type recordingModule struct {
+	types.IBCModule
+
+	name  string
+	calls *[]string
+}
+
+func (m recordingModule) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) exported.Acknowledgement {
+	*m.calls = append(*m.calls, m.name)
+	if m.IBCModule == nil {
+		return nil
+	}
+
+	return m.IBCModule.OnRecvPacket(ctx, packet, relayer)
+}
+
+// recordingICS4Wrapper records the order in which SendPacket travels up the stack.
+type recordingICS4Wrapper struct {
+	types.ICS4Wrapper
+
+	name  string
+	calls *[]string
+}
+
+func (w recordingICS4Wrapper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet exported.PacketI) error {
+	*w.calls = append(*w.calls, w.name)
+	if w.ICS4Wrapper == nil {
+		return nil
+	}
+
+	return w.ICS4Wrapper.SendPacket(ctx, chanCap, packet)
+}
+
+// recordingMiddleware is a middleware recording both directions of the stack.
+type recordingMiddleware struct {
+	recordingModule
+	recordingICS4Wrapper
+}
+
+func newRecordingMiddleware(name string, calls *[]string) types.MiddlewareConstructor {
+	return func(app types.IBCModule, ics4Wrapper types.ICS4Wrapper) types.Middleware {
+		return recordingMiddleware{
+			recordingModule:      recordingModule{IBCModule: app, name: name, calls: calls},
+			recordingICS4Wrapper: recordingICS4Wrapper{ICS4Wrapper: ics4Wrapper, name: name, calls: calls},
+		}
+	}
+}
+
+func TestStackBuilder(t *testing.T) {
+	var calls []string
+
+	channelKeeper := recordingICS4Wrapper{name: "channel", calls: &calls}
+	base := recordingModule{name: "base", calls: &calls}
+
+	stack := types.NewStackBuilder(channelKeeper).
+		Base("base", base).
+		Use("first", newRecordingMiddleware("first", &calls)).
+		Use("second", newRecordingMiddleware("second", &calls)).
+		Build()
+
+	require.Equal(t, "base", stack.Base())
+	require.Equal(t, []string{"first", "second"}, stack.Middlewares())
+
+	// IBCModule callbacks travel top-down
+	stack.OnRecvPacket(sdk.Context{}, channeltypes.Packet{}, nil)
+	require.Equal(t, []string{"second", "first", "base"}, calls)
+
+	// packets sent by the base application travel bottom-up
+	calls = nil
+	require.NoError(t, stack.ICS4Wrapper().SendPacket(sdk.Context{}, nil, channeltypes.Packet{}))
+	require.Equal(t, []string{"first", "second", "channel"}, calls)
+
+	// the top of the stack does not unmarshal packet data
+	_, err := stack.UnmarshalPacketData([]byte("data"))
+	require.Error(t, err)
+
+	// nothing is charged if the top of the stack does not charge received packets
+	require.NoError(t, stack.ChargeRecvPacket(sdk.Context{}, channeltypes.Packet{}, nil))
+
+	// a stack without middleware sends directly through the builder ICS4Wrapper
+	calls = nil
+	stack = types.NewStackBuilder(channelKeeper).Base("base", base).Build()
+	require.Empty(t, stack.Middlewares())
+
+	stack.OnRecvPacket(sdk.Context{}, channeltypes.Packet{}, nil)
+	require.NoError(t, stack.ICS4Wrapper().SendPacket(sdk.Context{}, nil, channeltypes.Packet{}))
+	require.Equal(t, []string{"base", "channel"}, calls)
+}
+
+func TestStackBuilderPanics(t *testing.T) {
+	var calls []string
+
+	channelKeeper := recordingICS4Wrapper{name: "channel", calls: &calls}
+	base := recordingModule{name: "base", calls: &calls}
+
+	testCases := []struct {
+		name    string
+		builder *types.StackBuilder
+	}{
+		{
+			"base application not set",
+			types.NewStackBuilder(channelKeeper).Use("first", newRecordingMiddleware("first", &calls)),
+		},
+		{
+			"empty base application name",
+			types.NewStackBuilder(channelKeeper).Base("", base),
+		},
+		{
+			"empty middleware name",
+			types.NewStackBuilder(channelKeeper).Base("base", base).Use("", newRecordingMiddleware("first", &calls)),
+		},
+		{
+			"middleware registered twice",
+			types.NewStackBuilder(channelKeeper).Base("base", base).
+				Use("first", newRecordingMiddleware("first", &calls)).
+				Use("first", newRecordingMiddleware("first", &calls)),
+		},
+		{
+			"middleware named after the base application",
+			types.NewStackBuilder(channelKeeper).Base("base", base).Use("base", newRecordingMiddleware("base", &calls)),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.Panics(t, func() { tc.builder.Build() }, tc.name)
+	}
+}
+
+func TestRouterGetMiddlewares(t *testing.T) {
+	var calls []string
+
+	channelKeeper := recordingICS4Wrapper{name: "channel", calls: &calls}
+	base := recordingModule{name: "base", calls: &calls}
+
+	stack := types.NewStackBuilder(channelKeeper).
+		Base("stacked", base).
+		Use("first", newRecordingMiddleware("first", &calls)).
+		Use("second", newRecordingMiddleware("second", &calls)).
+		Build()
+
+	router := types.NewRouter().
+		AddRoute("stacked", stack).
+		AddRoute("plain", base)
+
+	require.Equal(t, []string{"plain", "stacked"}, router.Modules())
+
+	middlewares, found := router.GetMiddlewares("stacked")
+	require.True(t, found)
+	require.Equal(t, []string{"first", "second"}, middlewares)
+
+	middlewares, found = router.GetMiddlewares("plain")
+	require.True(t, found)
+	require.Empty(t, middlewares)
+
+	_, found = router.GetMiddlewares("unknown")
+	require.False(t, found)
+}
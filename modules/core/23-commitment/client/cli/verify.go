@@ -0,0 +1,152 @@
+package cli
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	ics23 "github.com/confio/ics23/go"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+const (
+	flagValue      = "value"
+	flagProofSpecs = "proof-specs"
+)
+
+// GetCmdVerifyProof defines the command to verify a merkle proof offline against a commitment root.
+func GetCmdVerifyProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify [root-hash] [proof] [key-path...]",
+		Short: "Verify a merkle proof against a commitment root offline",
+		Long: `Verify a merkle proof against a commitment root without connecting to a node.
+The root hash is hex encoded. The proof is either the JSON encoded merkle proof, a path to a file containing
+the JSON encoded merkle proof, or a path to a file containing the proto encoded merkle proof. The key path is the
+full commitment path, starting with the key of the highest subtree (the store key of the counterparty commitment prefix).
+A membership proof is verified if the hex encoded value is provided, a non-membership proof otherwise.
+The proof specs default to the specs of an SDK chain and may be provided as a JSON array or a path to a .json file.`,
+		Example: fmt.Sprintf(
+			"%s query %s %s verify [root-hash] [path/to/proof.json] ibc commitments/ports/transfer/channels/channel-0/sequences/1 --%s [value]",
+			version.AppName, host.ModuleName, types.SubModuleName, flagValue,
+		),
+		Args: cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+			rootHash, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid root hash: %w", err)
+			}
+			root := types.NewMerkleRoot(rootHash)
+
+			proof, err := parseMerkleProof(cdc, args[1])
+			if err != nil {
+				return err
+			}
+
+			path := types.NewMerklePath(args[2:]...)
+
+			specsContentOrFileName, err := cmd.Flags().GetString(flagProofSpecs)
+			if err != nil {
+				return err
+			}
+
+			specs := types.GetSDKSpecs()
+			if specsContentOrFileName != "" {
+				if specs, err = parseProofSpecs(cdc, specsContentOrFileName); err != nil {
+					return err
+				}
+			}
+
+			valueHex, err := cmd.Flags().GetString(flagValue)
+			if err != nil {
+				return err
+			}
+
+			if valueHex == "" {
+				if err := proof.VerifyNonMembership(specs, &root, path); err != nil {
+					return err
+				}
+
+				cmd.Printf("verified the absence of %s\n", path)
+				return nil
+			}
+
+			value, err := hex.DecodeString(valueHex)
+			if err != nil {
+				return fmt.Errorf("invalid value: %w", err)
+			}
+
+			if err := proof.VerifyMembership(specs, &root, path, value); err != nil {
+				return err
+			}
+
+			cmd.Printf("verified the existence of the value at %s\n", path)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagValue, "", "hex encoded value stored at the key path, verifies a non-membership proof if empty")
+	cmd.Flags().String(flagProofSpecs, "", "JSON array or path to .json file of the proof specs, defaults to the proof specs of an SDK chain")
+
+	return cmd
+}
+
+// parseMerkleProof parses a merkle proof from a JSON string, or from a file containing the JSON
+// or proto encoded merkle proof.
+func parseMerkleProof(cdc codec.Codec, proofContentOrFileName string) (types.MerkleProof, error) {
+	var proof types.MerkleProof
+	if err := cdc.UnmarshalJSON([]byte(proofContentOrFileName), &proof); err == nil {
+		return proof, nil
+	}
+
+	// check for file path if JSON input is not provided
+	contents, err := ioutil.ReadFile(proofContentOrFileName)
+	if err != nil {
+		return types.MerkleProof{}, fmt.Errorf("neither JSON input nor path to file for merkle proof were provided: %w", err)
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proof); err != nil {
+		if err := cdc.Unmarshal(contents, &proof); err != nil {
+			return types.MerkleProof{}, fmt.Errorf("error unmarshalling merkle proof file as JSON or proto: %w", err)
+		}
+	}
+
+	return proof, nil
+}
+
+// parseProofSpecs parses a JSON array of proof specs from a JSON string or a .json file.
+func parseProofSpecs(cdc codec.Codec, specsContentOrFileName string) ([]*ics23.ProofSpec, error) {
+	var rawSpecs []json.RawMessage
+	contents := []byte(specsContentOrFileName)
+	if err := json.Unmarshal(contents, &rawSpecs); err != nil {
+
+		// check for file path if JSON input is not provided
+		contents, err = ioutil.ReadFile(specsContentOrFileName)
+		if err != nil {
+			return nil, fmt.Errorf("neither JSON input nor path to .json file for proof specs were provided: %w", err)
+		}
+
+		if err := json.Unmarshal(contents, &rawSpecs); err != nil {
+			return nil, fmt.Errorf("error unmarshalling proof specs file: %w", err)
+		}
+	}
+
+	specs := make([]*ics23.ProofSpec, len(rawSpecs))
+	for i, rawSpec := range rawSpecs {
+		var spec ics23.ProofSpec
+		if err := cdc.UnmarshalJSON(rawSpec, &spec); err != nil {
+			return nil, fmt.Errorf("error unmarshalling proof spec at index %d: %w", i, err)
+		}
+		specs[i] = &spec
+	}
+
+	return specs, nil
+}
@@ -0,0 +1,18 @@
+package commitment
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/23-commitment/client/cli"
+	"github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+)
+
+// Name returns the IBC commitment ICS name.
+func Name() string {
+	return types.SubModuleName
+}
+
+// GetQueryCmd returns the root query command for IBC commitments.
+func GetQueryCmd() *cobra.Command {
+	return cli.GetQueryCmd()
+}
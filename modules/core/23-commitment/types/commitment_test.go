@@ -1,14 +1,18 @@
 package types_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/store/iavl"
 	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
 )
 
 type MerkleTestSuite struct {
@@ -31,6 +35,30 @@ func (suite *MerkleTestSuite) SetupTest() {
 	suite.iavlStore = suite.store.GetCommitStore(suite.storeKey).(*iavl.Store)
 }
 
+// queryBatchProof queries a merkle proof for each key at the latest version and combines them
+// into a single compressed batch proof.
+func (suite *MerkleTestSuite) queryBatchProof(keys ...string) types.MerkleProof {
+	proofs := make([]types.MerkleProof, len(keys))
+	for i, key := range keys {
+		res := suite.store.Query(abci.RequestQuery{
+			Path:  fmt.Sprintf("/%s/key", suite.storeKey.Name()), // required path to get key/value+proof
+			Data:  []byte(key),
+			Prove: true,
+		})
+		suite.Require().NotNil(res.ProofOps)
+
+		proof, err := types.ConvertProofs(res.ProofOps)
+		suite.Require().NoError(err)
+
+		proofs[i] = proof
+	}
+
+	proof, err := types.CombineMerkleProofs(proofs)
+	suite.Require().NoError(err)
+
+	return proof
+}
+
 func TestMerkleTestSuite(t *testing.T) {
 	suite.Run(t, new(MerkleTestSuite))
 }
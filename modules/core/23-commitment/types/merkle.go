@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"net/url"
+	"sort"
 
 	ics23 "github.com/confio/ics23/go"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -112,6 +113,13 @@ func (mp MerklePath) GetKey(i uint64) ([]byte, error) {
 	return []byte(key), nil
 }
 
+// appendKey returns a new MerklePath with the given key appended to the key path.
+func (mp MerklePath) appendKey(key string) MerklePath {
+	keyPath := make([]string, len(mp.KeyPath), len(mp.KeyPath)+1)
+	copy(keyPath, mp.KeyPath)
+	return NewMerklePath(append(keyPath, key)...)
+}
+
 // Empty returns true if the path is empty
 func (mp MerklePath) Empty() bool {
 	return len(mp.KeyPath) == 0
@@ -203,16 +211,158 @@ func (proof MerkleProof) VerifyNonMembership(specs []*ics23.ProofSpec, root expo
 	return nil
 }
 
-// BatchVerifyMembership verifies a group of key value pairs against the given root
-// NOTE: Currently left unimplemented as it is unused
+// VerifyBatchMembership verifies the existence of the given values at the given paths against the
+// given root. The lowest proof must be an ics23 batch proof, compressed or not, containing an
+// existence proof for the last key of every path. All paths must share the keys of the higher
+// subtrees, which are proven by the remaining proofs as in VerifyMembership. A single proof
+// may be used to verify the membership and non-membership of different keys.
+func (proof MerkleProof) VerifyBatchMembership(specs []*ics23.ProofSpec, root exported.Root, paths []exported.Path, values [][]byte) error {
+	keys, mpath, err := proof.validateBatchVerificationArgs(specs, root, paths)
+	if err != nil {
+		return err
+	}
+
+	if len(values) != len(paths) {
+		return sdkerrors.Wrapf(ErrInvalidProof, "number of values %d not same as number of paths %d", len(values), len(paths))
+	}
+
+	items := make(map[string][]byte, len(keys))
+	for i, key := range keys {
+		if len(values[i]) == 0 {
+			return sdkerrors.Wrapf(ErrInvalidProof, "empty value in membership proof for key %s", string(key))
+		}
+		items[string(key)] = values[i]
+	}
+
+	// decompress the lowest proof once, all entries of the batch must commit to the same subroot
+	lowestProof := ics23.Decompress(proof.Proofs[0])
+	subroot, err := lowestProof.Calculate()
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidProof, "could not calculate root for batch proof at index 0, merkle tree may be empty. %v", err)
+	}
+	if ok := ics23.BatchVerifyMembership(specs[0], subroot, lowestProof, items); !ok {
+		return sdkerrors.Wrapf(ErrInvalidProof, "could not verify batch membership of %d keys. Please ensure the paths and values are correct.", len(items))
+	}
+
+	// Verify chained membership proof starting from index 1 with value = subroot
+	return verifyChainedMembershipProof(root.GetHash(), specs, proof.Proofs, mpath, subroot, 1)
+}
+
+// VerifyBatchNonMembership verifies the absence of the given paths against the given root. The
+// lowest proof must be an ics23 batch proof, compressed or not, containing a non-existence proof
+// for the last key of every path. All paths must share the keys of the higher subtrees, which are
+// proven by the remaining proofs as in VerifyNonMembership.
+func (proof MerkleProof) VerifyBatchNonMembership(specs []*ics23.ProofSpec, root exported.Root, paths []exported.Path) error {
+	keys, mpath, err := proof.validateBatchVerificationArgs(specs, root, paths)
+	if err != nil {
+		return err
+	}
+
+	// decompress the lowest proof once, all entries of the batch must commit to the same subroot
+	lowestProof := ics23.Decompress(proof.Proofs[0])
+	subroot, err := lowestProof.Calculate()
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidProof, "could not calculate root for batch proof at index 0, merkle tree may be empty. %v", err)
+	}
+	if ok := ics23.BatchVerifyNonMembership(specs[0], subroot, lowestProof, keys); !ok {
+		return sdkerrors.Wrapf(ErrInvalidProof, "could not verify batch absence of %d keys. Please ensure the paths are correct.", len(keys))
+	}
+
+	// Verify chained membership proof starting from index 1 with value = subroot
+	return verifyChainedMembershipProof(root.GetHash(), specs, proof.Proofs, mpath, subroot, 1)
+}
+
+// BatchVerifyMembership verifies a group of key value pairs against the given root. The path
+// contains the keys of the higher subtrees shared by all items, the item keys are the keys in
+// the lowest subtree. See VerifyBatchMembership.
 func (proof MerkleProof) BatchVerifyMembership(specs []*ics23.ProofSpec, root exported.Root, path exported.Path, items map[string][]byte) error {
-	return sdkerrors.Wrap(ErrInvalidProof, "batch proofs are currently unsupported")
+	mpath, ok := path.(MerklePath)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidProof, "path %v is not of type MerklePath", path)
+	}
+
+	keys := make([]string, 0, len(items))
+	for key := range items {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	paths := make([]exported.Path, len(keys))
+	values := make([][]byte, len(keys))
+	for i, key := range keys {
+		paths[i] = mpath.appendKey(key)
+		values[i] = items[key]
+	}
+
+	return proof.VerifyBatchMembership(specs, root, paths, values)
 }
 
-// BatchVerifyNonMembership verifies absence of a group of keys against the given root
-// NOTE: Currently left unimplemented as it is unused
+// BatchVerifyNonMembership verifies absence of a group of keys against the given root. The path
+// contains the keys of the higher subtrees shared by all items, the item keys are the keys in
+// the lowest subtree. See VerifyBatchNonMembership.
 func (proof MerkleProof) BatchVerifyNonMembership(specs []*ics23.ProofSpec, root exported.Root, path exported.Path, items [][]byte) error {
-	return sdkerrors.Wrap(ErrInvalidProof, "batch proofs are currently unsupported")
+	mpath, ok := path.(MerklePath)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidProof, "path %v is not of type MerklePath", path)
+	}
+
+	paths := make([]exported.Path, len(items))
+	for i, key := range items {
+		paths[i] = mpath.appendKey(string(key))
+	}
+
+	return proof.VerifyBatchNonMembership(specs, root, paths)
+}
+
+// validateBatchVerificationArgs verifies the batch proof arguments are valid. It returns the keys
+// of the lowest subtree and the first path, whose higher subtree keys are shared by all paths.
+func (proof MerkleProof) validateBatchVerificationArgs(specs []*ics23.ProofSpec, root exported.Root, paths []exported.Path) ([][]byte, MerklePath, error) {
+	if err := proof.validateVerificationArgs(specs, root); err != nil {
+		return nil, MerklePath{}, err
+	}
+
+	if len(paths) == 0 {
+		return nil, MerklePath{}, sdkerrors.Wrap(ErrInvalidProof, "batch proof must verify at least one path")
+	}
+
+	var (
+		first MerklePath
+		keys  = make([][]byte, len(paths))
+		seen  = make(map[string]bool, len(paths))
+	)
+	for i, path := range paths {
+		mpath, ok := path.(MerklePath)
+		if !ok {
+			return nil, MerklePath{}, sdkerrors.Wrapf(ErrInvalidProof, "path %v is not of type MerklePath", path)
+		}
+		if len(mpath.KeyPath) != len(specs) {
+			return nil, MerklePath{}, sdkerrors.Wrapf(ErrInvalidProof, "path length %d not same as proof %d",
+				len(mpath.KeyPath), len(specs))
+		}
+
+		// the higher subtree keys are proven once for the batch and must be shared by all paths
+		if i == 0 {
+			first = mpath
+		}
+		for j := 0; j < len(mpath.KeyPath)-1; j++ {
+			if mpath.KeyPath[j] != first.KeyPath[j] {
+				return nil, MerklePath{}, sdkerrors.Wrapf(ErrInvalidProof, "path %s does not share the subtree keys of path %s", mpath, first)
+			}
+		}
+
+		key, err := mpath.GetKey(uint64(len(mpath.KeyPath) - 1))
+		if err != nil {
+			return nil, MerklePath{}, sdkerrors.Wrapf(ErrInvalidProof, "could not retrieve key bytes for key: %s", mpath.KeyPath[len(mpath.KeyPath)-1])
+		}
+		if seen[string(key)] {
+			return nil, MerklePath{}, sdkerrors.Wrapf(ErrInvalidProof, "duplicate key %s in batch proof", string(key))
+		}
+		seen[string(key)] = true
+
+		keys[i] = key
+	}
+
+	return keys, first, nil
 }
 
 // verifyChainedMembershipProof takes a list of proofs and specs and verifies each proof sequentially ensuring that the value is committed to
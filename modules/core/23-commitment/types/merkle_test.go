@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"testing"
 
+	ics23 "github.com/confio/ics23/go"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
 
 func (suite *MerkleTestSuite) TestVerifyMembership() {
@@ -137,6 +139,199 @@ func (suite *MerkleTestSuite) TestVerifyNonMembership() {
 	}
 }
 
+func (suite *MerkleTestSuite) TestVerifyBatchMembership() {
+	var (
+		proof  types.MerkleProof
+		paths  []exported.Path
+		values [][]byte
+		root   types.MerkleRoot
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"success with uncompressed batch proof", func() {
+				proof.Proofs[0] = ics23.Decompress(proof.Proofs[0])
+			}, true,
+		},
+		{
+			"success with subset of the batch", func() {
+				paths = paths[1:]
+				values = values[1:]
+			}, true,
+		},
+		{
+			"wrong value", func() {
+				values[1] = []byte("WRONGVALUE")
+			}, false,
+		},
+		{
+			"empty value", func() {
+				values[1] = nil
+			}, false,
+		},
+		{
+			"number of values does not match number of paths", func() {
+				values = values[1:]
+			}, false,
+		},
+		{
+			"no paths", func() {
+				paths = nil
+				values = nil
+			}, false,
+		},
+		{
+			"duplicate path", func() {
+				paths[1] = paths[0]
+				values[1] = values[0]
+			}, false,
+		},
+		{
+			"key not in batch proof", func() {
+				paths[1] = types.NewMerklePath(suite.storeKey.Name(), "NOTMYKEY")
+			}, false,
+		},
+		{
+			"paths do not share the store key", func() {
+				paths[1] = types.NewMerklePath("otherStoreKey", "MYKEY2")
+			}, false,
+		},
+		{
+			"wrong path length", func() {
+				paths[1] = types.NewMerklePath(suite.storeKey.Name(), "MYKEY2", "MYKEY2")
+			}, false,
+		},
+		{
+			"wrong root", func() {
+				root = types.NewMerkleRoot([]byte("WRONGROOT"))
+			}, false,
+		},
+		{
+			"proof is wrong length", func() {
+				proof.Proofs = proof.Proofs[:1]
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			keys := []string{"MYKEY1", "MYKEY2", "MYKEY3"}
+			for _, key := range keys {
+				suite.iavlStore.Set([]byte(key), []byte(key+"VALUE"))
+			}
+			cid := suite.store.Commit()
+
+			proof = suite.queryBatchProof(keys...)
+			root = types.NewMerkleRoot(cid.Hash)
+			paths = make([]exported.Path, len(keys))
+			values = make([][]byte, len(keys))
+			for i, key := range keys {
+				paths[i] = types.NewMerklePath(suite.storeKey.Name(), key)
+				values[i] = []byte(key + "VALUE")
+			}
+
+			tc.malleate()
+
+			err := proof.VerifyBatchMembership(types.GetSDKSpecs(), &root, paths, values)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MerkleTestSuite) TestVerifyBatchNonMembership() {
+	var (
+		proof types.MerkleProof
+		paths []exported.Path
+		root  types.MerkleRoot
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"existing key", func() {
+				paths[0] = types.NewMerklePath(suite.storeKey.Name(), "MYKEY")
+			}, false,
+		},
+		{
+			"key not in batch proof", func() {
+				paths[0] = types.NewMerklePath(suite.storeKey.Name(), "ZZZ")
+			}, false,
+		},
+		{
+			"wrong root", func() {
+				root = types.NewMerkleRoot([]byte("WRONGROOT"))
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			suite.iavlStore.Set([]byte("MYKEY"), []byte("MYVALUE"))
+			suite.iavlStore.Set([]byte("MYKEY3"), []byte("MYVALUE"))
+			cid := suite.store.Commit()
+
+			keys := []string{"MYABSENTKEY", "MYKEY2"}
+			proof = suite.queryBatchProof(keys...)
+			root = types.NewMerkleRoot(cid.Hash)
+			paths = make([]exported.Path, len(keys))
+			for i, key := range keys {
+				paths[i] = types.NewMerklePath(suite.storeKey.Name(), key)
+			}
+
+			tc.malleate()
+
+			err := proof.VerifyBatchNonMembership(types.GetSDKSpecs(), &root, paths)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MerkleTestSuite) TestBatchVerifyMixedProof() {
+	suite.iavlStore.Set([]byte("MYKEY"), []byte("MYVALUE"))
+	cid := suite.store.Commit()
+
+	root := types.NewMerkleRoot(cid.Hash)
+	proof := suite.queryBatchProof("MYKEY", "MYABSENTKEY")
+
+	path := types.NewMerklePath(suite.storeKey.Name())
+	suite.Require().NoError(proof.BatchVerifyMembership(types.GetSDKSpecs(), &root, path, map[string][]byte{"MYKEY": []byte("MYVALUE")}))
+	suite.Require().NoError(proof.BatchVerifyNonMembership(types.GetSDKSpecs(), &root, path, [][]byte{[]byte("MYABSENTKEY")}))
+
+	suite.Require().Error(proof.BatchVerifyMembership(types.GetSDKSpecs(), &root, path, map[string][]byte{"MYABSENTKEY": []byte("MYVALUE")}))
+	suite.Require().Error(proof.BatchVerifyNonMembership(types.GetSDKSpecs(), &root, path, [][]byte{[]byte("MYKEY")}))
+}
+
 func TestApplyPrefix(t *testing.T) {
 	prefix := types.NewMerklePrefix([]byte("storePrefixKey"))
 
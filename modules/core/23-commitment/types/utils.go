@@ -3,6 +3,7 @@ package types
 import (
 	ics23 "github.com/confio/ics23/go"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
 	crypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
 )
 
@@ -25,3 +26,39 @@ func ConvertProofs(tmProof *crypto.ProofOps) (MerkleProof, error) {
 		Proofs: proofs,
 	}, nil
 }
+
+// CombineMerkleProofs combines merkle proofs of keys in the same lowest subtree into a single
+// merkle proof whose lowest proof is a compressed ics23 batch proof. Inner nodes shared by the
+// lowest proofs are stored once. All proofs must have been queried at the same height, the
+// proofs of the higher subtrees must therefore be identical.
+func CombineMerkleProofs(proofs []MerkleProof) (MerkleProof, error) {
+	if len(proofs) == 0 {
+		return MerkleProof{}, sdkerrors.Wrap(ErrInvalidMerkleProof, "cannot combine empty list of proofs")
+	}
+
+	lowestProofs := make([]*ics23.CommitmentProof, len(proofs))
+	for i, proof := range proofs {
+		if proof.Empty() {
+			return MerkleProof{}, sdkerrors.Wrapf(ErrInvalidMerkleProof, "proof at index %d is empty", i)
+		}
+		if len(proof.Proofs) != len(proofs[0].Proofs) {
+			return MerkleProof{}, sdkerrors.Wrapf(ErrInvalidMerkleProof, "proof at index %d has length %d, expected %d", i, len(proof.Proofs), len(proofs[0].Proofs))
+		}
+		for j := 1; j < len(proof.Proofs); j++ {
+			if !proto.Equal(proof.Proofs[j], proofs[0].Proofs[j]) {
+				return MerkleProof{}, sdkerrors.Wrapf(ErrInvalidMerkleProof, "proof at index %d does not share the subtree proof at index %d", i, j)
+			}
+		}
+
+		lowestProofs[i] = proof.Proofs[0]
+	}
+
+	batch, err := ics23.CombineProofs(lowestProofs)
+	if err != nil {
+		return MerkleProof{}, sdkerrors.Wrapf(ErrInvalidMerkleProof, "could not combine proofs: %v", err)
+	}
+
+	return MerkleProof{
+		Proofs: append([]*ics23.CommitmentProof{batch}, proofs[0].Proofs[1:]...),
+	}, nil
+}
@@ -3,6 +3,7 @@ package types_test
 import (
 	"fmt"
 
+	ics23 "github.com/confio/ics23/go"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	crypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
@@ -96,3 +97,82 @@ func (suite *MerkleTestSuite) TestConvertProofs() {
 		}
 	}
 }
+
+func (suite *MerkleTestSuite) TestCombineMerkleProofs() {
+	var proofs []types.MerkleProof
+
+	queryProof := func(key string, height int64) types.MerkleProof {
+		res := suite.store.Query(abci.RequestQuery{
+			Path:   fmt.Sprintf("/%s/key", suite.storeKey.Name()), // required path to get key/value+proof
+			Height: height,
+			Data:   []byte(key),
+			Prove:  true,
+		})
+		require.NotNil(suite.T(), res.ProofOps)
+
+		proof, err := types.ConvertProofs(res.ProofOps)
+		suite.Require().NoError(err)
+
+		return proof
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"empty list of proofs", func() {
+				proofs = nil
+			}, false,
+		},
+		{
+			"empty proof", func() {
+				proofs[1] = types.MerkleProof{}
+			}, false,
+		},
+		{
+			"proofs have different lengths", func() {
+				proofs[1].Proofs = proofs[1].Proofs[:1]
+			}, false,
+		},
+		{
+			"proofs queried at different heights", func() {
+				suite.iavlStore.Set([]byte("MYKEY3"), []byte("MYVALUE3"))
+				cid := suite.store.Commit()
+
+				proofs[1] = queryProof("MYKEY2", cid.Version)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			suite.iavlStore.Set([]byte("MYKEY1"), []byte("MYVALUE1"))
+			suite.iavlStore.Set([]byte("MYKEY2"), []byte("MYVALUE2"))
+			version := suite.store.Commit().Version
+
+			proofs = []types.MerkleProof{queryProof("MYKEY1", version), queryProof("MYKEY2", version), queryProof("MYABSENTKEY", version)}
+
+			tc.malleate()
+
+			proof, err := types.CombineMerkleProofs(proofs)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Len(proof.Proofs, len(proofs[0].Proofs))
+				suite.Require().True(ics23.IsCompressed(proof.Proofs[0]))
+				suite.Require().Equal(proofs[0].Proofs[1], proof.Proofs[1])
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
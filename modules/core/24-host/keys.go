@@ -1,6 +1,7 @@
 package host
 
 import (
+	"encoding/binary"
 	"fmt"
 
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
@@ -41,6 +42,15 @@ const (
 	KeyPacketCommitmentPrefix  = "commitments"
 	KeyPacketAckPrefix         = "acks"
 	KeyPacketReceiptPrefix     = "receipts"
+	KeyPacketDelayPrefix       = "packetDelays"
+	KeyPacketDataPrefix        = "packetData"
+	KeyPruningSequenceStart    = "pruningSequenceStart"
+	KeyPruningSequenceEnd      = "pruningSequenceEnd"
+	KeyChannelHaltedPrefix     = "channelHalted"
+	KeyPendingAsyncAckPrefix   = "pendingAsyncAcks"
+
+	KeyPendingAsyncAckRecvHeightIndex = "pendingAsyncAckRecvHeightIndex"
+	KeyPendingAsyncAckRecvTimeIndex   = "pendingAsyncAckRecvTimeIndex"
 )
 
 // FullClientPath returns the full path of a specific client path in the format:
@@ -142,6 +152,18 @@ func ChannelCapabilityPath(portID, channelID string) string {
 	return fmt.Sprintf("%s/%s", KeyChannelCapabilityPrefix, channelPath(portID, channelID))
 }
 
+// PacketDelayPath defines the path under which the additional packet delay
+// of a channel is stored
+func PacketDelayPath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s", KeyPacketDelayPrefix, channelPath(portID, channelID))
+}
+
+// PacketDelayKey returns the store key for the additional packet delay of a
+// particular channel binded to a specific port.
+func PacketDelayKey(portID, channelID string) []byte {
+	return []byte(PacketDelayPath(portID, channelID))
+}
+
 // NextSequenceSendPath defines the next send sequence counter store path
 func NextSequenceSendPath(portID, channelID string) string {
 	return fmt.Sprintf("%s/%s", KeyNextSeqSendPrefix, channelPath(portID, channelID))
@@ -218,6 +240,113 @@ func PacketReceiptKey(portID, channelID string, sequence uint64) []byte {
 	return []byte(PacketReceiptPath(portID, channelID, sequence))
 }
 
+// PacketDataPath defines the path under which the full data of a sent packet
+// is stored
+func PacketDataPath(portID, channelID string, sequence uint64) string {
+	return fmt.Sprintf("%s/%d", PacketDataPrefixPath(portID, channelID), sequence)
+}
+
+// PacketDataKey returns the store key of under which the full data of a sent
+// packet is stored
+func PacketDataKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(PacketDataPath(portID, channelID, sequence))
+}
+
+// PacketDataPrefixPath defines the prefix for the full data of sent packets store path.
+func PacketDataPrefixPath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s/%s", KeyPacketDataPrefix, channelPath(portID, channelID), KeySequencePrefix)
+}
+
+// PendingAsyncAckPath defines the path under which a received packet pending an
+// asynchronous acknowledgement is stored
+func PendingAsyncAckPath(portID, channelID string, sequence uint64) string {
+	return fmt.Sprintf("%s/%d", PendingAsyncAckPrefixPath(portID, channelID), sequence)
+}
+
+// PendingAsyncAckKey returns the store key of under which a received packet
+// pending an asynchronous acknowledgement is stored
+func PendingAsyncAckKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(PendingAsyncAckPath(portID, channelID, sequence))
+}
+
+// PendingAsyncAckPrefixPath defines the prefix for the received packets pending
+// an asynchronous acknowledgement store path.
+func PendingAsyncAckPrefixPath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s/%s", KeyPendingAsyncAckPrefix, channelPath(portID, channelID), KeySequencePrefix)
+}
+
+// PendingAsyncAckRecvHeightIndexKey returns the key under which a received packet pending an
+// asynchronous acknowledgement is indexed by its receive height. Keys are ordered by receive height.
+func PendingAsyncAckRecvHeightIndexKey(recvHeight uint64, portID, channelID string, sequence uint64) []byte {
+	return pendingAsyncAckIndexKey(KeyPendingAsyncAckRecvHeightIndex, recvHeight, portID, channelID, sequence)
+}
+
+// PendingAsyncAckRecvHeightIndexPrefix returns the prefix of the receive height index keys of the
+// packets received at the given height.
+func PendingAsyncAckRecvHeightIndexPrefix(recvHeight uint64) []byte {
+	return pendingAsyncAckIndexPrefix(KeyPendingAsyncAckRecvHeightIndex, recvHeight)
+}
+
+// PendingAsyncAckRecvTimeIndexKey returns the key under which a received packet pending an
+// asynchronous acknowledgement is indexed by its receive time. Keys are ordered by receive time.
+func PendingAsyncAckRecvTimeIndexKey(recvTimestamp uint64, portID, channelID string, sequence uint64) []byte {
+	return pendingAsyncAckIndexKey(KeyPendingAsyncAckRecvTimeIndex, recvTimestamp, portID, channelID, sequence)
+}
+
+// PendingAsyncAckRecvTimeIndexPrefix returns the prefix of the receive time index keys of the
+// packets received at the given time.
+func PendingAsyncAckRecvTimeIndexPrefix(recvTimestamp uint64) []byte {
+	return pendingAsyncAckIndexPrefix(KeyPendingAsyncAckRecvTimeIndex, recvTimestamp)
+}
+
+func pendingAsyncAckIndexKey(index string, value uint64, portID, channelID string, sequence uint64) []byte {
+	return append(pendingAsyncAckIndexPrefix(index, value), []byte("/"+PendingAsyncAckPath(portID, channelID, sequence))...)
+}
+
+// pendingAsyncAckIndexPrefix encodes the value in big endian such that the index keys are
+// ordered by value.
+func pendingAsyncAckIndexPrefix(index string, value uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, value)
+	return append([]byte(index+"/"), bz...)
+}
+
+// PruningSequenceStartPath defines the path under which the next sequence whose
+// packet receipt and acknowledgement have not yet been pruned is stored
+func PruningSequenceStartPath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s", KeyPruningSequenceStart, channelPath(portID, channelID))
+}
+
+// PruningSequenceStartKey returns the store key of the pruning sequence start of
+// a particular channel binded to a specific port.
+func PruningSequenceStartKey(portID, channelID string) []byte {
+	return []byte(PruningSequenceStartPath(portID, channelID))
+}
+
+// PruningSequenceEndPath defines the path under which the sequence below which
+// packet receipts and acknowledgements may be pruned is stored
+func PruningSequenceEndPath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s", KeyPruningSequenceEnd, channelPath(portID, channelID))
+}
+
+// PruningSequenceEndKey returns the store key of the pruning sequence end of a
+// particular channel binded to a specific port.
+func PruningSequenceEndKey(portID, channelID string) []byte {
+	return []byte(PruningSequenceEndPath(portID, channelID))
+}
+
+// ChannelHaltedPath defines the path under which the halt flag of a channel set
+// by governance is stored
+func ChannelHaltedPath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s", KeyChannelHaltedPrefix, channelPath(portID, channelID))
+}
+
+// ChannelHaltedKey returns the store key of the halt flag of a particular
+// channel binded to a specific port.
+func ChannelHaltedKey(portID, channelID string) []byte {
+	return []byte(ChannelHaltedPath(portID, channelID))
+}
+
 func channelPath(portID, channelID string) string {
 	return fmt.Sprintf("%s/%s/%s/%s", KeyPortPrefix, portID, KeyChannelPrefix, channelID)
 }
@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SubModuleName is the error codespace
+const SubModuleName string = "multihop"
+
+// IBC multi-hop sentinel errors
+var (
+	ErrInvalidMultihopProof  = sdkerrors.Register(SubModuleName, 2, "invalid multi-hop proof")
+	ErrInvalidConnectionHops = sdkerrors.Register(SubModuleName, 3, "invalid connection hops")
+	ErrClientNotFrozen       = sdkerrors.Register(SubModuleName, 4, "client is not frozen")
+)
@@ -0,0 +1,228 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+var (
+	_ codectypes.UnpackInterfacesMessage = MultihopProof{}
+	_ codectypes.UnpackInterfacesMessage = HopProof{}
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p MultihopProof) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, hop := range p.Hops {
+		if err := hop.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (hp HopProof) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var consensusState exported.ConsensusState
+	return unpacker.UnpackAny(hp.ConsensusState, &consensusState)
+}
+
+// VerifyMembership verifies that the value is stored under the given path on the
+// last chain reached by the connection hops. The consensus state and prefix are
+// the consensus state and commitment prefix of the first chain reached by the
+// connection hops, as stored by the verifying chain.
+func (p MultihopProof) VerifyMembership(
+	cdc codec.BinaryCodec,
+	consensusState exported.ConsensusState,
+	prefix exported.Prefix,
+	connectionHops []string,
+	path string,
+	value []byte,
+) error {
+	root, prefix, err := p.verifyHops(cdc, consensusState, prefix, connectionHops)
+	if err != nil {
+		return err
+	}
+
+	merkleProof, merklePath, err := produceVerificationArgs(cdc, prefix, p.KeyProof, path)
+	if err != nil {
+		return err
+	}
+
+	return merkleProof.VerifyMembership(commitmenttypes.GetSDKSpecs(), root, merklePath, value)
+}
+
+// VerifyNonMembership verifies that no value is stored under the given path on
+// the last chain reached by the connection hops. The consensus state and prefix
+// are the consensus state and commitment prefix of the first chain reached by
+// the connection hops, as stored by the verifying chain.
+func (p MultihopProof) VerifyNonMembership(
+	cdc codec.BinaryCodec,
+	consensusState exported.ConsensusState,
+	prefix exported.Prefix,
+	connectionHops []string,
+	path string,
+) error {
+	root, prefix, err := p.verifyHops(cdc, consensusState, prefix, connectionHops)
+	if err != nil {
+		return err
+	}
+
+	merkleProof, merklePath, err := produceVerificationArgs(cdc, prefix, p.KeyProof, path)
+	if err != nil {
+		return err
+	}
+
+	return merkleProof.VerifyNonMembership(commitmenttypes.GetSDKSpecs(), root, merklePath)
+}
+
+// CounterpartyConnectionHops returns the connection hops of the channel end on
+// the last chain of the path, given the connection end of the first hop.
+func (p MultihopProof) CounterpartyConnectionHops(connection exported.ConnectionI) []string {
+	n := len(p.Hops)
+	counterpartyHops := make([]string, n+1)
+	counterpartyHops[n] = connection.GetCounterparty().GetConnectionID()
+	for i, hop := range p.Hops {
+		counterpartyHops[n-1-i] = hop.Connection.Counterparty.ConnectionId
+	}
+	return counterpartyHops
+}
+
+// MaxDelayPeriod returns the largest delay period of the connections along the
+// path, given the connection end of the first hop.
+func (p MultihopProof) MaxDelayPeriod(connection exported.ConnectionI) uint64 {
+	delayPeriod := connection.GetDelayPeriod()
+	for _, hop := range p.Hops {
+		if hop.Connection.DelayPeriod > delayPeriod {
+			delayPeriod = hop.Connection.DelayPeriod
+		}
+	}
+	return delayPeriod
+}
+
+// LastConsensusState returns the consensus state of the last chain of the path
+// and its height. The consensus state is only trusted once the proof has been
+// verified.
+func (p MultihopProof) LastConsensusState() (exported.ConsensusState, exported.Height, error) {
+	if len(p.Hops) == 0 {
+		return nil, nil, sdkerrors.Wrap(ErrInvalidMultihopProof, "multi-hop proof does not contain any hop proof")
+	}
+
+	hop := p.Hops[len(p.Hops)-1]
+	consensusState, err := clienttypes.UnpackConsensusState(hop.ConsensusState)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return consensusState, hop.ConsensusHeight, nil
+}
+
+// verifyHops verifies the connection end and the consensus state proven for
+// every intermediate chain of the path, and returns the commitment root and the
+// commitment prefix of the last chain.
+func (p MultihopProof) verifyHops(
+	cdc codec.BinaryCodec,
+	consensusState exported.ConsensusState,
+	prefix exported.Prefix,
+	connectionHops []string,
+) (exported.Root, exported.Prefix, error) {
+	if len(connectionHops) == 0 {
+		return nil, nil, sdkerrors.Wrap(ErrInvalidConnectionHops, "multi-hop proofs require at least 1 connection hop")
+	}
+
+	if len(p.Hops) != len(connectionHops)-1 {
+		return nil, nil, sdkerrors.Wrapf(ErrInvalidMultihopProof, "expected %d hop proofs, got %d", len(connectionHops)-1, len(p.Hops))
+	}
+
+	root := consensusState.GetRoot()
+	for i := range p.Hops {
+		hop := p.Hops[i]
+		connectionID := connectionHops[i+1]
+
+		if hop.Connection.State != connectiontypes.OPEN {
+			return nil, nil, sdkerrors.Wrapf(
+				connectiontypes.ErrInvalidConnectionState,
+				"connection %s at hop %d is not OPEN (got %s)", connectionID, i+1, hop.Connection.State,
+			)
+		}
+
+		if hop.ConsensusHeight.IsZero() {
+			return nil, nil, sdkerrors.Wrapf(ErrInvalidMultihopProof, "consensus height at hop %d cannot be zero", i+1)
+		}
+
+		bz, err := cdc.Marshal(&p.Hops[i].Connection)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		merkleProof, merklePath, err := produceVerificationArgs(cdc, prefix, hop.ProofConnection, host.ConnectionPath(connectionID))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if err := merkleProof.VerifyMembership(commitmenttypes.GetSDKSpecs(), root, merklePath, bz); err != nil {
+			return nil, nil, sdkerrors.Wrapf(err, "failed connection state verification at hop %d", i+1)
+		}
+
+		hopConsensusState, err := clienttypes.UnpackConsensusState(hop.ConsensusState)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		bz, err = clienttypes.MarshalConsensusState(cdc, hopConsensusState)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		consensusPath := host.FullConsensusStatePath(hop.Connection.ClientId, hop.ConsensusHeight)
+		merkleProof, merklePath, err = produceVerificationArgs(cdc, prefix, hop.ProofConsensus, consensusPath)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if err := merkleProof.VerifyMembership(commitmenttypes.GetSDKSpecs(), root, merklePath, bz); err != nil {
+			return nil, nil, sdkerrors.Wrapf(err, "failed consensus state verification at hop %d", i+1)
+		}
+
+		// the proofs of the next hop are verified against the consensus state
+		// of the chain reached by the connection
+		root = hopConsensusState.GetRoot()
+		prefix = &p.Hops[i].Connection.Counterparty.Prefix
+	}
+
+	return root, prefix, nil
+}
+
+// produceVerificationArgs unmarshals the merkle proof and applies the prefix to
+// the path to be verified.
+func produceVerificationArgs(
+	cdc codec.BinaryCodec,
+	prefix exported.Prefix,
+	proof []byte,
+	path string,
+) (merkleProof commitmenttypes.MerkleProof, merklePath commitmenttypes.MerklePath, err error) {
+	if prefix == nil {
+		return commitmenttypes.MerkleProof{}, commitmenttypes.MerklePath{}, sdkerrors.Wrap(commitmenttypes.ErrInvalidPrefix, "prefix cannot be empty")
+	}
+
+	if len(proof) == 0 {
+		return commitmenttypes.MerkleProof{}, commitmenttypes.MerklePath{}, sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "proof cannot be empty")
+	}
+
+	if err = cdc.Unmarshal(proof, &merkleProof); err != nil {
+		return commitmenttypes.MerkleProof{}, commitmenttypes.MerklePath{}, sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "failed to unmarshal proof into commitment merkle proof")
+	}
+
+	merklePath, err = commitmenttypes.ApplyPrefix(prefix, commitmenttypes.NewMerklePath(path))
+	if err != nil {
+		return commitmenttypes.MerkleProof{}, commitmenttypes.MerklePath{}, err
+	}
+
+	return merkleProof, merklePath, nil
+}
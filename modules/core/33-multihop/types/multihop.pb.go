@@ -0,0 +1,865 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/core/multihop/v1/multihop.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	types2 "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	types "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MultihopProof defines a proof of a key stored on the last chain of a channel
+// path spanning several connection hops, as defined in ICS-33. The proof is
+// verified starting from the consensus state of the first intermediate chain
+// stored by the verifying chain, walking through the connection end and the
+// consensus state of the next chain stored on every intermediate chain.
+type MultihopProof struct {
+	// proofs of the intermediate chains, ordered from the chain closest to the
+	// verifying chain to the chain closest to the last chain of the path
+	Hops []HopProof `protobuf:"bytes,1,rep,name=hops,proto3" json:"hops"`
+	// proof of the key on the last chain of the path
+	KeyProof []byte `protobuf:"bytes,2,opt,name=key_proof,json=keyProof,proto3" json:"key_proof,omitempty" yaml:"key_proof"`
+	// value proven by the key proof. It is only set when the value cannot be
+	// constructed by the verifying chain, as for proofs of frozen clients.
+	Value []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *MultihopProof) Reset()         { *m = MultihopProof{} }
+func (m *MultihopProof) String() string { return proto.CompactTextString(m) }
+func (*MultihopProof) ProtoMessage()    {}
+func (*MultihopProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4f32d4eb9f8667d, []int{0}
+}
+func (m *MultihopProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultihopProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultihopProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultihopProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultihopProof.Merge(m, src)
+}
+func (m *MultihopProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultihopProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultihopProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultihopProof proto.InternalMessageInfo
+
+func (m *MultihopProof) GetHops() []HopProof {
+	if m != nil {
+		return m.Hops
+	}
+	return nil
+}
+
+func (m *MultihopProof) GetKeyProof() []byte {
+	if m != nil {
+		return m.KeyProof
+	}
+	return nil
+}
+
+func (m *MultihopProof) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+// HopProof defines the proofs provided for an intermediate chain of a
+// multi-hop channel path.
+type HopProof struct {
+	// connection end of the next connection hop stored on the intermediate chain
+	Connection types.ConnectionEnd `protobuf:"bytes,1,opt,name=connection,proto3" json:"connection"`
+	// proof of the connection end
+	ProofConnection []byte `protobuf:"bytes,2,opt,name=proof_connection,json=proofConnection,proto3" json:"proof_connection,omitempty" yaml:"proof_connection"`
+	// consensus state of the next chain stored by the client of the connection
+	ConsensusState *types1.Any `protobuf:"bytes,3,opt,name=consensus_state,json=consensusState,proto3" json:"consensus_state,omitempty" yaml:"consensus_state"`
+	// height of the consensus state
+	ConsensusHeight types2.Height `protobuf:"bytes,4,opt,name=consensus_height,json=consensusHeight,proto3" json:"consensus_height" yaml:"consensus_height"`
+	// proof of the consensus state
+	ProofConsensus []byte `protobuf:"bytes,5,opt,name=proof_consensus,json=proofConsensus,proto3" json:"proof_consensus,omitempty" yaml:"proof_consensus"`
+}
+
+func (m *HopProof) Reset()         { *m = HopProof{} }
+func (m *HopProof) String() string { return proto.CompactTextString(m) }
+func (*HopProof) ProtoMessage()    {}
+func (*HopProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4f32d4eb9f8667d, []int{1}
+}
+func (m *HopProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HopProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HopProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HopProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HopProof.Merge(m, src)
+}
+func (m *HopProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *HopProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_HopProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HopProof proto.InternalMessageInfo
+
+func (m *HopProof) GetConnection() types.ConnectionEnd {
+	if m != nil {
+		return m.Connection
+	}
+	return types.ConnectionEnd{}
+}
+
+func (m *HopProof) GetProofConnection() []byte {
+	if m != nil {
+		return m.ProofConnection
+	}
+	return nil
+}
+
+func (m *HopProof) GetConsensusState() *types1.Any {
+	if m != nil {
+		return m.ConsensusState
+	}
+	return nil
+}
+
+func (m *HopProof) GetConsensusHeight() types2.Height {
+	if m != nil {
+		return m.ConsensusHeight
+	}
+	return types2.Height{}
+}
+
+func (m *HopProof) GetProofConsensus() []byte {
+	if m != nil {
+		return m.ProofConsensus
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MultihopProof)(nil), "ibc.core.multihop.v1.MultihopProof")
+	proto.RegisterType((*HopProof)(nil), "ibc.core.multihop.v1.HopProof")
+}
+
+func init() {
+	proto.RegisterFile("ibc/core/multihop/v1/multihop.proto", fileDescriptor_d4f32d4eb9f8667d)
+}
+
+var fileDescriptor_d4f32d4eb9f8667d = []byte{
+	// 476 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0x51, 0x8b, 0xd3, 0x40,
+	0x10, 0xc7, 0x1b, 0xdb, 0x93, 0x73, 0xab, 0xd7, 0x12, 0x8a, 0xc6, 0x0a, 0x49, 0x89, 0x88, 0x7d,
+	0xb9, 0x5d, 0xda, 0xbe, 0xc8, 0xbd, 0x99, 0x43, 0x11, 0x44, 0x90, 0xe8, 0x8b, 0xbe, 0x94, 0x26,
+	0xb7, 0x4d, 0xc2, 0x25, 0x99, 0xd0, 0xdd, 0x04, 0xf2, 0x2d, 0xc4, 0x2f, 0xe1, 0x57, 0xb9, 0xc7,
+	0x7b, 0xf4, 0xa9, 0x48, 0xfb, 0x0d, 0xfa, 0x09, 0x24, 0xbb, 0xe9, 0x26, 0x94, 0x7b, 0xdb, 0x99,
+	0xf9, 0xcd, 0xcc, 0x7f, 0x66, 0x12, 0xf4, 0x3a, 0xf2, 0x7c, 0xe2, 0xc3, 0x86, 0x92, 0x24, 0x8f,
+	0x79, 0x14, 0x42, 0x46, 0x8a, 0x99, 0x7a, 0xe3, 0x6c, 0x03, 0x1c, 0xf4, 0x51, 0xe4, 0xf9, 0xb8,
+	0x82, 0xb0, 0x0a, 0x14, 0xb3, 0xf1, 0x28, 0x80, 0x00, 0x04, 0x40, 0xaa, 0x97, 0x64, 0xc7, 0x2f,
+	0x03, 0x80, 0x20, 0xa6, 0x44, 0x58, 0x5e, 0xbe, 0x26, 0xab, 0xb4, 0xac, 0x43, 0x96, 0xea, 0xe5,
+	0xc7, 0x11, 0x4d, 0x79, 0xd5, 0x49, 0xbe, 0x6a, 0xe0, 0x6d, 0x03, 0x40, 0x9a, 0x52, 0x9f, 0x47,
+	0x90, 0x0a, 0x48, 0x59, 0x12, 0xb4, 0x7f, 0x6b, 0xe8, 0xd9, 0x97, 0x5a, 0xca, 0xd7, 0x0d, 0xc0,
+	0x5a, 0x7f, 0x87, 0x7a, 0x21, 0x64, 0xcc, 0xd0, 0x26, 0xdd, 0x69, 0x7f, 0x6e, 0xe2, 0x87, 0x14,
+	0xe3, 0x4f, 0x35, 0xed, 0xf4, 0xee, 0xb6, 0x56, 0xc7, 0x15, 0x19, 0xfa, 0x0c, 0x3d, 0xb9, 0xa5,
+	0xe5, 0x32, 0xab, 0x02, 0xc6, 0xa3, 0x89, 0x36, 0x7d, 0xea, 0x8c, 0x0e, 0x5b, 0x6b, 0x58, 0xae,
+	0x92, 0xf8, 0xca, 0x56, 0x21, 0xdb, 0x3d, 0xbf, 0xa5, 0xa5, 0x6c, 0x36, 0x42, 0x67, 0xc5, 0x2a,
+	0xce, 0xa9, 0xd1, 0xad, 0x70, 0x57, 0x1a, 0xf6, 0x9f, 0x2e, 0x3a, 0x3f, 0x76, 0xd0, 0x3f, 0x23,
+	0xd4, 0xa8, 0x36, 0xb4, 0x89, 0x36, 0xed, 0xcf, 0xdf, 0x34, 0xaa, 0x5a, 0x13, 0x15, 0x33, 0x7c,
+	0xad, 0xac, 0x0f, 0xe9, 0x4d, 0x2d, 0xae, 0x95, 0xae, 0x7f, 0x44, 0x43, 0xa1, 0x61, 0xd9, 0x2a,
+	0x29, 0x95, 0xbe, 0x3a, 0x6c, 0xad, 0x17, 0x52, 0xe9, 0x29, 0x61, 0xbb, 0x03, 0xe1, 0x6a, 0x8a,
+	0xeb, 0x3f, 0xd0, 0xc0, 0x87, 0x94, 0xd1, 0x94, 0xe5, 0x6c, 0xc9, 0xf8, 0x8a, 0xcb, 0x09, 0xfa,
+	0xf3, 0x11, 0x96, 0x57, 0xc3, 0xc7, 0xab, 0xe1, 0xf7, 0x69, 0xe9, 0x8c, 0x0f, 0x5b, 0xeb, 0xb9,
+	0x2c, 0x7e, 0x92, 0x66, 0xbb, 0x17, 0xca, 0xf3, 0xad, 0x72, 0xe8, 0x6b, 0x34, 0x6c, 0x98, 0x90,
+	0x46, 0x41, 0xc8, 0x8d, 0x9e, 0xa8, 0x3d, 0x6e, 0x4d, 0x2d, 0x8f, 0x5d, 0x5d, 0x42, 0x10, 0x8e,
+	0x55, 0x8d, 0xda, 0x8c, 0x70, 0x5a, 0xc1, 0x76, 0x1b, 0xbd, 0x32, 0x43, 0xbf, 0x46, 0x03, 0x35,
+	0xa8, 0x0c, 0x18, 0x67, 0x62, 0x13, 0x2d, 0xb1, 0x27, 0x80, 0xed, 0x5e, 0x1c, 0x17, 0x21, 0x1d,
+	0xce, 0xf7, 0xbb, 0x9d, 0xa9, 0xdd, 0xef, 0x4c, 0xed, 0xdf, 0xce, 0xd4, 0x7e, 0xed, 0xcd, 0xce,
+	0xfd, 0xde, 0xec, 0xfc, 0xdd, 0x9b, 0x9d, 0x9f, 0x57, 0x41, 0xc4, 0xc3, 0xdc, 0xc3, 0x3e, 0x24,
+	0xc4, 0x07, 0x96, 0x00, 0x23, 0x91, 0xe7, 0x5f, 0x06, 0x40, 0x8a, 0x05, 0x49, 0xe0, 0x26, 0x8f,
+	0x29, 0x93, 0x5f, 0xe8, 0x62, 0x71, 0xa9, 0xfe, 0x18, 0x5e, 0x66, 0x94, 0x79, 0x8f, 0xc5, 0xf2,
+	0x16, 0xff, 0x07, 0x00, 0x6e, 0x78, 0x75, 0x33, 0x53, 0x03, 0x00, 0x00,
+}
+
+func (m *MultihopProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultihopProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultihopProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintMultihop(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.KeyProof) > 0 {
+		i -= len(m.KeyProof)
+		copy(dAtA[i:], m.KeyProof)
+		i = encodeVarintMultihop(dAtA, i, uint64(len(m.KeyProof)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hops) > 0 {
+		for iNdEx := len(m.Hops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMultihop(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HopProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HopProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HopProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProofConsensus) > 0 {
+		i -= len(m.ProofConsensus)
+		copy(dAtA[i:], m.ProofConsensus)
+		i = encodeVarintMultihop(dAtA, i, uint64(len(m.ProofConsensus)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.ConsensusHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMultihop(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ConsensusState != nil {
+		{
+			size, err := m.ConsensusState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMultihop(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ProofConnection) > 0 {
+		i -= len(m.ProofConnection)
+		copy(dAtA[i:], m.ProofConnection)
+		i = encodeVarintMultihop(dAtA, i, uint64(len(m.ProofConnection)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Connection.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMultihop(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMultihop(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMultihop(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MultihopProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Hops) > 0 {
+		for _, e := range m.Hops {
+			l = e.Size()
+			n += 1 + l + sovMultihop(uint64(l))
+		}
+	}
+	l = len(m.KeyProof)
+	if l > 0 {
+		n += 1 + l + sovMultihop(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovMultihop(uint64(l))
+	}
+	return n
+}
+
+func (m *HopProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Connection.Size()
+	n += 1 + l + sovMultihop(uint64(l))
+	l = len(m.ProofConnection)
+	if l > 0 {
+		n += 1 + l + sovMultihop(uint64(l))
+	}
+	if m.ConsensusState != nil {
+		l = m.ConsensusState.Size()
+		n += 1 + l + sovMultihop(uint64(l))
+	}
+	l = m.ConsensusHeight.Size()
+	n += 1 + l + sovMultihop(uint64(l))
+	l = len(m.ProofConsensus)
+	if l > 0 {
+		n += 1 + l + sovMultihop(uint64(l))
+	}
+	return n
+}
+
+func sovMultihop(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMultihop(x uint64) (n int) {
+	return sovMultihop(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MultihopProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultihop
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultihopProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultihopProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hops = append(m.Hops, HopProof{})
+			if err := m.Hops[len(m.Hops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyProof = append(m.KeyProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.KeyProof == nil {
+				m.KeyProof = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultihop(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HopProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultihop
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HopProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HopProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Connection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Connection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofConnection", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofConnection = append(m.ProofConnection[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofConnection == nil {
+				m.ProofConnection = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusState == nil {
+				m.ConsensusState = &types1.Any{}
+			}
+			if err := m.ConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConsensusHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofConsensus", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofConsensus = append(m.ProofConsensus[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofConsensus == nil {
+				m.ProofConsensus = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultihop(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMultihop(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMultihop
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMultihop
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMultihop
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMultihop
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMultihop        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMultihop          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMultihop = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,84 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/33-multihop/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+	"github.com/cosmos/ibc-go/v3/testing/simapp"
+)
+
+func newHopProof(counterpartyConnectionID string, delayPeriod uint64) types.HopProof {
+	counterparty := connectiontypes.NewCounterparty("07-tendermint-0", counterpartyConnectionID, commitmenttypes.NewMerklePrefix([]byte("ibc")))
+	return types.HopProof{
+		Connection: connectiontypes.NewConnectionEnd(
+			connectiontypes.OPEN, "07-tendermint-1", counterparty, connectiontypes.ExportedVersionsToProto(connectiontypes.GetCompatibleVersions()), delayPeriod,
+		),
+		ConsensusHeight: clienttypes.NewHeight(0, 1),
+	}
+}
+
+func TestCounterpartyConnectionHops(t *testing.T) {
+	counterparty := connectiontypes.NewCounterparty("07-tendermint-0", "connection-9", commitmenttypes.NewMerklePrefix([]byte("ibc")))
+	connection := connectiontypes.NewConnectionEnd(connectiontypes.OPEN, "07-tendermint-0", counterparty, nil, 10)
+
+	proof := types.MultihopProof{
+		Hops: []types.HopProof{
+			newHopProof("connection-1", 30),
+			newHopProof("connection-2", 20),
+		},
+	}
+
+	require.Equal(t, []string{"connection-2", "connection-1", "connection-9"}, proof.CounterpartyConnectionHops(connection))
+	require.Equal(t, uint64(30), proof.MaxDelayPeriod(connection))
+}
+
+func TestLastConsensusState(t *testing.T) {
+	_, _, err := types.MultihopProof{}.LastConsensusState()
+	require.ErrorIs(t, err, types.ErrInvalidMultihopProof)
+
+	consensusState := &ibctmtypes.ConsensusState{Root: commitmenttypes.NewMerkleRoot([]byte("root"))}
+	anyConsensusState, err := clienttypes.PackConsensusState(consensusState)
+	require.NoError(t, err)
+
+	hop := newHopProof("connection-1", 0)
+	hop.ConsensusState = anyConsensusState
+	hop.ConsensusHeight = clienttypes.NewHeight(0, 5)
+
+	proof := types.MultihopProof{Hops: []types.HopProof{newHopProof("connection-2", 0), hop}}
+	lastConsensusState, height, err := proof.LastConsensusState()
+	require.NoError(t, err)
+	require.Equal(t, consensusState, lastConsensusState)
+	require.Equal(t, clienttypes.NewHeight(0, 5), height)
+}
+
+func TestVerifyMembershipInvalidHops(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+	consensusState := &ibctmtypes.ConsensusState{Root: commitmenttypes.NewMerkleRoot([]byte("root"))}
+	prefix := commitmenttypes.NewMerklePrefix([]byte("ibc"))
+
+	testCases := []struct {
+		name           string
+		proof          types.MultihopProof
+		connectionHops []string
+		expErr         error
+	}{
+		{"no connection hops", types.MultihopProof{}, nil, types.ErrInvalidConnectionHops},
+		{"missing hop proof", types.MultihopProof{}, []string{"connection-0", "connection-1"}, types.ErrInvalidMultihopProof},
+		{"connection is not open", types.MultihopProof{Hops: []types.HopProof{{ConsensusHeight: clienttypes.NewHeight(0, 1)}}}, []string{"connection-0", "connection-1"}, connectiontypes.ErrInvalidConnectionState},
+		{"zero consensus height", types.MultihopProof{Hops: []types.HopProof{{Connection: connectiontypes.ConnectionEnd{State: connectiontypes.OPEN}}}}, []string{"connection-0", "connection-1"}, types.ErrInvalidMultihopProof},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.proof.VerifyMembership(cdc, consensusState, &prefix, tc.connectionHops, "path", []byte("value"))
+			require.ErrorIs(t, err, tc.expErr)
+		})
+	}
+}
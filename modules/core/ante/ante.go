@@ -17,7 +17,8 @@ func NewAnteDecorator(k *keeper.Keeper) AnteDecorator {
 }
 
 // AnteDecorator returns an error if a multiMsg tx only contains packet messages (Recv, Ack, Timeout) and additional update messages
-// and all packet messages are redundant. If the transaction is just a single UpdateClient message, or the multimsg transaction
+// and all packet messages are redundant. Each packet of a batched packet message (RecvPackets, Acknowledgements) is counted as a
+// separate packet message. If the transaction is just a single UpdateClient message, or the multimsg transaction
 // contains some other message type, then the antedecorator returns no error and continues processing to ensure these transactions
 // are included. This will ensure that relayers do not waste fees on multiMsg transactions when another relayer has already submitted
 // all packets, by rejecting the tx at the mempool layer.
@@ -49,6 +50,22 @@ func (ad AnteDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 				}
 				packetMsgs += 1
 
+			case *channeltypes.MsgRecvPackets:
+				response, err := ad.k.RecvPackets(sdk.WrapSDKContext(ctx), msg)
+				if err != nil {
+					return ctx, err
+				}
+				redundancies += countRedundancies(response.Results)
+				packetMsgs += len(response.Results)
+
+			case *channeltypes.MsgAcknowledgements:
+				response, err := ad.k.Acknowledgements(sdk.WrapSDKContext(ctx), msg)
+				if err != nil {
+					return ctx, err
+				}
+				redundancies += countRedundancies(response.Results)
+				packetMsgs += len(response.Results)
+
 			case *channeltypes.MsgTimeout:
 				response, err := ad.k.Timeout(sdk.WrapSDKContext(ctx), msg)
 				if err != nil {
@@ -90,3 +107,15 @@ func (ad AnteDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 	}
 	return next(ctx, tx, simulate)
 }
+
+// countRedundancies returns the number of redundant packets in the results of a
+// batched packet message.
+func countRedundancies(results []channeltypes.ResponseResultType) int {
+	redundancies := 0
+	for _, result := range results {
+		if result == channeltypes.NOOP {
+			redundancies++
+		}
+	}
+	return redundancies
+}
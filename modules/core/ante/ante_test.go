@@ -91,6 +91,62 @@ func (suite *AnteTestSuite) createAcknowledgementMessage(sequenceNumber uint64,
 	return channeltypes.NewMsgAcknowledgement(packet, ibctesting.MockAcknowledgement, proof, proofHeight, suite.path.EndpointA.Chain.SenderAccount.GetAddress().String())
 }
 
+// createRecvPacketsMessage creates a RecvPackets message for packets sent from chain A to chain B.
+// Packet sequences start at 1 and the proofs of all packets share a single proof height.
+func (suite *AnteTestSuite) createRecvPacketsMessage(isRedundant ...bool) sdk.Msg {
+	packets := make([]channeltypes.Packet, len(isRedundant))
+	for i, redundant := range isRedundant {
+		msg := suite.createRecvPacketMessage(uint64(i+1), redundant)
+		packets[i] = msg.(*channeltypes.MsgRecvPacket).Packet
+	}
+
+	err := suite.path.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	var (
+		proofs      [][]byte
+		proofHeight clienttypes.Height
+	)
+	for _, packet := range packets {
+		packetKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+		proof, height := suite.chainA.QueryProof(packetKey)
+		proofs = append(proofs, proof)
+		proofHeight = height
+	}
+
+	return channeltypes.NewMsgRecvPackets(packets, proofs, proofHeight, suite.path.EndpointA.Chain.SenderAccount.GetAddress().String())
+}
+
+// createAcknowledgementsMessage creates an Acknowledgements message for packets sent from chain B to chain A.
+// Packet sequences start at 1 and the proofs of all acknowledgements share a single proof height.
+func (suite *AnteTestSuite) createAcknowledgementsMessage(isRedundant ...bool) sdk.Msg {
+	var (
+		packets []channeltypes.Packet
+		acks    [][]byte
+	)
+	for i, redundant := range isRedundant {
+		msg := suite.createAcknowledgementMessage(uint64(i+1), redundant)
+		packets = append(packets, msg.(*channeltypes.MsgAcknowledgement).Packet)
+		acks = append(acks, ibctesting.MockAcknowledgement)
+	}
+
+	err := suite.path.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	var (
+		proofs      [][]byte
+		proofHeight clienttypes.Height
+	)
+	for _, packet := range packets {
+		packetKey := host.PacketAcknowledgementKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+		proof, height := suite.chainA.QueryProof(packetKey)
+		proofs = append(proofs, proof)
+		proofHeight = height
+	}
+
+	return channeltypes.NewMsgAcknowledgements(packets, acks, proofs, proofHeight, suite.path.EndpointA.Chain.SenderAccount.GetAddress().String())
+}
+
 // createTimeoutMessage creates an Timeout message for a packet sent from chain B to chain A.
 func (suite *AnteTestSuite) createTimeoutMessage(sequenceNumber uint64, isRedundant bool) sdk.Msg {
 	height := suite.chainA.TestChainClient.(*ibctesting.TestChainTendermint).LastHeader.GetHeight()
@@ -425,6 +481,42 @@ func (suite *AnteTestSuite) TestAnteDecorator() {
 			},
 			false,
 		},
+		{
+			"success on one RecvPackets message with new and redundant packets",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createRecvPacketsMessage(true, false, true)}
+			},
+			true,
+		},
+		{
+			"no success on one RecvPackets message with only redundant packets",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createRecvPacketsMessage(true, true)}
+			},
+			false,
+		},
+		{
+			"success on one Acknowledgements message with new and redundant acknowledgements",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createAcknowledgementsMessage(true, false)}
+			},
+			true,
+		},
+		{
+			"no success on one Acknowledgements message with only redundant acknowledgements",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createAcknowledgementsMessage(true, true, true)}
+			},
+			false,
+		},
+		{
+			"no success on one RecvPackets message and one redundant RecvPacket message",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				batch := suite.createRecvPacketsMessage(true, true)
+				return []sdk.Msg{batch, suite.createRecvPacketMessage(uint64(3), true)}
+			},
+			false,
+		},
 		{
 			"no success on one new message and one redundant message in the same block",
 			func(suite *AnteTestSuite) []sdk.Msg {
@@ -8,11 +8,14 @@ import (
 	ibcclient "github.com/cosmos/ibc-go/v3/modules/core/02-client"
 	connection "github.com/cosmos/ibc-go/v3/modules/core/03-connection"
 	channel "github.com/cosmos/ibc-go/v3/modules/core/04-channel"
+	port "github.com/cosmos/ibc-go/v3/modules/core/05-port"
+	commitment "github.com/cosmos/ibc-go/v3/modules/core/23-commitment"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
-// GetTxCmd returns the transaction commands for this module
-func GetTxCmd() *cobra.Command {
+// GetTxCmd returns the transaction commands for this module. The given light client
+// commands are added to the IBC client commands.
+func GetTxCmd(lightClientCmds ...*cobra.Command) *cobra.Command {
 	ibcTxCmd := &cobra.Command{
 		Use:                        host.ModuleName,
 		Short:                      "IBC transaction subcommands",
@@ -22,15 +25,16 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	ibcTxCmd.AddCommand(
-		ibcclient.GetTxCmd(),
+		ibcclient.GetTxCmd(lightClientCmds...),
 		channel.GetTxCmd(),
 	)
 
 	return ibcTxCmd
 }
 
-// GetQueryCmd returns the cli query commands for this module
-func GetQueryCmd() *cobra.Command {
+// GetQueryCmd returns the cli query commands for this module. The given light client
+// commands are added to the IBC client commands.
+func GetQueryCmd(lightClientCmds ...*cobra.Command) *cobra.Command {
 	// Group ibc queries under a subcommand
 	ibcQueryCmd := &cobra.Command{
 		Use:                        host.ModuleName,
@@ -41,9 +45,11 @@ func GetQueryCmd() *cobra.Command {
 	}
 
 	ibcQueryCmd.AddCommand(
-		ibcclient.GetQueryCmd(),
+		ibcclient.GetQueryCmd(lightClientCmds...),
 		connection.GetQueryCmd(),
 		channel.GetQueryCmd(),
+		port.GetQueryCmd(),
+		commitment.GetQueryCmd(),
 	)
 
 	return ibcQueryCmd
@@ -37,3 +37,20 @@ type Acknowledgement interface {
 	Success() bool
 	Acknowledgement() []byte
 }
+
+// PacketData defines an optional interface which an application's packet data
+// structure may implement to expose the address of the packet sender.
+type PacketData interface {
+	// GetPacketSender returns the sender address of the packet data. If the
+	// packet sender is unknown or undefined, an empty string is returned.
+	GetPacketSender(sourcePortID string) string
+}
+
+// PacketDataProvider defines an optional interface which an application's packet
+// data structure may implement to expose custom data stored on behalf of other
+// applications, such as the callback target of the callbacks middleware.
+type PacketDataProvider interface {
+	// GetCustomPacketData returns the packet data held under the provided key,
+	// or nil if the key is not present.
+	GetCustomPacketData(key string) interface{}
+}
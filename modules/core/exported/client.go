@@ -24,6 +24,9 @@ const (
 	// Dymint is used to indicate that the client is a Dymension rollapp.
 	Dymint string = "01-dymint"
 
+	// Wasm is used to indicate that the light client logic is implemented by a Wasm contract.
+	Wasm string = "08-wasm"
+
 	// Localhost is the client type for a localhost client. It is also used as the clientID
 	// for the localhost client.
 	Localhost string = "09-localhost"
@@ -90,6 +93,37 @@ type ClientState interface {
 
 	// State verification functions
 
+	// VerifyMembership is a generic proof verification method which verifies a proof of the existence of a
+	// value at a given CommitmentPath at the specified height. The caller is expected to construct the full
+	// CommitmentPath from a CommitmentPrefix and a standardized path (as defined in ICS 24).
+	VerifyMembership(
+		ctx sdk.Context,
+		clientStore sdk.KVStore,
+		cdc codec.BinaryCodec,
+		height Height,
+		delayTimePeriod uint64,
+		delayBlockPeriod uint64,
+		proof []byte,
+		path Path,
+		value []byte,
+	) error
+	// VerifyNonMembership is a generic proof verification method which verifies the absence of a given
+	// CommitmentPath at the specified height. The caller is expected to construct the full CommitmentPath
+	// from a CommitmentPrefix and a standardized path (as defined in ICS 24).
+	VerifyNonMembership(
+		ctx sdk.Context,
+		clientStore sdk.KVStore,
+		cdc codec.BinaryCodec,
+		height Height,
+		delayTimePeriod uint64,
+		delayBlockPeriod uint64,
+		proof []byte,
+		path Path,
+	) error
+
+	// Deprecated: the state verification functions below are no longer used by core IBC, which
+	// verifies counterparty state through VerifyMembership and VerifyNonMembership.
+
 	VerifyClientState(
 		store sdk.KVStore,
 		cdc codec.BinaryCodec,
@@ -182,6 +216,69 @@ type ClientState interface {
 		channelID string,
 		nextSequenceRecv uint64,
 	) error
+	VerifyNextSequenceAck(
+		ctx sdk.Context,
+		store sdk.KVStore,
+		cdc codec.BinaryCodec,
+		height Height,
+		delayTimePeriod uint64,
+		delayBlockPeriod uint64,
+		prefix Prefix,
+		proof []byte,
+		portID,
+		channelID string,
+		nextSequenceAck uint64,
+	) error
+}
+
+// DelayPeriodClientState defines the functions implemented by light clients which
+// record the time and height at which their consensus states were processed. It
+// allows core IBC to enforce delay periods on proofs which are not verified by the
+// light client itself, such as multi-hop proofs.
+type DelayPeriodClientState interface {
+	ClientState
+
+	VerifyDelayPeriodPassed(
+		ctx sdk.Context,
+		store sdk.KVStore,
+		proofHeight Height,
+		delayTimePeriod uint64,
+		delayBlockPeriod uint64,
+	) error
+}
+
+// BatchVerificationClientState defines the functions implemented by light clients which
+// verify a single batch proof for several commitment paths. All paths must share the
+// commitment prefix, which allows the proof of the higher subtrees to be included once
+// and the shared inner nodes of the lowest subtree to be compressed.
+type BatchVerificationClientState interface {
+	ClientState
+
+	// VerifyBatchMembership verifies a batch proof of the existence of each value at the
+	// corresponding CommitmentPath at the specified height.
+	VerifyBatchMembership(
+		ctx sdk.Context,
+		clientStore sdk.KVStore,
+		cdc codec.BinaryCodec,
+		height Height,
+		delayTimePeriod uint64,
+		delayBlockPeriod uint64,
+		proof []byte,
+		paths []Path,
+		values [][]byte,
+	) error
+	// VerifyBatchNonMembership verifies a batch proof of the absence of each CommitmentPath
+	// at the specified height.
+	VerifyBatchNonMembership(
+		ctx sdk.Context,
+		clientStore sdk.KVStore,
+		cdc codec.BinaryCodec,
+		height Height,
+		delayTimePeriod uint64,
+		delayBlockPeriod uint64,
+		proof []byte,
+		paths []Path,
+	) error
 }
 
 // ConsensusState is the state of the consensus process
@@ -1,5 +1,9 @@
 package exported
 
+// LocalhostConnectionID is the identifier of the sentinel connection of the localhost client.
+// Channels opened on it connect two modules of the running chain.
+const LocalhostConnectionID string = "connection-localhost"
+
 // ConnectionI describes the required methods for a connection.
 type ConnectionI interface {
 	GetClientID() string
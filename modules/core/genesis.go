@@ -6,6 +6,7 @@ import (
 	client "github.com/cosmos/ibc-go/v3/modules/core/02-client"
 	connection "github.com/cosmos/ibc-go/v3/modules/core/03-connection"
 	channel "github.com/cosmos/ibc-go/v3/modules/core/04-channel"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 	"github.com/cosmos/ibc-go/v3/modules/core/keeper"
 	"github.com/cosmos/ibc-go/v3/modules/core/types"
 )
@@ -16,6 +17,21 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, createLocalhost bool, gs *typ
 	client.InitGenesis(ctx, k.ClientKeeper, gs.ClientGenesis)
 	connection.InitGenesis(ctx, k.ConnectionKeeper, gs.ConnectionGenesis)
 	channel.InitGenesis(ctx, k.ChannelKeeper, gs.ChannelGenesis)
+
+	createSentinelLocalhostConnection(ctx, k)
+}
+
+// createSentinelLocalhostConnection creates the sentinel connection of the localhost client,
+// on which channels between modules of the chain are opened, if the localhost client exists
+// and the connection does not.
+func createSentinelLocalhostConnection(ctx sdk.Context, k keeper.Keeper) {
+	if _, found := k.ClientKeeper.GetClientState(ctx, exported.Localhost); !found {
+		return
+	}
+
+	if _, found := k.ConnectionKeeper.GetConnection(ctx, exported.LocalhostConnectionID); !found {
+		k.ConnectionKeeper.CreateSentinelLocalhostConnection(ctx)
+	}
 }
 
 // ExportGenesis returns the ibc exported genesis.
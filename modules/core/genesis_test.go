@@ -3,6 +3,7 @@ package ibc_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/stretchr/testify/suite"
@@ -119,6 +120,9 @@ func (suite *IBCTestSuite) TestValidateGenesis() {
 					},
 					0,
 					connectiontypes.NewParams(10),
+					[]connectiontypes.HandshakeStartTime{
+						connectiontypes.NewHandshakeStartTime(connectionID, 100),
+					},
 				),
 				ChannelGenesis: channeltypes.NewGenesisState(
 					[]channeltypes.IdentifiedChannel{
@@ -195,6 +199,7 @@ func (suite *IBCTestSuite) TestValidateGenesis() {
 					},
 					0,
 					connectiontypes.Params{},
+					nil,
 				),
 			},
 			expPass: false,
@@ -284,6 +289,9 @@ func (suite *IBCTestSuite) TestInitGenesis() {
 					},
 					0,
 					connectiontypes.NewParams(10),
+					[]connectiontypes.HandshakeStartTime{
+						connectiontypes.NewHandshakeStartTime(connectionID, 100),
+					},
 				),
 				ChannelGenesis: channeltypes.NewGenesisState(
 					[]channeltypes.IdentifiedChannel{
@@ -327,6 +335,48 @@ func (suite *IBCTestSuite) TestInitGenesis() {
 	}
 }
 
+// TestCreateLocalhostAfterGenesis tests that the localhost client and its sentinel connection
+// are created on BeginBlock once the localhost client is allowed on a chain which did not
+// create them in genesis.
+func (suite *IBCTestSuite) TestCreateLocalhostAfterGenesis() {
+	ibcKeeper := suite.chainA.App.GetIBCKeeper()
+
+	_, found := ibcKeeper.ClientKeeper.GetClientState(suite.chainA.GetContext(), exported.Localhost)
+	suite.Require().False(found)
+
+	// the localhost client is not created as long as it is not allowed
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	_, found = ibcKeeper.ClientKeeper.GetClientState(suite.chainA.GetContext(), exported.Localhost)
+	suite.Require().False(found)
+	_, found = ibcKeeper.ConnectionKeeper.GetConnection(suite.chainA.GetContext(), exported.LocalhostConnectionID)
+	suite.Require().False(found)
+
+	params := ibcKeeper.ClientKeeper.GetParams(suite.chainA.GetContext())
+	params.AllowedClients = append(params.AllowedClients, exported.Localhost)
+	ibcKeeper.ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	ctx := suite.chainA.GetContext()
+	clientState, found := ibcKeeper.ClientKeeper.GetClientState(ctx, exported.Localhost)
+	suite.Require().True(found)
+	suite.Require().Equal(clienttypes.GetSelfHeight(ctx), clientState.GetLatestHeight())
+
+	connection, found := ibcKeeper.ConnectionKeeper.GetConnection(ctx, exported.LocalhostConnectionID)
+	suite.Require().True(found)
+	suite.Require().Equal(connectiontypes.OPEN, connection.State)
+	suite.Require().Equal(exported.Localhost, connection.ClientId)
+
+	// the existing localhost client is updated on the following blocks
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	ctx = suite.chainA.GetContext()
+	clientState, found = ibcKeeper.ClientKeeper.GetClientState(ctx, exported.Localhost)
+	suite.Require().True(found)
+	suite.Require().Equal(clienttypes.GetSelfHeight(ctx), clientState.GetLatestHeight())
+}
+
 func (suite *IBCTestSuite) TestExportGenesis() {
 	testCases := []struct {
 		msg      string
@@ -373,3 +423,50 @@ func (suite *IBCTestSuite) TestExportGenesis() {
 		})
 	}
 }
+
+// TestExportGenesisHandshakeStartTime tests that the start time of an opening connection
+// handshake is kept across a genesis export and import.
+func (suite *IBCTestSuite) TestExportGenesisHandshakeStartTime() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupClients(path)
+	suite.Require().NoError(path.EndpointA.ConnOpenInit())
+
+	ctx := suite.chainA.GetContext()
+	startTime, found := suite.chainA.App.GetIBCKeeper().ConnectionKeeper.GetHandshakeStartTime(ctx, path.EndpointA.ConnectionID)
+	suite.Require().True(found)
+
+	gs := ibc.ExportGenesis(ctx, *suite.chainA.App.GetIBCKeeper())
+	suite.Require().Equal(
+		[]connectiontypes.HandshakeStartTime{connectiontypes.NewHandshakeStartTime(path.EndpointA.ConnectionID, startTime)},
+		gs.ConnectionGenesis.HandshakeStartTimes,
+	)
+
+	cdc := codec.NewProtoCodec(suite.chainA.GetSimApp().InterfaceRegistry())
+	bz := cdc.MustMarshalJSON(gs)
+
+	// import the genesis on a chain whose genesis time is after the handshake start time
+	genesisTime := ctx.BlockTime().Add(time.Hour)
+
+	var importedGenesis types.GenesisState
+	cdc.MustUnmarshalJSON(bz, &importedGenesis)
+
+	app := simapp.Setup(false)
+	importCtx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: genesisTime})
+	ibc.InitGenesis(importCtx, *app.IBCKeeper, true, &importedGenesis)
+
+	importedStartTime, found := app.IBCKeeper.ConnectionKeeper.GetHandshakeStartTime(importCtx, path.EndpointA.ConnectionID)
+	suite.Require().True(found)
+	suite.Require().Equal(startTime, importedStartTime)
+	suite.Require().Equal(gs.ConnectionGenesis, ibc.ExportGenesis(importCtx, *app.IBCKeeper).ConnectionGenesis)
+
+	// the opening handshake of a connection imported without a start time starts at genesis
+	importedGenesis.ConnectionGenesis.HandshakeStartTimes = nil
+
+	app = simapp.Setup(false)
+	importCtx = app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: genesisTime})
+	ibc.InitGenesis(importCtx, *app.IBCKeeper, true, &importedGenesis)
+
+	importedStartTime, found = app.IBCKeeper.ConnectionKeeper.GetHandshakeStartTime(importCtx, path.EndpointA.ConnectionID)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(genesisTime.UnixNano()), importedStartTime)
+}
@@ -6,6 +6,7 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
 )
 
 // ClientState implements the IBC QueryServer interface
@@ -48,6 +49,11 @@ func (q Keeper) UpgradedClientState(c context.Context, req *clienttypes.QueryUpg
 	return q.ClientKeeper.UpgradedClientState(c, req)
 }
 
+// VerifyProof implements the IBC QueryServer interface
+func (q Keeper) VerifyProof(c context.Context, req *clienttypes.QueryVerifyProofRequest) (*clienttypes.QueryVerifyProofResponse, error) {
+	return q.ClientKeeper.VerifyProof(c, req)
+}
+
 // Connection implements the IBC QueryServer interface
 func (q Keeper) Connection(c context.Context, req *connectiontypes.QueryConnectionRequest) (*connectiontypes.QueryConnectionResponse, error) {
 	return q.ConnectionKeeper.Connection(c, req)
@@ -137,3 +143,48 @@ func (q Keeper) UnreceivedAcks(c context.Context, req *channeltypes.QueryUnrecei
 func (q Keeper) NextSequenceReceive(c context.Context, req *channeltypes.QueryNextSequenceReceiveRequest) (*channeltypes.QueryNextSequenceReceiveResponse, error) {
 	return q.ChannelKeeper.NextSequenceReceive(c, req)
 }
+
+// PacketDelay implements the IBC QueryServer interface
+func (q Keeper) PacketDelay(c context.Context, req *channeltypes.QueryPacketDelayRequest) (*channeltypes.QueryPacketDelayResponse, error) {
+	return q.ChannelKeeper.PacketDelay(c, req)
+}
+
+// Packet implements the IBC QueryServer interface
+func (q Keeper) Packet(c context.Context, req *channeltypes.QueryPacketRequest) (*channeltypes.QueryPacketResponse, error) {
+	return q.ChannelKeeper.Packet(c, req)
+}
+
+// PendingPackets implements the IBC QueryServer interface
+func (q Keeper) PendingPackets(c context.Context, req *channeltypes.QueryPendingPacketsRequest) (*channeltypes.QueryPendingPacketsResponse, error) {
+	return q.ChannelKeeper.PendingPackets(c, req)
+}
+
+// PruningSequences implements the IBC QueryServer interface
+func (q Keeper) PruningSequences(c context.Context, req *channeltypes.QueryPruningSequencesRequest) (*channeltypes.QueryPruningSequencesResponse, error) {
+	return q.ChannelKeeper.PruningSequences(c, req)
+}
+
+// PendingAsyncAcks implements the IBC QueryServer interface
+func (q Keeper) PendingAsyncAcks(c context.Context, req *channeltypes.QueryPendingAsyncAcksRequest) (*channeltypes.QueryPendingAsyncAcksResponse, error) {
+	return q.ChannelKeeper.PendingAsyncAcks(c, req)
+}
+
+// AppVersion implements the IBC QueryServer interface
+func (q Keeper) AppVersion(c context.Context, req *porttypes.QueryAppVersionRequest) (*porttypes.QueryAppVersionResponse, error) {
+	return q.PortKeeper.AppVersion(c, req)
+}
+
+// Ports implements the IBC QueryServer interface
+func (q Keeper) Ports(c context.Context, req *porttypes.QueryPortsRequest) (*porttypes.QueryPortsResponse, error) {
+	return q.PortKeeper.Ports(c, req)
+}
+
+// Port implements the IBC QueryServer interface
+func (q Keeper) Port(c context.Context, req *porttypes.QueryPortRequest) (*porttypes.QueryPortResponse, error) {
+	return q.PortKeeper.Port(c, req)
+}
+
+// PortChannels implements the IBC QueryServer interface
+func (q Keeper) PortChannels(c context.Context, req *porttypes.QueryPortChannelsRequest) (*porttypes.QueryPortChannelsResponse, error) {
+	return q.PortKeeper.PortChannels(c, req)
+}
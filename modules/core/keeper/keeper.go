@@ -9,13 +9,12 @@ import (
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
-	ibcdmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/01-dymint/types"
-
 	clientkeeper "github.com/cosmos/ibc-go/v3/modules/core/02-client/keeper"
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	connectionkeeper "github.com/cosmos/ibc-go/v3/modules/core/03-connection/keeper"
 	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
 	channelkeeper "github.com/cosmos/ibc-go/v3/modules/core/04-channel/keeper"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	portkeeper "github.com/cosmos/ibc-go/v3/modules/core/05-port/keeper"
 	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
@@ -38,28 +37,60 @@ type Keeper struct {
 	Router           *porttypes.Router
 }
 
-//IBCKeeper initialized in wasm code (in wasmd@v0.28.0/x/wasm/keeper/test_common.go:354)
-//We need to maintain backward comptabile interface
+// NewKeeper creates a new ibc Keeper with the light clients of this repository. The self
+// client is always the 01-dymint client. It is kept for backward compatibility with callers
+// of the previous signature, such as wasmd@v0.28.0/x/wasm/keeper/test_common.go.
+//
+// Deprecated: use NewKeeperWithLightClients, which selects the self client type explicitly
+// and registers the light client modules of the application.
 func NewKeeper(
 	cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
 	stakingKeeper clienttypes.StakingKeeper, upgradeKeeper clienttypes.UpgradeKeeper,
 	scopedKeeper capabilitykeeper.ScopedKeeper,
 ) *Keeper {
-	//Using dymint and nil as default. we can panic instead
-	return NewKeeperWithSelfClient(cdc, key, paramSpace, stakingKeeper, upgradeKeeper, scopedKeeper, ibcdmtypes.NewSelfClient(), nil)
+	return NewKeeperWithLightClients(cdc, key, paramSpace, stakingKeeper, upgradeKeeper, scopedKeeper, types.NewLightClientRegistry(), exported.Dymint, nil)
+}
+
+// NewKeeperWithLightClients creates a new ibc Keeper with the light clients of the given
+// registry. The self client is provided by the light client module registered for the
+// self client type. It panics if the module does not provide a self client.
+func NewKeeperWithLightClients(
+	cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
+	stakingKeeper clienttypes.StakingKeeper, upgradeKeeper clienttypes.UpgradeKeeper,
+	scopedKeeper capabilitykeeper.ScopedKeeper, lightClients *clienttypes.LightClientRegistry,
+	selfClientType string, clientHooks exported.ClientHooks,
+) *Keeper {
+	selfClient, err := lightClients.SelfClient(selfClientType)
+	if err != nil {
+		panic(fmt.Errorf("cannot initialize IBC keeper: %w", err))
+	}
+
+	return newKeeper(cdc, key, paramSpace, stakingKeeper, upgradeKeeper, scopedKeeper, lightClients, selfClient, clientHooks)
 }
 
-// NewKeeper creates a new ibc Keeper
+// NewKeeperWithSelfClient creates a new ibc Keeper with the light clients of this repository
+// and the given self client.
 func NewKeeperWithSelfClient(
 	cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
 	stakingKeeper clienttypes.StakingKeeper, upgradeKeeper clienttypes.UpgradeKeeper,
 	scopedKeeper capabilitykeeper.ScopedKeeper, selfClient exported.SelfClient, clientHooks exported.ClientHooks,
+) *Keeper {
+	return newKeeper(cdc, key, paramSpace, stakingKeeper, upgradeKeeper, scopedKeeper, types.NewLightClientRegistry(), selfClient, clientHooks)
+}
+
+// newKeeper creates a new ibc Keeper
+func newKeeper(
+	cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
+	stakingKeeper clienttypes.StakingKeeper, upgradeKeeper clienttypes.UpgradeKeeper,
+	scopedKeeper capabilitykeeper.ScopedKeeper, lightClients *clienttypes.LightClientRegistry,
+	selfClient exported.SelfClient, clientHooks exported.ClientHooks,
 ) *Keeper {
 	// register paramSpace at top level keeper
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
 		keyTable := clienttypes.ParamKeyTable()
 		keyTable.RegisterParamSet(&connectiontypes.Params{})
+		keyTable.RegisterParamSet(&channeltypes.Params{})
 		paramSpace = paramSpace.WithKeyTable(keyTable)
 	}
 
@@ -76,10 +107,10 @@ func NewKeeperWithSelfClient(
 		panic(fmt.Errorf("cannot initialize IBC keeper: empty scoped keeper"))
 	}
 
-	clientKeeper := clientkeeper.NewKeeper(cdc, key, paramSpace, stakingKeeper, upgradeKeeper, selfClient, clientHooks)
+	clientKeeper := clientkeeper.NewKeeper(cdc, key, paramSpace, stakingKeeper, upgradeKeeper, lightClients, selfClient, clientHooks)
 	connectionKeeper := connectionkeeper.NewKeeper(cdc, key, paramSpace, clientKeeper)
 	portKeeper := portkeeper.NewKeeper(scopedKeeper)
-	channelKeeper := channelkeeper.NewKeeper(cdc, key, clientKeeper, connectionKeeper, portKeeper, scopedKeeper)
+	channelKeeper := channelkeeper.NewKeeper(cdc, key, paramSpace, clientKeeper, connectionKeeper, portKeeper, scopedKeeper)
 
 	return &Keeper{
 		cdc:              cdc,
@@ -95,6 +126,12 @@ func (k Keeper) Codec() codec.BinaryCodec {
 	return k.cdc
 }
 
+// SetCapabilityKeeper sets the capability keeper and the capability memory store key
+// used by the port keeper to query the ownership of the port and channel capabilities.
+func (k *Keeper) SetCapabilityKeeper(capabilityKeeper porttypes.CapabilityKeeper, memKey sdk.StoreKey) {
+	k.PortKeeper.SetCapabilityKeeper(capabilityKeeper, memKey)
+}
+
 // SetRouter sets the Router in IBC Keeper and seals it. The method panics if
 // there is an existing router that's already sealed.
 func (k *Keeper) SetRouter(rtr *porttypes.Router) {
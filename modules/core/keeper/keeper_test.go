@@ -13,8 +13,9 @@ import (
 	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	ibchost "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 	ibckeeper "github.com/cosmos/ibc-go/v3/modules/core/keeper"
-	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/types"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
 
@@ -60,18 +61,20 @@ func (d MockStakingKeeper) UnbondingTime(ctx sdk.Context) time.Duration {
 // It verifies if ibckeeper.NewKeeper panic when any of the keepers passed in is empty.
 func (suite *KeeperTestSuite) TestNewKeeper() {
 	var (
-		stakingKeeper clienttypes.StakingKeeper
-		upgradeKeeper clienttypes.UpgradeKeeper
-		scopedKeeper  capabilitykeeper.ScopedKeeper
-		newIBCKeeper  = func() {
-			ibckeeper.NewKeeperWithSelfClient(
+		stakingKeeper  clienttypes.StakingKeeper
+		upgradeKeeper  clienttypes.UpgradeKeeper
+		scopedKeeper   capabilitykeeper.ScopedKeeper
+		selfClientType string
+		newIBCKeeper   = func() {
+			ibckeeper.NewKeeperWithLightClients(
 				suite.chainA.GetSimApp().AppCodec(),
 				suite.chainA.GetSimApp().GetKey(ibchost.StoreKey),
 				suite.chainA.GetSimApp().GetSubspace(ibchost.ModuleName),
 				stakingKeeper,
 				upgradeKeeper,
 				scopedKeeper,
-				ibctmtypes.NewSelfClient(),
+				types.NewLightClientRegistry(),
+				selfClientType,
 				nil,
 			)
 		}
@@ -103,6 +106,12 @@ func (suite *KeeperTestSuite) TestNewKeeper() {
 
 			scopedKeeper = emptyScopedKeeper
 		}, false},
+		{"failure: self client type is not registered", func() {
+			selfClientType = "9999-mock"
+		}, false},
+		{"failure: light client module does not provide a self client", func() {
+			selfClientType = exported.Solomachine
+		}, false},
 		{"success: replace stakingKeeper with non-empty MockStakingKeeper", func() {
 			// use a different implementation of clienttypes.StakingKeeper
 			mockStakingKeeper := MockStakingKeeper{"not empty"}
@@ -119,6 +128,7 @@ func (suite *KeeperTestSuite) TestNewKeeper() {
 			stakingKeeper = suite.chainA.GetSimApp().StakingKeeper
 			upgradeKeeper = suite.chainA.GetSimApp().UpgradeKeeper
 			scopedKeeper = suite.chainA.GetSimApp().ScopedIBCKeeper
+			selfClientType = exported.Tendermint
 
 			tc.malleate()
 
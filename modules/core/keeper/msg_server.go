@@ -2,17 +2,20 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	metrics "github.com/armon/go-metrics"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 	coretypes "github.com/cosmos/ibc-go/v3/modules/core/types"
 )
 
@@ -159,6 +162,19 @@ func (k Keeper) ConnectionOpenConfirm(goCtx context.Context, msg *connectiontype
 	return &connectiontypes.MsgConnectionOpenConfirmResponse{}, nil
 }
 
+// ConnectionOpenCancel defines a rpc handler method for MsgConnectionOpenCancel.
+func (k Keeper) ConnectionOpenCancel(goCtx context.Context, msg *connectiontypes.MsgConnectionOpenCancel) (*connectiontypes.MsgConnectionOpenCancelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ConnectionKeeper.ConnOpenCancel(
+		ctx, k.ChannelKeeper, msg.ConnectionId, msg.CounterpartyConnection, msg.ProofClosed, msg.ProofHeight,
+	); err != nil {
+		return nil, sdkerrors.Wrap(err, "connection handshake open cancel failed")
+	}
+
+	return &connectiontypes.MsgConnectionOpenCancelResponse{}, nil
+}
+
 // ChannelOpenInit defines a rpc handler method for MsgChannelOpenInit.
 // ChannelOpenInit will perform 04-channel checks, route to the application
 // callback, and write an OpenInit channel into state upon successful execution.
@@ -194,6 +210,11 @@ func (k Keeper) ChannelOpenInit(goCtx context.Context, msg *channeltypes.MsgChan
 	// Write channel into state
 	k.ChannelKeeper.WriteOpenInitChannel(ctx, msg.PortId, channelID, msg.Channel.Ordering, msg.Channel.ConnectionHops, msg.Channel.Counterparty, msg.Channel.Version)
 
+	// Store the optional additional packet delay declared by the channel
+	if !msg.PacketDelay.IsZero() {
+		k.ChannelKeeper.SetPacketDelay(ctx, msg.PortId, channelID, msg.PacketDelay)
+	}
+
 	return &channeltypes.MsgChannelOpenInitResponse{
 		ChannelId: channelID,
 		Version:   msg.Channel.Version,
@@ -235,6 +256,11 @@ func (k Keeper) ChannelOpenTry(goCtx context.Context, msg *channeltypes.MsgChann
 	// Write channel into state
 	k.ChannelKeeper.WriteOpenTryChannel(ctx, msg.PortId, channelID, msg.Channel.Ordering, msg.Channel.ConnectionHops, msg.Channel.Counterparty, version)
 
+	// Store the optional additional packet delay declared by the channel
+	if !msg.PacketDelay.IsZero() {
+		k.ChannelKeeper.SetPacketDelay(ctx, msg.PortId, channelID, msg.PacketDelay)
+	}
+
 	return &channeltypes.MsgChannelOpenTryResponse{
 		Version: version,
 	}, nil
@@ -365,6 +391,36 @@ func (k Keeper) ChannelCloseConfirm(goCtx context.Context, msg *channeltypes.Msg
 	return &channeltypes.MsgChannelCloseConfirmResponse{}, nil
 }
 
+// ChannelCloseFrozen defines a rpc handler method for MsgChannelCloseFrozen.
+func (k Keeper) ChannelCloseFrozen(goCtx context.Context, msg *channeltypes.MsgChannelCloseFrozen) (*channeltypes.MsgChannelCloseFrozenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Lookup module by channel capability
+	module, cap, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.PortId, msg.ChannelId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// Retrieve callbacks from router
+	cbs, ok := k.Router.GetRoute(module)
+	if !ok {
+		return nil, sdkerrors.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	// the counterparty channel end can no longer be reached, the application
+	// is notified as if the counterparty had closed the channel
+	if err = cbs.OnChanCloseConfirm(ctx, msg.PortId, msg.ChannelId); err != nil {
+		return nil, sdkerrors.Wrap(err, "channel close frozen callback failed")
+	}
+
+	err = k.ChannelKeeper.ChanCloseFrozen(ctx, msg.PortId, msg.ChannelId, cap, msg.ProofConnection, msg.ProofClientState, msg.ProofHeight)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "channel close frozen failed")
+	}
+
+	return &channeltypes.MsgChannelCloseFrozenResponse{}, nil
+}
+
 // RecvPacket defines a rpc handler method for MsgRecvPacket.
 func (k Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPacket) (*channeltypes.MsgRecvPacketResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -374,24 +430,70 @@ func (k Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPacke
 		return nil, sdkerrors.Wrap(err, "Invalid address for msg Signer")
 	}
 
-	// Lookup module by channel capability
-	module, cap, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.Packet.DestinationPort, msg.Packet.DestinationChannel)
+	route, err := k.lookupPacketRoute(ctx, msg.Packet.DestinationPort, msg.Packet.DestinationChannel)
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "could not retrieve module from port-id")
+		return nil, err
 	}
 
-	// Retrieve callbacks from router
-	cbs, ok := k.Router.GetRoute(module)
-	if !ok {
-		return nil, sdkerrors.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	result, err := k.recvPacket(ctx, route, relayer, msg)
+	if err != nil {
+		return nil, err
 	}
 
+	return &channeltypes.MsgRecvPacketResponse{Result: result}, nil
+}
+
+// RecvPackets defines a rpc handler method for MsgRecvPackets. The channel, its
+// connection and the light client are loaded once for the batch, and all packet
+// commitments are verified before any packet is passed to the application. The
+// packets are then received in order, each as if it was submitted in its own
+// MsgRecvPacket.
+func (k Keeper) RecvPackets(goCtx context.Context, msg *channeltypes.MsgRecvPackets) (*channeltypes.MsgRecvPacketsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	relayer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Invalid address for msg Signer")
+	}
+
+	if len(msg.Packets) == 0 {
+		return nil, sdkerrors.Wrap(channeltypes.ErrInvalidPacket, "batch cannot be empty")
+	}
+
+	route, err := k.lookupPacketRoute(ctx, msg.Packets[0].DestinationPort, msg.Packets[0].DestinationChannel)
+	if err != nil {
+		return nil, err
+	}
+
+	// Perform TAO verification of the whole batch, the application callbacks of
+	// each received packet are performed before the next packet is received
+	results := make([]channeltypes.ResponseResultType, len(msg.Packets))
+	if err := k.ChannelKeeper.RecvPackets(
+		ctx, route.cap, msg.Packets, msg.ProofCommitments, msg.ProofBatch, msg.ProofHeight,
+		func(i int, err error) error {
+			if err == channeltypes.ErrNoOpMsg {
+				results[i] = channeltypes.NOOP
+				return nil
+			}
+
+			results[i] = channeltypes.SUCCESS
+			return k.onRecvPacket(ctx, route, relayer, msg.Packets[i])
+		},
+	); err != nil {
+		return nil, sdkerrors.Wrap(err, "receive packets failed")
+	}
+
+	return &channeltypes.MsgRecvPacketsResponse{Results: results}, nil
+}
+
+// recvPacket receives a single packet using the route of its destination channel.
+func (k Keeper) recvPacket(ctx sdk.Context, route packetRoute, relayer sdk.AccAddress, msg *channeltypes.MsgRecvPacket) (channeltypes.ResponseResultType, error) {
 	// Perform TAO verification
 	//
 	// If the packet was already received, perform a no-op
 	// Use a cached context to prevent accidental state changes
 	cacheCtx, writeFn := ctx.CacheContext()
-	err = k.ChannelKeeper.RecvPacket(cacheCtx, cap, msg.Packet, msg.ProofCommitment, msg.ProofHeight)
+	err := k.ChannelKeeper.RecvPacket(cacheCtx, route.cap, msg.Packet, msg.ProofCommitment, msg.ProofHeight)
 
 	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
 	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
@@ -400,31 +502,52 @@ func (k Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPacke
 	case nil:
 		writeFn()
 	case channeltypes.ErrNoOpMsg:
-		return &channeltypes.MsgRecvPacketResponse{Result: channeltypes.NOOP}, nil
+		return channeltypes.NOOP, nil
 	default:
-		return nil, sdkerrors.Wrap(err, "receive packet verification failed")
+		return channeltypes.UNSPECIFIED, sdkerrors.Wrap(err, "receive packet verification failed")
 	}
 
-	// Perform application logic callback
-	//
-	// Cache context so that we may discard state changes from callback if the acknowledgement is unsuccessful.
-	cacheCtx, writeFn = ctx.CacheContext()
-	ack := cbs.OnRecvPacket(cacheCtx, msg.Packet, relayer)
-	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
-	// Events from callback are emitted regardless of acknowledgement success
-	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
-	if ack == nil || ack.Success() {
-		// write application state changes for asynchronous and successful acknowledgements
-		writeFn()
+	if err := k.onRecvPacket(ctx, route, relayer, msg.Packet); err != nil {
+		return channeltypes.UNSPECIFIED, err
+	}
+
+	return channeltypes.SUCCESS, nil
+}
+
+// onRecvPacket performs the application callback of a received packet and writes
+// its acknowledgement, or records it as pending an asynchronous acknowledgement.
+func (k Keeper) onRecvPacket(ctx sdk.Context, route packetRoute, relayer sdk.AccAddress, packet channeltypes.Packet) error {
+	var ack exported.Acknowledgement
+	if err := k.chargeRecvPacket(ctx, route, relayer, packet); err != nil {
+		// the ABCI code is deterministic, the codespace and log of the error are discarded
+		_, code, _ := sdkerrors.ABCIInfo(err, false)
+		ack = channeltypes.NewErrorAcknowledgement(fmt.Sprintf("ABCI code: %d: failed to charge packet execution", code))
+	} else {
+		// Perform application logic callback
+		//
+		// Cache context so that we may discard state changes from callback if the acknowledgement is unsuccessful.
+		cacheCtx, writeFn := ctx.CacheContext()
+		ack = route.cbs.OnRecvPacket(cacheCtx, packet, relayer)
+		// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
+		// Events from callback are emitted regardless of acknowledgement success
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		if ack == nil || ack.Success() {
+			// write application state changes for asynchronous and successful acknowledgements
+			writeFn()
+		}
 	}
 
 	// Set packet acknowledgement only if the acknowledgement is not nil.
 	// NOTE: IBC applications modules may call the WriteAcknowledgement asynchronously if the
 	// acknowledgement is nil.
 	if ack != nil {
-		if err := k.ChannelKeeper.WriteAcknowledgement(ctx, cap, msg.Packet, ack); err != nil {
-			return nil, err
+		if err := k.ChannelKeeper.WriteAcknowledgement(ctx, route.cap, packet, ack); err != nil {
+			return err
 		}
+	} else {
+		// record the packet so that the pending asynchronous acknowledgement can be
+		// queried and expired
+		k.ChannelKeeper.SetPendingAsyncAck(ctx, channeltypes.NewPendingAsyncAck(packet, uint64(ctx.BlockHeight()), uint64(ctx.BlockTime().UnixNano())))
 	}
 
 	defer func() {
@@ -432,15 +555,36 @@ func (k Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPacke
 			[]string{"tx", "msg", "ibc", channeltypes.EventTypeRecvPacket},
 			1,
 			[]metrics.Label{
-				telemetry.NewLabel(coretypes.LabelSourcePort, msg.Packet.SourcePort),
-				telemetry.NewLabel(coretypes.LabelSourceChannel, msg.Packet.SourceChannel),
-				telemetry.NewLabel(coretypes.LabelDestinationPort, msg.Packet.DestinationPort),
-				telemetry.NewLabel(coretypes.LabelDestinationChannel, msg.Packet.DestinationChannel),
+				telemetry.NewLabel(coretypes.LabelSourcePort, packet.SourcePort),
+				telemetry.NewLabel(coretypes.LabelSourceChannel, packet.SourceChannel),
+				telemetry.NewLabel(coretypes.LabelDestinationPort, packet.DestinationPort),
+				telemetry.NewLabel(coretypes.LabelDestinationChannel, packet.DestinationChannel),
 			},
 		)
 	}()
 
-	return &channeltypes.MsgRecvPacketResponse{Result: channeltypes.SUCCESS}, nil
+	return nil
+}
+
+// chargeRecvPacket charges the application of the route for the execution of a received
+// packet if it implements the RecvPacketCharger interface. The state changes of the charge
+// are written only if it succeeds, regardless of the acknowledgement of the packet.
+func (k Keeper) chargeRecvPacket(ctx sdk.Context, route packetRoute, relayer sdk.AccAddress, packet channeltypes.Packet) error {
+	charger, ok := route.cbs.(porttypes.RecvPacketCharger)
+	if !ok {
+		return nil
+	}
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	if err := charger.ChargeRecvPacket(cacheCtx, packet, relayer); err != nil {
+		return err
+	}
+
+	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	writeFn()
+
+	return nil
 }
 
 // Timeout defines a rpc handler method for MsgTimeout.
@@ -591,24 +735,71 @@ func (k Keeper) Acknowledgement(goCtx context.Context, msg *channeltypes.MsgAckn
 		return nil, sdkerrors.Wrap(err, "Invalid address for msg Signer")
 	}
 
-	// Lookup module by channel capability
-	module, cap, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.Packet.SourcePort, msg.Packet.SourceChannel)
+	route, err := k.lookupPacketRoute(ctx, msg.Packet.SourcePort, msg.Packet.SourceChannel)
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "could not retrieve module from port-id")
+		return nil, err
 	}
 
-	// Retrieve callbacks from router
-	cbs, ok := k.Router.GetRoute(module)
-	if !ok {
-		return nil, sdkerrors.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	result, err := k.acknowledgement(ctx, route, relayer, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &channeltypes.MsgAcknowledgementResponse{Result: result}, nil
+}
+
+// Acknowledgements defines a rpc handler method for MsgAcknowledgements. The
+// channel, its connection and the light client are loaded once for the batch,
+// and all acknowledgements are verified before any of them is passed to the
+// application. The acknowledgements are then processed in order, each as if it
+// was submitted in its own MsgAcknowledgement.
+func (k Keeper) Acknowledgements(goCtx context.Context, msg *channeltypes.MsgAcknowledgements) (*channeltypes.MsgAcknowledgementsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	relayer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "Invalid address for msg Signer")
 	}
 
+	if len(msg.Packets) == 0 {
+		return nil, sdkerrors.Wrap(channeltypes.ErrInvalidPacket, "batch cannot be empty")
+	}
+
+	route, err := k.lookupPacketRoute(ctx, msg.Packets[0].SourcePort, msg.Packets[0].SourceChannel)
+	if err != nil {
+		return nil, err
+	}
+
+	// Perform TAO verification of the whole batch, the application callback of
+	// each acknowledgement is performed before the next one is processed
+	results := make([]channeltypes.ResponseResultType, len(msg.Packets))
+	if err := k.ChannelKeeper.AcknowledgePackets(
+		ctx, route.cap, msg.Packets, msg.Acknowledgements, msg.ProofsAcked, msg.ProofBatch, msg.ProofHeight,
+		func(i int, err error) error {
+			if err == channeltypes.ErrNoOpMsg {
+				results[i] = channeltypes.NOOP
+				return nil
+			}
+
+			results[i] = channeltypes.SUCCESS
+			return k.onAcknowledgementPacket(ctx, route, relayer, msg.Packets[i], msg.Acknowledgements[i])
+		},
+	); err != nil {
+		return nil, sdkerrors.Wrap(err, "acknowledge packets failed")
+	}
+
+	return &channeltypes.MsgAcknowledgementsResponse{Results: results}, nil
+}
+
+// acknowledgement processes a single acknowledgement using the route of the
+// source channel of its packet.
+func (k Keeper) acknowledgement(ctx sdk.Context, route packetRoute, relayer sdk.AccAddress, msg *channeltypes.MsgAcknowledgement) (channeltypes.ResponseResultType, error) {
 	// Perform TAO verification
 	//
 	// If the acknowledgement was already received, perform a no-op
 	// Use a cached context to prevent accidental state changes
 	cacheCtx, writeFn := ctx.CacheContext()
-	err = k.ChannelKeeper.AcknowledgePacket(cacheCtx, cap, msg.Packet, msg.Acknowledgement, msg.ProofAcked, msg.ProofHeight)
+	err := k.ChannelKeeper.AcknowledgePacket(cacheCtx, route.cap, msg.Packet, msg.Acknowledgement, msg.ProofAcked, msg.ProofHeight)
 
 	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
 	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
@@ -617,15 +808,25 @@ func (k Keeper) Acknowledgement(goCtx context.Context, msg *channeltypes.MsgAckn
 	case nil:
 		writeFn()
 	case channeltypes.ErrNoOpMsg:
-		return &channeltypes.MsgAcknowledgementResponse{Result: channeltypes.NOOP}, nil
+		return channeltypes.NOOP, nil
 	default:
-		return nil, sdkerrors.Wrap(err, "acknowledge packet verification failed")
+		return channeltypes.UNSPECIFIED, sdkerrors.Wrap(err, "acknowledge packet verification failed")
+	}
+
+	if err := k.onAcknowledgementPacket(ctx, route, relayer, msg.Packet, msg.Acknowledgement); err != nil {
+		return channeltypes.UNSPECIFIED, err
 	}
 
+	return channeltypes.SUCCESS, nil
+}
+
+// onAcknowledgementPacket performs the application callback of an acknowledged
+// packet.
+func (k Keeper) onAcknowledgementPacket(ctx sdk.Context, route packetRoute, relayer sdk.AccAddress, packet channeltypes.Packet, acknowledgement []byte) error {
 	// Perform application logic callback
-	err = cbs.OnAcknowledgementPacket(ctx, msg.Packet, msg.Acknowledgement, relayer)
+	err := route.cbs.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "acknowledge packet callback failed")
+		return sdkerrors.Wrap(err, "acknowledge packet callback failed")
 	}
 
 	defer func() {
@@ -633,13 +834,55 @@ func (k Keeper) Acknowledgement(goCtx context.Context, msg *channeltypes.MsgAckn
 			[]string{"tx", "msg", "ibc", channeltypes.EventTypeAcknowledgePacket},
 			1,
 			[]metrics.Label{
-				telemetry.NewLabel(coretypes.LabelSourcePort, msg.Packet.SourcePort),
-				telemetry.NewLabel(coretypes.LabelSourceChannel, msg.Packet.SourceChannel),
-				telemetry.NewLabel(coretypes.LabelDestinationPort, msg.Packet.DestinationPort),
-				telemetry.NewLabel(coretypes.LabelDestinationChannel, msg.Packet.DestinationChannel),
+				telemetry.NewLabel(coretypes.LabelSourcePort, packet.SourcePort),
+				telemetry.NewLabel(coretypes.LabelSourceChannel, packet.SourceChannel),
+				telemetry.NewLabel(coretypes.LabelDestinationPort, packet.DestinationPort),
+				telemetry.NewLabel(coretypes.LabelDestinationChannel, packet.DestinationChannel),
 			},
 		)
 	}()
 
-	return &channeltypes.MsgAcknowledgementResponse{Result: channeltypes.SUCCESS}, nil
+	return nil
+}
+
+// PruneAcknowledgements defines a rpc handler method for MsgPruneAcknowledgements.
+func (k Keeper) PruneAcknowledgements(goCtx context.Context, msg *channeltypes.MsgPruneAcknowledgements) (*channeltypes.MsgPruneAcknowledgementsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pruned, remaining, err := k.ChannelKeeper.PruneAcknowledgements(
+		ctx, msg.PortId, msg.ChannelId, msg.NextSequenceAck, msg.ProofNextSequenceAck, msg.ProofHeight, msg.Limit,
+	)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "prune acknowledgements failed")
+	}
+
+	return &channeltypes.MsgPruneAcknowledgementsResponse{
+		TotalPrunedSequences:    pruned,
+		TotalRemainingSequences: remaining,
+	}, nil
+}
+
+// packetRoute holds the channel capability and the callbacks of the module
+// bound to a channel.
+type packetRoute struct {
+	cap *capabilitytypes.Capability
+	cbs porttypes.IBCModule
+}
+
+// lookupPacketRoute looks up the module bound to a channel and returns its
+// channel capability and callbacks.
+func (k Keeper) lookupPacketRoute(ctx sdk.Context, portID, channelID string) (packetRoute, error) {
+	// Lookup module by channel capability
+	module, cap, err := k.ChannelKeeper.LookupModuleByChannel(ctx, portID, channelID)
+	if err != nil {
+		return packetRoute{}, sdkerrors.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// Retrieve callbacks from router
+	cbs, ok := k.Router.GetRoute(module)
+	if !ok {
+		return packetRoute{}, sdkerrors.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	return packetRoute{cap: cap, cbs: cbs}, nil
 }
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"context"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
@@ -177,13 +179,18 @@ func (suite *KeeperTestSuite) TestHandleRecvPacket() {
 				// verify if ack was written
 				ack, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
 
+				// verify if the packet is pending an asynchronous acknowledgement
+				_, pending := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPendingAsyncAck(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+
 				if async {
 					suite.Require().Nil(ack)
 					suite.Require().False(found)
+					suite.Require().True(pending)
 
 				} else {
 					suite.Require().NotNil(ack)
 					suite.Require().True(found)
+					suite.Require().False(pending)
 				}
 			} else {
 				suite.Require().Error(err)
@@ -192,6 +199,112 @@ func (suite *KeeperTestSuite) TestHandleRecvPacket() {
 	}
 }
 
+// tests the IBC handler receiving a batch of packets sharing a single proof
+// height. It verifies that each packet is received individually and that
+// previously received packets are reported as no-ops.
+func (suite *KeeperTestSuite) TestHandleRecvPackets() {
+	var (
+		packets    []channeltypes.Packet
+		path       *ibctesting.Path
+		expResults []channeltypes.ResponseResultType
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"success: UNORDERED", func() {
+			expResults = []channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.SUCCESS, channeltypes.SUCCESS}
+		}, true},
+		{"success: UNORDERED with already received packet", func() {
+			err := path.EndpointB.RecvPacket(packets[1])
+			suite.Require().NoError(err)
+
+			expResults = []channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.NOOP, channeltypes.SUCCESS}
+		}, true},
+		{"failure: packet not sent", func() {
+			packets = append(packets, channeltypes.NewPacket(ibctesting.MockPacketData, 4, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0))
+		}, false},
+		{"failure: channel does not exist", func() {
+			packets[2].DestinationChannel = ibctesting.InvalidID
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		for _, proofBatch := range []bool{false, true} {
+			tc := tc
+			proofBatch := proofBatch
+
+			name := tc.name
+			if proofBatch {
+				name += " with batch proof"
+			}
+
+			suite.Run(name, func() {
+				suite.SetupTest() // reset
+				path = ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				packets = nil
+				for seq := uint64(1); seq <= 3; seq++ {
+					packet := channeltypes.NewPacket(ibctesting.MockPacketData, seq, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+					err := path.EndpointA.SendPacket(packet)
+					suite.Require().NoError(err)
+
+					packets = append(packets, packet)
+				}
+
+				tc.malleate()
+
+				err := path.EndpointB.UpdateClient()
+				suite.Require().NoError(err)
+
+				var packetKeys [][]byte
+				for _, packet := range packets {
+					packetKeys = append(packetKeys, host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+				}
+
+				var msg *channeltypes.MsgRecvPackets
+				if proofBatch {
+					proof, proofHeight := path.EndpointA.QueryProofs(packetKeys...)
+					msg = channeltypes.NewMsgRecvPacketsWithProofBatch(packets, proof, proofHeight, suite.chainB.SenderAccount.GetAddress().String())
+				} else {
+					var (
+						proofs      [][]byte
+						proofHeight clienttypes.Height
+					)
+					for _, packetKey := range packetKeys {
+						proof, height := path.EndpointA.QueryProof(packetKey)
+						proofs = append(proofs, proof)
+						proofHeight = height
+					}
+					msg = channeltypes.NewMsgRecvPackets(packets, proofs, proofHeight, suite.chainB.SenderAccount.GetAddress().String())
+				}
+
+				res, err := keeper.Keeper.RecvPackets(*suite.chainB.App.GetIBCKeeper(), sdk.WrapSDKContext(suite.chainB.GetContext()), msg)
+
+				if tc.expPass {
+					suite.Require().NoError(err)
+					suite.Require().Equal(expResults, res.Results)
+
+					for _, packet := range packets {
+						_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+						suite.Require().True(found)
+					}
+
+					// replay should not fail since every packet will be treated as a no-op
+					res, err = keeper.Keeper.RecvPackets(*suite.chainB.App.GetIBCKeeper(), sdk.WrapSDKContext(suite.chainB.GetContext()), msg)
+					suite.Require().NoError(err)
+					suite.Require().Equal([]channeltypes.ResponseResultType{channeltypes.NOOP, channeltypes.NOOP, channeltypes.NOOP}, res.Results)
+				} else {
+					suite.Require().Error(err)
+				}
+			})
+		}
+	}
+}
+
 // tests the IBC handler acknowledgement of a packet on ordered and unordered
 // channels. It verifies that the deletion of packet commitments from state
 // occurs. It test high level properties like ordering and basic sanity
@@ -338,6 +451,229 @@ func (suite *KeeperTestSuite) TestHandleAcknowledgePacket() {
 	}
 }
 
+// tests the IBC handler acknowledging a batch of packets sharing a single
+// proof height. It verifies that the packet commitments are deleted and that
+// previously acknowledged packets are reported as no-ops.
+func (suite *KeeperTestSuite) TestHandleAcknowledgements() {
+	var (
+		packets    []channeltypes.Packet
+		path       *ibctesting.Path
+		expResults []channeltypes.ResponseResultType
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"success: UNORDERED", func() {
+			expResults = []channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.SUCCESS, channeltypes.SUCCESS}
+		}, true},
+		{"success: UNORDERED with already acknowledged packet", func() {
+			err := path.EndpointA.AcknowledgePacket(packets[0], ibctesting.MockAcknowledgement)
+			suite.Require().NoError(err)
+
+			expResults = []channeltypes.ResponseResultType{channeltypes.NOOP, channeltypes.SUCCESS, channeltypes.SUCCESS}
+		}, true},
+		{"failure: packet not received", func() {
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, 4, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+
+			packets = append(packets, packet)
+		}, false},
+		{"failure: channel does not exist", func() {
+			packets[2].SourceChannel = ibctesting.InvalidID
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		for _, proofBatch := range []bool{false, true} {
+			tc := tc
+			proofBatch := proofBatch
+
+			name := tc.name
+			if proofBatch {
+				name += " with batch proof"
+			}
+
+			suite.Run(name, func() {
+				suite.SetupTest() // reset
+				path = ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.coordinator.Setup(path)
+
+				packets = nil
+				for seq := uint64(1); seq <= 3; seq++ {
+					packet := channeltypes.NewPacket(ibctesting.MockPacketData, seq, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+					err := path.EndpointA.SendPacket(packet)
+					suite.Require().NoError(err)
+
+					err = path.EndpointB.RecvPacket(packet)
+					suite.Require().NoError(err)
+
+					packets = append(packets, packet)
+				}
+
+				tc.malleate()
+
+				err := path.EndpointA.UpdateClient()
+				suite.Require().NoError(err)
+
+				var (
+					acks       [][]byte
+					packetKeys [][]byte
+				)
+				for _, packet := range packets {
+					acks = append(acks, ibcmock.MockAcknowledgement.Acknowledgement())
+					packetKeys = append(packetKeys, host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+				}
+
+				var msg *channeltypes.MsgAcknowledgements
+				if proofBatch {
+					proof, proofHeight := path.EndpointB.QueryProofs(packetKeys...)
+					msg = channeltypes.NewMsgAcknowledgementsWithProofBatch(packets, acks, proof, proofHeight, suite.chainA.SenderAccount.GetAddress().String())
+				} else {
+					var (
+						proofs      [][]byte
+						proofHeight clienttypes.Height
+					)
+					for _, packetKey := range packetKeys {
+						proof, height := path.EndpointB.QueryProof(packetKey)
+						proofs = append(proofs, proof)
+						proofHeight = height
+					}
+					msg = channeltypes.NewMsgAcknowledgements(packets, acks, proofs, proofHeight, suite.chainA.SenderAccount.GetAddress().String())
+				}
+
+				res, err := keeper.Keeper.Acknowledgements(*suite.chainA.App.GetIBCKeeper(), sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+				if tc.expPass {
+					suite.Require().NoError(err)
+					suite.Require().Equal(expResults, res.Results)
+
+					// verify packet commitments were deleted on source chain
+					for _, packet := range packets {
+						has := suite.chainA.App.GetIBCKeeper().ChannelKeeper.HasPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+						suite.Require().False(has)
+					}
+
+					// replay should not error as every acknowledgement is treated as a no-op
+					res, err = keeper.Keeper.Acknowledgements(*suite.chainA.App.GetIBCKeeper(), sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+					suite.Require().NoError(err)
+					suite.Require().Equal([]channeltypes.ResponseResultType{channeltypes.NOOP, channeltypes.NOOP, channeltypes.NOOP}, res.Results)
+				} else {
+					suite.Require().Error(err)
+				}
+			})
+		}
+	}
+}
+
+// tests that receiving and acknowledging a batch of packets consumes less gas
+// than submitting a message for each packet, since the channel, the connection
+// and the light client are only loaded once per batch, and that a single batch
+// proof reduces the gas further.
+func (suite *KeeperTestSuite) TestBatchPacketGas() {
+	const numPackets = 10
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	var (
+		packets      []channeltypes.Packet
+		commitKeys   [][]byte
+		ackKeys      [][]byte
+		acks         [][]byte
+		commitProofs [][]byte
+		ackProofs    [][]byte
+	)
+	for seq := uint64(1); seq <= numPackets; seq++ {
+		packet := channeltypes.NewPacket(ibctesting.MockPacketData, seq, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+		err := path.EndpointA.SendPacket(packet)
+		suite.Require().NoError(err)
+
+		packets = append(packets, packet)
+		commitKeys = append(commitKeys, host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+		ackKeys = append(ackKeys, host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+		acks = append(acks, ibcmock.MockAcknowledgement.Acknowledgement())
+	}
+
+	err := path.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	var proofHeight clienttypes.Height
+	for _, key := range commitKeys {
+		var proof []byte
+		proof, proofHeight = path.EndpointA.QueryProof(key)
+		commitProofs = append(commitProofs, proof)
+	}
+	commitProofBatch, _ := path.EndpointA.QueryProofs(commitKeys...)
+
+	signer := suite.chainB.SenderAccount.GetAddress().String()
+	ibcKeeper := *suite.chainB.App.GetIBCKeeper()
+
+	// consumeGas runs the handler on a branch of the current state with a fresh gas meter
+	consumeGas := func(chain *ibctesting.TestChain, handle func(goCtx context.Context)) uint64 {
+		ctx, _ := chain.GetContext().CacheContext()
+		ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		handle(sdk.WrapSDKContext(ctx))
+		return ctx.GasMeter().GasConsumed()
+	}
+
+	singleGas := consumeGas(suite.chainB, func(goCtx context.Context) {
+		for i, packet := range packets {
+			_, err := ibcKeeper.RecvPacket(goCtx, channeltypes.NewMsgRecvPacket(packet, commitProofs[i], proofHeight, signer))
+			suite.Require().NoError(err)
+		}
+	})
+	batchGas := consumeGas(suite.chainB, func(goCtx context.Context) {
+		_, err := ibcKeeper.RecvPackets(goCtx, channeltypes.NewMsgRecvPackets(packets, commitProofs, proofHeight, signer))
+		suite.Require().NoError(err)
+	})
+	proofBatchGas := consumeGas(suite.chainB, func(goCtx context.Context) {
+		_, err := ibcKeeper.RecvPackets(goCtx, channeltypes.NewMsgRecvPacketsWithProofBatch(packets, commitProofBatch, proofHeight, signer))
+		suite.Require().NoError(err)
+	})
+
+	suite.Require().Less(batchGas, singleGas)
+	suite.Require().Less(proofBatchGas, batchGas)
+
+	// receive the packets and acknowledge them on chain A
+	_, err = suite.chainB.SendMsgs(channeltypes.NewMsgRecvPackets(packets, commitProofs, proofHeight, signer))
+	suite.Require().NoError(err)
+
+	err = path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	for _, key := range ackKeys {
+		var proof []byte
+		proof, proofHeight = path.EndpointB.QueryProof(key)
+		ackProofs = append(ackProofs, proof)
+	}
+	ackProofBatch, _ := path.EndpointB.QueryProofs(ackKeys...)
+
+	signer = suite.chainA.SenderAccount.GetAddress().String()
+	ibcKeeper = *suite.chainA.App.GetIBCKeeper()
+
+	singleGas = consumeGas(suite.chainA, func(goCtx context.Context) {
+		for i, packet := range packets {
+			_, err := ibcKeeper.Acknowledgement(goCtx, channeltypes.NewMsgAcknowledgement(packet, acks[i], ackProofs[i], proofHeight, signer))
+			suite.Require().NoError(err)
+		}
+	})
+	batchGas = consumeGas(suite.chainA, func(goCtx context.Context) {
+		_, err := ibcKeeper.Acknowledgements(goCtx, channeltypes.NewMsgAcknowledgements(packets, acks, ackProofs, proofHeight, signer))
+		suite.Require().NoError(err)
+	})
+	proofBatchGas = consumeGas(suite.chainA, func(goCtx context.Context) {
+		_, err := ibcKeeper.Acknowledgements(goCtx, channeltypes.NewMsgAcknowledgementsWithProofBatch(packets, acks, ackProofBatch, proofHeight, signer))
+		suite.Require().NoError(err)
+	})
+
+	suite.Require().Less(batchGas, singleGas)
+	suite.Require().Less(proofBatchGas, batchGas)
+}
+
 // tests the IBC handler timing out a packet on ordered and unordered channels.
 // It verifies that the deletion of a packet commitment occurs. It tests
 // high level properties like ordering and basic sanity checks. More
@@ -624,6 +960,21 @@ func (suite *KeeperTestSuite) TestHandleTimeoutOnClosePacket() {
 	}
 }
 
+// tests that the optional packet delay declared in the channel handshake
+// messages is stored for the opened channels.
+func (suite *KeeperTestSuite) TestChannelOpenPacketDelay() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.EndpointA.ChannelConfig.PacketDelay = channeltypes.NewPacketDelay(100, 0)
+	path.EndpointB.ChannelConfig.PacketDelay = channeltypes.NewPacketDelay(0, 10)
+	suite.coordinator.Setup(path)
+
+	packetDelayA := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketDelay(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().Equal(path.EndpointA.ChannelConfig.PacketDelay, packetDelayA)
+
+	packetDelayB := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketDelay(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+	suite.Require().Equal(path.EndpointB.ChannelConfig.PacketDelay, packetDelayB)
+}
+
 func (suite *KeeperTestSuite) TestUpgradeClient() {
 	var (
 		path              *ibctesting.Path
@@ -21,7 +21,9 @@ import (
 	clientkeeper "github.com/cosmos/ibc-go/v3/modules/core/02-client/keeper"
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	ibcchannel "github.com/cosmos/ibc-go/v3/modules/core/04-channel"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v3/modules/core/client/cli"
 	"github.com/cosmos/ibc-go/v3/modules/core/keeper"
@@ -36,7 +38,29 @@ var (
 )
 
 // AppModuleBasic defines the basic application module used by the ibc module.
-type AppModuleBasic struct{}
+//
+// Applications registering light clients which are not part of this repository create a
+// single registry with types.NewLightClientRegistry, set it on the AppModuleBasic of their
+// module basics, which registers the interfaces of the light clients on the codec built by
+// MakeEncodingConfig, and give the same registry to keeper.NewKeeperWithLightClients:
+//
+//	var lightClients = ibccoretypes.NewLightClientRegistry(mock.LightClientModule{})
+//
+//	var ModuleBasics = module.NewBasicManager(
+//		...
+//		ibc.AppModuleBasic{LightClients: lightClients},
+//	)
+//
+//	app.IBCKeeper = ibckeeper.NewKeeperWithLightClients(..., lightClients, exported.Tendermint, nil)
+//
+// The genesis validation and the CLI commands of the module basics then cover the
+// registered light clients, and the keeper creates clients of their types once they are
+// allowed by the 02-client params.
+type AppModuleBasic struct {
+	// LightClients are the light client modules registered by the application. The light
+	// clients of this repository are used if it is nil.
+	LightClients *clienttypes.LightClientRegistry
+}
 
 var _ module.AppModuleBasic = AppModuleBasic{}
 
@@ -55,13 +79,17 @@ func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
 }
 
 // ValidateGenesis performs genesis state validation for the ibc module.
-func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
+func (b AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
 	var gs types.GenesisState
 	if err := cdc.UnmarshalJSON(bz, &gs); err != nil {
 		return fmt.Errorf("failed to unmarshal %s genesis state: %w", host.ModuleName, err)
 	}
 
-	return gs.Validate()
+	if err := gs.Validate(); err != nil {
+		return err
+	}
+
+	return b.lightClientRegistry().ValidateGenesis(gs.ClientGenesis)
 }
 
 // RegisterRESTRoutes does nothing. IBC does not support legacy REST routes.
@@ -72,21 +100,36 @@ func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *r
 	clienttypes.RegisterQueryHandlerClient(context.Background(), mux, clienttypes.NewQueryClient(clientCtx))
 	connectiontypes.RegisterQueryHandlerClient(context.Background(), mux, connectiontypes.NewQueryClient(clientCtx))
 	channeltypes.RegisterQueryHandlerClient(context.Background(), mux, channeltypes.NewQueryClient(clientCtx))
+	porttypes.RegisterQueryHandlerClient(context.Background(), mux, porttypes.NewQueryClient(clientCtx))
 }
 
 // GetTxCmd returns the root tx command for the ibc module.
-func (AppModuleBasic) GetTxCmd() *cobra.Command {
-	return cli.GetTxCmd()
+func (b AppModuleBasic) GetTxCmd() *cobra.Command {
+	return cli.GetTxCmd(b.lightClientRegistry().GetTxCmds()...)
 }
 
 // GetQueryCmd returns no root query command for the ibc module.
-func (AppModuleBasic) GetQueryCmd() *cobra.Command {
-	return cli.GetQueryCmd()
+func (b AppModuleBasic) GetQueryCmd() *cobra.Command {
+	return cli.GetQueryCmd(b.lightClientRegistry().GetQueryCmds()...)
 }
 
 // RegisterInterfaces registers module concrete types into protobuf Any.
-func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+func (b AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	types.RegisterInterfaces(registry)
+
+	if b.LightClients != nil {
+		b.LightClients.RegisterInterfaces(registry)
+	}
+}
+
+// lightClientRegistry returns the light client modules registered by the application or
+// the light clients of this repository.
+func (b AppModuleBasic) lightClientRegistry() *clienttypes.LightClientRegistry {
+	if b.LightClients != nil {
+		return b.LightClients
+	}
+
+	return types.NewLightClientRegistry()
 }
 
 // AppModule implements an application module for the ibc module.
@@ -101,6 +144,9 @@ type AppModule struct {
 // NewAppModule creates a new AppModule object
 func NewAppModule(k *keeper.Keeper) AppModule {
 	return AppModule{
+		AppModuleBasic: AppModuleBasic{
+			LightClients: k.ClientKeeper.GetLightClientRegistry(),
+		},
 		keeper: k,
 	}
 }
@@ -162,14 +208,17 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return 2 }
 
-// BeginBlock returns the begin blocker for the ibc module.
+// BeginBlock returns the begin blocker for the ibc module. The sentinel connection of the
+// localhost client is created along with the client, once the client is allowed.
 func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 	ibcclient.BeginBlocker(ctx, am.keeper.ClientKeeper)
+	createSentinelLocalhostConnection(ctx, *am.keeper)
 }
 
 // EndBlock returns the end blocker for the ibc module. It returns no validator
 // updates.
 func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
+	ibcchannel.EndBlocker(ctx, am.keeper.ChannelKeeper, am.keeper.Router)
 	return []abci.ValidatorUpdate{}
 }
 
@@ -0,0 +1,165 @@
+package ibc_test
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+
+	ibc "github.com/cosmos/ibc-go/v3/modules/core"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/cosmos/ibc-go/v3/modules/core/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/core/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/cosmos/ibc-go/v3/testing/simapp"
+)
+
+const mockClientType = "9999-mock"
+
+var _ clienttypes.CLIModule = mockLightClientModule{}
+
+// mockLightClientModule is a light client module which is not part of this repository.
+type mockLightClientModule struct{}
+
+func (mockLightClientModule) ClientType() string {
+	return mockClientType
+}
+
+func (mockLightClientModule) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*exported.ClientState)(nil), &mockClientState{})
+	registry.RegisterImplementations((*exported.ConsensusState)(nil), &mockConsensusState{})
+}
+
+func (mockLightClientModule) GetTxCmd() *cobra.Command {
+	return &cobra.Command{Use: "mock"}
+}
+
+func (mockLightClientModule) GetQueryCmd() *cobra.Command {
+	return &cobra.Command{Use: "mock"}
+}
+
+// mockClientState is a client state of the mock light client, which verifies the
+// counterparty as a tendermint client.
+type mockClientState struct {
+	ibctmtypes.ClientState
+}
+
+func (*mockClientState) XXX_MessageName() string {
+	return "ibc.lightclients.mock.v1.ClientState"
+}
+
+func (*mockClientState) ClientType() string {
+	return mockClientType
+}
+
+func (cs *mockClientState) Initialize(ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore, consState exported.ConsensusState) error {
+	mockConsState, ok := consState.(*mockConsensusState)
+	if !ok {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidConsensus, "invalid initial consensus state, expected type %T, got %T", &mockConsensusState{}, consState)
+	}
+
+	return cs.ClientState.Initialize(ctx, cdc, clientStore, &mockConsState.ConsensusState)
+}
+
+func (cs *mockClientState) MarshalJSONPB(m *jsonpb.Marshaler) ([]byte, error) {
+	return marshalJSONPB(m, &cs.ClientState)
+}
+
+func (cs *mockClientState) UnmarshalJSONPB(u *jsonpb.Unmarshaler, bz []byte) error {
+	return u.Unmarshal(bytes.NewReader(bz), &cs.ClientState)
+}
+
+// mockConsensusState is a consensus state of the mock light client.
+type mockConsensusState struct {
+	ibctmtypes.ConsensusState
+}
+
+func (*mockConsensusState) XXX_MessageName() string {
+	return "ibc.lightclients.mock.v1.ConsensusState"
+}
+
+func (*mockConsensusState) ClientType() string {
+	return mockClientType
+}
+
+func (cs *mockConsensusState) MarshalJSONPB(m *jsonpb.Marshaler) ([]byte, error) {
+	return marshalJSONPB(m, &cs.ConsensusState)
+}
+
+func (cs *mockConsensusState) UnmarshalJSONPB(u *jsonpb.Unmarshaler, bz []byte) error {
+	return u.Unmarshal(bytes.NewReader(bz), &cs.ConsensusState)
+}
+
+func marshalJSONPB(m *jsonpb.Marshaler, msg proto.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	err := m.Marshal(&buf, msg)
+	return buf.Bytes(), err
+}
+
+// TestExternalLightClient registers a light client module which is not part of this
+// repository the way an application does, with a single registry shared by the module
+// basics and the keeper.
+func (suite *IBCTestSuite) TestExternalLightClient() {
+	app := suite.chainA.App.(*simapp.SimApp)
+	ctx := suite.chainA.GetContext()
+
+	lightClients := types.NewLightClientRegistry(mockLightClientModule{})
+	moduleBasic := ibc.AppModuleBasic{LightClients: lightClients}
+
+	// codec: the module basics register the interfaces of the light client modules
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	moduleBasic.RegisterInterfaces(interfaceRegistry)
+	cdc := codec.NewProtoCodec(interfaceRegistry)
+
+	ibcKeeper := keeper.NewKeeperWithLightClients(
+		cdc, app.GetKey(host.StoreKey), app.GetSubspace(host.ModuleName), app.StakingKeeper, app.UpgradeKeeper,
+		app.ScopedIBCKeeper, lightClients, exported.Tendermint, nil,
+	)
+	ibcKeeper.ClientKeeper.SetParams(ctx, clienttypes.NewParams(append(clienttypes.DefaultAllowedClients, mockClientType)...))
+
+	// CreateClient
+	chainBTendermint := suite.chainB.TestChainClient.(*ibctesting.TestChainTendermint)
+	height := chainBTendermint.LastHeader.GetHeight().(clienttypes.Height)
+	clientState := &mockClientState{*ibctmtypes.NewClientState(
+		suite.chainB.ChainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod,
+		ibctesting.MaxClockDrift, height, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath, false, false,
+	)}
+
+	consensusState := &mockConsensusState{*ibctmtypes.NewConsensusState(
+		chainBTendermint.LastHeader.GetTime(), commitmenttypes.NewMerkleRoot([]byte("hash")), suite.chainB.Vals.Hash(),
+	)}
+
+	clientID, err := ibcKeeper.ClientKeeper.CreateClient(ctx, clientState, consensusState)
+	suite.Require().NoError(err)
+	suite.Require().Equal(clienttypes.FormatClientIdentifier(mockClientType, 0), clientID)
+
+	storedClientState, found := ibcKeeper.ClientKeeper.GetClientState(ctx, clientID)
+	suite.Require().True(found)
+	suite.Require().Equal(mockClientType, storedClientState.ClientType())
+
+	// genesis validation
+	bz := cdc.MustMarshalJSON(ibc.ExportGenesis(ctx, *ibcKeeper))
+	suite.Require().NoError(moduleBasic.ValidateGenesis(cdc, nil, bz))
+	suite.Require().Error(ibc.AppModuleBasic{}.ValidateGenesis(cdc, nil, bz))
+
+	// the client state cannot be decoded without the interfaces of the light client
+	otherRegistry := codectypes.NewInterfaceRegistry()
+	ibc.AppModuleBasic{}.RegisterInterfaces(otherRegistry)
+	suite.Require().Error(moduleBasic.ValidateGenesis(codec.NewProtoCodec(otherRegistry), nil, bz))
+
+	// CLI
+	for _, cmd := range []*cobra.Command{moduleBasic.GetTxCmd(), moduleBasic.GetQueryCmd()} {
+		lightClientCmd, _, err := cmd.Find([]string{clienttypes.SubModuleName, "mock"})
+		suite.Require().NoError(err)
+		suite.Require().Equal("mock", lightClientCmd.Use)
+	}
+}
@@ -7,20 +7,14 @@ import (
 	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
-	ibcdmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/01-dymint/types"
-	solomachinetypes "github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine/types"
-	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
-	localhosttypes "github.com/cosmos/ibc-go/v3/modules/light-clients/09-localhost/types"
 )
 
-// RegisterInterfaces registers x/ibc interfaces into protobuf Any.
+// RegisterInterfaces registers x/ibc interfaces into protobuf Any, along with the
+// implementations of the light clients of this repository.
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	clienttypes.RegisterInterfaces(registry)
 	connectiontypes.RegisterInterfaces(registry)
 	channeltypes.RegisterInterfaces(registry)
-	ibcdmtypes.RegisterInterfaces(registry)
-	solomachinetypes.RegisterInterfaces(registry)
-	ibctmtypes.RegisterInterfaces(registry)
-	localhosttypes.RegisterInterfaces(registry)
 	commitmenttypes.RegisterInterfaces(registry)
+	NewLightClientRegistry().RegisterInterfaces(registry)
 }
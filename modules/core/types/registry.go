@@ -0,0 +1,32 @@
+package types
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	dymint "github.com/cosmos/ibc-go/v3/modules/light-clients/01-dymint"
+	solomachine "github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine"
+	tendermint "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint"
+	wasm "github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm"
+	localhost "github.com/cosmos/ibc-go/v3/modules/light-clients/09-localhost"
+)
+
+// NewLightClientRegistry returns a light client registry holding the light client modules
+// of this repository along with the given light client modules, which allows chains to
+// register light clients without modifying core IBC. A given light client module replaces
+// the light client module of this repository of the same client type, such as the 08-wasm
+// light client module holding the WasmEngine of the application.
+func NewLightClientRegistry(modules ...clienttypes.LightClientModule) *clienttypes.LightClientRegistry {
+	registry := clienttypes.NewLightClientRegistry(modules...)
+	for _, module := range []clienttypes.LightClientModule{
+		dymint.LightClientModule{},
+		solomachine.LightClientModule{},
+		tendermint.LightClientModule{},
+		wasm.LightClientModule{},
+		localhost.LightClientModule{},
+	} {
+		if !registry.HasClientType(module.ClientType()) {
+			registry.Register(module)
+		}
+	}
+
+	return registry
+}
@@ -1,6 +1,10 @@
 package dymint
 
 import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 	"github.com/cosmos/ibc-go/v3/modules/light-clients/01-dymint/types"
 )
 
@@ -8,3 +12,23 @@ import (
 func Name() string {
 	return types.SubModuleName
 }
+
+var _ clienttypes.SelfClientModule = LightClientModule{}
+
+// LightClientModule is the 01-dymint light client module registered on the 02-client keeper.
+type LightClientModule struct{}
+
+// ClientType implements the LightClientModule interface.
+func (LightClientModule) ClientType() string {
+	return exported.Dymint
+}
+
+// RegisterInterfaces implements the LightClientModule interface.
+func (LightClientModule) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	types.RegisterInterfaces(registry)
+}
+
+// SelfClient implements the SelfClientModule interface.
+func (LightClientModule) SelfClient() exported.SelfClient {
+	return types.NewSelfClient()
+}
@@ -18,7 +18,11 @@ import (
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
 
-var _ exported.ClientState = (*ClientState)(nil)
+var (
+	_ exported.ClientState                  = (*ClientState)(nil)
+	_ exported.DelayPeriodClientState       = (*ClientState)(nil)
+	_ exported.BatchVerificationClientState = (*ClientState)(nil)
+)
 
 // NewClientState creates a new ClientState instance
 func NewClientState(
@@ -172,6 +176,101 @@ func (cs ClientState) Initialize(ctx sdk.Context, _ codec.BinaryCodec, clientSto
 	return nil
 }
 
+// VerifyMembership is a generic proof verification method which verifies a proof of the existence of a value at a given CommitmentPath at the specified height.
+// The caller is expected to construct the full CommitmentPath from a CommitmentPrefix and a standardized path (as defined in ICS 24).
+// If a zero proof height is passed in, it will fail to retrieve the associated consensus state.
+func (cs ClientState) VerifyMembership(
+	ctx sdk.Context,
+	clientStore sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+	value []byte,
+) error {
+	merkleProof, consensusState, err := produceMembershipVerificationArgs(ctx, clientStore, cdc, cs, height, delayTimePeriod, delayBlockPeriod, proof, path)
+	if err != nil {
+		return err
+	}
+
+	return merkleProof.VerifyMembership(cs.ProofSpecs, consensusState.GetRoot(), path, value)
+}
+
+// VerifyNonMembership is a generic proof verification method which verifies the absence of a given CommitmentPath at a specified height.
+// The caller is expected to construct the full CommitmentPath from a CommitmentPrefix and a standardized path (as defined in ICS 24).
+// If a zero proof height is passed in, it will fail to retrieve the associated consensus state.
+func (cs ClientState) VerifyNonMembership(
+	ctx sdk.Context,
+	clientStore sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+) error {
+	merkleProof, consensusState, err := produceMembershipVerificationArgs(ctx, clientStore, cdc, cs, height, delayTimePeriod, delayBlockPeriod, proof, path)
+	if err != nil {
+		return err
+	}
+
+	return merkleProof.VerifyNonMembership(cs.ProofSpecs, consensusState.GetRoot(), path)
+}
+
+// VerifyBatchMembership verifies a batch proof of the existence of each value at the corresponding CommitmentPath
+// at a specified height. The lowest proof of the merkle proof must be an ics23 batch proof, compressed or not, and
+// all paths must share the CommitmentPrefix. If a zero proof height is passed in, it will fail to retrieve the
+// associated consensus state.
+func (cs ClientState) VerifyBatchMembership(
+	ctx sdk.Context,
+	clientStore sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	paths []exported.Path,
+	values [][]byte,
+) error {
+	if len(paths) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "batch proof must verify at least one path")
+	}
+
+	merkleProof, consensusState, err := produceMembershipVerificationArgs(ctx, clientStore, cdc, cs, height, delayTimePeriod, delayBlockPeriod, proof, paths[0])
+	if err != nil {
+		return err
+	}
+
+	return merkleProof.VerifyBatchMembership(cs.ProofSpecs, consensusState.GetRoot(), paths, values)
+}
+
+// VerifyBatchNonMembership verifies a batch proof of the absence of each CommitmentPath at a specified height.
+// The lowest proof of the merkle proof must be an ics23 batch proof, compressed or not, and all paths must share
+// the CommitmentPrefix. If a zero proof height is passed in, it will fail to retrieve the associated consensus state.
+func (cs ClientState) VerifyBatchNonMembership(
+	ctx sdk.Context,
+	clientStore sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	paths []exported.Path,
+) error {
+	if len(paths) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "batch proof must verify at least one path")
+	}
+
+	merkleProof, consensusState, err := produceMembershipVerificationArgs(ctx, clientStore, cdc, cs, height, delayTimePeriod, delayBlockPeriod, proof, paths[0])
+	if err != nil {
+		return err
+	}
+
+	return merkleProof.VerifyBatchNonMembership(cs.ProofSpecs, consensusState.GetRoot(), paths)
+}
+
 // VerifyClientState verifies a proof of the client state of the running chain
 // stored on the target machine
 func (cs ClientState) VerifyClientState(
@@ -491,6 +590,59 @@ func (cs ClientState) VerifyNextSequenceRecv(
 	return nil
 }
 
+// VerifyNextSequenceAck verifies a proof of the next sequence number to be
+// acknowledged of the specified channel at the specified port.
+func (cs ClientState) VerifyNextSequenceAck(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	nextSequenceAck uint64,
+) error {
+	merkleProof, consensusState, err := produceVerificationArgs(store, cdc, cs, height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	// check delay period has passed
+	if err := verifyDelayPeriodPassed(ctx, store, height, delayTimePeriod, delayBlockPeriod); err != nil {
+		return err
+	}
+
+	nextSequenceAckPath := commitmenttypes.NewMerklePath(host.NextSequenceAckPath(portID, channelID))
+	path, err := commitmenttypes.ApplyPrefix(prefix, nextSequenceAckPath)
+	if err != nil {
+		return err
+	}
+
+	bz := sdk.Uint64ToBigEndian(nextSequenceAck)
+
+	if err := merkleProof.VerifyMembership(cs.ProofSpecs, consensusState.GetRoot(), path, bz); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// VerifyDelayPeriodPassed implements exported.DelayPeriodClientState. It ensures that
+// at least delayTimePeriod amount of time and delayBlockPeriod number of blocks have
+// passed since the consensus state at the proof height was processed.
+func (cs ClientState) VerifyDelayPeriodPassed(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	proofHeight exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+) error {
+	return verifyDelayPeriodPassed(ctx, store, proofHeight, delayTimePeriod, delayBlockPeriod)
+}
+
 // verifyDelayPeriodPassed will ensure that at least delayTimePeriod amount of time and delayBlockPeriod number of blocks have passed
 // since consensus state was submitted before allowing verification to continue.
 func verifyDelayPeriodPassed(ctx sdk.Context, store sdk.KVStore, proofHeight exported.Height, delayTimePeriod, delayBlockPeriod uint64) error {
@@ -563,3 +715,49 @@ func produceVerificationArgs(
 
 	return merkleProof, consensusState, nil
 }
+
+// produceMembershipVerificationArgs performs the basic checks on the arguments that are
+// shared between the generic membership verification functions, checks that the delay
+// period has passed and returns the unmarshalled merkle proof and the consensus state
+// at the proof height.
+func produceMembershipVerificationArgs(
+	ctx sdk.Context,
+	clientStore sdk.KVStore,
+	cdc codec.BinaryCodec,
+	cs ClientState,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+) (merkleProof commitmenttypes.MerkleProof, consensusState *ConsensusState, err error) {
+	if cs.GetLatestHeight().LT(height) {
+		return commitmenttypes.MerkleProof{}, nil, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidHeight,
+			"client state height < proof height (%d < %d), please ensure the client has been updated", cs.GetLatestHeight(), height,
+		)
+	}
+
+	if err := verifyDelayPeriodPassed(ctx, clientStore, height, delayTimePeriod, delayBlockPeriod); err != nil {
+		return commitmenttypes.MerkleProof{}, nil, err
+	}
+
+	if _, ok := path.(commitmenttypes.MerklePath); !ok {
+		return commitmenttypes.MerkleProof{}, nil, sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "expected %T, got %T", commitmenttypes.MerklePath{}, path)
+	}
+
+	if proof == nil {
+		return commitmenttypes.MerkleProof{}, nil, sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "proof cannot be empty")
+	}
+
+	if err = cdc.Unmarshal(proof, &merkleProof); err != nil {
+		return commitmenttypes.MerkleProof{}, nil, sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "failed to unmarshal proof into commitment merkle proof")
+	}
+
+	consensusState, err = GetConsensusState(clientStore, cdc, height)
+	if err != nil {
+		return commitmenttypes.MerkleProof{}, nil, sdkerrors.Wrap(err, "please ensure the proof was constructed against a height that exists on the client")
+	}
+
+	return merkleProof, consensusState, nil
+}
@@ -980,3 +980,504 @@ func (suite *DymintTestSuite) TestVerifyNextSeqRecv() {
 		})
 	}
 }
+
+func (suite *DymintTestSuite) TestVerifyNextSeqAck() {
+	var (
+		clientState                          *types.ClientState
+		proof                                []byte
+		delayTimePeriod                      uint64
+		delayBlockPeriod                     uint64
+		proofHeight                          exported.Height
+		prefix                               commitmenttypes.MerklePrefix
+		dymintChain, dymintCounterpartyChain *ibctesting.TestChain
+		endpoint1, endpoint2                 *ibctesting.Endpoint
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"successful verification", func() {}, true,
+		},
+		{
+			name: "delay time period has passed",
+			malleate: func() {
+				delayTimePeriod = uint64(time.Second.Nanoseconds())
+			},
+			expPass: true,
+		},
+		{
+			name: "delay time period has not passed",
+			malleate: func() {
+				delayTimePeriod = uint64(time.Hour.Nanoseconds())
+			},
+			expPass: false,
+		},
+		{
+			name: "delay block period has passed",
+			malleate: func() {
+				delayBlockPeriod = 1
+			},
+			expPass: true,
+		},
+		{
+			name: "delay block period has not passed",
+			malleate: func() {
+				delayBlockPeriod = 10
+			},
+			expPass: false,
+		},
+
+		{
+			"ApplyPrefix failed", func() {
+				prefix = commitmenttypes.MerklePrefix{}
+			}, false,
+		},
+		{
+			"latest client height < height", func() {
+				proofHeight = clientState.LatestHeight.Increment()
+			}, false,
+		},
+		{
+			"proof verification failed", func() {
+				proof = invalidProof
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			// setup testing conditions
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetChannelOrdered()
+			suite.coordinator.Setup(path)
+
+			if suite.chainB.TestChainClient.GetSelfClientType() == exported.Dymint {
+				dymintCounterpartyChain = suite.chainA
+				dymintChain = suite.chainB
+				endpoint1 = path.EndpointA
+				endpoint2 = path.EndpointB
+			} else {
+				dymintCounterpartyChain = suite.chainB
+				dymintChain = suite.chainA
+				endpoint1 = path.EndpointB
+				endpoint2 = path.EndpointA
+			}
+
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, 1, endpoint2.ChannelConfig.PortID, endpoint2.ChannelID, endpoint1.ChannelConfig.PortID, endpoint1.ChannelID, clienttypes.NewHeight(0, 100), 0)
+
+			// send packet
+			err := endpoint2.SendPacket(packet)
+			suite.Require().NoError(err)
+
+			// next seq ack incremented
+			err = path.RelayPacket(packet)
+			suite.Require().NoError(err)
+
+			err = endpoint1.UpdateClient()
+			suite.Require().NoError(err)
+
+			var ok bool
+			clientStateI := dymintCounterpartyChain.GetClientState(endpoint1.ClientID)
+			clientState, ok = clientStateI.(*types.ClientState)
+			suite.Require().True(ok)
+
+			prefix = dymintChain.GetPrefix()
+
+			// make next seq ack proof
+			nextSeqAckKey := host.NextSequenceAckKey(packet.GetSourcePort(), packet.GetSourceChannel())
+			proof, proofHeight = dymintChain.QueryProof(nextSeqAckKey)
+
+			// reset time and block delays to 0, malleate may change to a specific non-zero value.
+			delayTimePeriod = 0
+			delayBlockPeriod = 0
+			tc.malleate() // make changes as necessary
+
+			ctx := dymintCounterpartyChain.GetContext()
+			store := dymintCounterpartyChain.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, endpoint1.ClientID)
+
+			err = clientState.VerifyNextSequenceAck(
+				ctx, store, dymintCounterpartyChain.Codec, proofHeight, delayTimePeriod, delayBlockPeriod, &prefix, proof,
+				packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()+1,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *DymintTestSuite) TestVerifyMembership() {
+	var (
+		clientState                          *types.ClientState
+		proof                                []byte
+		delayTimePeriod                      uint64
+		delayBlockPeriod                     uint64
+		proofHeight                          exported.Height
+		merklePath                           exported.Path
+		dymintChain, dymintCounterpartyChain *ibctesting.TestChain
+		endpoint1, endpoint2                 *ibctesting.Endpoint
+		value                                []byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"successful verification", func() {}, true,
+		},
+		{
+			"delay time period has passed", func() {
+				delayTimePeriod = uint64(time.Second.Nanoseconds())
+			}, true,
+		},
+		{
+			"delay time period has not passed", func() {
+				delayTimePeriod = uint64(time.Hour.Nanoseconds())
+			}, false,
+		},
+		{
+			"delay block period has passed", func() {
+				delayBlockPeriod = 1
+			}, true,
+		},
+		{
+			"delay block period has not passed", func() {
+				delayBlockPeriod = 10
+			}, false,
+		},
+		{
+			"latest client height < height", func() {
+				proofHeight = clientState.LatestHeight.Increment()
+			}, false,
+		},
+		{
+			"consensus state not found", func() {
+				proofHeight = clienttypes.ZeroHeight()
+			}, false,
+		},
+		{
+			"invalid path type", func() {
+				merklePath = ibcmock.KeyPath{}
+			}, false,
+		},
+		{
+			"empty proof", func() {
+				proof = nil
+			}, false,
+		},
+		{
+			"proof verification failed", func() {
+				proof = invalidProof
+			}, false,
+		},
+		{
+			"value does not match", func() {
+				value = []byte("invalid value")
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			if suite.chainB.TestChainClient.GetSelfClientType() == exported.Dymint {
+				dymintCounterpartyChain = suite.chainA
+				dymintChain = suite.chainB
+				endpoint1 = path.EndpointA
+				endpoint2 = path.EndpointB
+			} else {
+				dymintCounterpartyChain = suite.chainB
+				dymintChain = suite.chainA
+				endpoint1 = path.EndpointB
+				endpoint2 = path.EndpointA
+			}
+
+			var ok bool
+			clientStateI := dymintCounterpartyChain.GetClientState(endpoint1.ClientID)
+			clientState, ok = clientStateI.(*types.ClientState)
+			suite.Require().True(ok)
+
+			// prove the connection end stored on the counterparty
+			key := host.ConnectionKey(endpoint2.ConnectionID)
+			proof, proofHeight = endpoint2.QueryProof(key)
+
+			var err error
+			merklePath, err = commitmenttypes.ApplyPrefix(dymintChain.GetPrefix(), commitmenttypes.NewMerklePath(host.ConnectionPath(endpoint2.ConnectionID)))
+			suite.Require().NoError(err)
+
+			connection := endpoint2.GetConnection()
+			value, err = dymintChain.Codec.Marshal(&connection)
+			suite.Require().NoError(err)
+
+			// reset time and block delays to 0, malleate may change to a specific non-zero value.
+			delayTimePeriod = 0
+			delayBlockPeriod = 0
+			tc.malleate() // make changes as necessary
+
+			ctx := dymintCounterpartyChain.GetContext()
+			store := dymintCounterpartyChain.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, endpoint1.ClientID)
+
+			err = clientState.VerifyMembership(
+				ctx, store, dymintCounterpartyChain.Codec, proofHeight, delayTimePeriod, delayBlockPeriod,
+				proof, merklePath, value,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *DymintTestSuite) TestVerifyNonMembership() {
+	var (
+		clientState                          *types.ClientState
+		proof                                []byte
+		delayTimePeriod                      uint64
+		delayBlockPeriod                     uint64
+		proofHeight                          exported.Height
+		merklePath                           exported.Path
+		dymintChain, dymintCounterpartyChain *ibctesting.TestChain
+		endpoint1, endpoint2                 *ibctesting.Endpoint
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"successful verification", func() {}, true,
+		},
+		{
+			"delay time period has not passed", func() {
+				delayTimePeriod = uint64(time.Hour.Nanoseconds())
+			}, false,
+		},
+		{
+			"delay block period has not passed", func() {
+				delayBlockPeriod = 10
+			}, false,
+		},
+		{
+			"latest client height < height", func() {
+				proofHeight = clientState.LatestHeight.Increment()
+			}, false,
+		},
+		{
+			"invalid path type", func() {
+				merklePath = ibcmock.KeyPath{}
+			}, false,
+		},
+		{
+			"proof verification failed", func() {
+				proof = invalidProof
+			}, false,
+		},
+		{
+			"key exists", func() {
+				// the proof of absence of the receipt key is not a proof of absence of an existing key
+				var err error
+				merklePath, err = commitmenttypes.ApplyPrefix(dymintChain.GetPrefix(), commitmenttypes.NewMerklePath(host.FullClientStatePath(ibctesting.FirstClientID)))
+				suite.Require().NoError(err)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			if suite.chainB.TestChainClient.GetSelfClientType() == exported.Dymint {
+				dymintCounterpartyChain = suite.chainA
+				dymintChain = suite.chainB
+				endpoint1 = path.EndpointA
+				endpoint2 = path.EndpointB
+			} else {
+				dymintCounterpartyChain = suite.chainB
+				dymintChain = suite.chainA
+				endpoint1 = path.EndpointB
+				endpoint2 = path.EndpointA
+			}
+
+			var ok bool
+			clientStateI := dymintCounterpartyChain.GetClientState(endpoint1.ClientID)
+			clientState, ok = clientStateI.(*types.ClientState)
+			suite.Require().True(ok)
+
+			// prove the absence of a packet receipt which was never written on the dymint chain
+			receiptPath := host.PacketReceiptPath(endpoint2.ChannelConfig.PortID, endpoint2.ChannelID, 1)
+			proof, proofHeight = endpoint2.QueryProof([]byte(receiptPath))
+
+			var err error
+			merklePath, err = commitmenttypes.ApplyPrefix(dymintChain.GetPrefix(), commitmenttypes.NewMerklePath(receiptPath))
+			suite.Require().NoError(err)
+
+			// reset time and block delays to 0, malleate may change to a specific non-zero value.
+			delayTimePeriod = 0
+			delayBlockPeriod = 0
+			tc.malleate() // make changes as necessary
+
+			ctx := dymintCounterpartyChain.GetContext()
+			store := dymintCounterpartyChain.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, endpoint1.ClientID)
+
+			err = clientState.VerifyNonMembership(
+				ctx, store, dymintCounterpartyChain.Codec, proofHeight, delayTimePeriod, delayBlockPeriod,
+				proof, merklePath,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *DymintTestSuite) TestVerifyBatchMembership() {
+	var (
+		clientState                          *types.ClientState
+		proof                                []byte
+		delayTimePeriod                      uint64
+		delayBlockPeriod                     uint64
+		proofHeight                          exported.Height
+		paths                                []exported.Path
+		dymintChain, dymintCounterpartyChain *ibctesting.TestChain
+		endpoint1, endpoint2                 *ibctesting.Endpoint
+		values                               [][]byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"successful verification", func() {}, true,
+		},
+		{
+			"delay time period has not passed", func() {
+				delayTimePeriod = uint64(time.Hour.Nanoseconds())
+			}, false,
+		},
+		{
+			"latest client height < height", func() {
+				proofHeight = clientState.LatestHeight.Increment()
+			}, false,
+		},
+		{
+			"no paths", func() {
+				paths = nil
+				values = nil
+			}, false,
+		},
+		{
+			"invalid path type", func() {
+				paths[0] = ibcmock.KeyPath{}
+			}, false,
+		},
+		{
+			"proof is not a batch proof", func() {
+				proof, _ = endpoint2.QueryProof(host.ConnectionKey(endpoint2.ConnectionID))
+			}, false,
+		},
+		{
+			"value does not match", func() {
+				values[1] = []byte("invalid value")
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			if suite.chainB.TestChainClient.GetSelfClientType() == exported.Dymint {
+				dymintCounterpartyChain = suite.chainA
+				dymintChain = suite.chainB
+				endpoint1 = path.EndpointA
+				endpoint2 = path.EndpointB
+			} else {
+				dymintCounterpartyChain = suite.chainB
+				dymintChain = suite.chainA
+				endpoint1 = path.EndpointB
+				endpoint2 = path.EndpointA
+			}
+
+			var ok bool
+			clientStateI := dymintCounterpartyChain.GetClientState(endpoint1.ClientID)
+			clientState, ok = clientStateI.(*types.ClientState)
+			suite.Require().True(ok)
+
+			// prove the connection and channel ends stored on the counterparty with a single proof
+			proof, proofHeight = endpoint2.QueryProofs(
+				host.ConnectionKey(endpoint2.ConnectionID),
+				host.ChannelKey(endpoint2.ChannelConfig.PortID, endpoint2.ChannelID),
+			)
+
+			connectionPath, err := commitmenttypes.ApplyPrefix(dymintChain.GetPrefix(), commitmenttypes.NewMerklePath(host.ConnectionPath(endpoint2.ConnectionID)))
+			suite.Require().NoError(err)
+			channelPath, err := commitmenttypes.ApplyPrefix(dymintChain.GetPrefix(), commitmenttypes.NewMerklePath(host.ChannelPath(endpoint2.ChannelConfig.PortID, endpoint2.ChannelID)))
+			suite.Require().NoError(err)
+			paths = []exported.Path{connectionPath, channelPath}
+
+			connection := endpoint2.GetConnection()
+			connectionBz, err := dymintChain.Codec.Marshal(&connection)
+			suite.Require().NoError(err)
+			channel := endpoint2.GetChannel()
+			channelBz, err := dymintChain.Codec.Marshal(&channel)
+			suite.Require().NoError(err)
+			values = [][]byte{connectionBz, channelBz}
+
+			// reset time and block delays to 0, malleate may change to a specific non-zero value.
+			delayTimePeriod = 0
+			delayBlockPeriod = 0
+			tc.malleate() // make changes as necessary
+
+			ctx := dymintCounterpartyChain.GetContext()
+			store := dymintCounterpartyChain.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, endpoint1.ClientID)
+
+			err = clientState.VerifyBatchMembership(
+				ctx, store, dymintCounterpartyChain.Codec, proofHeight, delayTimePeriod, delayBlockPeriod,
+				proof, paths, values,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
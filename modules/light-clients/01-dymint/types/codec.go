@@ -24,5 +24,6 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*exported.Misbehaviour)(nil),
 		&Misbehaviour{},
+		&LightClientAttackMisbehaviour{},
 	)
 }
@@ -178,6 +178,51 @@ func (m *Misbehaviour) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Misbehaviour proto.InternalMessageInfo
 
+// LightClientAttackMisbehaviour is a wrapper over a conflicting Header and a
+// trace of trusted Headers that implements Misbehaviour interface expected by
+// ICS-02. The ConflictingHeader and the TrustedTrace both start from the trusted
+// ConsensusState at the CommonHeight and the TrustedTrace links that
+// ConsensusState to the height of the ConflictingHeader.
+type LightClientAttackMisbehaviour struct {
+	ClientId          string       `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty" yaml:"client_id"`
+	ConflictingHeader *Header      `protobuf:"bytes,2,opt,name=conflicting_header,json=conflictingHeader,proto3" json:"conflicting_header,omitempty" yaml:"conflicting_header"`
+	CommonHeight      types.Height `protobuf:"bytes,3,opt,name=common_height,json=commonHeight,proto3" json:"common_height" yaml:"common_height"`
+	TrustedTrace      []*Header    `protobuf:"bytes,4,rep,name=trusted_trace,json=trustedTrace,proto3" json:"trusted_trace,omitempty" yaml:"trusted_trace"`
+}
+
+func (m *LightClientAttackMisbehaviour) Reset()         { *m = LightClientAttackMisbehaviour{} }
+func (m *LightClientAttackMisbehaviour) String() string { return proto.CompactTextString(m) }
+func (*LightClientAttackMisbehaviour) ProtoMessage()    {}
+func (*LightClientAttackMisbehaviour) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cef6cb256dd4d990, []int{3}
+}
+func (m *LightClientAttackMisbehaviour) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LightClientAttackMisbehaviour) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LightClientAttackMisbehaviour.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LightClientAttackMisbehaviour) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightClientAttackMisbehaviour.Merge(m, src)
+}
+func (m *LightClientAttackMisbehaviour) XXX_Size() int {
+	return m.Size()
+}
+func (m *LightClientAttackMisbehaviour) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightClientAttackMisbehaviour.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LightClientAttackMisbehaviour proto.InternalMessageInfo
+
 // Header defines the Dymint client consensus Header.
 // It encapsulates all the information necessary to update from a trusted
 // Dymint ConsensusState. The inclusion of TrustedHeight and
@@ -201,7 +246,7 @@ func (m *Header) Reset()         { *m = Header{} }
 func (m *Header) String() string { return proto.CompactTextString(m) }
 func (*Header) ProtoMessage()    {}
 func (*Header) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cef6cb256dd4d990, []int{3}
+	return fileDescriptor_cef6cb256dd4d990, []int{4}
 }
 func (m *Header) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -262,7 +307,7 @@ func (m *Fraction) Reset()         { *m = Fraction{} }
 func (m *Fraction) String() string { return proto.CompactTextString(m) }
 func (*Fraction) ProtoMessage()    {}
 func (*Fraction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cef6cb256dd4d990, []int{4}
+	return fileDescriptor_cef6cb256dd4d990, []int{5}
 }
 func (m *Fraction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -309,6 +354,7 @@ func init() {
 	proto.RegisterType((*ClientState)(nil), "ibc.lightclients.dymint.ClientState")
 	proto.RegisterType((*ConsensusState)(nil), "ibc.lightclients.dymint.ConsensusState")
 	proto.RegisterType((*Misbehaviour)(nil), "ibc.lightclients.dymint.Misbehaviour")
+	proto.RegisterType((*LightClientAttackMisbehaviour)(nil), "ibc.lightclients.dymint.LightClientAttackMisbehaviour")
 	proto.RegisterType((*Header)(nil), "ibc.lightclients.dymint.Header")
 	proto.RegisterType((*Fraction)(nil), "ibc.lightclients.dymint.Fraction")
 }
@@ -318,70 +364,75 @@ func init() {
 }
 
 var fileDescriptor_cef6cb256dd4d990 = []byte{
-	// 1002 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0x8f, 0x9b, 0xd0, 0x26, 0x93, 0x74, 0x5b, 0x4c, 0xd9, 0xa6, 0xa5, 0x1b, 0x07, 0x83, 0x44,
-	0x2f, 0xb5, 0x49, 0xca, 0xa9, 0xe2, 0x82, 0xbb, 0x42, 0x2d, 0x62, 0xa5, 0xca, 0x45, 0x20, 0x2d,
-	0x02, 0xe3, 0x3f, 0x13, 0x7b, 0xb4, 0xb6, 0xc7, 0xf2, 0x8c, 0xa3, 0x96, 0x4f, 0x00, 0x07, 0xa4,
-	0x3d, 0x22, 0x4e, 0x1c, 0xf8, 0x30, 0x7b, 0xec, 0x91, 0x93, 0x41, 0xed, 0x37, 0xc8, 0x91, 0x0b,
-	0x2b, 0xcf, 0x8c, 0xff, 0xa4, 0xbb, 0x55, 0xd5, 0x4b, 0x32, 0xef, 0xbd, 0xdf, 0xfb, 0xfd, 0x3c,
-	0x6f, 0xe6, 0x3d, 0x1b, 0x7c, 0x8c, 0x1c, 0x57, 0x0f, 0x91, 0x1f, 0x50, 0x37, 0x44, 0x30, 0xa6,
-	0x44, 0xf7, 0x2e, 0x23, 0x14, 0x53, 0xf1, 0xa7, 0x25, 0x29, 0xa6, 0x58, 0xde, 0x46, 0x8e, 0xab,
-	0x35, 0x51, 0x1a, 0x0f, 0xef, 0x8e, 0x29, 0x8c, 0x3d, 0x98, 0xb2, 0x0c, 0x7a, 0x99, 0x40, 0xa2,
-	0xcf, 0xed, 0x10, 0x79, 0x36, 0xc5, 0x29, 0x4f, 0xdd, 0xdd, 0x7b, 0x03, 0xc1, 0x7e, 0x45, 0x74,
-	0x90, 0xa4, 0x18, 0xcf, 0x4a, 0x6b, 0xe4, 0x63, 0xec, 0x87, 0x50, 0x67, 0x96, 0x93, 0xcd, 0x74,
-	0x2f, 0x4b, 0x6d, 0x8a, 0x70, 0x2c, 0xe2, 0xca, 0xed, 0x38, 0x45, 0x11, 0x24, 0xd4, 0x8e, 0x92,
-	0x12, 0x50, 0xec, 0xc6, 0xc5, 0x29, 0xd4, 0xf9, 0x73, 0xea, 0xf3, 0x89, 0x58, 0x09, 0xc0, 0x27,
-	0x35, 0x00, 0x47, 0x11, 0xa2, 0x51, 0x09, 0xaa, 0x2c, 0x01, 0xdc, 0xf2, 0xb1, 0x8f, 0xd9, 0x52,
-	0x2f, 0x56, 0xdc, 0xab, 0xfe, 0xb6, 0x0a, 0xfa, 0xc7, 0x8c, 0xef, 0x9c, 0xda, 0x14, 0xca, 0x3b,
-	0xa0, 0xeb, 0x06, 0x36, 0x8a, 0x2d, 0xe4, 0x0d, 0xa5, 0xb1, 0xb4, 0xdf, 0x33, 0xd7, 0x98, 0x7d,
-	0xea, 0xc9, 0x3f, 0x82, 0x3e, 0x4d, 0x33, 0x42, 0xad, 0x10, 0xce, 0x61, 0x38, 0x5c, 0x19, 0x4b,
-	0xfb, 0xfd, 0xe9, 0x87, 0xda, 0x1d, 0x85, 0xd4, 0xbe, 0x4c, 0x6d, 0xb7, 0xd8, 0xa9, 0xb1, 0xfb,
-	0x2a, 0x57, 0x5a, 0x8b, 0x5c, 0x91, 0x2f, 0xed, 0x28, 0x3c, 0x52, 0x1b, 0x1c, 0xaa, 0x09, 0x98,
-	0xf5, 0x75, 0x61, 0xc8, 0x33, 0xb0, 0xc1, 0x2c, 0x14, 0xfb, 0x56, 0x02, 0x53, 0x84, 0xbd, 0x61,
-	0x9b, 0x69, 0xec, 0x68, 0xbc, 0x4a, 0x5a, 0x59, 0x25, 0xed, 0xa9, 0xa8, 0xa2, 0xa1, 0x0a, 0xee,
-	0xc7, 0x0d, 0xee, 0x3a, 0x5f, 0xfd, 0xfd, 0x1f, 0x45, 0x32, 0x1f, 0x95, 0xde, 0x33, 0xe6, 0x94,
-	0x11, 0xd8, 0xcc, 0x62, 0x07, 0xc7, 0x5e, 0x43, 0xa8, 0x73, 0x9f, 0xd0, 0x47, 0x42, 0x68, 0x9b,
-	0x0b, 0xdd, 0x26, 0xe0, 0x4a, 0x1b, 0x95, 0x5b, 0x48, 0x41, 0xb0, 0x11, 0xd9, 0x17, 0x96, 0x1b,
-	0x62, 0xf7, 0x85, 0xe5, 0xa5, 0x68, 0x46, 0x87, 0xef, 0x3c, 0x70, 0x4b, 0xb7, 0xf2, 0xb9, 0xd0,
-	0x7a, 0x64, 0x5f, 0x1c, 0x17, 0xce, 0xa7, 0x85, 0x4f, 0xfe, 0x01, 0xac, 0xcf, 0x52, 0xfc, 0x33,
-	0x8c, 0xad, 0x00, 0x16, 0x27, 0x31, 0x5c, 0x65, 0x22, 0xbb, 0xec, 0x6c, 0x8a, 0xbb, 0xa1, 0x89,
-	0x2b, 0x33, 0x9f, 0x68, 0x27, 0x0c, 0x61, 0xec, 0x09, 0x95, 0x2d, 0xae, 0xb2, 0x94, 0xae, 0x9a,
-	0x03, 0x6e, 0x73, 0x6c, 0x41, 0x1f, 0xda, 0x14, 0x12, 0x5a, 0xd2, 0xaf, 0x3d, 0x94, 0x7e, 0x29,
-	0x5d, 0x35, 0x07, 0xdc, 0x16, 0xf4, 0xa7, 0xa0, 0xcf, 0x7a, 0xc6, 0x22, 0x09, 0x74, 0xc9, 0xb0,
-	0x3b, 0x6e, 0xef, 0xf7, 0xa7, 0x9b, 0x1a, 0x72, 0xc9, 0xf4, 0x50, 0x3b, 0x2b, 0x22, 0xe7, 0x09,
-	0x74, 0x8d, 0xc7, 0xf5, 0x15, 0x6a, 0xc0, 0x55, 0x13, 0x24, 0x25, 0x84, 0xc8, 0x47, 0x60, 0x90,
-	0x25, 0x7e, 0x6a, 0x7b, 0xd0, 0x4a, 0x6c, 0x1a, 0x0c, 0x7b, 0xe3, 0xf6, 0x7e, 0xcf, 0xd8, 0x5e,
-	0xe4, 0xca, 0x7b, 0xe2, 0xdc, 0x1a, 0x51, 0xd5, 0xec, 0x0b, 0xf3, 0xcc, 0xa6, 0xc1, 0x51, 0xe7,
-	0x97, 0x3f, 0x95, 0x96, 0xfa, 0xd7, 0x0a, 0x78, 0x74, 0x8c, 0x63, 0x02, 0x63, 0x92, 0x11, 0xde,
-	0x12, 0x06, 0xe8, 0x55, 0x5d, 0xc9, 0x7a, 0xa2, 0xd8, 0xfa, 0xed, 0xe3, 0xfb, 0xa6, 0x44, 0x18,
-	0xdd, 0x62, 0xeb, 0x2f, 0x8b, 0x53, 0xaa, 0xd3, 0xe4, 0xcf, 0x41, 0x27, 0xc5, 0x98, 0x8a, 0xa6,
-	0x51, 0x1b, 0x95, 0xab, 0xdb, 0x74, 0x3e, 0xd1, 0x9e, 0xc1, 0xf4, 0x45, 0x08, 0x4d, 0x8c, 0xa9,
-	0xd1, 0x29, 0x68, 0x4c, 0x96, 0x25, 0xff, 0x2a, 0x81, 0xad, 0x18, 0x5e, 0x50, 0xab, 0x1a, 0x45,
-	0xc4, 0x0a, 0x6c, 0x12, 0xb0, 0xfe, 0x18, 0x18, 0xdf, 0x2d, 0x72, 0xe5, 0x03, 0xbe, 0xbf, 0xb7,
-	0xa1, 0xd4, 0xff, 0x72, 0xe5, 0x33, 0x1f, 0xd1, 0x20, 0x73, 0x0a, 0x39, 0xbd, 0x39, 0xbe, 0xea,
-	0x65, 0x88, 0x1c, 0xa2, 0x3b, 0x97, 0x14, 0x12, 0xed, 0x04, 0x5e, 0x18, 0xc5, 0xc2, 0x94, 0x0b,
-	0xba, 0x6f, 0x2b, 0xb6, 0x13, 0x9b, 0x94, 0x65, 0xfa, 0x5f, 0x02, 0x83, 0x67, 0x88, 0x38, 0x30,
-	0xb0, 0xe7, 0x08, 0x67, 0xa9, 0x3c, 0x01, 0x3d, 0x7e, 0x09, 0xaa, 0xc1, 0x61, 0x6c, 0x2d, 0x72,
-	0x65, 0x93, 0x3f, 0x56, 0x15, 0x52, 0xcd, 0x2e, 0x5f, 0x9f, 0x7a, 0xf2, 0x73, 0xd0, 0x0d, 0xa0,
-	0xed, 0xc1, 0xd4, 0x9a, 0x88, 0xba, 0x28, 0x77, 0x0e, 0x93, 0x13, 0x06, 0x34, 0x46, 0xd7, 0xb9,
-	0xb2, 0xc6, 0xd7, 0x93, 0x45, 0xae, 0x6c, 0x70, 0xf6, 0x92, 0x45, 0x35, 0xd7, 0xf8, 0x72, 0xd2,
-	0xe0, 0x9e, 0x8a, 0x21, 0xf2, 0x10, 0xee, 0xe9, 0x1b, 0xdc, 0xd3, 0x8a, 0x7b, 0x2a, 0x2a, 0xf0,
-	0x47, 0x1b, 0xac, 0x72, 0xb4, 0x6c, 0x83, 0x75, 0x82, 0xfc, 0x18, 0x7a, 0x16, 0x87, 0x88, 0x4b,
-	0x32, 0xd2, 0xea, 0xf2, 0x6a, 0xfc, 0x15, 0x71, 0xce, 0x60, 0x42, 0x70, 0xef, 0x2a, 0x57, 0xa4,
-	0xba, 0x47, 0x96, 0x28, 0x54, 0x73, 0x40, 0x1a, 0xd8, 0xa2, 0x05, 0xab, 0x53, 0xb5, 0x08, 0x2c,
-	0x2f, 0xd2, 0x5b, 0x24, 0xaa, 0xe3, 0x3a, 0x87, 0xd4, 0x18, 0xd6, 0xf4, 0x4b, 0xe9, 0xaa, 0x39,
-	0x98, 0x37, 0x70, 0xf2, 0x4f, 0x80, 0x0f, 0x49, 0xa6, 0xcf, 0x5a, 0xbc, 0x7d, 0x6f, 0x8b, 0x3f,
-	0x11, 0x2d, 0xfe, 0x7e, 0x63, 0xf4, 0x56, 0xf9, 0xaa, 0xb9, 0x2e, 0x1c, 0xa2, 0xc9, 0x43, 0x20,
-	0x97, 0x88, 0xfa, 0x7a, 0x8a, 0xb1, 0x7b, 0xdf, 0x2e, 0x9e, 0x2c, 0x72, 0x65, 0x67, 0x59, 0xa5,
-	0xe6, 0x50, 0xcd, 0x77, 0x85, 0xb3, 0xbe, 0xa8, 0xea, 0x57, 0xa0, 0x5b, 0xbe, 0x7e, 0xe4, 0x3d,
-	0xd0, 0x8b, 0xb3, 0x08, 0xa6, 0x45, 0x84, 0x9d, 0x4c, 0xc7, 0xac, 0x1d, 0xf2, 0x18, 0xf4, 0x3d,
-	0x18, 0xe3, 0x08, 0xc5, 0x2c, 0xbe, 0xc2, 0xe2, 0x4d, 0x97, 0xf1, 0xfd, 0xab, 0xeb, 0x91, 0x74,
-	0x75, 0x3d, 0x92, 0xfe, 0xbd, 0x1e, 0x49, 0x2f, 0x6f, 0x46, 0xad, 0xab, 0x9b, 0x51, 0xeb, 0xef,
-	0x9b, 0x51, 0xeb, 0xf9, 0x17, 0x8d, 0xa6, 0x72, 0x31, 0x89, 0x30, 0xd1, 0x91, 0xe3, 0x1e, 0xf8,
-	0x58, 0x9f, 0x1f, 0xea, 0x11, 0xf6, 0xb2, 0x10, 0x12, 0xfe, 0x25, 0x72, 0x50, 0x7e, 0x8a, 0x7c,
-	0x3a, 0x39, 0x10, 0x5f, 0x23, 0x6c, 0x9f, 0xce, 0x2a, 0x1b, 0x20, 0x87, 0xaf, 0x03, 0x00, 0x00,
-	0xff, 0xff, 0xe7, 0x69, 0x57, 0xd0, 0xb5, 0x08, 0x00, 0x00,
+	// 1082 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0xaf, 0x37, 0xa1, 0x4d, 0x26, 0xe9, 0x9f, 0x35, 0x65, 0xeb, 0x96, 0x36, 0x0e, 0x06, 0x89,
+	0x5e, 0x6a, 0x93, 0x94, 0x53, 0xc5, 0x65, 0xdd, 0x15, 0x6a, 0xd1, 0xae, 0x54, 0xb9, 0xab, 0x45,
+	0x5a, 0xc4, 0x9a, 0x89, 0x3d, 0x49, 0x46, 0xb5, 0x3d, 0x91, 0x67, 0x12, 0xb5, 0x7c, 0x00, 0x04,
+	0x07, 0xa4, 0x3d, 0x22, 0x4e, 0x1c, 0xf8, 0x30, 0x7b, 0xec, 0x91, 0x53, 0x40, 0xed, 0x37, 0xc8,
+	0x91, 0x0b, 0x68, 0xfe, 0xf8, 0x4f, 0xda, 0x5d, 0xd1, 0x8a, 0x4b, 0x3b, 0xef, 0xbd, 0xdf, 0xfb,
+	0x3d, 0xbf, 0xf7, 0xe6, 0xbd, 0x0c, 0xf8, 0x04, 0xf7, 0x02, 0x27, 0xc2, 0x83, 0x21, 0x0b, 0x22,
+	0x8c, 0x12, 0x46, 0x9d, 0xf0, 0x22, 0xc6, 0x09, 0x53, 0xff, 0xec, 0x51, 0x4a, 0x18, 0xd1, 0x37,
+	0x70, 0x2f, 0xb0, 0xcb, 0x28, 0x5b, 0x9a, 0xb7, 0xda, 0x0c, 0x25, 0x21, 0x4a, 0x85, 0x07, 0xbb,
+	0x18, 0x21, 0xea, 0x4c, 0x60, 0x84, 0x43, 0xc8, 0x48, 0x2a, 0x5d, 0xb7, 0xb6, 0x6f, 0x21, 0xc4,
+	0x5f, 0x65, 0x6d, 0x8e, 0x52, 0x42, 0xfa, 0x99, 0xd4, 0x1a, 0x10, 0x32, 0x88, 0x90, 0x23, 0xa4,
+	0xde, 0xb8, 0xef, 0x84, 0xe3, 0x14, 0x32, 0x4c, 0x12, 0x65, 0x37, 0x6f, 0xda, 0x19, 0x8e, 0x11,
+	0x65, 0x30, 0x1e, 0x65, 0x00, 0x9e, 0x4d, 0x40, 0x52, 0xe4, 0xc8, 0xef, 0x74, 0x26, 0x1d, 0x75,
+	0x52, 0x80, 0x4f, 0x0b, 0x00, 0x89, 0x63, 0xcc, 0xe2, 0x0c, 0x94, 0x4b, 0x0a, 0xb8, 0x3e, 0x20,
+	0x03, 0x22, 0x8e, 0x0e, 0x3f, 0x49, 0xad, 0xf5, 0xf3, 0x22, 0x68, 0x1c, 0x0a, 0xbe, 0x53, 0x06,
+	0x19, 0xd2, 0x37, 0x41, 0x2d, 0x18, 0x42, 0x9c, 0xf8, 0x38, 0x34, 0xb4, 0xb6, 0xb6, 0x5b, 0xf7,
+	0x96, 0x84, 0x7c, 0x1c, 0xea, 0xaf, 0x40, 0x83, 0xa5, 0x63, 0xca, 0xfc, 0x08, 0x4d, 0x50, 0x64,
+	0x3c, 0x68, 0x6b, 0xbb, 0x8d, 0xee, 0x47, 0xf6, 0x3b, 0x0a, 0x69, 0x7f, 0x99, 0xc2, 0x80, 0x67,
+	0xea, 0x6e, 0xbd, 0x99, 0x9a, 0x0b, 0xb3, 0xa9, 0xa9, 0x5f, 0xc0, 0x38, 0x3a, 0xb0, 0x4a, 0x1c,
+	0x96, 0x07, 0x84, 0xf4, 0x94, 0x0b, 0x7a, 0x1f, 0xac, 0x0a, 0x09, 0x27, 0x03, 0x7f, 0x84, 0x52,
+	0x4c, 0x42, 0xa3, 0x22, 0x62, 0x6c, 0xda, 0xb2, 0x4a, 0x76, 0x56, 0x25, 0xfb, 0x89, 0xaa, 0xa2,
+	0x6b, 0x29, 0xee, 0x47, 0x25, 0xee, 0xc2, 0xdf, 0xfa, 0xe5, 0x4f, 0x53, 0xf3, 0x56, 0x32, 0xed,
+	0x89, 0x50, 0xea, 0x18, 0xac, 0x8d, 0x93, 0x1e, 0x49, 0xc2, 0x52, 0xa0, 0xea, 0x7f, 0x05, 0xfa,
+	0x58, 0x05, 0xda, 0x90, 0x81, 0x6e, 0x12, 0xc8, 0x48, 0xab, 0xb9, 0x5a, 0x85, 0x42, 0x60, 0x35,
+	0x86, 0xe7, 0x7e, 0x10, 0x91, 0xe0, 0xcc, 0x0f, 0x53, 0xdc, 0x67, 0xc6, 0x7b, 0xf7, 0x4c, 0xe9,
+	0x86, 0xbf, 0x0c, 0xb4, 0x1c, 0xc3, 0xf3, 0x43, 0xae, 0x7c, 0xc2, 0x75, 0xfa, 0xb7, 0x60, 0xb9,
+	0x9f, 0x92, 0xef, 0x51, 0xe2, 0x0f, 0x11, 0xef, 0x84, 0xb1, 0x28, 0x82, 0x6c, 0x89, 0xde, 0xf0,
+	0xbb, 0x61, 0xab, 0x2b, 0x33, 0xe9, 0xd8, 0x47, 0x02, 0xe1, 0x6e, 0xab, 0x28, 0xeb, 0x32, 0xca,
+	0x9c, 0xbb, 0xe5, 0x35, 0xa5, 0x2c, 0xb1, 0x9c, 0x3e, 0x82, 0x0c, 0x51, 0x96, 0xd1, 0x2f, 0xdd,
+	0x97, 0x7e, 0xce, 0xdd, 0xf2, 0x9a, 0x52, 0x56, 0xf4, 0xc7, 0xa0, 0x21, 0x66, 0xc6, 0xa7, 0x23,
+	0x14, 0x50, 0xa3, 0xd6, 0xae, 0xec, 0x36, 0xba, 0x6b, 0x36, 0x0e, 0x68, 0x77, 0xdf, 0x3e, 0xe1,
+	0x96, 0xd3, 0x11, 0x0a, 0xdc, 0x47, 0xc5, 0x15, 0x2a, 0xc1, 0x2d, 0x0f, 0x8c, 0x32, 0x08, 0xd5,
+	0x0f, 0x40, 0x73, 0x3c, 0x1a, 0xa4, 0x30, 0x44, 0xfe, 0x08, 0xb2, 0xa1, 0x51, 0x6f, 0x57, 0x76,
+	0xeb, 0xee, 0xc6, 0x6c, 0x6a, 0xbe, 0xaf, 0xfa, 0x56, 0xb2, 0x5a, 0x5e, 0x43, 0x89, 0x27, 0x90,
+	0x0d, 0x0f, 0xaa, 0x3f, 0xfe, 0x66, 0x2e, 0x58, 0xbf, 0x3f, 0x00, 0x2b, 0x87, 0x24, 0xa1, 0x28,
+	0xa1, 0x63, 0x2a, 0x47, 0xc2, 0x05, 0xf5, 0x7c, 0x2a, 0x0d, 0x4d, 0xa5, 0x7e, 0xb3, 0x7d, 0xcf,
+	0x33, 0x84, 0x5b, 0xe3, 0xa9, 0xbf, 0xe6, 0x5d, 0x2a, 0xdc, 0xf4, 0x2f, 0x40, 0x35, 0x25, 0x84,
+	0xa9, 0xa1, 0xb1, 0x4a, 0x95, 0x2b, 0xc6, 0x74, 0xd2, 0xb1, 0x9f, 0xa1, 0xf4, 0x2c, 0x42, 0x1e,
+	0x21, 0xcc, 0xad, 0x72, 0x1a, 0x4f, 0x78, 0xe9, 0x3f, 0x69, 0x60, 0x3d, 0x41, 0xe7, 0xcc, 0xcf,
+	0x57, 0x11, 0xf5, 0x87, 0x90, 0x0e, 0xc5, 0x7c, 0x34, 0xdd, 0xaf, 0x67, 0x53, 0xf3, 0x43, 0x99,
+	0xdf, 0xdb, 0x50, 0xd6, 0xdf, 0x53, 0xf3, 0xf3, 0x01, 0x66, 0xc3, 0x71, 0x8f, 0x87, 0x73, 0xca,
+	0xeb, 0xab, 0x38, 0x46, 0xb8, 0x47, 0x9d, 0xde, 0x05, 0x43, 0xd4, 0x3e, 0x42, 0xe7, 0x2e, 0x3f,
+	0x78, 0x3a, 0xa7, 0x7b, 0x91, 0xb3, 0x1d, 0x41, 0x9a, 0x95, 0xe9, 0x1f, 0x0d, 0x34, 0x9f, 0x61,
+	0xda, 0x43, 0x43, 0x38, 0xc1, 0x64, 0x9c, 0xea, 0x1d, 0x50, 0x97, 0x97, 0x20, 0x5f, 0x1c, 0xee,
+	0xfa, 0x6c, 0x6a, 0xae, 0xc9, 0xcf, 0xca, 0x4d, 0x96, 0x57, 0x93, 0xe7, 0xe3, 0x50, 0x7f, 0x09,
+	0x6a, 0x43, 0x04, 0x43, 0x94, 0xfa, 0x1d, 0x55, 0x17, 0xf3, 0x9d, 0xcb, 0xe4, 0x48, 0x00, 0xdd,
+	0xd6, 0xd5, 0xd4, 0x5c, 0x92, 0xe7, 0xce, 0x6c, 0x6a, 0xae, 0x4a, 0xf6, 0x8c, 0xc5, 0xf2, 0x96,
+	0xe4, 0xb1, 0x53, 0xe2, 0xee, 0x1a, 0x95, 0x7b, 0x73, 0x77, 0x6f, 0x71, 0x77, 0x73, 0xee, 0xae,
+	0xaa, 0xc0, 0x0f, 0x15, 0xb0, 0xf3, 0x94, 0xb3, 0xc9, 0xed, 0xf9, 0x98, 0x31, 0x18, 0x9c, 0xfd,
+	0xdf, 0x92, 0xc4, 0x40, 0x0f, 0x48, 0xd2, 0x8f, 0x70, 0x20, 0xb6, 0x98, 0x8c, 0x78, 0xd7, 0xe2,
+	0xec, 0xcc, 0xa6, 0xe6, 0xa6, 0x22, 0xbf, 0x45, 0x62, 0x79, 0x0f, 0x4b, 0x4a, 0xe9, 0xc1, 0x07,
+	0x9b, 0xdf, 0x3f, 0x92, 0xef, 0x8d, 0xca, 0x7d, 0x07, 0x7b, 0xce, 0xdd, 0xf2, 0x9a, 0x52, 0x56,
+	0x83, 0xfd, 0x0a, 0x2c, 0x8b, 0xd5, 0x8b, 0x42, 0x9f, 0xa5, 0x30, 0x40, 0x46, 0xb5, 0x5d, 0xb9,
+	0x4b, 0x22, 0x46, 0xc1, 0x3f, 0xe7, 0x6f, 0x79, 0x4d, 0x25, 0x3f, 0xe7, 0xa2, 0x6a, 0xc4, 0xaf,
+	0x15, 0xb0, 0xa8, 0xf2, 0x81, 0x60, 0x99, 0xe2, 0x41, 0x82, 0xc2, 0xac, 0x72, 0x72, 0x5a, 0x5b,
+	0x76, 0x71, 0xcf, 0x6d, 0xf9, 0x5b, 0x7d, 0x2a, 0x60, 0x2a, 0xde, 0xf6, 0xe5, 0xd4, 0xd4, 0x8a,
+	0x98, 0x73, 0x14, 0x96, 0xd7, 0xa4, 0x25, 0x2c, 0x2f, 0x59, 0x3e, 0x5e, 0x3e, 0x45, 0xd9, 0x44,
+	0xbf, 0x25, 0x44, 0x3e, 0x37, 0xa7, 0x88, 0x95, 0x53, 0x9a, 0x73, 0xb7, 0xbc, 0xe6, 0xa4, 0x84,
+	0xd3, 0xbf, 0x03, 0x2b, 0x59, 0xca, 0x77, 0x6e, 0xc9, 0x8e, 0x6a, 0xc9, 0x07, 0xf3, 0x25, 0xcb,
+	0x7a, 0x92, 0xf5, 0x40, 0x35, 0x25, 0x02, 0x7a, 0x86, 0x28, 0xf6, 0x84, 0x51, 0xbd, 0x53, 0x16,
+	0xa5, 0x1b, 0x76, 0x9b, 0xc3, 0xf2, 0x1e, 0x2a, 0xe5, 0x8b, 0x42, 0xf7, 0x15, 0xa8, 0x65, 0xef,
+	0x00, 0x7d, 0x1b, 0xd4, 0x93, 0x71, 0x8c, 0x52, 0x6e, 0x11, 0x9d, 0xa9, 0x7a, 0x85, 0x42, 0x6f,
+	0x83, 0x46, 0x88, 0x12, 0x12, 0xe3, 0x44, 0xd8, 0x1f, 0x08, 0x7b, 0x59, 0xe5, 0x7e, 0xf3, 0xe6,
+	0xaa, 0xa5, 0x5d, 0x5e, 0xb5, 0xb4, 0xbf, 0xae, 0x5a, 0xda, 0xeb, 0xeb, 0xd6, 0xc2, 0xe5, 0x75,
+	0x6b, 0xe1, 0x8f, 0xeb, 0xd6, 0xc2, 0xcb, 0xc7, 0xa5, 0xed, 0x16, 0x10, 0x1a, 0x13, 0xea, 0xe0,
+	0x5e, 0xb0, 0x37, 0x20, 0xce, 0x64, 0xdf, 0x89, 0x49, 0x38, 0x8e, 0x10, 0x95, 0x4f, 0xc2, 0xbd,
+	0xec, 0x4d, 0xf8, 0x59, 0x67, 0x4f, 0x3d, 0x0b, 0x45, 0x9e, 0xbd, 0x45, 0xb1, 0xc9, 0xf7, 0xff,
+	0x1d, 0x00, 0xbe, 0xc7, 0xeb, 0x2d, 0x3e, 0x0a, 0x00, 0x00,
 }
 
 func (m *ClientState) Marshal() (dAtA []byte, err error) {
@@ -593,6 +644,72 @@ func (m *Misbehaviour) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LightClientAttackMisbehaviour) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LightClientAttackMisbehaviour) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LightClientAttackMisbehaviour) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TrustedTrace) > 0 {
+		for iNdEx := len(m.TrustedTrace) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TrustedTrace[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDymint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.CommonHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDymint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ConflictingHeader != nil {
+		{
+			size, err := m.ConflictingHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDymint(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintDymint(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Header) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -781,6 +898,31 @@ func (m *Misbehaviour) Size() (n int) {
 	return n
 }
 
+func (m *LightClientAttackMisbehaviour) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovDymint(uint64(l))
+	}
+	if m.ConflictingHeader != nil {
+		l = m.ConflictingHeader.Size()
+		n += 1 + l + sovDymint(uint64(l))
+	}
+	l = m.CommonHeight.Size()
+	n += 1 + l + sovDymint(uint64(l))
+	if len(m.TrustedTrace) > 0 {
+		for _, e := range m.TrustedTrace {
+			l = e.Size()
+			n += 1 + l + sovDymint(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Header) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1475,6 +1617,191 @@ func (m *Misbehaviour) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LightClientAttackMisbehaviour) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDymint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LightClientAttackMisbehaviour: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LightClientAttackMisbehaviour: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDymint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDymint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDymint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictingHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDymint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDymint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDymint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConflictingHeader == nil {
+				m.ConflictingHeader = &Header{}
+			}
+			if err := m.ConflictingHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDymint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDymint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDymint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommonHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustedTrace", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDymint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDymint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDymint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrustedTrace = append(m.TrustedTrace, &Header{})
+			if err := m.TrustedTrace[len(m.TrustedTrace)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDymint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDymint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Header) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
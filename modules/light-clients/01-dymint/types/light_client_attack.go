@@ -0,0 +1,112 @@
+package types
+
+import (
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+)
+
+var _ exported.Misbehaviour = &LightClientAttackMisbehaviour{}
+
+// NewLightClientAttackMisbehaviour creates a new LightClientAttackMisbehaviour instance.
+func NewLightClientAttackMisbehaviour(clientID string, conflictingHeader *Header, commonHeight clienttypes.Height, trustedTrace []*Header) *LightClientAttackMisbehaviour {
+	return &LightClientAttackMisbehaviour{
+		ClientId:          clientID,
+		ConflictingHeader: conflictingHeader,
+		CommonHeight:      commonHeight,
+		TrustedTrace:      trustedTrace,
+	}
+}
+
+// ClientType is Dymint light client
+func (misbehaviour LightClientAttackMisbehaviour) ClientType() string {
+	return exported.Dymint
+}
+
+// GetChainID returns the chain-id
+func (misbehaviour LightClientAttackMisbehaviour) GetChainID() string {
+	// assuming the chain-id of the conflicting header and the trusted trace are the same as checked in ValidateBasic
+	return misbehaviour.ConflictingHeader.GetChainID()
+}
+
+// GetClientID returns the ID of the client that committed a misbehaviour.
+func (misbehaviour LightClientAttackMisbehaviour) GetClientID() string {
+	return misbehaviour.ClientId
+}
+
+// GetForkHeight returns the height at which the conflicting header forks from the
+// trusted trace. The client is frozen at this height.
+func (misbehaviour LightClientAttackMisbehaviour) GetForkHeight() clienttypes.Height {
+	return misbehaviour.ConflictingHeader.GetHeight().(clienttypes.Height)
+}
+
+// GetTime returns the timestamp at which misbehaviour occurred. It uses the
+// maximum value from the conflicting header and the last trusted header to prevent
+// producing an invalid header outside of the misbehaviour age range.
+func (misbehaviour LightClientAttackMisbehaviour) GetTime() time.Time {
+	t1 := misbehaviour.ConflictingHeader.GetTime()
+	t2 := misbehaviour.TrustedTrace[len(misbehaviour.TrustedTrace)-1].GetTime()
+	if t1.After(t2) {
+		return t1
+	}
+	return t2
+}
+
+// ValidateBasic implements Misbehaviour interface
+func (misbehaviour LightClientAttackMisbehaviour) ValidateBasic() error {
+	if err := host.ClientIdentifierValidator(misbehaviour.ClientId); err != nil {
+		return sdkerrors.Wrap(err, "misbehaviour client ID is invalid")
+	}
+
+	if misbehaviour.ConflictingHeader == nil {
+		return sdkerrors.Wrap(ErrInvalidHeader, "misbehaviour ConflictingHeader cannot be nil")
+	}
+	if misbehaviour.CommonHeight.RevisionHeight == 0 {
+		return sdkerrors.Wrap(ErrInvalidHeaderHeight, "misbehaviour common height cannot have zero revision height")
+	}
+	if !misbehaviour.ConflictingHeader.TrustedHeight.EQ(misbehaviour.CommonHeight) {
+		return sdkerrors.Wrapf(
+			clienttypes.ErrInvalidMisbehaviour,
+			"conflicting header must be trusted from the common height (%s != %s)", misbehaviour.ConflictingHeader.TrustedHeight, misbehaviour.CommonHeight,
+		)
+	}
+	if err := misbehaviour.ConflictingHeader.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(
+			clienttypes.ErrInvalidMisbehaviour,
+			sdkerrors.Wrap(err, "conflicting header failed validation").Error(),
+		)
+	}
+
+	if len(misbehaviour.TrustedTrace) == 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidMisbehaviour, "trusted trace cannot be empty")
+	}
+
+	trace := make([]ibctmtypes.TraceHeader, len(misbehaviour.TrustedTrace))
+	for i, header := range misbehaviour.TrustedTrace {
+		if header == nil {
+			return sdkerrors.Wrapf(ErrInvalidHeader, "header %d of the trusted trace cannot be nil", i)
+		}
+		if err := header.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(
+				clienttypes.ErrInvalidMisbehaviour,
+				sdkerrors.Wrapf(err, "header %d of the trusted trace failed validation", i).Error(),
+			)
+		}
+		if header.Header.ChainID != misbehaviour.ConflictingHeader.Header.ChainID {
+			return sdkerrors.Wrap(clienttypes.ErrInvalidMisbehaviour, "headers must have identical chainIDs")
+		}
+
+		trace[i] = header
+	}
+
+	if err := ibctmtypes.ValidateTrustedTrace(misbehaviour.CommonHeight, misbehaviour.ConflictingHeader.GetHeight(), trace); err != nil {
+		return err
+	}
+
+	return misbehaviour.ConflictingHeader.ValidateCommit()
+}
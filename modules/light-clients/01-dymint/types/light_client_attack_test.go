@@ -0,0 +1,92 @@
+package types_test
+
+import (
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/01-dymint/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *DymintTestSuite) TestLightClientAttackMisbehaviourValidateBasic() {
+	var (
+		dymintChain *ibctesting.TestChainDymint
+		attack      *types.LightClientAttackMisbehaviour
+	)
+
+	heightMinus1 := clienttypes.NewHeight(0, height.RevisionHeight-1)
+	heightMinus2 := clienttypes.NewHeight(0, height.RevisionHeight-2)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"valid light client attack", func() {}, true,
+		},
+		{
+			"invalid client ID", func() {
+				attack.ClientId = "GAIA"
+			}, false,
+		},
+		{
+			"conflicting header is nil", func() {
+				attack.ConflictingHeader = nil
+			}, false,
+		},
+		{
+			"conflicting header is not trusted from the common height", func() {
+				attack.ConflictingHeader.TrustedHeight = heightMinus2
+			}, false,
+		},
+		{
+			"trusted trace is empty", func() {
+				attack.TrustedTrace = nil
+			}, false,
+		},
+		{
+			"trusted trace does not start at the common height", func() {
+				attack.TrustedTrace[0] = dymintChain.CreateDMClientHeader(chainID, int64(height.RevisionHeight), heightMinus2, suite.now, suite.valSet, suite.valSet, getSuiteSigners(suite))
+			}, false,
+		},
+		{
+			"trusted trace does not end at the fork height", func() {
+				attack.ConflictingHeader = dymintChain.CreateDMClientHeader(chainID, int64(height.RevisionHeight+1), heightMinus1, suite.now, suite.valSet, suite.valSet, getSuiteSigners(suite))
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			if suite.chainA.TestChainClient.GetSelfClientType() == exported.Dymint {
+				dymintChain = suite.chainA.TestChainClient.(*ibctesting.TestChainDymint)
+			} else {
+				dymintChain = suite.chainB.TestChainClient.(*ibctesting.TestChainDymint)
+			}
+
+			attack = types.NewLightClientAttackMisbehaviour(
+				clientID,
+				dymintChain.CreateDMClientHeader(chainID, int64(height.RevisionHeight), heightMinus1, suite.now.Add(time.Minute), suite.valSet, suite.valSet, getSuiteSigners(suite)),
+				heightMinus1,
+				[]*types.Header{suite.header},
+			)
+
+			tc.malleate()
+
+			err := attack.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(height, attack.GetForkHeight())
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
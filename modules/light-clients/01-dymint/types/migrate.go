@@ -0,0 +1,74 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+)
+
+// MigrateFromTendermint converts a 07-tendermint client to the dymint client type in place.
+// Every tendermint consensus state in the client store is replaced by a dymint consensus
+// state with the same timestamp, root and next validators hash. The consensus metadata
+// (processed time, processed height and iteration keys) is stored under the same keys by
+// both client types and is therefore kept, an iteration key is added for any consensus
+// state missing one. The migrated client state is returned and must be set by the caller.
+func MigrateFromTendermint(clientStore sdk.KVStore, cdc codec.BinaryCodec, tmClientState *ibctmtypes.ClientState) (*ClientState, error) {
+	var heights []exported.Height
+
+	iterator := sdk.KVStorePrefixIterator(clientStore, []byte(host.KeyConsensusStatePrefix))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		keySplit := strings.Split(string(iterator.Key()), "/")
+		// consensus key is in the format "consensusStates/<height>"
+		if len(keySplit) != 2 {
+			continue
+		}
+
+		height, err := clienttypes.ParseHeight(keySplit[1])
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "invalid consensus state key %s", iterator.Key())
+		}
+
+		heights = append(heights, height)
+	}
+
+	for _, height := range heights {
+		tmConsensusState, err := ibctmtypes.GetConsensusState(clientStore, cdc, height)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "could not migrate consensus state at height %s", height)
+		}
+
+		consensusState := &ConsensusState{
+			Timestamp:          tmConsensusState.Timestamp,
+			Root:               tmConsensusState.Root,
+			NextValidatorsHash: tmConsensusState.NextValidatorsHash,
+		}
+		SetConsensusState(clientStore, cdc, consensusState, height)
+		SetIterationKey(clientStore, height)
+	}
+
+	clientState := &ClientState{
+		ChainId:         tmClientState.ChainId,
+		TrustLevel:      Fraction{Numerator: tmClientState.TrustLevel.Numerator, Denominator: tmClientState.TrustLevel.Denominator},
+		TrustingPeriod:  tmClientState.TrustingPeriod,
+		UnbondingPeriod: tmClientState.UnbondingPeriod,
+		MaxClockDrift:   tmClientState.MaxClockDrift,
+		FrozenHeight:    tmClientState.FrozenHeight,
+		LatestHeight:    tmClientState.LatestHeight,
+		ProofSpecs:      tmClientState.ProofSpecs,
+		UpgradePath:     tmClientState.UpgradePath,
+	}
+
+	if err := clientState.Validate(); err != nil {
+		return nil, sdkerrors.Wrap(err, "migrated client state is invalid")
+	}
+
+	return clientState, nil
+}
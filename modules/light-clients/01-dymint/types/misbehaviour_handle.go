@@ -12,6 +12,7 @@ import (
 
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
 )
 
 // CheckMisbehaviourAndUpdateState determines whether or not two conflicting
@@ -22,12 +23,18 @@ import (
 // Similarly, consensusState2 is the trusted consensus state that corresponds
 // to misbehaviour.Header2
 // Misbehaviour sets frozen height to {0, 1} since it is only used as a boolean value (zero or non-zero).
+// LightClientAttackMisbehaviour is handled by checkLightClientAttack and freezes the client at the
+// fork height.
 func (cs ClientState) CheckMisbehaviourAndUpdateState(
 	ctx sdk.Context,
 	cdc codec.BinaryCodec,
 	clientStore sdk.KVStore,
 	misbehaviour exported.Misbehaviour,
 ) (exported.ClientState, error) {
+	if attack, ok := misbehaviour.(*LightClientAttackMisbehaviour); ok {
+		return cs.checkLightClientAttack(ctx, cdc, clientStore, attack)
+	}
+
 	tmMisbehaviour, ok := misbehaviour.(*Misbehaviour)
 	if !ok {
 		return nil, sdkerrors.Wrapf(clienttypes.ErrInvalidClientType, "expected type %T, got %T", misbehaviour, &Misbehaviour{})
@@ -97,6 +104,49 @@ func (cs ClientState) CheckMisbehaviourAndUpdateState(
 	return &cs, nil
 }
 
+// checkLightClientAttack determines whether or not the conflicting header and the trusted trace
+// of a light client attack, both verified from the trusted consensus state at the common height,
+// would have convinced the light client of two different blocks at the fork height.
+func (cs ClientState) checkLightClientAttack(
+	ctx sdk.Context,
+	cdc codec.BinaryCodec,
+	clientStore sdk.KVStore,
+	attack *LightClientAttackMisbehaviour,
+) (exported.ClientState, error) {
+	// The status of the client is checked in 02-client
+
+	commonConsensusState, err := GetConsensusState(clientStore, cdc, attack.CommonHeight)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "could not get trusted consensus state from clientStore at common height: %s", attack.CommonHeight)
+	}
+
+	if err := checkMisbehaviourHeader(
+		&cs, commonConsensusState, attack.ConflictingHeader, ctx.BlockTime(),
+	); err != nil {
+		return nil, sdkerrors.Wrap(err, "verifying ConflictingHeader in LightClientAttackMisbehaviour failed")
+	}
+
+	// NOTE: the links between the headers of the trusted trace are checked in
+	// misbehaviour.ValidateBasic by the client keeper.
+	trustedConsensusState := commonConsensusState
+	for i, header := range attack.TrustedTrace {
+		if err := checkValidity(&cs, trustedConsensusState, header, ctx.BlockTime()); err != nil {
+			return nil, sdkerrors.Wrapf(err, "verifying header %d of the trusted trace in LightClientAttackMisbehaviour failed", i)
+		}
+
+		trustedConsensusState = header.ConsensusState()
+	}
+
+	trustedHeader := attack.TrustedTrace[len(attack.TrustedTrace)-1]
+	if err := ibctmtypes.CheckForkedSignedHeaders(trustedHeader.SignedHeader, attack.ConflictingHeader.SignedHeader); err != nil {
+		return nil, err
+	}
+
+	cs.FrozenHeight = attack.GetForkHeight()
+
+	return &cs, nil
+}
+
 // checkMisbehaviourHeader checks that a Header in Misbehaviour is valid misbehaviour given
 // a trusted ConsensusState
 func checkMisbehaviourHeader(
@@ -362,3 +362,107 @@ func (suite *DymintTestSuite) TestCheckMisbehaviourAndUpdateState() {
 		})
 	}
 }
+
+func (suite *DymintTestSuite) TestCheckLightClientAttackAndUpdateState() {
+	var (
+		chainDymint *ibctesting.TestChainDymint
+		attack      *types.LightClientAttackMisbehaviour
+		timestamp   time.Time
+	)
+
+	heightPlus1 := clienttypes.NewHeight(height.RevisionNumber, height.RevisionHeight+1)
+	forkHeight := clienttypes.NewHeight(height.RevisionNumber, height.RevisionHeight+2)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"valid light client attack", func() {}, true,
+		},
+		{
+			"valid trusted trace with adjacent headers", func() {
+				attack.TrustedTrace = []*types.Header{
+					chainDymint.CreateDMClientHeader(chainID, int64(heightPlus1.RevisionHeight), height, suite.now.Add(time.Minute), suite.valSet, suite.valSet, getSuiteSigners(suite)),
+					chainDymint.CreateDMClientHeader(chainID, int64(forkHeight.RevisionHeight), heightPlus1, suite.now.Add(90*time.Second), suite.valSet, suite.valSet, getSuiteSigners(suite)),
+				}
+			}, true,
+		},
+		{
+			"conflicting header is the trusted header", func() {
+				attack.ConflictingHeader = attack.TrustedTrace[0]
+			}, false,
+		},
+		{
+			"conflicting header has a different chain-id", func() {
+				attack.ConflictingHeader = chainDymint.CreateDMClientHeader("ethermint", int64(forkHeight.RevisionHeight), height, suite.now.Add(2*time.Minute), suite.valSet, suite.valSet, getSuiteSigners(suite))
+			}, false,
+		},
+		{
+			"trusted trace header is not after the common consensus state", func() {
+				attack.TrustedTrace[0] = chainDymint.CreateDMClientHeader(chainID, int64(forkHeight.RevisionHeight), height, suite.now, suite.valSet, suite.valSet, getSuiteSigners(suite))
+			}, false,
+		},
+		{
+			"consensus state at common height not found", func() {
+				attack.CommonHeight = heightPlus1
+				attack.ConflictingHeader.TrustedHeight = heightPlus1
+				attack.TrustedTrace[0].TrustedHeight = heightPlus1
+			}, false,
+		},
+		{
+			"trusting period has expired", func() {
+				timestamp = suite.now.Add(trustingPeriod)
+			}, false,
+		},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case: %s", tc.name), func() {
+			// reset suite to create fresh application state
+			suite.SetupTest()
+
+			if suite.chainA.TestChainClient.GetSelfClientType() == exported.Dymint {
+				chainDymint = suite.chainA.TestChainClient.(*ibctesting.TestChainDymint)
+			} else {
+				chainDymint = suite.chainB.TestChainClient.(*ibctesting.TestChainDymint)
+			}
+
+			clientState := types.NewClientState(chainID, trustingPeriod, maxClockDrift, height, commitmenttypes.GetSDKSpecs(), upgradePath)
+			attack = types.NewLightClientAttackMisbehaviour(
+				clientID,
+				chainDymint.CreateDMClientHeader(chainID, int64(forkHeight.RevisionHeight), height, suite.now.Add(2*time.Minute), suite.valSet, suite.valSet, getSuiteSigners(suite)),
+				height,
+				[]*types.Header{
+					chainDymint.CreateDMClientHeader(chainID, int64(forkHeight.RevisionHeight), height, suite.now.Add(time.Minute), suite.valSet, suite.valSet, getSuiteSigners(suite)),
+				},
+			)
+			timestamp = suite.now.Add(3 * time.Minute)
+
+			tc.malleate()
+
+			// Set current timestamp in context
+			ctx := chainDymint.TC.GetContext().WithBlockTime(timestamp)
+
+			// Set trusted consensus state at the common height in client store
+			chainDymint.TC.App.GetIBCKeeper().ClientKeeper.SetClientConsensusState(ctx, clientID, height, types.NewConsensusState(suite.now, commitmenttypes.NewMerkleRoot(tmhash.Sum([]byte("app_hash"))), suite.valsHash))
+
+			updatedClientState, err := clientState.CheckMisbehaviourAndUpdateState(
+				ctx,
+				chainDymint.TC.App.AppCodec(),
+				chainDymint.TC.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, clientID), // pass in clientID prefixed clientStore
+				attack,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.name)
+				suite.Require().Equal(forkHeight, updatedClientState.(*types.ClientState).FrozenHeight, "valid test case %d failed: %s", i, tc.name)
+			} else {
+				suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.name)
+				suite.Require().Nil(updatedClientState, "invalid test case %d passed: %s", i, tc.name)
+			}
+		})
+	}
+}
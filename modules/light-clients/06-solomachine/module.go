@@ -1,6 +1,10 @@
 package solomachine
 
 import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 	"github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine/types"
 )
 
@@ -8,3 +12,18 @@ import (
 func Name() string {
 	return types.SubModuleName
 }
+
+var _ clienttypes.LightClientModule = LightClientModule{}
+
+// LightClientModule is the 06-solomachine light client module registered on the 02-client keeper.
+type LightClientModule struct{}
+
+// ClientType implements the LightClientModule interface.
+func (LightClientModule) ClientType() string {
+	return exported.Solomachine
+}
+
+// RegisterInterfaces implements the LightClientModule interface.
+func (LightClientModule) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	types.RegisterInterfaces(registry)
+}
@@ -2,9 +2,9 @@ package types
 
 import (
 	"reflect"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
-	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
@@ -17,6 +17,12 @@ import (
 
 var _ exported.ClientState = (*ClientState)(nil)
 
+// MaxClockDrift is the maximum duration by which the timestamp of a signature of a client
+// with a signature window may be ahead of the block time. It prevents a signature from
+// advancing the consensus state timestamp far into the future, which would leave the later
+// signatures outside of the signature window.
+const MaxClockDrift = 10 * time.Minute
+
 // NewClientState creates a new ClientState instance.
 func NewClientState(latestSequence uint64, consensusState *ConsensusState, allowUpdateAfterProposal bool) *ClientState {
 	return &ClientState{
@@ -97,6 +103,81 @@ func (cs ClientState) VerifyUpgradeAndUpdateState(
 	return nil, nil, sdkerrors.Wrap(clienttypes.ErrInvalidUpgradeClient, "cannot upgrade solomachine client")
 }
 
+// VerifyMembership is a generic proof verification method which verifies a proof of the existence of a value
+// at a given CommitmentPath at the specified height. The solo machine signs over the path and the value with
+// the MEMBERSHIP data type. The delay periods are ignored by the solo machine.
+func (cs *ClientState) VerifyMembership(
+	ctx sdk.Context,
+	clientStore sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	_ uint64,
+	_ uint64,
+	proof []byte,
+	path exported.Path,
+	value []byte,
+) error {
+	return cs.verifyPathSignature(ctx, clientStore, cdc, height, proof, path, MEMBERSHIP, value)
+}
+
+// VerifyNonMembership is a generic proof verification method which verifies the absence of a given
+// CommitmentPath at the specified height. The solo machine signs over the path with the NONMEMBERSHIP
+// data type. The delay periods are ignored by the solo machine.
+func (cs *ClientState) VerifyNonMembership(
+	ctx sdk.Context,
+	clientStore sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	_ uint64,
+	_ uint64,
+	proof []byte,
+	path exported.Path,
+) error {
+	return cs.verifyPathSignature(ctx, clientStore, cdc, height, proof, path, NONMEMBERSHIP, nil)
+}
+
+// verifyPathSignature verifies the signature of the solo machine over the given path and
+// value and increments the sequence of the client on success.
+func (cs *ClientState) verifyPathSignature(
+	ctx sdk.Context,
+	clientStore sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	proof []byte,
+	path exported.Path,
+	dataType DataType,
+	value []byte,
+) error {
+	merklePath, ok := path.(commitmenttypes.MerklePath)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidProof, "expected %T, got %T", commitmenttypes.MerklePath{}, path)
+	}
+
+	sigData, timestamp, sequence, err := produceProofArgs(cdc, cs, ctx.BlockTime(), height, proof)
+	if err != nil {
+		return err
+	}
+
+	if dataType == NONMEMBERSHIP {
+		if err := cs.checkNonMembershipTimestamp(clientStore, cdc, height, timestamp); err != nil {
+			return err
+		}
+	}
+
+	signBz, err := MembershipSignBytes(cdc, sequence, timestamp, cs.ConsensusState.Diversifier, dataType, merklePath, value)
+	if err != nil {
+		return err
+	}
+
+	if err := cs.ConsensusState.VerifySignature(signBz, sigData); err != nil {
+		return err
+	}
+
+	cs.incrementSequence(timestamp)
+	setClientState(clientStore, cdc, cs)
+	return nil
+}
+
 // VerifyClientState verifies a proof of the client state of the running chain
 // stored on the solo machine.
 func (cs *ClientState) VerifyClientState(
@@ -111,7 +192,7 @@ func (cs *ClientState) VerifyClientState(
 	// NOTE: the proof height sequence is incremented by one due to the connection handshake verification ordering
 	height = clienttypes.NewHeight(height.GetRevisionNumber(), height.GetRevisionHeight()+1)
 
-	publicKey, sigData, timestamp, sequence, err := produceVerificationArgs(cdc, cs, height, prefix, proof)
+	sigData, timestamp, sequence, err := produceVerificationArgs(cdc, cs, time.Time{}, height, prefix, proof)
 	if err != nil {
 		return err
 	}
@@ -127,12 +208,11 @@ func (cs *ClientState) VerifyClientState(
 		return err
 	}
 
-	if err := VerifySignature(publicKey, signBz, sigData); err != nil {
+	if err := cs.ConsensusState.VerifySignature(signBz, sigData); err != nil {
 		return err
 	}
 
-	cs.Sequence++
-	cs.ConsensusState.Timestamp = timestamp
+	cs.incrementSequence(timestamp)
 	setClientState(store, cdc, cs)
 	return nil
 }
@@ -152,7 +232,7 @@ func (cs *ClientState) VerifyClientConsensusState(
 	// NOTE: the proof height sequence is incremented by two due to the connection handshake verification ordering
 	height = clienttypes.NewHeight(height.GetRevisionNumber(), height.GetRevisionHeight()+2)
 
-	publicKey, sigData, timestamp, sequence, err := produceVerificationArgs(cdc, cs, height, prefix, proof)
+	sigData, timestamp, sequence, err := produceVerificationArgs(cdc, cs, time.Time{}, height, prefix, proof)
 	if err != nil {
 		return err
 	}
@@ -168,12 +248,11 @@ func (cs *ClientState) VerifyClientConsensusState(
 		return err
 	}
 
-	if err := VerifySignature(publicKey, signBz, sigData); err != nil {
+	if err := cs.ConsensusState.VerifySignature(signBz, sigData); err != nil {
 		return err
 	}
 
-	cs.Sequence++
-	cs.ConsensusState.Timestamp = timestamp
+	cs.incrementSequence(timestamp)
 	setClientState(store, cdc, cs)
 	return nil
 }
@@ -189,7 +268,7 @@ func (cs *ClientState) VerifyConnectionState(
 	connectionID string,
 	connectionEnd exported.ConnectionI,
 ) error {
-	publicKey, sigData, timestamp, sequence, err := produceVerificationArgs(cdc, cs, height, prefix, proof)
+	sigData, timestamp, sequence, err := produceVerificationArgs(cdc, cs, time.Time{}, height, prefix, proof)
 	if err != nil {
 		return err
 	}
@@ -205,12 +284,11 @@ func (cs *ClientState) VerifyConnectionState(
 		return err
 	}
 
-	if err := VerifySignature(publicKey, signBz, sigData); err != nil {
+	if err := cs.ConsensusState.VerifySignature(signBz, sigData); err != nil {
 		return err
 	}
 
-	cs.Sequence++
-	cs.ConsensusState.Timestamp = timestamp
+	cs.incrementSequence(timestamp)
 	setClientState(store, cdc, cs)
 	return nil
 }
@@ -227,7 +305,7 @@ func (cs *ClientState) VerifyChannelState(
 	channelID string,
 	channel exported.ChannelI,
 ) error {
-	publicKey, sigData, timestamp, sequence, err := produceVerificationArgs(cdc, cs, height, prefix, proof)
+	sigData, timestamp, sequence, err := produceVerificationArgs(cdc, cs, time.Time{}, height, prefix, proof)
 	if err != nil {
 		return err
 	}
@@ -243,12 +321,11 @@ func (cs *ClientState) VerifyChannelState(
 		return err
 	}
 
-	if err := VerifySignature(publicKey, signBz, sigData); err != nil {
+	if err := cs.ConsensusState.VerifySignature(signBz, sigData); err != nil {
 		return err
 	}
 
-	cs.Sequence++
-	cs.ConsensusState.Timestamp = timestamp
+	cs.incrementSequence(timestamp)
 	setClientState(store, cdc, cs)
 	return nil
 }
@@ -269,7 +346,7 @@ func (cs *ClientState) VerifyPacketCommitment(
 	packetSequence uint64,
 	commitmentBytes []byte,
 ) error {
-	publicKey, sigData, timestamp, sequence, err := produceVerificationArgs(cdc, cs, height, prefix, proof)
+	sigData, timestamp, sequence, err := produceVerificationArgs(cdc, cs, ctx.BlockTime(), height, prefix, proof)
 	if err != nil {
 		return err
 	}
@@ -285,12 +362,11 @@ func (cs *ClientState) VerifyPacketCommitment(
 		return err
 	}
 
-	if err := VerifySignature(publicKey, signBz, sigData); err != nil {
+	if err := cs.ConsensusState.VerifySignature(signBz, sigData); err != nil {
 		return err
 	}
 
-	cs.Sequence++
-	cs.ConsensusState.Timestamp = timestamp
+	cs.incrementSequence(timestamp)
 	setClientState(store, cdc, cs)
 	return nil
 }
@@ -311,7 +387,7 @@ func (cs *ClientState) VerifyPacketAcknowledgement(
 	packetSequence uint64,
 	acknowledgement []byte,
 ) error {
-	publicKey, sigData, timestamp, sequence, err := produceVerificationArgs(cdc, cs, height, prefix, proof)
+	sigData, timestamp, sequence, err := produceVerificationArgs(cdc, cs, ctx.BlockTime(), height, prefix, proof)
 	if err != nil {
 		return err
 	}
@@ -327,12 +403,11 @@ func (cs *ClientState) VerifyPacketAcknowledgement(
 		return err
 	}
 
-	if err := VerifySignature(publicKey, signBz, sigData); err != nil {
+	if err := cs.ConsensusState.VerifySignature(signBz, sigData); err != nil {
 		return err
 	}
 
-	cs.Sequence++
-	cs.ConsensusState.Timestamp = timestamp
+	cs.incrementSequence(timestamp)
 	setClientState(store, cdc, cs)
 	return nil
 }
@@ -353,11 +428,15 @@ func (cs *ClientState) VerifyPacketReceiptAbsence(
 	channelID string,
 	packetSequence uint64,
 ) error {
-	publicKey, sigData, timestamp, sequence, err := produceVerificationArgs(cdc, cs, height, prefix, proof)
+	sigData, timestamp, sequence, err := produceVerificationArgs(cdc, cs, ctx.BlockTime(), height, prefix, proof)
 	if err != nil {
 		return err
 	}
 
+	if err := cs.checkNonMembershipTimestamp(store, cdc, height, timestamp); err != nil {
+		return err
+	}
+
 	receiptPath := commitmenttypes.NewMerklePath(host.PacketReceiptPath(portID, channelID, packetSequence))
 	path, err := commitmenttypes.ApplyPrefix(prefix, receiptPath)
 	if err != nil {
@@ -369,12 +448,11 @@ func (cs *ClientState) VerifyPacketReceiptAbsence(
 		return err
 	}
 
-	if err := VerifySignature(publicKey, signBz, sigData); err != nil {
+	if err := cs.ConsensusState.VerifySignature(signBz, sigData); err != nil {
 		return err
 	}
 
-	cs.Sequence++
-	cs.ConsensusState.Timestamp = timestamp
+	cs.incrementSequence(timestamp)
 	setClientState(store, cdc, cs)
 	return nil
 }
@@ -394,7 +472,7 @@ func (cs *ClientState) VerifyNextSequenceRecv(
 	channelID string,
 	nextSequenceRecv uint64,
 ) error {
-	publicKey, sigData, timestamp, sequence, err := produceVerificationArgs(cdc, cs, height, prefix, proof)
+	sigData, timestamp, sequence, err := produceVerificationArgs(cdc, cs, ctx.BlockTime(), height, prefix, proof)
 	if err != nil {
 		return err
 	}
@@ -410,83 +488,202 @@ func (cs *ClientState) VerifyNextSequenceRecv(
 		return err
 	}
 
-	if err := VerifySignature(publicKey, signBz, sigData); err != nil {
+	if err := cs.ConsensusState.VerifySignature(signBz, sigData); err != nil {
 		return err
 	}
 
-	cs.Sequence++
-	cs.ConsensusState.Timestamp = timestamp
+	cs.incrementSequence(timestamp)
+	setClientState(store, cdc, cs)
+	return nil
+}
+
+// VerifyNextSequenceAck verifies a proof of the next sequence number to be
+// acknowledged of the specified channel at the specified port.
+func (cs *ClientState) VerifyNextSequenceAck(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	_ uint64,
+	_ uint64,
+	prefix exported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	nextSequenceAck uint64,
+) error {
+	sigData, timestamp, sequence, err := produceVerificationArgs(cdc, cs, ctx.BlockTime(), height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	nextSequenceAckPath := commitmenttypes.NewMerklePath(host.NextSequenceAckPath(portID, channelID))
+	path, err := commitmenttypes.ApplyPrefix(prefix, nextSequenceAckPath)
+	if err != nil {
+		return err
+	}
+
+	signBz, err := NextSequenceAckSignBytes(cdc, sequence, timestamp, cs.ConsensusState.Diversifier, path, nextSequenceAck)
+	if err != nil {
+		return err
+	}
+
+	if err := cs.ConsensusState.VerifySignature(signBz, sigData); err != nil {
+		return err
+	}
+
+	cs.incrementSequence(timestamp)
 	setClientState(store, cdc, cs)
 	return nil
 }
 
 // produceVerificationArgs perfoms the basic checks on the arguments that are
-// shared between the verification functions and returns the public key of the
-// consensus state, the unmarshalled proof representing the signature and timestamp
-// along with the solo-machine sequence encoded in the proofHeight.
+// shared between the verification functions and returns the unmarshalled proof
+// representing the signature and timestamp along with the solo-machine sequence
+// encoded in the proofHeight.
 func produceVerificationArgs(
 	cdc codec.BinaryCodec,
 	cs *ClientState,
+	blockTime time.Time,
 	height exported.Height,
 	prefix exported.Prefix,
 	proof []byte,
-) (cryptotypes.PubKey, signing.SignatureData, uint64, uint64, error) {
-	if revision := height.GetRevisionNumber(); revision != 0 {
-		return nil, nil, 0, 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidHeight, "revision must be 0 for solomachine, got revision-number: %d", revision)
-	}
-	// sequence is encoded in the revision height of height struct
-	sequence := height.GetRevisionHeight()
+) (signing.SignatureData, uint64, uint64, error) {
 	if prefix == nil {
-		return nil, nil, 0, 0, sdkerrors.Wrap(commitmenttypes.ErrInvalidPrefix, "prefix cannot be empty")
+		return nil, 0, 0, sdkerrors.Wrap(commitmenttypes.ErrInvalidPrefix, "prefix cannot be empty")
 	}
 
 	_, ok := prefix.(*commitmenttypes.MerklePrefix)
 	if !ok {
-		return nil, nil, 0, 0, sdkerrors.Wrapf(commitmenttypes.ErrInvalidPrefix, "invalid prefix type %T, expected MerklePrefix", prefix)
+		return nil, 0, 0, sdkerrors.Wrapf(commitmenttypes.ErrInvalidPrefix, "invalid prefix type %T, expected MerklePrefix", prefix)
+	}
+
+	return produceProofArgs(cdc, cs, blockTime, height, proof)
+}
+
+// produceProofArgs performs the basic checks on the proof and height shared between all
+// the verification functions and returns the signature data, the timestamp and the
+// sequence of the proof. If the client has a signature window the proof height is
+// ignored and the returned sequence is 0, the sequence signed over by sequence
+// independent proofs. The signature timestamp must then be at most MaxClockDrift ahead
+// of the block time, which is zero for the verification functions called without a
+// context, thus they do not support clients with a signature window. Proofs of absence
+// of clients with a signature window are further checked by checkNonMembershipTimestamp.
+func produceProofArgs(
+	cdc codec.BinaryCodec,
+	cs *ClientState,
+	blockTime time.Time,
+	height exported.Height,
+	proof []byte,
+) (signing.SignatureData, uint64, uint64, error) {
+	if revision := height.GetRevisionNumber(); revision != 0 {
+		return nil, 0, 0, sdkerrors.Wrapf(sdkerrors.ErrInvalidHeight, "revision must be 0 for solomachine, got revision-number: %d", revision)
 	}
+	// sequence is encoded in the revision height of height struct
+	sequence := height.GetRevisionHeight()
 
 	if proof == nil {
-		return nil, nil, 0, 0, sdkerrors.Wrap(ErrInvalidProof, "proof cannot be empty")
+		return nil, 0, 0, sdkerrors.Wrap(ErrInvalidProof, "proof cannot be empty")
 	}
 
 	timestampedSigData := &TimestampedSignatureData{}
 	if err := cdc.Unmarshal(proof, timestampedSigData); err != nil {
-		return nil, nil, 0, 0, sdkerrors.Wrapf(err, "failed to unmarshal proof into type %T", timestampedSigData)
+		return nil, 0, 0, sdkerrors.Wrapf(err, "failed to unmarshal proof into type %T", timestampedSigData)
 	}
 
 	timestamp := timestampedSigData.Timestamp
 
 	if len(timestampedSigData.SignatureData) == 0 {
-		return nil, nil, 0, 0, sdkerrors.Wrap(ErrInvalidProof, "signature data cannot be empty")
+		return nil, 0, 0, sdkerrors.Wrap(ErrInvalidProof, "signature data cannot be empty")
 	}
 
 	sigData, err := UnmarshalSignatureData(cdc, timestampedSigData.SignatureData)
 	if err != nil {
-		return nil, nil, 0, 0, err
+		return nil, 0, 0, err
 	}
 
 	if cs.ConsensusState == nil {
-		return nil, nil, 0, 0, sdkerrors.Wrap(clienttypes.ErrInvalidConsensus, "consensus state cannot be empty")
+		return nil, 0, 0, sdkerrors.Wrap(clienttypes.ErrInvalidConsensus, "consensus state cannot be empty")
+	}
+
+	if cs.SignatureWindow != 0 {
+		// proofs are not bound to the sequence, the signature timestamp must be within the signature window
+		if consensusTimestamp := cs.ConsensusState.GetTimestamp(); consensusTimestamp > timestamp && consensusTimestamp-timestamp > cs.SignatureWindow {
+			return nil, 0, 0, sdkerrors.Wrapf(ErrInvalidProof, "the signature timestamp is outside of the signature window (%d + %d < %d)", timestamp, cs.SignatureWindow, consensusTimestamp)
+		}
+
+		if blockTime.IsZero() {
+			return nil, 0, 0, sdkerrors.Wrap(ErrInvalidProof, "the signature timestamp cannot be verified without the block time")
+		}
+
+		if maxTimestamp := maxSignatureTimestamp(blockTime); timestamp > maxTimestamp {
+			return nil, 0, 0, sdkerrors.Wrapf(ErrInvalidProof, "the signature timestamp is too far in the future (%d > %d)", timestamp, maxTimestamp)
+		}
+
+		return sigData, timestamp, 0, nil
 	}
 
 	latestSequence := cs.GetLatestHeight().GetRevisionHeight()
 	if latestSequence != sequence {
-		return nil, nil, 0, 0, sdkerrors.Wrapf(
+		return nil, 0, 0, sdkerrors.Wrapf(
 			sdkerrors.ErrInvalidHeight,
 			"client state sequence != proof sequence (%d != %d)", latestSequence, sequence,
 		)
 	}
 
 	if cs.ConsensusState.GetTimestamp() > timestamp {
-		return nil, nil, 0, 0, sdkerrors.Wrapf(ErrInvalidProof, "the consensus state timestamp is greater than the signature timestamp (%d >= %d)", cs.ConsensusState.GetTimestamp(), timestamp)
+		return nil, 0, 0, sdkerrors.Wrapf(ErrInvalidProof, "the consensus state timestamp is greater than the signature timestamp (%d >= %d)", cs.ConsensusState.GetTimestamp(), timestamp)
+	}
+
+	return sigData, timestamp, sequence, nil
+}
+
+// checkNonMembershipTimestamp checks that the signature timestamp of a proof of absence
+// verified by a client with a signature window is not earlier than the timestamp of the
+// consensus state stored at the proof height. Core IBC uses the latter as the proof
+// timestamp of packet timeouts, thus a proof of absence signed before the packet timeout
+// cannot be replayed within the signature window against a later proof height. Proofs
+// of sequence bound clients are only valid at the current sequence.
+func (cs *ClientState) checkNonMembershipTimestamp(clientStore sdk.KVStore, cdc codec.BinaryCodec, height exported.Height, timestamp uint64) error {
+	if cs.SignatureWindow == 0 {
+		return nil
+	}
+
+	bz := clientStore.Get(host.ConsensusStateKey(height))
+	if bz == nil {
+		return sdkerrors.Wrapf(clienttypes.ErrConsensusStateNotFound, "consensus state does not exist for proof height %s", height)
 	}
 
-	publicKey, err := cs.ConsensusState.GetPubKey()
+	consensusState, err := clienttypes.UnmarshalConsensusState(cdc, bz)
 	if err != nil {
-		return nil, nil, 0, 0, err
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidConsensus, "unmarshal error: %v", err)
+	}
+
+	if proofTimestamp := consensusState.GetTimestamp(); timestamp < proofTimestamp {
+		return sdkerrors.Wrapf(
+			ErrInvalidProof,
+			"the signature timestamp of a proof of absence is less than the timestamp at the proof height (%d < %d)", timestamp, proofTimestamp,
+		)
 	}
 
-	return publicKey, sigData, timestamp, sequence, nil
+	return nil
+}
+
+// maxSignatureTimestamp returns the greatest signature timestamp accepted from a client
+// with a signature window at the given block time.
+func maxSignatureTimestamp(blockTime time.Time) uint64 {
+	return uint64(blockTime.Add(MaxClockDrift).UnixNano())
+}
+
+// incrementSequence increments the sequence of the client after a successful verification
+// and advances the consensus state timestamp to the signature timestamp. The consensus
+// state timestamp never decreases, signatures within the signature window may be
+// verified out of order.
+func (cs *ClientState) incrementSequence(timestamp uint64) {
+	cs.Sequence++
+	if timestamp > cs.ConsensusState.Timestamp {
+		cs.ConsensusState.Timestamp = timestamp
+	}
 }
 
 // sets the client state to the store
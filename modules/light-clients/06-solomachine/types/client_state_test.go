@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine/types"
 	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	ibcmock "github.com/cosmos/ibc-go/v3/testing/mock"
 )
 
 const (
@@ -58,22 +59,22 @@ func (suite *SoloMachineTestSuite) TestClientStateValidateBasic() {
 			},
 			{
 				"sequence is zero",
-				types.NewClientState(0, &types.ConsensusState{solomachine.ConsensusState().PublicKey, solomachine.Diversifier, solomachine.Time}, false),
+				types.NewClientState(0, &types.ConsensusState{solomachine.ConsensusState().PublicKey, solomachine.Diversifier, solomachine.Time, nil}, false),
 				false,
 			},
 			{
 				"timestamp is zero",
-				types.NewClientState(1, &types.ConsensusState{solomachine.ConsensusState().PublicKey, solomachine.Diversifier, 0}, false),
+				types.NewClientState(1, &types.ConsensusState{solomachine.ConsensusState().PublicKey, solomachine.Diversifier, 0, nil}, false),
 				false,
 			},
 			{
 				"diversifier is blank",
-				types.NewClientState(1, &types.ConsensusState{solomachine.ConsensusState().PublicKey, "  ", 1}, false),
+				types.NewClientState(1, &types.ConsensusState{solomachine.ConsensusState().PublicKey, "  ", 1, nil}, false),
 				false,
 			},
 			{
 				"pubkey is empty",
-				types.NewClientState(1, &types.ConsensusState{nil, solomachine.Diversifier, solomachine.Time}, false),
+				types.NewClientState(1, &types.ConsensusState{nil, solomachine.Diversifier, solomachine.Time, nil}, false),
 				false,
 			},
 		}
@@ -842,3 +843,345 @@ func (suite *SoloMachineTestSuite) TestVerifyNextSeqRecv() {
 		}
 	}
 }
+
+func (suite *SoloMachineTestSuite) TestVerifyNextSeqAck() {
+	// test singlesig and multisig public keys
+	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+
+		nextSeqAck := solomachine.Sequence + 1
+		path := solomachine.GetNextSequenceAckPath(testPortID, testChannelID)
+
+		value, err := types.NextSequenceAckSignBytes(suite.chainA.Codec, solomachine.Sequence, solomachine.Time, solomachine.Diversifier, path, nextSeqAck)
+		suite.Require().NoError(err)
+
+		sig := solomachine.GenerateSignature(value)
+		signatureDoc := &types.TimestampedSignatureData{
+			SignatureData: sig,
+			Timestamp:     solomachine.Time,
+		}
+
+		proof, err := suite.chainA.Codec.Marshal(signatureDoc)
+		suite.Require().NoError(err)
+
+		testCases := []struct {
+			name        string
+			clientState *types.ClientState
+			prefix      exported.Prefix
+			proof       []byte
+			expPass     bool
+		}{
+			{
+				"successful verification",
+				solomachine.ClientState(),
+				prefix,
+				proof,
+				true,
+			},
+			{
+				"ApplyPrefix failed",
+				solomachine.ClientState(),
+				commitmenttypes.NewMerklePrefix([]byte{}),
+				proof,
+				false,
+			},
+			{
+				"proof is nil",
+				solomachine.ClientState(),
+				prefix,
+				nil,
+				false,
+			},
+			{
+				"proof verification failed",
+				solomachine.ClientState(),
+				prefix,
+				suite.GetInvalidProof(),
+				false,
+			},
+		}
+
+		for i, tc := range testCases {
+			tc := tc
+
+			expSeq := tc.clientState.Sequence + 1
+			ctx := suite.chainA.GetContext()
+
+			err := tc.clientState.VerifyNextSequenceAck(
+				ctx, suite.store, suite.chainA.Codec, solomachine.GetHeight(), 0, 0, tc.prefix, tc.proof, testPortID, testChannelID, nextSeqAck,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.name)
+				suite.Require().Equal(expSeq, tc.clientState.Sequence)
+				suite.Require().Equal(expSeq, suite.GetSequenceFromStore(), "sequence not updated in the store (%d) on valid test case %d: %s", suite.GetSequenceFromStore(), i, tc.name)
+			} else {
+				suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.name)
+			}
+		}
+	}
+}
+
+func (suite *SoloMachineTestSuite) TestVerifyMembership() {
+	// test singlesig and multisig public keys
+	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+
+		path := solomachine.GetPacketCommitmentPath(testPortID, testChannelID)
+		commitmentBytes := []byte("COMMITMENT BYTES")
+
+		proof := solomachine.GenerateProof(types.MEMBERSHIP, path, commitmentBytes)
+
+		testCases := []struct {
+			name        string
+			clientState *types.ClientState
+			path        exported.Path
+			value       []byte
+			proof       []byte
+			expPass     bool
+		}{
+			{
+				"successful verification",
+				solomachine.ClientState(),
+				path,
+				commitmentBytes,
+				proof,
+				true,
+			},
+			{
+				"invalid path type",
+				solomachine.ClientState(),
+				ibcmock.KeyPath{},
+				commitmentBytes,
+				proof,
+				false,
+			},
+			{
+				"value does not match",
+				solomachine.ClientState(),
+				path,
+				[]byte("invalid value"),
+				proof,
+				false,
+			},
+			{
+				"proof signs over non membership",
+				solomachine.ClientState(),
+				path,
+				commitmentBytes,
+				solomachine.GenerateProof(types.NONMEMBERSHIP, path, nil),
+				false,
+			},
+			{
+				"proof is nil",
+				solomachine.ClientState(),
+				path,
+				commitmentBytes,
+				nil,
+				false,
+			},
+			{
+				"proof verification failed",
+				solomachine.ClientState(),
+				path,
+				commitmentBytes,
+				suite.GetInvalidProof(),
+				false,
+			},
+		}
+
+		for i, tc := range testCases {
+			tc := tc
+
+			expSeq := tc.clientState.Sequence + 1
+			ctx := suite.chainA.GetContext()
+
+			err := tc.clientState.VerifyMembership(
+				ctx, suite.store, suite.chainA.Codec, solomachine.GetHeight(), 0, 0, tc.proof, tc.path, tc.value,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.name)
+				suite.Require().Equal(expSeq, tc.clientState.Sequence)
+				suite.Require().Equal(expSeq, suite.GetSequenceFromStore(), "sequence not updated in the store (%d) on valid test case %d: %s", suite.GetSequenceFromStore(), i, tc.name)
+			} else {
+				suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.name)
+			}
+		}
+	}
+}
+
+func (suite *SoloMachineTestSuite) TestVerifyNonMembership() {
+	// test singlesig and multisig public keys
+	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+
+		path := solomachine.GetPacketReceiptPath(testPortID, testChannelID)
+
+		proof := solomachine.GenerateProof(types.NONMEMBERSHIP, path, nil)
+
+		testCases := []struct {
+			name        string
+			clientState *types.ClientState
+			path        exported.Path
+			proof       []byte
+			expPass     bool
+		}{
+			{
+				"successful verification",
+				solomachine.ClientState(),
+				path,
+				proof,
+				true,
+			},
+			{
+				"invalid path type",
+				solomachine.ClientState(),
+				ibcmock.KeyPath{},
+				proof,
+				false,
+			},
+			{
+				"proof signs over membership",
+				solomachine.ClientState(),
+				path,
+				solomachine.GenerateProof(types.MEMBERSHIP, path, nil),
+				false,
+			},
+			{
+				"proof is nil",
+				solomachine.ClientState(),
+				path,
+				nil,
+				false,
+			},
+			{
+				"proof verification failed",
+				solomachine.ClientState(),
+				path,
+				suite.GetInvalidProof(),
+				false,
+			},
+		}
+
+		for i, tc := range testCases {
+			tc := tc
+
+			expSeq := tc.clientState.Sequence + 1
+			ctx := suite.chainA.GetContext()
+
+			err := tc.clientState.VerifyNonMembership(
+				ctx, suite.store, suite.chainA.Codec, solomachine.GetHeight(), 0, 0, tc.proof, tc.path,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.name)
+				suite.Require().Equal(expSeq, tc.clientState.Sequence)
+				suite.Require().Equal(expSeq, suite.GetSequenceFromStore(), "sequence not updated in the store (%d) on valid test case %d: %s", suite.GetSequenceFromStore(), i, tc.name)
+			} else {
+				suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.name)
+			}
+		}
+	}
+}
+
+func (suite *SoloMachineTestSuite) TestVerifyMembershipSignatureWindow() {
+	var (
+		solomachine *ibctesting.Solomachine
+		clientState *types.ClientState
+		proofHeight exported.Height
+		proof       []byte
+	)
+
+	commitmentBytes := []byte("COMMITMENT BYTES")
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"success: proof height is not the client sequence", func() {
+				proofHeight = clienttypes.NewHeight(0, solomachine.Sequence+5)
+			}, true,
+		},
+		{
+			"success: signature timestamp is within the signature window", func() {
+				clientState.ConsensusState.Timestamp = solomachine.Time + 100
+			}, true,
+		},
+		{
+			"success: signers reach the threshold", func() {
+				path := solomachine.GetPacketCommitmentPath(testPortID, testChannelID)
+				proof = solomachine.GenerateProofWithSigners(types.MEMBERSHIP, path, commitmentBytes, 0, 3)
+			}, true,
+		},
+		{
+			"signature timestamp is outside of the signature window", func() {
+				clientState.ConsensusState.Timestamp = solomachine.Time + 101
+			}, false,
+		},
+		{
+			"success: signature timestamp is max clock drift ahead of the block time", func() {
+				solomachine.Time = uint64(suite.chainA.GetContext().BlockTime().Add(types.MaxClockDrift).UnixNano())
+
+				path := solomachine.GetPacketCommitmentPath(testPortID, testChannelID)
+				proof = solomachine.GenerateProof(types.MEMBERSHIP, path, commitmentBytes)
+			}, true,
+		},
+		{
+			"signature timestamp is more than max clock drift ahead of the block time", func() {
+				solomachine.Time = uint64(suite.chainA.GetContext().BlockTime().Add(types.MaxClockDrift).UnixNano()) + 1
+
+				path := solomachine.GetPacketCommitmentPath(testPortID, testChannelID)
+				proof = solomachine.GenerateProof(types.MEMBERSHIP, path, commitmentBytes)
+			}, false,
+		},
+		{
+			"signers do not reach the threshold", func() {
+				path := solomachine.GetPacketCommitmentPath(testPortID, testChannelID)
+				proof = solomachine.GenerateProofWithSigners(types.MEMBERSHIP, path, commitmentBytes, 1, 2)
+			}, false,
+		},
+		{
+			"sequence independent proof verified by a sequence bound client", func() {
+				clientState.SignatureWindow = 0
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			solomachine = ibctesting.NewWeightedSolomachine(suite.T(), suite.chainA.Codec, "solomachineweighted", "testing", []uint64{2, 1, 1, 1}, 3)
+			solomachine.SignatureWindow = 100
+			clientState = solomachine.ClientState()
+			proofHeight = solomachine.GetHeight()
+
+			path := solomachine.GetPacketCommitmentPath(testPortID, testChannelID)
+			proof = solomachine.GenerateProof(types.MEMBERSHIP, path, commitmentBytes)
+
+			tc.malleate()
+
+			expTimestamp := clientState.ConsensusState.Timestamp
+			if solomachine.Time > expTimestamp {
+				expTimestamp = solomachine.Time
+			}
+
+			err := clientState.VerifyMembership(
+				suite.chainA.GetContext(), suite.store, suite.chainA.Codec, proofHeight, 0, 0, proof, path, commitmentBytes,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(solomachine.Sequence+1, suite.GetSequenceFromStore())
+				suite.Require().Equal(expTimestamp, clientState.ConsensusState.Timestamp, "consensus state timestamp must not decrease")
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
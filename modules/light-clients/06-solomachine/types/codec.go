@@ -125,7 +125,60 @@ func UnmarshalDataByType(cdc codec.BinaryCodec, dataType DataType, data []byte)
 
 		return nextSeqRecvData, nil
 
+	case NEXTSEQUENCEACK:
+		nextSeqAckData := &NextSequenceAckData{}
+		if err := cdc.Unmarshal(data, nextSeqAckData); err != nil {
+			return nil, err
+		}
+
+		return nextSeqAckData, nil
+
+	case HEADER:
+		headerData := &HeaderData{}
+		if err := cdc.Unmarshal(data, headerData); err != nil {
+			return nil, err
+		}
+
+		return headerData, nil
+
+	case MEMBERSHIP, NONMEMBERSHIP:
+		membershipData := &MembershipData{}
+		if err := cdc.Unmarshal(data, membershipData); err != nil {
+			return nil, err
+		}
+
+		return membershipData, nil
+
 	default:
 		return nil, sdkerrors.Wrapf(ErrInvalidDataType, "unsupported data type %T", dataType)
 	}
 }
+
+// DataPath returns the path signed over by the given data. Header data is not signed
+// over a path and nil is returned.
+func DataPath(data Data) []byte {
+	switch data := data.(type) {
+	case *ClientStateData:
+		return data.Path
+	case *ConsensusStateData:
+		return data.Path
+	case *ConnectionStateData:
+		return data.Path
+	case *ChannelStateData:
+		return data.Path
+	case *PacketCommitmentData:
+		return data.Path
+	case *PacketAcknowledgementData:
+		return data.Path
+	case *PacketReceiptAbsenceData:
+		return data.Path
+	case *NextSequenceRecvData:
+		return data.Path
+	case *NextSequenceAckData:
+		return data.Path
+	case *MembershipData:
+		return data.Path
+	default:
+		return nil
+	}
+}
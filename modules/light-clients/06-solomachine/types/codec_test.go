@@ -163,6 +163,47 @@ func (suite SoloMachineTestSuite) TestUnmarshalDataByType() {
 					suite.Require().NoError(err)
 				}, false,
 			},
+			{
+				"next sequence ack", types.NEXTSEQUENCEACK, func() {
+					path := solomachine.GetNextSequenceAckPath("portID", "channelID")
+
+					data, err = types.NextSequenceAckDataBytes(cdc, path, 10)
+					suite.Require().NoError(err)
+				}, true,
+			},
+			{
+				"bad next sequence ack (uses packet commitment)", types.NEXTSEQUENCEACK, func() {
+					commitment := []byte("packet commitment")
+					path := solomachine.GetPacketCommitmentPath("portID", "channelID")
+
+					data, err = types.PacketCommitmentDataBytes(cdc, path, commitment)
+					suite.Require().NoError(err)
+				}, false,
+			},
+			{
+				"membership", types.MEMBERSHIP, func() {
+					path := solomachine.GetPacketCommitmentPath("portID", "channelID")
+					data, err = types.MembershipDataBytes(cdc, path, []byte("value"))
+					suite.Require().NoError(err)
+				}, true,
+			},
+			{
+				"non membership", types.NONMEMBERSHIP, func() {
+					path := solomachine.GetPacketReceiptPath("portID", "channelID")
+					data, err = types.MembershipDataBytes(cdc, path, nil)
+					suite.Require().NoError(err)
+				}, true,
+			},
+			{
+				"header", types.HEADER, func() {
+					header := solomachine.CreateHeader()
+					data, err = cdc.Marshal(&types.HeaderData{
+						NewPubKey:      header.NewPublicKey,
+						NewDiversifier: header.NewDiversifier,
+					})
+					suite.Require().NoError(err)
+				}, true,
+			},
 		}
 
 		for _, tc := range cases {
@@ -5,6 +5,7 @@ import (
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
@@ -52,6 +53,18 @@ func (cs ConsensusState) ValidateBasic() error {
 		return sdkerrors.Wrap(clienttypes.ErrInvalidConsensus, "diversifier cannot contain only spaces")
 	}
 
+	if cs.SignerSet != nil {
+		if cs.PublicKey != nil {
+			return sdkerrors.Wrap(clienttypes.ErrInvalidConsensus, "public key and signer set cannot both be set")
+		}
+
+		if err := cs.SignerSet.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(clienttypes.ErrInvalidConsensus, err.Error())
+		}
+
+		return nil
+	}
+
 	publicKey, err := cs.GetPubKey()
 	if err != nil || publicKey == nil || len(publicKey.Bytes()) == 0 {
 		return sdkerrors.Wrap(clienttypes.ErrInvalidConsensus, "public key cannot be empty")
@@ -59,3 +72,18 @@ func (cs ConsensusState) ValidateBasic() error {
 
 	return nil
 }
+
+// VerifySignature verifies the signature over the sign bytes against the signer set of
+// the consensus state if it is set, otherwise against its public key.
+func (cs ConsensusState) VerifySignature(signBytes []byte, sigData signing.SignatureData) error {
+	if cs.SignerSet != nil {
+		return cs.SignerSet.VerifySignature(signBytes, sigData)
+	}
+
+	publicKey, err := cs.GetPubKey()
+	if err != nil {
+		return err
+	}
+
+	return VerifySignature(publicKey, signBytes, sigData)
+}
@@ -15,6 +15,8 @@ func (suite *SoloMachineTestSuite) TestConsensusState() {
 }
 
 func (suite *SoloMachineTestSuite) TestConsensusStateValidateBasic() {
+	signerSet := ibctesting.NewWeightedSolomachine(suite.T(), suite.chainA.Codec, "solomachineweighted", "testing", []uint64{2, 1, 1, 1}, 3).SignerSet()
+
 	// test singlesig and multisig public keys
 	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
 
@@ -55,6 +57,34 @@ func (suite *SoloMachineTestSuite) TestConsensusStateValidateBasic() {
 				},
 				false,
 			},
+			{
+				"valid signer set",
+				&types.ConsensusState{
+					Timestamp:   solomachine.Time,
+					Diversifier: solomachine.Diversifier,
+					SignerSet:   signerSet,
+				},
+				true,
+			},
+			{
+				"pubkey and signer set are both set",
+				&types.ConsensusState{
+					Timestamp:   solomachine.Time,
+					Diversifier: solomachine.Diversifier,
+					PublicKey:   solomachine.ConsensusState().PublicKey,
+					SignerSet:   signerSet,
+				},
+				false,
+			},
+			{
+				"signer set fails basic validation",
+				&types.ConsensusState{
+					Timestamp:   solomachine.Time,
+					Diversifier: solomachine.Diversifier,
+					SignerSet:   types.NewSignerSet(signerSet.Signers, 0),
+				},
+				false,
+			},
 		}
 
 		for _, tc := range testCases {
@@ -15,4 +15,6 @@ var (
 	ErrSignatureVerificationFailed = sdkerrors.Register(SubModuleName, 5, "signature verification failed")
 	ErrInvalidProof                = sdkerrors.Register(SubModuleName, 6, "invalid solo machine proof")
 	ErrInvalidDataType             = sdkerrors.Register(SubModuleName, 7, "invalid data type")
+	ErrInvalidSignerSet            = sdkerrors.Register(SubModuleName, 8, "invalid signer set")
+	ErrInsufficientSignerWeight    = sdkerrors.Register(SubModuleName, 9, "insufficient signer weight")
 )
@@ -45,8 +45,9 @@ func (h Header) GetPubKey() (cryptotypes.PubKey, error) {
 	return publicKey, nil
 }
 
-// ValidateBasic ensures that the sequence, signature and public key have all
-// been initialized.
+// ValidateBasic ensures that the sequence, signature and exactly one of the new public
+// key and the new signer set have been initialized. A new signer set must be
+// accompanied by its signature approving the rotation.
 func (h Header) ValidateBasic() error {
 	if h.Sequence == 0 {
 		return sdkerrors.Wrap(clienttypes.ErrInvalidHeader, "sequence number cannot be zero")
@@ -64,6 +65,22 @@ func (h Header) ValidateBasic() error {
 		return sdkerrors.Wrap(clienttypes.ErrInvalidHeader, "signature cannot be empty")
 	}
 
+	if h.NewSignerSet != nil {
+		if h.NewPublicKey != nil {
+			return sdkerrors.Wrap(clienttypes.ErrInvalidHeader, "new public key and new signer set cannot both be set")
+		}
+
+		if err := h.NewSignerSet.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(clienttypes.ErrInvalidHeader, err.Error())
+		}
+
+		if len(h.NewSignerSetSignature) == 0 {
+			return sdkerrors.Wrap(clienttypes.ErrInvalidHeader, "new signer set signature cannot be empty")
+		}
+
+		return nil
+	}
+
 	newPublicKey, err := h.GetPubKey()
 	if err != nil || newPublicKey == nil || len(newPublicKey.Bytes()) == 0 {
 		return sdkerrors.Wrap(clienttypes.ErrInvalidHeader, "new public key cannot be empty")
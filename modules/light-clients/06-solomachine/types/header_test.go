@@ -11,6 +11,7 @@ func (suite *SoloMachineTestSuite) TestHeaderValidateBasic() {
 	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
 
 		header := solomachine.CreateHeader()
+		signerSetHeader := ibctesting.NewWeightedSolomachine(suite.T(), suite.chainA.Codec, "solomachineweighted", "testing", []uint64{2, 1, 1, 1}, 3).CreateHeader()
 
 		cases := []struct {
 			name    string
@@ -77,6 +78,47 @@ func (suite *SoloMachineTestSuite) TestHeaderValidateBasic() {
 				},
 				false,
 			},
+			{
+				"valid new signer set",
+				signerSetHeader,
+				true,
+			},
+			{
+				"new public key and new signer set are both set",
+				&types.Header{
+					Sequence:              header.Sequence,
+					Timestamp:             header.Timestamp,
+					Signature:             header.Signature,
+					NewPublicKey:          header.NewPublicKey,
+					NewDiversifier:        header.NewDiversifier,
+					NewSignerSet:          signerSetHeader.NewSignerSet,
+					NewSignerSetSignature: signerSetHeader.NewSignerSetSignature,
+				},
+				false,
+			},
+			{
+				"new signer set fails basic validation",
+				&types.Header{
+					Sequence:              header.Sequence,
+					Timestamp:             header.Timestamp,
+					Signature:             header.Signature,
+					NewDiversifier:        header.NewDiversifier,
+					NewSignerSet:          types.NewSignerSet(nil, 1),
+					NewSignerSetSignature: signerSetHeader.NewSignerSetSignature,
+				},
+				false,
+			},
+			{
+				"new signer set signature is empty",
+				&types.Header{
+					Sequence:       header.Sequence,
+					Timestamp:      header.Timestamp,
+					Signature:      header.Signature,
+					NewDiversifier: header.NewDiversifier,
+					NewSignerSet:   signerSetHeader.NewSignerSet,
+				},
+				false,
+			},
 		}
 
 		suite.Require().Equal(exported.Solomachine, header.ClientType())
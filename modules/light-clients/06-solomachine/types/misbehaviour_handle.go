@@ -1,6 +1,8 @@
 package types
 
 import (
+	"bytes"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -32,6 +34,15 @@ func (cs ClientState) CheckMisbehaviourAndUpdateState(
 	// NOTE: a check that the misbehaviour message data are not equal is done by
 	// misbehaviour.ValidateBasic which is called by the 02-client keeper.
 
+	// signatures of clients with a signature window are not bound to a sequence, two
+	// signatures only conflict if they sign different data for the same path at the
+	// same timestamp
+	if cs.SignatureWindow != 0 {
+		if err := checkConflictingSignatures(cdc, soloMisbehaviour); err != nil {
+			return nil, err
+		}
+	}
+
 	// verify first signature
 	if err := verifySignatureAndData(cdc, cs, soloMisbehaviour, soloMisbehaviour.SignatureOne); err != nil {
 		return nil, sdkerrors.Wrap(err, "failed to verify signature one")
@@ -57,9 +68,14 @@ func verifySignatureAndData(cdc codec.BinaryCodec, clientState ClientState, misb
 		return err
 	}
 
+	sequence := misbehaviour.Sequence
+	if clientState.SignatureWindow != 0 {
+		sequence = 0
+	}
+
 	data, err := MisbehaviourSignBytes(
 		cdc,
-		misbehaviour.Sequence, sigAndData.Timestamp,
+		sequence, sigAndData.Timestamp,
 		clientState.ConsensusState.Diversifier,
 		sigAndData.DataType,
 		sigAndData.Data,
@@ -73,14 +89,36 @@ func verifySignatureAndData(cdc codec.BinaryCodec, clientState ClientState, misb
 		return err
 	}
 
-	publicKey, err := clientState.ConsensusState.GetPubKey()
+	if err := clientState.ConsensusState.VerifySignature(data, sigData); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// checkConflictingSignatures returns an error if the signatures of the misbehaviour are
+// not made at the same timestamp or are not made over the same path.
+func checkConflictingSignatures(cdc codec.BinaryCodec, misbehaviour *Misbehaviour) error {
+	if misbehaviour.SignatureOne.Timestamp != misbehaviour.SignatureTwo.Timestamp {
+		return sdkerrors.Wrapf(
+			clienttypes.ErrInvalidMisbehaviour,
+			"misbehaviour signatures must have the same timestamp (%d != %d)", misbehaviour.SignatureOne.Timestamp, misbehaviour.SignatureTwo.Timestamp,
+		)
+	}
+
+	dataOne, err := UnmarshalDataByType(cdc, misbehaviour.SignatureOne.DataType, misbehaviour.SignatureOne.Data)
 	if err != nil {
 		return err
 	}
 
-	if err := VerifySignature(publicKey, data, sigData); err != nil {
+	dataTwo, err := UnmarshalDataByType(cdc, misbehaviour.SignatureTwo.DataType, misbehaviour.SignatureTwo.Data)
+	if err != nil {
 		return err
 	}
 
+	if !bytes.Equal(DataPath(dataOne), DataPath(dataTwo)) {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidMisbehaviour, "misbehaviour signatures must be made over the same path")
+	}
+
 	return nil
 }
@@ -262,3 +262,97 @@ func (suite *SoloMachineTestSuite) TestCheckMisbehaviourAndUpdateState() {
 		}
 	}
 }
+
+func (suite *SoloMachineTestSuite) TestCheckMisbehaviourAndUpdateStateSignatureWindow() {
+	var (
+		solomachine  *ibctesting.Solomachine
+		misbehaviour *types.Misbehaviour
+	)
+
+	// signMembership returns the signature of the solo machine over the value at the path and timestamp
+	signMembership := func(path string, value []byte, timestamp uint64) *types.SignatureAndData {
+		data, err := types.MembershipDataBytes(suite.chainA.Codec, solomachine.GetClientStatePath(path), value)
+		suite.Require().NoError(err)
+
+		signBytes, err := types.MisbehaviourSignBytes(suite.chainA.Codec, 0, timestamp, solomachine.Diversifier, types.MEMBERSHIP, data)
+		suite.Require().NoError(err)
+
+		return &types.SignatureAndData{
+			Signature: solomachine.GenerateSignature(signBytes),
+			DataType:  types.MEMBERSHIP,
+			Data:      data,
+			Timestamp: timestamp,
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		setup   func()
+		expPass bool
+	}{
+		{
+			"valid misbehaviour",
+			func() {
+				misbehaviour = solomachine.CreateMisbehaviour()
+			},
+			true,
+		},
+		{
+			"valid misbehaviour of a public key",
+			func() {
+				solomachine = ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, "solomachinesingle", "testing", 1)
+				solomachine.SignatureWindow = 100
+				misbehaviour = solomachine.CreateMisbehaviour()
+			},
+			true,
+		},
+		{
+			"signatures at different timestamps",
+			func() {
+				misbehaviour = solomachine.CreateMisbehaviour()
+				misbehaviour.SignatureTwo = signMembership("counterparty", []byte("value two"), solomachine.Time+1)
+			},
+			false,
+		},
+		{
+			"signatures over different paths",
+			func() {
+				misbehaviour = solomachine.CreateMisbehaviour()
+				misbehaviour.SignatureTwo = signMembership("other", []byte("value two"), solomachine.Time)
+			},
+			false,
+		},
+		{
+			"signatures bound to the misbehaviour sequence",
+			func() {
+				solomachine.SignatureWindow = 0
+				misbehaviour = solomachine.CreateMisbehaviour()
+				solomachine.SignatureWindow = 100
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			solomachine = ibctesting.NewWeightedSolomachine(suite.T(), suite.chainA.Codec, "solomachineweighted", "testing", []uint64{2, 1, 1, 1}, 3)
+			solomachine.SignatureWindow = 100
+
+			tc.setup()
+
+			suite.Require().NoError(misbehaviour.ValidateBasic())
+
+			clientState, err := solomachine.ClientState().CheckMisbehaviourAndUpdateState(suite.chainA.GetContext(), suite.chainA.Codec, suite.store, misbehaviour)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().True(clientState.(*types.ClientState).IsFrozen)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(clientState)
+			}
+		})
+	}
+}
@@ -68,14 +68,17 @@ func MisbehaviourSignBytes(
 	return cdc.Marshal(signBytes)
 }
 
-// HeaderSignBytes returns the sign bytes for verification of misbehaviour.
+// HeaderSignBytes returns the sign bytes for verification of the header at the given
+// sequence. The sequence is 0 for clients with a signature window.
 func HeaderSignBytes(
 	cdc codec.BinaryCodec,
+	sequence uint64,
 	header *Header,
 ) ([]byte, error) {
 	data := &HeaderData{
 		NewPubKey:      header.NewPublicKey,
 		NewDiversifier: header.NewDiversifier,
+		NewSignerSet:   header.NewSignerSet,
 	}
 
 	dataBz, err := cdc.Marshal(data)
@@ -84,7 +87,7 @@ func HeaderSignBytes(
 	}
 
 	signBytes := &SignBytes{
-		Sequence:    header.Sequence,
+		Sequence:    sequence,
 		Timestamp:   header.Timestamp,
 		Diversifier: header.NewDiversifier,
 		DataType:    HEADER,
@@ -475,3 +478,94 @@ func NextSequenceRecvDataBytes(
 
 	return dataBz, nil
 }
+
+// NextSequenceAckSignBytes returns the sign bytes for verification of the next
+// sequence to be acknowledged.
+func NextSequenceAckSignBytes(
+	cdc codec.BinaryCodec,
+	sequence, timestamp uint64,
+	diversifier string,
+	path commitmenttypes.MerklePath,
+	nextSequenceAck uint64,
+) ([]byte, error) {
+	dataBz, err := NextSequenceAckDataBytes(cdc, path, nextSequenceAck)
+	if err != nil {
+		return nil, err
+	}
+
+	signBytes := &SignBytes{
+		Sequence:    sequence,
+		Timestamp:   timestamp,
+		Diversifier: diversifier,
+		DataType:    NEXTSEQUENCEACK,
+		Data:        dataBz,
+	}
+
+	return cdc.Marshal(signBytes)
+}
+
+// NextSequenceAckDataBytes returns the next sequence ack data bytes used in constructing
+// SignBytes.
+func NextSequenceAckDataBytes(
+	cdc codec.BinaryCodec,
+	path commitmenttypes.MerklePath, // nolint: interfacer
+	nextSequenceAck uint64,
+) ([]byte, error) {
+	data := &NextSequenceAckData{
+		Path:       []byte(path.String()),
+		NextSeqAck: nextSequenceAck,
+	}
+
+	dataBz, err := cdc.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return dataBz, nil
+}
+
+// MembershipSignBytes returns the sign bytes for generic membership and non-membership
+// verification of the given path. The value must be empty for non-membership.
+func MembershipSignBytes(
+	cdc codec.BinaryCodec,
+	sequence, timestamp uint64,
+	diversifier string,
+	dataType DataType,
+	path commitmenttypes.MerklePath,
+	value []byte,
+) ([]byte, error) {
+	dataBz, err := MembershipDataBytes(cdc, path, value)
+	if err != nil {
+		return nil, err
+	}
+
+	signBytes := &SignBytes{
+		Sequence:    sequence,
+		Timestamp:   timestamp,
+		Diversifier: diversifier,
+		DataType:    dataType,
+		Data:        dataBz,
+	}
+
+	return cdc.Marshal(signBytes)
+}
+
+// MembershipDataBytes returns the membership data bytes used in constructing
+// SignBytes.
+func MembershipDataBytes(
+	cdc codec.BinaryCodec,
+	path commitmenttypes.MerklePath, // nolint: interfacer
+	value []byte,
+) ([]byte, error) {
+	data := &MembershipData{
+		Path:  []byte(path.String()),
+		Value: value,
+	}
+
+	dataBz, err := cdc.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return dataBz, nil
+}
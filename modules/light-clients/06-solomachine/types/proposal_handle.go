@@ -16,8 +16,8 @@ import (
 // It will update the consensus state to the substitute's consensus state and
 // the sequence to the substitute's current sequence. An error is returned if
 // the client has been disallowed to be updated by a governance proposal,
-// the substitute is not a solo machine, or the current public key equals
-// the new public key.
+// the substitute is not a solo machine, or the current public key or signer
+// set equals the new public key or signer set.
 func (cs ClientState) CheckSubstituteAndUpdateState(
 	ctx sdk.Context, cdc codec.BinaryCodec, subjectClientStore,
 	_ sdk.KVStore, substituteClient exported.ClientState,
@@ -36,20 +36,29 @@ func (cs ClientState) CheckSubstituteAndUpdateState(
 		)
 	}
 
-	subjectPublicKey, err := cs.ConsensusState.GetPubKey()
-	if err != nil {
-		return nil, sdkerrors.Wrap(err, "failed to get consensus public key")
-	}
+	if cs.ConsensusState.SignerSet != nil || substituteClientState.ConsensusState.SignerSet != nil {
+		if cs.ConsensusState.SignerSet != nil && substituteClientState.ConsensusState.SignerSet != nil &&
+			cs.ConsensusState.SignerSet.Equal(*substituteClientState.ConsensusState.SignerSet) {
+			return nil, sdkerrors.Wrapf(
+				clienttypes.ErrInvalidHeader, "subject and substitute have the same signer set",
+			)
+		}
+	} else {
+		subjectPublicKey, err := cs.ConsensusState.GetPubKey()
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "failed to get consensus public key")
+		}
 
-	substitutePublicKey, err := substituteClientState.ConsensusState.GetPubKey()
-	if err != nil {
-		return nil, sdkerrors.Wrap(err, "failed to get substitute client public key")
-	}
+		substitutePublicKey, err := substituteClientState.ConsensusState.GetPubKey()
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "failed to get substitute client public key")
+		}
 
-	if reflect.DeepEqual(subjectPublicKey, substitutePublicKey) {
-		return nil, sdkerrors.Wrapf(
-			clienttypes.ErrInvalidHeader, "subject and substitute have the same public key",
-		)
+		if reflect.DeepEqual(subjectPublicKey, substitutePublicKey) {
+			return nil, sdkerrors.Wrapf(
+				clienttypes.ErrInvalidHeader, "subject and substitute have the same public key",
+			)
+		}
 	}
 
 	clientState := &cs
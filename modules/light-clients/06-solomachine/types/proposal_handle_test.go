@@ -11,6 +11,7 @@ func (suite *SoloMachineTestSuite) TestCheckSubstituteAndUpdateState() {
 	var (
 		subjectClientState    *types.ClientState
 		substituteClientState exported.ClientState
+		weighted              *ibctesting.Solomachine
 	)
 
 	// test singlesig and multisig public keys
@@ -52,6 +53,17 @@ func (suite *SoloMachineTestSuite) TestCheckSubstituteAndUpdateState() {
 					substituteClientState.(*types.ClientState).ConsensusState.PublicKey = subjectClientState.ConsensusState.PublicKey
 				}, false,
 			},
+			{
+				"valid substitute using a signer set", func() {
+					substituteClientState = weighted.ClientState()
+				}, true,
+			},
+			{
+				"subject and substitute use the same signer set", func() {
+					subjectClientState.ConsensusState = weighted.ConsensusState()
+					substituteClientState = weighted.ClientState()
+				}, false,
+			},
 		}
 
 		for _, tc := range testCases {
@@ -64,6 +76,7 @@ func (suite *SoloMachineTestSuite) TestCheckSubstituteAndUpdateState() {
 				subjectClientState.AllowUpdateAfterProposal = true
 				substitute := ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, "substitute", "testing", 5)
 				substituteClientState = substitute.ClientState()
+				weighted = ibctesting.NewWeightedSolomachine(suite.T(), suite.chainA.Codec, "substitute", "testing", []uint64{2, 1, 1, 1}, 3)
 
 				tc.malleate()
 
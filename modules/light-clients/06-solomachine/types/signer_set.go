@@ -0,0 +1,165 @@
+package types
+
+import (
+	"math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// NewSigner creates a new Signer instance.
+func NewSigner(publicKey *codectypes.Any, weight uint64) Signer {
+	return Signer{
+		PublicKey: publicKey,
+		Weight:    weight,
+	}
+}
+
+// NewSignerSet creates a new SignerSet instance.
+func NewSignerSet(signers []Signer, threshold uint64) *SignerSet {
+	return &SignerSet{
+		Signers:   signers,
+		Threshold: threshold,
+	}
+}
+
+// GetPubKey unmarshals the public key into a cryptotypes.PubKey type.
+// An error is returned if the public key is nil or the cached value
+// is not a PubKey.
+func (s Signer) GetPubKey() (cryptotypes.PubKey, error) {
+	if s.PublicKey == nil {
+		return nil, sdkerrors.Wrap(ErrInvalidSignerSet, "signer PublicKey cannot be nil")
+	}
+
+	publicKey, ok := s.PublicKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrInvalidSignerSet, "signer PublicKey is not cryptotypes.PubKey")
+	}
+
+	return publicKey, nil
+}
+
+// ValidateBasic ensures that the signer set is non-empty, that every signer has a
+// unique single public key and a non-zero weight and that the threshold can be reached.
+func (ss SignerSet) ValidateBasic() error {
+	if len(ss.Signers) == 0 {
+		return sdkerrors.Wrap(ErrInvalidSignerSet, "signer set cannot be empty")
+	}
+
+	var total uint64
+	seen := make(map[string]bool, len(ss.Signers))
+	for i, signer := range ss.Signers {
+		publicKey, err := signer.GetPubKey()
+		if err != nil || publicKey == nil || len(publicKey.Bytes()) == 0 {
+			return sdkerrors.Wrapf(ErrInvalidSignerSet, "public key of signer %d cannot be empty", i)
+		}
+
+		if _, ok := publicKey.(multisig.PubKey); ok {
+			return sdkerrors.Wrapf(ErrInvalidSignerSet, "public key of signer %d cannot be a multisig public key", i)
+		}
+
+		if seen[string(publicKey.Bytes())] {
+			return sdkerrors.Wrapf(ErrInvalidSignerSet, "duplicate public key of signer %d", i)
+		}
+		seen[string(publicKey.Bytes())] = true
+
+		if signer.Weight == 0 {
+			return sdkerrors.Wrapf(ErrInvalidSignerSet, "weight of signer %d cannot be 0", i)
+		}
+
+		if total > math.MaxUint64-signer.Weight {
+			return sdkerrors.Wrap(ErrInvalidSignerSet, "total weight overflows uint64")
+		}
+		total += signer.Weight
+	}
+
+	if ss.Threshold == 0 {
+		return sdkerrors.Wrap(ErrInvalidSignerSet, "threshold cannot be 0")
+	}
+
+	if ss.Threshold > total {
+		return sdkerrors.Wrapf(ErrInvalidSignerSet, "threshold cannot exceed the total weight (%d > %d)", ss.Threshold, total)
+	}
+
+	return nil
+}
+
+// VerifySignature verifies that the signers of the set who provided a signature over
+// the sign bytes reach the threshold. The signature data must be a multi signature
+// whose bit array marks the indices of the signers in the set that signed.
+func (ss SignerSet) VerifySignature(signBytes []byte, sigData signing.SignatureData) error {
+	data, ok := sigData.(*signing.MultiSignatureData)
+	if !ok {
+		return sdkerrors.Wrapf(ErrSignatureVerificationFailed, "invalid signature data type, expected %T, got %T", (*signing.MultiSignatureData)(nil), sigData)
+	}
+
+	if data.BitArray == nil || data.BitArray.Count() != len(ss.Signers) {
+		return sdkerrors.Wrapf(ErrSignatureVerificationFailed, "signature bit array must have a bit for each of the %d signers", len(ss.Signers))
+	}
+
+	if len(data.Signatures) != data.BitArray.NumTrueBitsBefore(len(ss.Signers)) {
+		return sdkerrors.Wrap(ErrSignatureVerificationFailed, "number of signatures does not match the signer bit array")
+	}
+
+	var (
+		weight   uint64
+		sigIndex int
+	)
+	for i, signer := range ss.Signers {
+		if !data.BitArray.GetIndex(i) {
+			continue
+		}
+
+		signature, ok := data.Signatures[sigIndex].(*signing.SingleSignatureData)
+		if !ok {
+			return sdkerrors.Wrapf(ErrSignatureVerificationFailed, "invalid signature data type of signer %d, expected %T, got %T", i, (*signing.SingleSignatureData)(nil), data.Signatures[sigIndex])
+		}
+		sigIndex++
+
+		publicKey, err := signer.GetPubKey()
+		if err != nil {
+			return err
+		}
+
+		if !publicKey.VerifySignature(signBytes, signature.Signature) {
+			return sdkerrors.Wrapf(ErrSignatureVerificationFailed, "invalid signature of signer %d", i)
+		}
+
+		weight += signer.Weight
+	}
+
+	if weight < ss.Threshold {
+		return sdkerrors.Wrapf(ErrInsufficientSignerWeight, "signer weight is less than the threshold (%d < %d)", weight, ss.Threshold)
+	}
+
+	return nil
+}
+
+// Equal returns true if both signer sets have the same threshold and the same signers
+// in the same order.
+func (ss SignerSet) Equal(other SignerSet) bool {
+	if ss.Threshold != other.Threshold || len(ss.Signers) != len(other.Signers) {
+		return false
+	}
+
+	for i, signer := range ss.Signers {
+		if signer.Weight != other.Signers[i].Weight {
+			return false
+		}
+
+		publicKey, err := signer.GetPubKey()
+		if err != nil {
+			return false
+		}
+
+		otherPublicKey, err := other.Signers[i].GetPubKey()
+		if err != nil || !publicKey.Equals(otherPublicKey) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,188 @@
+package types_test
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/06-solomachine/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *SoloMachineTestSuite) TestSignerSetValidateBasic() {
+	var signerSet *types.SignerSet
+
+	newPublicKey := func(pk cryptotypes.PubKey) *codectypes.Any {
+		publicKey, err := codectypes.NewAnyWithValue(pk)
+		suite.Require().NoError(err)
+
+		return publicKey
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"threshold equal to the total weight", func() {
+				signerSet.Threshold = 7
+			}, true,
+		},
+		{
+			"empty signer set", func() {
+				signerSet.Signers = nil
+			}, false,
+		},
+		{
+			"nil public key", func() {
+				signerSet.Signers[0].PublicKey = nil
+			}, false,
+		},
+		{
+			"multisig public key", func() {
+				pubKeys := []cryptotypes.PubKey{secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey()}
+				signerSet.Signers[0].PublicKey = newPublicKey(kmultisig.NewLegacyAminoPubKey(2, pubKeys))
+			}, false,
+		},
+		{
+			"duplicate public key", func() {
+				signerSet.Signers[1].PublicKey = signerSet.Signers[0].PublicKey
+			}, false,
+		},
+		{
+			"zero weight", func() {
+				signerSet.Signers[2].Weight = 0
+			}, false,
+		},
+		{
+			"total weight overflows", func() {
+				signerSet.Signers[0].Weight = ^uint64(0)
+			}, false,
+		},
+		{
+			"zero threshold", func() {
+				signerSet.Threshold = 0
+			}, false,
+		},
+		{
+			"threshold exceeds the total weight", func() {
+				signerSet.Threshold = 8
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			signerSet = ibctesting.NewWeightedSolomachine(suite.T(), suite.chainA.Codec, "solomachineweighted", "testing", []uint64{3, 2, 1, 1}, 4).SignerSet()
+
+			tc.malleate()
+
+			err := signerSet.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *SoloMachineTestSuite) TestSignerSetVerifySignature() {
+	var (
+		solomachine *ibctesting.Solomachine
+		signature   []byte
+	)
+
+	signBytes := []byte("sign bytes")
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"all signers", func() {
+				signature = solomachine.GenerateSignature(signBytes)
+			}, true,
+		},
+		{
+			"signers reach the threshold", func() {
+				signature = solomachine.GenerateSignerSetSignature(signBytes, 0, 2)
+			}, true,
+		},
+		{
+			"partial signatures aggregated out of order", func() {
+				signature = solomachine.AggregateSignatures(map[int][]byte{
+					3: solomachine.PartialSignature(3, signBytes),
+					1: solomachine.PartialSignature(1, signBytes),
+					2: solomachine.PartialSignature(2, signBytes),
+				})
+			}, true,
+		},
+		{
+			"signers do not reach the threshold", func() {
+				signature = solomachine.GenerateSignerSetSignature(signBytes, 1, 2)
+			}, false,
+		},
+		{
+			"no signers", func() {
+				signature = solomachine.GenerateSignerSetSignature(signBytes)
+			}, false,
+		},
+		{
+			"signature over different sign bytes", func() {
+				signature = solomachine.AggregateSignatures(map[int][]byte{
+					0: solomachine.PartialSignature(0, []byte("different sign bytes")),
+					1: solomachine.PartialSignature(1, signBytes),
+				})
+			}, false,
+		},
+		{
+			"signature of a signer attributed to another signer", func() {
+				signature = solomachine.AggregateSignatures(map[int][]byte{
+					0: solomachine.PartialSignature(1, signBytes),
+					1: solomachine.PartialSignature(0, signBytes),
+				})
+			}, false,
+		},
+		{
+			"bit array of a different signer set size", func() {
+				signature = ibctesting.NewWeightedSolomachine(suite.T(), suite.chainA.Codec, "solomachineweighted", "testing", []uint64{3, 2, 1}, 4).GenerateSignature(signBytes)
+			}, false,
+		},
+		{
+			"single signature", func() {
+				signature = suite.solomachine.GenerateSignature(signBytes)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			solomachine = ibctesting.NewWeightedSolomachine(suite.T(), suite.chainA.Codec, "solomachineweighted", "testing", []uint64{3, 2, 1, 1}, 4)
+
+			tc.malleate()
+
+			sigData, err := types.UnmarshalSignatureData(suite.chainA.Codec, signature)
+			suite.Require().NoError(err)
+
+			err = solomachine.SignerSet().VerifySignature(signBytes, sigData)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
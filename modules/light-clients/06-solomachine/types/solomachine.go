@@ -8,7 +8,7 @@ import (
 )
 
 // Interface implementation checks.
-var _, _, _, _ codectypes.UnpackInterfacesMessage = &ClientState{}, &ConsensusState{}, &Header{}, &HeaderData{}
+var _, _, _, _, _, _ codectypes.UnpackInterfacesMessage = &ClientState{}, &ConsensusState{}, &Header{}, &HeaderData{}, &Signer{}, &SignerSet{}
 
 // Data is an interface used for all the signature data bytes proto definitions.
 type Data interface{}
@@ -20,17 +20,57 @@ func (cs ClientState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 
 // UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
 func (cs ConsensusState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
-	return unpacker.UnpackAny(cs.PublicKey, new(cryptotypes.PubKey))
+	if err := unpacker.UnpackAny(cs.PublicKey, new(cryptotypes.PubKey)); err != nil {
+		return err
+	}
+
+	if cs.SignerSet != nil {
+		return cs.SignerSet.UnpackInterfaces(unpacker)
+	}
+
+	return nil
 }
 
 // UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
 func (h Header) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
-	return unpacker.UnpackAny(h.NewPublicKey, new(cryptotypes.PubKey))
+	if err := unpacker.UnpackAny(h.NewPublicKey, new(cryptotypes.PubKey)); err != nil {
+		return err
+	}
+
+	if h.NewSignerSet != nil {
+		return h.NewSignerSet.UnpackInterfaces(unpacker)
+	}
+
+	return nil
 }
 
 // UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
 func (hd HeaderData) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
-	return unpacker.UnpackAny(hd.NewPubKey, new(cryptotypes.PubKey))
+	if err := unpacker.UnpackAny(hd.NewPubKey, new(cryptotypes.PubKey)); err != nil {
+		return err
+	}
+
+	if hd.NewSignerSet != nil {
+		return hd.NewSignerSet.UnpackInterfaces(unpacker)
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (s Signer) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpacker.UnpackAny(s.PublicKey, new(cryptotypes.PubKey))
+}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (ss SignerSet) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, signer := range ss.Signers {
+		if err := signer.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
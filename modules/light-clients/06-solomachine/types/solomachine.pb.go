@@ -51,19 +51,28 @@ const (
 	NEXTSEQUENCERECV DataType = 8
 	// Data type for header verification
 	HEADER DataType = 9
+	// Data type for next sequence ack verification
+	NEXTSEQUENCEACK DataType = 10
+	// Data type for generic membership verification
+	MEMBERSHIP DataType = 11
+	// Data type for generic non-membership verification
+	NONMEMBERSHIP DataType = 12
 )
 
 var DataType_name = map[int32]string{
-	0: "DATA_TYPE_UNINITIALIZED_UNSPECIFIED",
-	1: "DATA_TYPE_CLIENT_STATE",
-	2: "DATA_TYPE_CONSENSUS_STATE",
-	3: "DATA_TYPE_CONNECTION_STATE",
-	4: "DATA_TYPE_CHANNEL_STATE",
-	5: "DATA_TYPE_PACKET_COMMITMENT",
-	6: "DATA_TYPE_PACKET_ACKNOWLEDGEMENT",
-	7: "DATA_TYPE_PACKET_RECEIPT_ABSENCE",
-	8: "DATA_TYPE_NEXT_SEQUENCE_RECV",
-	9: "DATA_TYPE_HEADER",
+	0:  "DATA_TYPE_UNINITIALIZED_UNSPECIFIED",
+	1:  "DATA_TYPE_CLIENT_STATE",
+	2:  "DATA_TYPE_CONSENSUS_STATE",
+	3:  "DATA_TYPE_CONNECTION_STATE",
+	4:  "DATA_TYPE_CHANNEL_STATE",
+	5:  "DATA_TYPE_PACKET_COMMITMENT",
+	6:  "DATA_TYPE_PACKET_ACKNOWLEDGEMENT",
+	7:  "DATA_TYPE_PACKET_RECEIPT_ABSENCE",
+	8:  "DATA_TYPE_NEXT_SEQUENCE_RECV",
+	9:  "DATA_TYPE_HEADER",
+	10: "DATA_TYPE_NEXT_SEQUENCE_ACK",
+	11: "DATA_TYPE_MEMBERSHIP",
+	12: "DATA_TYPE_NON_MEMBERSHIP",
 }
 
 var DataType_value = map[string]int32{
@@ -77,6 +86,9 @@ var DataType_value = map[string]int32{
 	"DATA_TYPE_PACKET_RECEIPT_ABSENCE":    7,
 	"DATA_TYPE_NEXT_SEQUENCE_RECV":        8,
 	"DATA_TYPE_HEADER":                    9,
+	"DATA_TYPE_NEXT_SEQUENCE_ACK":         10,
+	"DATA_TYPE_MEMBERSHIP":                11,
+	"DATA_TYPE_NON_MEMBERSHIP":            12,
 }
 
 func (x DataType) String() string {
@@ -98,6 +110,10 @@ type ClientState struct {
 	// when set to true, will allow governance to update a solo machine client.
 	// The client will be unfrozen if it is frozen.
 	AllowUpdateAfterProposal bool `protobuf:"varint,4,opt,name=allow_update_after_proposal,json=allowUpdateAfterProposal,proto3" json:"allow_update_after_proposal,omitempty" yaml:"allow_update_after_proposal"`
+	// when non-zero, proofs are signed independently of the sequence and are accepted
+	// if their timestamp is at most signature_window nanoseconds older than the
+	// consensus state timestamp.
+	SignatureWindow uint64 `protobuf:"varint,5,opt,name=signature_window,json=signatureWindow,proto3" json:"signature_window,omitempty" yaml:"signature_window"`
 }
 
 func (m *ClientState) Reset()         { *m = ClientState{} }
@@ -144,6 +160,8 @@ type ConsensusState struct {
 	// misbehaviour.
 	Diversifier string `protobuf:"bytes,2,opt,name=diversifier,proto3" json:"diversifier,omitempty"`
 	Timestamp   uint64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// weighted signer set of the solo machine, set instead of the public key
+	SignerSet *SignerSet `protobuf:"bytes,4,opt,name=signer_set,json=signerSet,proto3" json:"signer_set,omitempty" yaml:"signer_set"`
 }
 
 func (m *ConsensusState) Reset()         { *m = ConsensusState{} }
@@ -179,6 +197,85 @@ func (m *ConsensusState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ConsensusState proto.InternalMessageInfo
 
+// Signer defines a member of a solo machine signer set.
+type Signer struct {
+	PublicKey *types.Any `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty" yaml:"public_key"`
+	Weight    uint64     `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *Signer) Reset()         { *m = Signer{} }
+func (m *Signer) String() string { return proto.CompactTextString(m) }
+func (*Signer) ProtoMessage()    {}
+func (*Signer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141333b361aae010, []int{2}
+}
+func (m *Signer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Signer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Signer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Signer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Signer.Merge(m, src)
+}
+func (m *Signer) XXX_Size() int {
+	return m.Size()
+}
+func (m *Signer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Signer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Signer proto.InternalMessageInfo
+
+// SignerSet defines a weighted set of signers. A signature of the set is valid if
+// the summed weight of the signers that signed reaches the threshold.
+type SignerSet struct {
+	Signers   []Signer `protobuf:"bytes,1,rep,name=signers,proto3" json:"signers"`
+	Threshold uint64   `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (m *SignerSet) Reset()         { *m = SignerSet{} }
+func (m *SignerSet) String() string { return proto.CompactTextString(m) }
+func (*SignerSet) ProtoMessage()    {}
+func (*SignerSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141333b361aae010, []int{3}
+}
+func (m *SignerSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignerSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerSet.Merge(m, src)
+}
+func (m *SignerSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerSet proto.InternalMessageInfo
+
 // Header defines a solo machine consensus header
 type Header struct {
 	// sequence to update solo machine public key at
@@ -187,13 +284,17 @@ type Header struct {
 	Signature      []byte     `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
 	NewPublicKey   *types.Any `protobuf:"bytes,4,opt,name=new_public_key,json=newPublicKey,proto3" json:"new_public_key,omitempty" yaml:"new_public_key"`
 	NewDiversifier string     `protobuf:"bytes,5,opt,name=new_diversifier,json=newDiversifier,proto3" json:"new_diversifier,omitempty" yaml:"new_diversifier"`
+	// weighted signer set to rotate to, set instead of the new public key
+	NewSignerSet *SignerSet `protobuf:"bytes,6,opt,name=new_signer_set,json=newSignerSet,proto3" json:"new_signer_set,omitempty" yaml:"new_signer_set"`
+	// signature of the new signer set over the header sign bytes, approving the rotation
+	NewSignerSetSignature []byte `protobuf:"bytes,7,opt,name=new_signer_set_signature,json=newSignerSetSignature,proto3" json:"new_signer_set_signature,omitempty" yaml:"new_signer_set_signature"`
 }
 
 func (m *Header) Reset()         { *m = Header{} }
 func (m *Header) String() string { return proto.CompactTextString(m) }
 func (*Header) ProtoMessage()    {}
 func (*Header) Descriptor() ([]byte, []int) {
-	return fileDescriptor_141333b361aae010, []int{2}
+	return fileDescriptor_141333b361aae010, []int{4}
 }
 func (m *Header) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -235,7 +336,7 @@ func (m *Misbehaviour) Reset()         { *m = Misbehaviour{} }
 func (m *Misbehaviour) String() string { return proto.CompactTextString(m) }
 func (*Misbehaviour) ProtoMessage()    {}
 func (*Misbehaviour) Descriptor() ([]byte, []int) {
-	return fileDescriptor_141333b361aae010, []int{3}
+	return fileDescriptor_141333b361aae010, []int{5}
 }
 func (m *Misbehaviour) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -277,7 +378,7 @@ func (m *SignatureAndData) Reset()         { *m = SignatureAndData{} }
 func (m *SignatureAndData) String() string { return proto.CompactTextString(m) }
 func (*SignatureAndData) ProtoMessage()    {}
 func (*SignatureAndData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_141333b361aae010, []int{4}
+	return fileDescriptor_141333b361aae010, []int{6}
 }
 func (m *SignatureAndData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -317,7 +418,7 @@ func (m *TimestampedSignatureData) Reset()         { *m = TimestampedSignatureDa
 func (m *TimestampedSignatureData) String() string { return proto.CompactTextString(m) }
 func (*TimestampedSignatureData) ProtoMessage()    {}
 func (*TimestampedSignatureData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_141333b361aae010, []int{5}
+	return fileDescriptor_141333b361aae010, []int{7}
 }
 func (m *TimestampedSignatureData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -361,7 +462,7 @@ func (m *SignBytes) Reset()         { *m = SignBytes{} }
 func (m *SignBytes) String() string { return proto.CompactTextString(m) }
 func (*SignBytes) ProtoMessage()    {}
 func (*SignBytes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_141333b361aae010, []int{6}
+	return fileDescriptor_141333b361aae010, []int{8}
 }
 func (m *SignBytes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -396,13 +497,15 @@ type HeaderData struct {
 	NewPubKey *types.Any `protobuf:"bytes,1,opt,name=new_pub_key,json=newPubKey,proto3" json:"new_pub_key,omitempty" yaml:"new_pub_key"`
 	// header diversifier
 	NewDiversifier string `protobuf:"bytes,2,opt,name=new_diversifier,json=newDiversifier,proto3" json:"new_diversifier,omitempty" yaml:"new_diversifier"`
+	// header signer set
+	NewSignerSet *SignerSet `protobuf:"bytes,3,opt,name=new_signer_set,json=newSignerSet,proto3" json:"new_signer_set,omitempty" yaml:"new_signer_set"`
 }
 
 func (m *HeaderData) Reset()         { *m = HeaderData{} }
 func (m *HeaderData) String() string { return proto.CompactTextString(m) }
 func (*HeaderData) ProtoMessage()    {}
 func (*HeaderData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_141333b361aae010, []int{7}
+	return fileDescriptor_141333b361aae010, []int{9}
 }
 func (m *HeaderData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -441,7 +544,7 @@ func (m *ClientStateData) Reset()         { *m = ClientStateData{} }
 func (m *ClientStateData) String() string { return proto.CompactTextString(m) }
 func (*ClientStateData) ProtoMessage()    {}
 func (*ClientStateData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_141333b361aae010, []int{8}
+	return fileDescriptor_141333b361aae010, []int{10}
 }
 func (m *ClientStateData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -481,7 +584,7 @@ func (m *ConsensusStateData) Reset()         { *m = ConsensusStateData{} }
 func (m *ConsensusStateData) String() string { return proto.CompactTextString(m) }
 func (*ConsensusStateData) ProtoMessage()    {}
 func (*ConsensusStateData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_141333b361aae010, []int{9}
+	return fileDescriptor_141333b361aae010, []int{11}
 }
 func (m *ConsensusStateData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -521,7 +624,7 @@ func (m *ConnectionStateData) Reset()         { *m = ConnectionStateData{} }
 func (m *ConnectionStateData) String() string { return proto.CompactTextString(m) }
 func (*ConnectionStateData) ProtoMessage()    {}
 func (*ConnectionStateData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_141333b361aae010, []int{10}
+	return fileDescriptor_141333b361aae010, []int{12}
 }
 func (m *ConnectionStateData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -561,7 +664,7 @@ func (m *ChannelStateData) Reset()         { *m = ChannelStateData{} }
 func (m *ChannelStateData) String() string { return proto.CompactTextString(m) }
 func (*ChannelStateData) ProtoMessage()    {}
 func (*ChannelStateData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_141333b361aae010, []int{11}
+	return fileDescriptor_141333b361aae010, []int{13}
 }
 func (m *ChannelStateData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -601,7 +704,7 @@ func (m *PacketCommitmentData) Reset()         { *m = PacketCommitmentData{} }
 func (m *PacketCommitmentData) String() string { return proto.CompactTextString(m) }
 func (*PacketCommitmentData) ProtoMessage()    {}
 func (*PacketCommitmentData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_141333b361aae010, []int{12}
+	return fileDescriptor_141333b361aae010, []int{14}
 }
 func (m *PacketCommitmentData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -655,7 +758,7 @@ func (m *PacketAcknowledgementData) Reset()         { *m = PacketAcknowledgement
 func (m *PacketAcknowledgementData) String() string { return proto.CompactTextString(m) }
 func (*PacketAcknowledgementData) ProtoMessage()    {}
 func (*PacketAcknowledgementData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_141333b361aae010, []int{13}
+	return fileDescriptor_141333b361aae010, []int{15}
 }
 func (m *PacketAcknowledgementData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -708,7 +811,7 @@ func (m *PacketReceiptAbsenceData) Reset()         { *m = PacketReceiptAbsenceDa
 func (m *PacketReceiptAbsenceData) String() string { return proto.CompactTextString(m) }
 func (*PacketReceiptAbsenceData) ProtoMessage()    {}
 func (*PacketReceiptAbsenceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_141333b361aae010, []int{14}
+	return fileDescriptor_141333b361aae010, []int{16}
 }
 func (m *PacketReceiptAbsenceData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -755,7 +858,7 @@ func (m *NextSequenceRecvData) Reset()         { *m = NextSequenceRecvData{} }
 func (m *NextSequenceRecvData) String() string { return proto.CompactTextString(m) }
 func (*NextSequenceRecvData) ProtoMessage()    {}
 func (*NextSequenceRecvData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_141333b361aae010, []int{15}
+	return fileDescriptor_141333b361aae010, []int{17}
 }
 func (m *NextSequenceRecvData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -798,10 +901,120 @@ func (m *NextSequenceRecvData) GetNextSeqRecv() uint64 {
 	return 0
 }
 
+// NextSequenceAckData returns the SignBytes data for verification of the next
+// sequence to be acknowledged.
+type NextSequenceAckData struct {
+	Path       []byte `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	NextSeqAck uint64 `protobuf:"varint,2,opt,name=next_seq_ack,json=nextSeqAck,proto3" json:"next_seq_ack,omitempty" yaml:"next_seq_ack"`
+}
+
+func (m *NextSequenceAckData) Reset()         { *m = NextSequenceAckData{} }
+func (m *NextSequenceAckData) String() string { return proto.CompactTextString(m) }
+func (*NextSequenceAckData) ProtoMessage()    {}
+func (*NextSequenceAckData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141333b361aae010, []int{18}
+}
+func (m *NextSequenceAckData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NextSequenceAckData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NextSequenceAckData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NextSequenceAckData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NextSequenceAckData.Merge(m, src)
+}
+func (m *NextSequenceAckData) XXX_Size() int {
+	return m.Size()
+}
+func (m *NextSequenceAckData) XXX_DiscardUnknown() {
+	xxx_messageInfo_NextSequenceAckData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NextSequenceAckData proto.InternalMessageInfo
+
+func (m *NextSequenceAckData) GetPath() []byte {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+func (m *NextSequenceAckData) GetNextSeqAck() uint64 {
+	if m != nil {
+		return m.NextSeqAck
+	}
+	return 0
+}
+
+// MembershipData returns the SignBytes data for generic membership and
+// non-membership verification. The value is empty for non-membership.
+type MembershipData struct {
+	Path  []byte `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *MembershipData) Reset()         { *m = MembershipData{} }
+func (m *MembershipData) String() string { return proto.CompactTextString(m) }
+func (*MembershipData) ProtoMessage()    {}
+func (*MembershipData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141333b361aae010, []int{19}
+}
+func (m *MembershipData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MembershipData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MembershipData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MembershipData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MembershipData.Merge(m, src)
+}
+func (m *MembershipData) XXX_Size() int {
+	return m.Size()
+}
+func (m *MembershipData) XXX_DiscardUnknown() {
+	xxx_messageInfo_MembershipData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MembershipData proto.InternalMessageInfo
+
+func (m *MembershipData) GetPath() []byte {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+func (m *MembershipData) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("ibc.lightclients.solomachine.v2.DataType", DataType_name, DataType_value)
 	proto.RegisterType((*ClientState)(nil), "ibc.lightclients.solomachine.v2.ClientState")
 	proto.RegisterType((*ConsensusState)(nil), "ibc.lightclients.solomachine.v2.ConsensusState")
+	proto.RegisterType((*Signer)(nil), "ibc.lightclients.solomachine.v2.Signer")
+	proto.RegisterType((*SignerSet)(nil), "ibc.lightclients.solomachine.v2.SignerSet")
 	proto.RegisterType((*Header)(nil), "ibc.lightclients.solomachine.v2.Header")
 	proto.RegisterType((*Misbehaviour)(nil), "ibc.lightclients.solomachine.v2.Misbehaviour")
 	proto.RegisterType((*SignatureAndData)(nil), "ibc.lightclients.solomachine.v2.SignatureAndData")
@@ -816,6 +1029,8 @@ func init() {
 	proto.RegisterType((*PacketAcknowledgementData)(nil), "ibc.lightclients.solomachine.v2.PacketAcknowledgementData")
 	proto.RegisterType((*PacketReceiptAbsenceData)(nil), "ibc.lightclients.solomachine.v2.PacketReceiptAbsenceData")
 	proto.RegisterType((*NextSequenceRecvData)(nil), "ibc.lightclients.solomachine.v2.NextSequenceRecvData")
+	proto.RegisterType((*NextSequenceAckData)(nil), "ibc.lightclients.solomachine.v2.NextSequenceAckData")
+	proto.RegisterType((*MembershipData)(nil), "ibc.lightclients.solomachine.v2.MembershipData")
 }
 
 func init() {
@@ -823,93 +1038,112 @@ func init() {
 }
 
 var fileDescriptor_141333b361aae010 = []byte{
-	// 1370 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x5f, 0x8f, 0xdb, 0x44,
-	0x10, 0x3f, 0xa7, 0xe9, 0xf5, 0x32, 0xb9, 0xde, 0x05, 0x37, 0x6d, 0x73, 0x6e, 0x95, 0x18, 0x23,
-	0xca, 0x81, 0x68, 0xc2, 0x5d, 0x45, 0x85, 0x2a, 0x04, 0x38, 0x8e, 0x4b, 0xd3, 0xde, 0xf9, 0x82,
-	0xe3, 0x03, 0x5a, 0x21, 0x19, 0xc7, 0xd9, 0x4b, 0xac, 0x26, 0xde, 0x34, 0x76, 0x92, 0x06, 0x09,
-	0x09, 0xf1, 0x54, 0x22, 0x1e, 0xf8, 0x02, 0x91, 0x10, 0x88, 0xcf, 0xc1, 0x1b, 0xf0, 0xd8, 0x47,
-	0x9e, 0x02, 0x6a, 0xbf, 0x41, 0x3e, 0x01, 0xb2, 0x77, 0x13, 0xdb, 0xb9, 0x5e, 0x4e, 0xfc, 0x7b,
-	0xdb, 0x9d, 0xdf, 0xcc, 0x6f, 0x66, 0x67, 0xc6, 0xb3, 0x6b, 0xd8, 0xb1, 0x6a, 0x66, 0xa1, 0x65,
-	0x35, 0x9a, 0xae, 0xd9, 0xb2, 0x90, 0xed, 0x3a, 0x05, 0x07, 0xb7, 0x70, 0xdb, 0x30, 0x9b, 0x96,
-	0x8d, 0x0a, 0xfd, 0xdd, 0xf0, 0x36, 0xdf, 0xe9, 0x62, 0x17, 0xb3, 0x39, 0xab, 0x66, 0xe6, 0xc3,
-	0x26, 0xf9, 0xb0, 0x4e, 0x7f, 0x97, 0x7b, 0xcd, 0xe3, 0x34, 0x71, 0x17, 0x15, 0x4c, 0x6c, 0xdb,
-	0xc8, 0x74, 0x2d, 0x6c, 0x17, 0xfa, 0x3b, 0xa1, 0x1d, 0x61, 0xe2, 0x5e, 0x0e, 0x14, 0x9b, 0x86,
-	0x6d, 0xa3, 0x96, 0xaf, 0x45, 0x96, 0x54, 0x25, 0xdd, 0xc0, 0x0d, 0xec, 0x2f, 0x0b, 0xde, 0x8a,
-	0x4a, 0xb7, 0x1a, 0x18, 0x37, 0x5a, 0xa8, 0xe0, 0xef, 0x6a, 0xbd, 0xa3, 0x82, 0x61, 0x0f, 0x09,
-	0x24, 0xfc, 0x1c, 0x83, 0xa4, 0xe4, 0xc7, 0x55, 0x75, 0x0d, 0x17, 0xb1, 0x1c, 0xac, 0x39, 0xe8,
-	0x51, 0x0f, 0xd9, 0x26, 0xca, 0x30, 0x3c, 0xb3, 0x1d, 0x57, 0xe7, 0x7b, 0x76, 0x07, 0x12, 0x96,
-	0xa3, 0x1f, 0x75, 0xf1, 0x17, 0xc8, 0xce, 0xc4, 0x78, 0x66, 0x7b, 0xad, 0x98, 0x9e, 0x4e, 0x72,
-	0xa9, 0xa1, 0xd1, 0x6e, 0xdd, 0x12, 0xe6, 0x90, 0xa0, 0xae, 0x59, 0xce, 0x6d, 0x7f, 0xc9, 0xba,
-	0xb0, 0x69, 0x62, 0xdb, 0x41, 0xb6, 0xd3, 0x73, 0x74, 0xc7, 0xf3, 0x90, 0x39, 0xc3, 0x33, 0xdb,
-	0xc9, 0xdd, 0x42, 0xfe, 0x94, 0xb4, 0xe4, 0xa5, 0x99, 0x9d, 0x1f, 0x58, 0x91, 0x9b, 0x4e, 0x72,
-	0x97, 0x88, 0xa7, 0x05, 0x46, 0x41, 0xdd, 0x30, 0x23, 0xba, 0x2c, 0x82, 0x2b, 0x46, 0xab, 0x85,
-	0x07, 0x7a, 0xaf, 0x53, 0x37, 0x5c, 0xa4, 0x1b, 0x47, 0x2e, 0xea, 0xea, 0x9d, 0x2e, 0xee, 0x60,
-	0xc7, 0x68, 0x65, 0xe2, 0x7e, 0xe8, 0xd7, 0xa6, 0x93, 0x9c, 0x40, 0x08, 0x97, 0x28, 0x0b, 0x6a,
-	0xc6, 0x47, 0x0f, 0x7d, 0x50, 0xf4, 0xb0, 0x0a, 0x85, 0x6e, 0xc5, 0x9f, 0x7c, 0x9f, 0x5b, 0x11,
-	0x7e, 0x60, 0x60, 0x23, 0x1a, 0x2b, 0x7b, 0x17, 0xa0, 0xd3, 0xab, 0xb5, 0x2c, 0x53, 0x7f, 0x88,
-	0x86, 0x7e, 0x1a, 0x93, 0xbb, 0xe9, 0x3c, 0x29, 0x42, 0x7e, 0x56, 0x84, 0xbc, 0x68, 0x0f, 0x8b,
-	0x17, 0xa7, 0x93, 0xdc, 0x4b, 0x24, 0x88, 0xc0, 0x42, 0x50, 0x13, 0x64, 0x73, 0x0f, 0x0d, 0x59,
-	0x1e, 0x92, 0x75, 0xab, 0x8f, 0xba, 0x8e, 0x75, 0x64, 0xa1, 0xae, 0x9f, 0xf6, 0x84, 0x1a, 0x16,
-	0xb1, 0x57, 0x21, 0xe1, 0x5a, 0x6d, 0xe4, 0xb8, 0x46, 0xbb, 0xe3, 0x67, 0x37, 0xae, 0x06, 0x02,
-	0x1a, 0xe4, 0xd7, 0x31, 0x58, 0xbd, 0x83, 0x8c, 0x3a, 0xea, 0x2e, 0xad, 0x70, 0x84, 0x2a, 0xb6,
-	0x40, 0xe5, 0xa1, 0x8e, 0xd5, 0xb0, 0x0d, 0xb7, 0xd7, 0x25, 0x65, 0x5c, 0x57, 0x03, 0x01, 0x7b,
-	0x08, 0x1b, 0x36, 0x1a, 0xe8, 0xa1, 0x83, 0xc7, 0x97, 0x1c, 0x7c, 0x6b, 0x3a, 0xc9, 0x5d, 0x24,
-	0x07, 0x8f, 0x5a, 0x09, 0xea, 0xba, 0x8d, 0x06, 0x95, 0xf9, 0xf9, 0x25, 0xd8, 0xf4, 0x14, 0xc2,
-	0x39, 0x38, 0xeb, 0xe5, 0x20, 0xdc, 0x10, 0x0b, 0x0a, 0x82, 0xea, 0x45, 0x52, 0x0a, 0x04, 0x34,
-	0x09, 0xbf, 0xc6, 0x60, 0x7d, 0xdf, 0x72, 0x6a, 0xa8, 0x69, 0xf4, 0x2d, 0xdc, 0xeb, 0x7a, 0x0d,
-	0x4d, 0x9a, 0x4f, 0xb7, 0xea, 0x7e, 0x2e, 0x12, 0xe1, 0x86, 0x9e, 0x43, 0x82, 0xba, 0x46, 0xd6,
-	0xe5, 0x7a, 0x24, 0x7b, 0xb1, 0x85, 0xec, 0x75, 0xe0, 0xfc, 0x3c, 0x1d, 0x3a, 0xb6, 0x67, 0xad,
-	0xbe, 0x73, 0x6a, 0xab, 0x57, 0x67, 0x56, 0xa2, 0x5d, 0x2f, 0x19, 0xae, 0x51, 0xcc, 0x4c, 0x27,
-	0xb9, 0x34, 0x89, 0x22, 0xc2, 0x28, 0xa8, 0xeb, 0xf3, 0xfd, 0x81, 0xbd, 0xe0, 0xd1, 0x1d, 0x60,
-	0x9a, 0xf2, 0xff, 0xca, 0xa3, 0x3b, 0xc0, 0x61, 0x8f, 0xda, 0x00, 0xd3, 0x4c, 0xfe, 0xc2, 0x40,
-	0x6a, 0x91, 0x22, 0xda, 0x1e, 0xcc, 0x62, 0x7b, 0x7c, 0x06, 0x89, 0xba, 0xe1, 0x1a, 0xba, 0x3b,
-	0xec, 0x90, 0xcc, 0x6d, 0xec, 0xbe, 0x7e, 0x6a, 0x98, 0x1e, 0xaf, 0x36, 0xec, 0xa0, 0x70, 0x59,
-	0xe6, 0x2c, 0x82, 0xba, 0x56, 0xa7, 0x38, 0xcb, 0x42, 0xdc, 0x5b, 0xd3, 0xae, 0xf4, 0xd7, 0xd1,
-	0x66, 0x8e, 0xbf, 0xf8, 0xbb, 0xf8, 0x8a, 0x81, 0x8c, 0x36, 0x93, 0xa1, 0xfa, 0xfc, 0x4c, 0xfe,
-	0x81, 0x3e, 0x80, 0x8d, 0x20, 0x17, 0x3e, 0xbd, 0x7f, 0xaa, 0x70, 0xef, 0x46, 0x71, 0x41, 0x0d,
-	0xca, 0x51, 0x3a, 0x16, 0x42, 0xec, 0xc5, 0x21, 0xfc, 0xc1, 0x40, 0xc2, 0xf3, 0x5b, 0x1c, 0xba,
-	0xc8, 0xf9, 0x17, 0x5f, 0xe7, 0xc2, 0xa0, 0x38, 0x73, 0x7c, 0x50, 0x44, 0x4a, 0x10, 0xff, 0xbf,
-	0x4a, 0x70, 0x36, 0x28, 0x01, 0x3d, 0xe1, 0x4f, 0x0c, 0x00, 0x19, 0x3e, 0x7e, 0x52, 0xf6, 0x20,
-	0x49, 0x3f, 0xf9, 0x53, 0xc7, 0xe3, 0xa5, 0xe9, 0x24, 0xc7, 0x46, 0xa6, 0x04, 0x9d, 0x8f, 0x64,
-	0x44, 0x9c, 0x30, 0x1f, 0x62, 0xff, 0x70, 0x3e, 0x7c, 0x09, 0x9b, 0xa1, 0xab, 0xd0, 0x8f, 0x95,
-	0x85, 0x78, 0xc7, 0x70, 0x9b, 0xb4, 0x9d, 0xfd, 0x35, 0x5b, 0x81, 0x75, 0x3a, 0x1a, 0xc8, 0x85,
-	0x16, 0x5b, 0x72, 0x80, 0xcb, 0xd3, 0x49, 0xee, 0x42, 0x64, 0x9c, 0xd0, 0x2b, 0x2b, 0x69, 0x06,
-	0x9e, 0xa8, 0xfb, 0x6f, 0x18, 0x60, 0xa3, 0x17, 0xc9, 0x89, 0x21, 0xdc, 0x3f, 0x7e, 0xad, 0x2e,
-	0x8b, 0xe2, 0x6f, 0xdc, 0x9d, 0x34, 0x96, 0x3e, 0x5c, 0x90, 0xe6, 0xcf, 0x8f, 0xe5, 0xb1, 0xc8,
-	0x00, 0xc1, 0x4b, 0x85, 0x86, 0xf1, 0xaa, 0xdf, 0x56, 0xde, 0x53, 0x25, 0x1f, 0x7a, 0xc5, 0xf4,
-	0x77, 0xf2, 0x01, 0xa9, 0x6c, 0xd7, 0xd5, 0x90, 0x21, 0xf5, 0x5b, 0x87, 0x94, 0x44, 0x1e, 0x34,
-	0xcb, 0x9d, 0xde, 0x84, 0x73, 0xf4, 0xe1, 0x43, 0x3d, 0x5e, 0x0d, 0x79, 0xa4, 0x2f, 0x22, 0xcf,
-	0x1d, 0x59, 0xaa, 0x33, 0x65, 0xea, 0xe5, 0x2e, 0xa4, 0x2b, 0x86, 0xf9, 0x10, 0xb9, 0x12, 0x6e,
-	0xb7, 0x2d, 0xb7, 0x8d, 0x6c, 0xf7, 0x44, 0x4f, 0x59, 0xef, 0x78, 0x33, 0x2d, 0xdf, 0xd9, 0xba,
-	0x1a, 0x92, 0x08, 0xf7, 0x61, 0x8b, 0x70, 0x89, 0xe6, 0x43, 0x1b, 0x0f, 0x5a, 0xa8, 0xde, 0x40,
-	0x4b, 0x09, 0xb7, 0x61, 0xd3, 0x88, 0xaa, 0x52, 0xd6, 0x45, 0xb1, 0x90, 0x87, 0x0c, 0xa1, 0x56,
-	0x91, 0x89, 0xac, 0x8e, 0x2b, 0xd6, 0x1c, 0x6f, 0x0e, 0x9c, 0xc4, 0x2c, 0x34, 0x21, 0xad, 0xa0,
-	0xc7, 0x6e, 0x95, 0xce, 0x0b, 0x15, 0x99, 0xfd, 0x13, 0xa3, 0x78, 0x17, 0xce, 0xdb, 0xe8, 0xb1,
-	0xab, 0x3b, 0xe8, 0x91, 0xde, 0x45, 0x66, 0x9f, 0xcc, 0x93, 0xf0, 0x35, 0x10, 0x81, 0x05, 0x35,
-	0x69, 0x13, 0x6a, 0x8f, 0xf5, 0x8d, 0x6f, 0xe3, 0xb0, 0x36, 0x1b, 0x0c, 0xec, 0x3b, 0xf0, 0x4a,
-	0x49, 0xd4, 0x44, 0x5d, 0xbb, 0x5f, 0x91, 0xf5, 0x43, 0xa5, 0xac, 0x94, 0xb5, 0xb2, 0xb8, 0x57,
-	0x7e, 0x20, 0x97, 0xf4, 0x43, 0xa5, 0x5a, 0x91, 0xa5, 0xf2, 0xed, 0xb2, 0x5c, 0x4a, 0xad, 0x70,
-	0x9b, 0xa3, 0x31, 0x9f, 0x0c, 0x89, 0xd8, 0x6b, 0x70, 0x29, 0xb0, 0x94, 0xf6, 0xca, 0xb2, 0xa2,
-	0xe9, 0x55, 0x4d, 0xd4, 0xe4, 0x14, 0xc3, 0xc1, 0x68, 0xcc, 0xaf, 0x12, 0x19, 0xfb, 0x26, 0x6c,
-	0x85, 0xf4, 0x0e, 0x94, 0xaa, 0xac, 0x54, 0x0f, 0xab, 0x54, 0x35, 0xc6, 0x9d, 0x1f, 0x8d, 0xf9,
-	0xc4, 0x5c, 0xcc, 0xe6, 0x81, 0x8b, 0x68, 0x2b, 0xb2, 0xa4, 0x95, 0x0f, 0x14, 0xaa, 0x7e, 0x86,
-	0xdb, 0x18, 0x8d, 0x79, 0x08, 0xe4, 0xec, 0x36, 0x5c, 0x0e, 0xe9, 0xdf, 0x11, 0x15, 0x45, 0xde,
-	0xa3, 0xca, 0x71, 0x2e, 0x39, 0x1a, 0xf3, 0xe7, 0xa8, 0x90, 0x7d, 0x1b, 0xae, 0x04, 0x9a, 0x15,
-	0x51, 0xba, 0x27, 0x6b, 0xba, 0x74, 0xb0, 0xbf, 0x5f, 0xd6, 0xf6, 0x65, 0x45, 0x4b, 0x9d, 0xe5,
-	0xd2, 0xa3, 0x31, 0x9f, 0x22, 0x40, 0x20, 0x67, 0xdf, 0x07, 0xfe, 0x98, 0x99, 0x28, 0xdd, 0x53,
-	0x0e, 0x3e, 0xd9, 0x93, 0x4b, 0x1f, 0xca, 0xbe, 0xed, 0x2a, 0xb7, 0x35, 0x1a, 0xf3, 0x17, 0x09,
-	0xba, 0x00, 0xb2, 0xef, 0xbd, 0x80, 0x40, 0x95, 0x25, 0xb9, 0x5c, 0xd1, 0x74, 0xb1, 0x58, 0x95,
-	0x15, 0x49, 0x4e, 0x9d, 0xe3, 0x32, 0xa3, 0x31, 0x9f, 0x26, 0x28, 0x05, 0x29, 0xc6, 0xde, 0x84,
-	0xab, 0x81, 0xbd, 0x22, 0x7f, 0xaa, 0xe9, 0x55, 0xf9, 0xa3, 0x43, 0x0f, 0xf2, 0x68, 0x3e, 0x4e,
-	0xad, 0x91, 0xc0, 0x3d, 0x64, 0x06, 0x78, 0x72, 0x96, 0x87, 0x54, 0x60, 0x77, 0x47, 0x16, 0x4b,
-	0xb2, 0x9a, 0x4a, 0x90, 0xca, 0x90, 0x1d, 0x17, 0x7f, 0xf2, 0x63, 0x76, 0xa5, 0xf8, 0xf9, 0x6f,
-	0xcf, 0xb2, 0xcc, 0xd3, 0x67, 0x59, 0xe6, 0xcf, 0x67, 0x59, 0xe6, 0xbb, 0xe7, 0xd9, 0x95, 0xa7,
-	0xcf, 0xb3, 0x2b, 0xbf, 0x3f, 0xcf, 0xae, 0x3c, 0xb8, 0xdd, 0xb0, 0xdc, 0x66, 0xaf, 0x96, 0x37,
-	0x71, 0xbb, 0x60, 0x62, 0xa7, 0x8d, 0x9d, 0x82, 0x55, 0x33, 0xaf, 0x37, 0x70, 0xa1, 0x7f, 0xa3,
-	0xd0, 0xc6, 0xf5, 0x5e, 0x0b, 0x39, 0xe4, 0x7f, 0xea, 0xfa, 0xec, 0x87, 0xea, 0xad, 0x9b, 0xd7,
-	0xc3, 0xff, 0x54, 0xde, 0x35, 0xe3, 0xd4, 0x56, 0xfd, 0x79, 0x76, 0xe3, 0xaf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x5d, 0xd4, 0x6c, 0xfb, 0x80, 0x0d, 0x00, 0x00,
+	// 1667 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x5f, 0x6f, 0xe2, 0xd8,
+	0x15, 0x0f, 0x84, 0xfc, 0xe1, 0x40, 0x12, 0xf6, 0x0e, 0x33, 0x43, 0x3c, 0x23, 0x70, 0xbd, 0xea,
+	0x6e, 0xba, 0xea, 0x40, 0x93, 0x6d, 0x47, 0xed, 0xa8, 0x6a, 0x6b, 0x1c, 0x67, 0xc3, 0x26, 0x71,
+	0xa8, 0x21, 0xdd, 0xce, 0x6a, 0x25, 0xaf, 0xb1, 0x6f, 0xc0, 0x0a, 0xd8, 0x2c, 0x36, 0x30, 0x54,
+	0xaa, 0xd4, 0x87, 0x4a, 0xdd, 0xf2, 0x54, 0xf5, 0x1d, 0xa9, 0x52, 0xbf, 0x4b, 0xbb, 0x2f, 0x95,
+	0xf6, 0xb1, 0x4f, 0xb4, 0x9a, 0xf9, 0x06, 0x7c, 0x82, 0xca, 0xbe, 0x17, 0x7c, 0xcd, 0x6c, 0xc8,
+	0xae, 0x3a, 0xf3, 0x76, 0xef, 0xf9, 0xf3, 0xfb, 0x9d, 0x7b, 0xce, 0xbd, 0xc7, 0x47, 0x86, 0x43,
+	0xab, 0x61, 0x94, 0xda, 0x56, 0xb3, 0xe5, 0x19, 0x6d, 0x0b, 0xdb, 0x9e, 0x5b, 0x72, 0x9d, 0xb6,
+	0xd3, 0xd1, 0x8d, 0x96, 0x65, 0xe3, 0xd2, 0xe0, 0x88, 0xdd, 0x16, 0xbb, 0x3d, 0xc7, 0x73, 0x50,
+	0xc1, 0x6a, 0x18, 0x45, 0xd6, 0xa5, 0xc8, 0xda, 0x0c, 0x8e, 0xb8, 0xf7, 0x7d, 0x4c, 0xc3, 0xe9,
+	0xe1, 0x92, 0xe1, 0xd8, 0x36, 0x36, 0x3c, 0xcb, 0xb1, 0x4b, 0x83, 0x43, 0x66, 0x47, 0x90, 0xb8,
+	0xef, 0x85, 0x86, 0x2d, 0xdd, 0xb6, 0x71, 0x3b, 0xb0, 0x22, 0x4b, 0x6a, 0x92, 0x6d, 0x3a, 0x4d,
+	0x27, 0x58, 0x96, 0xfc, 0x15, 0x95, 0xee, 0x37, 0x1d, 0xa7, 0xd9, 0xc6, 0xa5, 0x60, 0xd7, 0xe8,
+	0x5f, 0x97, 0x74, 0x7b, 0x44, 0x54, 0xc2, 0x9f, 0xd6, 0x21, 0x25, 0x05, 0x71, 0xd5, 0x3c, 0xdd,
+	0xc3, 0x88, 0x83, 0x6d, 0x17, 0x7f, 0xd1, 0xc7, 0xb6, 0x81, 0x73, 0x31, 0x3e, 0x76, 0x90, 0x50,
+	0x17, 0x7b, 0x74, 0x08, 0x49, 0xcb, 0xd5, 0xae, 0x7b, 0xce, 0xef, 0xb0, 0x9d, 0x8b, 0xf3, 0xb1,
+	0x83, 0xed, 0x72, 0x76, 0x36, 0x2d, 0x64, 0x46, 0x7a, 0xa7, 0xfd, 0x4c, 0x58, 0xa8, 0x04, 0x75,
+	0xdb, 0x72, 0x4f, 0x82, 0x25, 0xf2, 0x60, 0xcf, 0x70, 0x6c, 0x17, 0xdb, 0x6e, 0xdf, 0xd5, 0x5c,
+	0x9f, 0x21, 0xb7, 0xce, 0xc7, 0x0e, 0x52, 0x47, 0xa5, 0xe2, 0x1d, 0x69, 0x29, 0x4a, 0x73, 0xbf,
+	0x20, 0xb0, 0x32, 0x37, 0x9b, 0x16, 0x1e, 0x10, 0xa6, 0x25, 0x44, 0x41, 0xdd, 0x35, 0x22, 0xb6,
+	0x08, 0xc3, 0x23, 0xbd, 0xdd, 0x76, 0x86, 0x5a, 0xbf, 0x6b, 0xea, 0x1e, 0xd6, 0xf4, 0x6b, 0x0f,
+	0xf7, 0xb4, 0x6e, 0xcf, 0xe9, 0x3a, 0xae, 0xde, 0xce, 0x25, 0x82, 0xd0, 0xdf, 0x9b, 0x4d, 0x0b,
+	0x02, 0x01, 0x5c, 0x61, 0x2c, 0xa8, 0xb9, 0x40, 0x7b, 0x15, 0x28, 0x45, 0x5f, 0x57, 0xa5, 0x2a,
+	0x74, 0x02, 0x19, 0xd7, 0x6a, 0xda, 0xba, 0xd7, 0xef, 0x61, 0x6d, 0x68, 0xd9, 0xa6, 0x33, 0xcc,
+	0x6d, 0xf8, 0x39, 0x2b, 0x3f, 0x9a, 0x4d, 0x0b, 0x0f, 0x09, 0xf6, 0xb2, 0x85, 0xa0, 0xee, 0x2d,
+	0x44, 0x9f, 0x04, 0x92, 0x67, 0x89, 0x2f, 0xff, 0x56, 0x58, 0x13, 0xfe, 0x18, 0x87, 0xdd, 0xe8,
+	0x99, 0xd1, 0xc7, 0x00, 0xdd, 0x7e, 0xa3, 0x6d, 0x19, 0xda, 0x0d, 0x1e, 0x05, 0xe5, 0x48, 0x1d,
+	0x65, 0x8b, 0xa4, 0x98, 0xc5, 0x79, 0x31, 0x8b, 0xa2, 0x3d, 0x2a, 0xdf, 0x9f, 0x4d, 0x0b, 0xef,
+	0x10, 0xc2, 0xd0, 0x43, 0x50, 0x93, 0x64, 0x73, 0x86, 0x47, 0x88, 0x87, 0x94, 0x69, 0x0d, 0x70,
+	0xcf, 0xb5, 0xae, 0x2d, 0xdc, 0x0b, 0xca, 0x97, 0x54, 0x59, 0x11, 0x7a, 0x0c, 0x49, 0xcf, 0xea,
+	0x60, 0xd7, 0xd3, 0x3b, 0xdd, 0xa0, 0x4a, 0x09, 0x35, 0x14, 0xa0, 0xcf, 0x01, 0xfc, 0xb8, 0x71,
+	0x4f, 0x73, 0xb1, 0x17, 0xa4, 0x30, 0x75, 0xf4, 0xc1, 0x9d, 0x45, 0xac, 0x05, 0x2e, 0x35, 0xec,
+	0xb1, 0x11, 0x86, 0x38, 0x82, 0x9a, 0x74, 0xe7, 0x16, 0x34, 0x0d, 0x3d, 0xd8, 0x24, 0x4e, 0x6f,
+	0xf4, 0xf4, 0x0f, 0x60, 0x73, 0x88, 0xfd, 0x38, 0x83, 0x83, 0x27, 0x54, 0xba, 0xa3, 0x9c, 0x2f,
+	0x20, 0xb9, 0x08, 0x14, 0x7d, 0x04, 0x5b, 0x24, 0x26, 0x37, 0x17, 0xe3, 0xd7, 0x0f, 0x52, 0x47,
+	0xef, 0x7f, 0xcb, 0x53, 0x96, 0x13, 0x5f, 0x4d, 0x0b, 0x6b, 0xea, 0xdc, 0x3b, 0xc8, 0x67, 0xab,
+	0x87, 0xdd, 0x96, 0xd3, 0x36, 0x29, 0x6d, 0x28, 0xa0, 0xcc, 0xff, 0x5a, 0x87, 0xcd, 0x53, 0xac,
+	0x9b, 0xb8, 0xb7, 0xf2, 0xe5, 0x45, 0x4a, 0x13, 0x5f, 0x2e, 0xcd, 0x63, 0x48, 0x2e, 0xae, 0x54,
+	0x50, 0xb8, 0xb4, 0x1a, 0x0a, 0xd0, 0x15, 0xec, 0xda, 0x78, 0xa8, 0x31, 0xa9, 0x4c, 0xac, 0x48,
+	0xe5, 0xfe, 0x6c, 0x5a, 0xb8, 0x4f, 0x52, 0x19, 0xf5, 0x12, 0xd4, 0xb4, 0x8d, 0x87, 0xd5, 0x45,
+	0x46, 0x25, 0xd8, 0xf3, 0x0d, 0xd8, 0x3b, 0xe5, 0xdf, 0xfd, 0x24, 0xfb, 0x50, 0x97, 0x0c, 0x04,
+	0xd5, 0x8f, 0xe4, 0x38, 0x14, 0xa0, 0x1b, 0x12, 0x1b, 0x73, 0xb1, 0x36, 0xbf, 0xf3, 0xc5, 0x5a,
+	0x8a, 0x98, 0xbd, 0x5c, 0x7e, 0xc4, 0x61, 0x61, 0x3f, 0x83, 0x5c, 0xd4, 0x40, 0x0b, 0xb3, 0xb6,
+	0xe5, 0x67, 0xad, 0xfc, 0xee, 0x6c, 0x5a, 0x28, 0x7c, 0x13, 0x54, 0x68, 0x29, 0xa8, 0xf7, 0x59,
+	0xd0, 0xda, 0x5c, 0x4e, 0xeb, 0xf9, 0xcf, 0x38, 0xa4, 0x2f, 0x2c, 0xb7, 0x81, 0x5b, 0xfa, 0xc0,
+	0x72, 0xfa, 0x3d, 0xbf, 0x67, 0x92, 0x13, 0x68, 0x96, 0x19, 0x94, 0x35, 0xc9, 0xf6, 0xcc, 0x85,
+	0x4a, 0x50, 0xb7, 0xc9, 0xba, 0x62, 0x46, 0x2e, 0x42, 0x7c, 0xe9, 0x22, 0x74, 0x61, 0x27, 0x6c,
+	0x28, 0x8e, 0x3d, 0xef, 0xa6, 0x87, 0xdf, 0x2a, 0x5f, 0x81, 0x97, 0x68, 0x9b, 0xc7, 0xba, 0xa7,
+	0x97, 0x73, 0xb3, 0x69, 0x21, 0xbb, 0xdc, 0xa2, 0x1c, 0x1b, 0x0b, 0x6a, 0x7a, 0xb1, 0xbf, 0xb4,
+	0x97, 0x18, 0xbd, 0xa1, 0x93, 0x4b, 0xbc, 0x51, 0x46, 0x6f, 0xe8, 0xb0, 0x8c, 0xf5, 0xa1, 0x43,
+	0x33, 0xf9, 0x8f, 0x18, 0x64, 0x96, 0x21, 0xa2, 0x37, 0x3d, 0xb6, 0x7c, 0xd3, 0x3f, 0x83, 0xa4,
+	0xa9, 0x7b, 0xba, 0xe6, 0x8d, 0xba, 0x24, 0x73, 0xbb, 0x47, 0x3f, 0xb8, 0x33, 0x4c, 0x1f, 0xb7,
+	0x3e, 0xea, 0x62, 0xb6, 0x2c, 0x0b, 0x14, 0x41, 0xdd, 0x36, 0xa9, 0x1e, 0x21, 0x48, 0xf8, 0x6b,
+	0xfa, 0xc0, 0x12, 0x26, 0x8d, 0x27, 0x7c, 0x97, 0x89, 0xa5, 0x77, 0x49, 0x0f, 0xf2, 0x87, 0x18,
+	0xe4, 0xea, 0x73, 0x19, 0x36, 0x17, 0x67, 0x0a, 0x0e, 0xf4, 0x2b, 0xd8, 0x0d, 0x73, 0x11, 0xc0,
+	0x07, 0xa7, 0x62, 0x2f, 0x75, 0x54, 0x2f, 0xa8, 0x3b, 0x6e, 0x04, 0x61, 0x65, 0x6b, 0xa0, 0x21,
+	0xfc, 0x27, 0x46, 0x1a, 0x5c, 0x79, 0xe4, 0x61, 0xf7, 0xff, 0x68, 0x34, 0x4b, 0xdf, 0x90, 0xf5,
+	0xd7, 0xbf, 0x21, 0x91, 0x12, 0x24, 0xde, 0x56, 0x09, 0x36, 0xc2, 0x12, 0xd0, 0x13, 0xfe, 0x35,
+	0x0e, 0x40, 0xfa, 0x68, 0x90, 0x94, 0x73, 0x48, 0xd1, 0xee, 0x75, 0xe7, 0xb7, 0xe3, 0xc1, 0x6c,
+	0x5a, 0x40, 0x91, 0x86, 0x47, 0x3f, 0x1e, 0xa4, 0xdb, 0xdd, 0xd2, 0xea, 0xe2, 0x6f, 0xa0, 0xd5,
+	0xad, 0xbf, 0xb5, 0x56, 0x47, 0x93, 0xf2, 0x7b, 0xd8, 0x63, 0x46, 0xbb, 0x20, 0x31, 0x08, 0x12,
+	0x5d, 0xdd, 0x6b, 0xd1, 0xb7, 0x13, 0xac, 0x51, 0x15, 0xd2, 0xb4, 0x0f, 0x91, 0x01, 0x2d, 0xbe,
+	0x22, 0x5b, 0x0f, 0x67, 0xd3, 0xc2, 0xbd, 0x48, 0xef, 0xa2, 0x23, 0x58, 0xca, 0x08, 0x99, 0x28,
+	0xfd, 0x9f, 0x63, 0x80, 0xa2, 0x03, 0xcd, 0xad, 0x21, 0x3c, 0x7f, 0x7d, 0x4c, 0x5c, 0x15, 0xc5,
+	0x77, 0x98, 0x05, 0x69, 0x2c, 0x03, 0xb8, 0x27, 0x2d, 0xc6, 0xe9, 0xd5, 0xb1, 0xc8, 0x00, 0xe1,
+	0xe4, 0x4d, 0xc3, 0xf8, 0x7e, 0x50, 0x24, 0x7f, 0xf4, 0x2e, 0x86, 0xba, 0xe2, 0xe0, 0xb0, 0x18,
+	0x82, 0xca, 0xb6, 0xa9, 0x32, 0x8e, 0x94, 0xd7, 0x84, 0x8c, 0x44, 0x06, 0xf4, 0xd5, 0xa4, 0x4f,
+	0x61, 0x8b, 0x0e, 0xf2, 0x94, 0xf1, 0x31, 0xc3, 0x48, 0x14, 0x01, 0x1d, 0x59, 0xaa, 0x73, 0x63,
+	0xca, 0xf2, 0x31, 0x64, 0xab, 0xba, 0x71, 0x83, 0x3d, 0xc9, 0xe9, 0x74, 0x2c, 0xaf, 0x83, 0x6d,
+	0xef, 0x56, 0xa6, 0xbc, 0x7f, 0xbc, 0xb9, 0x55, 0x40, 0x96, 0x56, 0x19, 0x89, 0xf0, 0x1c, 0xf6,
+	0x09, 0x96, 0x68, 0xdc, 0xd8, 0xce, 0xb0, 0x8d, 0xcd, 0x26, 0x5e, 0x09, 0x78, 0x00, 0x7b, 0x7a,
+	0xd4, 0x94, 0xa2, 0x2e, 0x8b, 0x85, 0x22, 0xe4, 0x08, 0xb4, 0x8a, 0x0d, 0x6c, 0x75, 0x3d, 0xb1,
+	0xe1, 0xfa, 0x4d, 0xe7, 0x36, 0x64, 0xa1, 0x05, 0x59, 0x05, 0xbf, 0xf0, 0x6a, 0xb4, 0x39, 0xa9,
+	0xd8, 0x18, 0xdc, 0x1a, 0xc5, 0xcf, 0x61, 0xc7, 0xc6, 0x2f, 0x3c, 0xcd, 0xc5, 0x5f, 0x68, 0x3d,
+	0x6c, 0x0c, 0x48, 0xf3, 0x62, 0xbf, 0x39, 0x11, 0xb5, 0xa0, 0xa6, 0x6c, 0x02, 0xed, 0xa3, 0x0a,
+	0x26, 0xdc, 0x63, 0x99, 0x44, 0xe3, 0xe6, 0x56, 0xa2, 0x9f, 0x41, 0x7a, 0x81, 0xa4, 0x1b, 0x37,
+	0x94, 0x87, 0x79, 0x17, 0xac, 0x56, 0x50, 0x81, 0xd2, 0x88, 0xc6, 0x8d, 0xf0, 0x0c, 0x76, 0x2f,
+	0x70, 0xa7, 0x81, 0x7b, 0x6e, 0xcb, 0xea, 0xde, 0x4a, 0x90, 0x85, 0x8d, 0x81, 0xde, 0xee, 0x63,
+	0x9a, 0x45, 0xb2, 0xf9, 0x60, 0xb2, 0x01, 0xdb, 0xf3, 0x3e, 0x89, 0x7e, 0x0a, 0xef, 0x1e, 0x8b,
+	0x75, 0x51, 0xab, 0x3f, 0xaf, 0xca, 0xda, 0x95, 0x52, 0x51, 0x2a, 0xf5, 0x8a, 0x78, 0x5e, 0xf9,
+	0x54, 0x3e, 0xd6, 0xae, 0x94, 0x5a, 0x55, 0x96, 0x2a, 0x27, 0x15, 0xf9, 0x38, 0xb3, 0xc6, 0xed,
+	0x8d, 0x27, 0x7c, 0x8a, 0x11, 0xa1, 0xf7, 0xe0, 0x41, 0xe8, 0x29, 0x9d, 0x57, 0x64, 0xa5, 0xae,
+	0xd5, 0xea, 0x62, 0x5d, 0xce, 0xc4, 0x38, 0x18, 0x4f, 0xf8, 0x4d, 0x22, 0x43, 0x3f, 0x84, 0x7d,
+	0xc6, 0xee, 0x52, 0xa9, 0xc9, 0x4a, 0xed, 0xaa, 0x46, 0x4d, 0xe3, 0xdc, 0xce, 0x78, 0xc2, 0x27,
+	0x17, 0x62, 0x54, 0x04, 0x2e, 0x62, 0xad, 0xc8, 0x52, 0xbd, 0x72, 0xa9, 0x50, 0xf3, 0x75, 0x6e,
+	0x77, 0x3c, 0xe1, 0x21, 0x94, 0xa3, 0x03, 0x78, 0xc8, 0xd8, 0x9f, 0x8a, 0x8a, 0x22, 0x9f, 0x53,
+	0xe3, 0x04, 0x97, 0x1a, 0x4f, 0xf8, 0x2d, 0x2a, 0x44, 0x3f, 0x81, 0x47, 0xa1, 0x65, 0x55, 0x94,
+	0xce, 0xe4, 0xba, 0x26, 0x5d, 0x5e, 0x5c, 0x54, 0xea, 0x17, 0xb2, 0x52, 0xcf, 0x6c, 0x70, 0xd9,
+	0xf1, 0x84, 0xcf, 0x10, 0x45, 0x28, 0x47, 0xbf, 0x04, 0xfe, 0x35, 0x37, 0x51, 0x3a, 0x53, 0x2e,
+	0x3f, 0x39, 0x97, 0x8f, 0x3f, 0x92, 0x03, 0xdf, 0x4d, 0x6e, 0x7f, 0x3c, 0xe1, 0xef, 0x13, 0xed,
+	0x92, 0x12, 0xfd, 0xe2, 0x1b, 0x00, 0x54, 0x59, 0x92, 0x2b, 0xd5, 0xba, 0x26, 0x96, 0x6b, 0xb2,
+	0x22, 0xc9, 0x99, 0x2d, 0x2e, 0x37, 0x9e, 0xf0, 0x59, 0xa2, 0xa5, 0x4a, 0xaa, 0x43, 0x4f, 0xe1,
+	0x71, 0xe8, 0xaf, 0xc8, 0xbf, 0xad, 0x6b, 0x35, 0xf9, 0xd7, 0x57, 0xbe, 0xca, 0x87, 0xf9, 0x4d,
+	0x66, 0x9b, 0x04, 0xee, 0x6b, 0xe6, 0x0a, 0x5f, 0x8e, 0x78, 0xc8, 0x84, 0x7e, 0xa7, 0xb2, 0x78,
+	0x2c, 0xab, 0x99, 0x24, 0xa9, 0x0c, 0xd9, 0xa1, 0x1f, 0xb3, 0x19, 0x89, 0x22, 0x8b, 0xd2, 0x59,
+	0x06, 0xb8, 0x7b, 0xe3, 0x09, 0xbf, 0xc7, 0x02, 0x8b, 0xd2, 0x19, 0x3a, 0x80, 0x6c, 0xe8, 0x75,
+	0x21, 0x5f, 0x94, 0x65, 0xb5, 0x76, 0x5a, 0xa9, 0x66, 0x52, 0xa4, 0x36, 0xa1, 0x04, 0x95, 0x20,
+	0xc7, 0xe0, 0x5f, 0x2a, 0xac, 0x75, 0x9a, 0x7b, 0x67, 0x3c, 0xe1, 0x77, 0x94, 0x4b, 0x25, 0x14,
+	0x72, 0x89, 0x2f, 0xff, 0x9e, 0x5f, 0x2b, 0x7f, 0xfe, 0xd5, 0xcb, 0x7c, 0xec, 0xeb, 0x97, 0xf9,
+	0xd8, 0x7f, 0x5f, 0xe6, 0x63, 0x7f, 0x79, 0x95, 0x5f, 0xfb, 0xfa, 0x55, 0x7e, 0xed, 0xdf, 0xaf,
+	0xf2, 0x6b, 0x9f, 0x9e, 0x34, 0x2d, 0xaf, 0xd5, 0x6f, 0x14, 0x0d, 0xa7, 0x53, 0x32, 0x1c, 0xb7,
+	0xe3, 0xb8, 0x25, 0xab, 0x61, 0x3c, 0x69, 0x3a, 0xa5, 0xc1, 0x87, 0xa5, 0x8e, 0x63, 0xf6, 0xdb,
+	0xd8, 0x25, 0xbf, 0x54, 0x9e, 0xcc, 0xff, 0xa9, 0xfc, 0xe8, 0xe9, 0x13, 0xf6, 0xb7, 0x8a, 0x3f,
+	0x06, 0xb8, 0x8d, 0xcd, 0xe0, 0x13, 0xf0, 0xe1, 0xff, 0x06, 0x00, 0x8e, 0xfa, 0xa9, 0xff, 0x83,
+	0x11, 0x00, 0x00,
 }
 
 func (m *ClientState) Marshal() (dAtA []byte, err error) {
@@ -932,6 +1166,11 @@ func (m *ClientState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SignatureWindow != 0 {
+		i = encodeVarintSolomachine(dAtA, i, uint64(m.SignatureWindow))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.AllowUpdateAfterProposal {
 		i--
 		if m.AllowUpdateAfterProposal {
@@ -992,6 +1231,18 @@ func (m *ConsensusState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SignerSet != nil {
+		{
+			size, err := m.SignerSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSolomachine(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Timestamp != 0 {
 		i = encodeVarintSolomachine(dAtA, i, uint64(m.Timestamp))
 		i--
@@ -1019,6 +1270,88 @@ func (m *ConsensusState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Signer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Signer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Signer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintSolomachine(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PublicKey != nil {
+		{
+			size, err := m.PublicKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSolomachine(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		i = encodeVarintSolomachine(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSolomachine(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Header) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1039,6 +1372,25 @@ func (m *Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NewSignerSetSignature) > 0 {
+		i -= len(m.NewSignerSetSignature)
+		copy(dAtA[i:], m.NewSignerSetSignature)
+		i = encodeVarintSolomachine(dAtA, i, uint64(len(m.NewSignerSetSignature)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.NewSignerSet != nil {
+		{
+			size, err := m.NewSignerSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSolomachine(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.NewDiversifier) > 0 {
 		i -= len(m.NewDiversifier)
 		copy(dAtA[i:], m.NewDiversifier)
@@ -1291,6 +1643,18 @@ func (m *HeaderData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NewSignerSet != nil {
+		{
+			size, err := m.NewSignerSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSolomachine(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.NewDiversifier) > 0 {
 		i -= len(m.NewDiversifier)
 		copy(dAtA[i:], m.NewDiversifier)
@@ -1620,27 +1984,99 @@ func (m *NextSequenceRecvData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintSolomachine(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSolomachine(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *NextSequenceAckData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ClientState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		n += 1 + sovSolomachine(uint64(m.Sequence))
-	}
-	if m.IsFrozen {
+
+func (m *NextSequenceAckData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NextSequenceAckData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextSeqAck != 0 {
+		i = encodeVarintSolomachine(dAtA, i, uint64(m.NextSeqAck))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintSolomachine(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MembershipData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MembershipData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MembershipData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintSolomachine(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintSolomachine(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSolomachine(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSolomachine(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ClientState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovSolomachine(uint64(m.Sequence))
+	}
+	if m.IsFrozen {
 		n += 2
 	}
 	if m.ConsensusState != nil {
@@ -1650,6 +2086,9 @@ func (m *ClientState) Size() (n int) {
 	if m.AllowUpdateAfterProposal {
 		n += 2
 	}
+	if m.SignatureWindow != 0 {
+		n += 1 + sovSolomachine(uint64(m.SignatureWindow))
+	}
 	return n
 }
 
@@ -1670,6 +2109,44 @@ func (m *ConsensusState) Size() (n int) {
 	if m.Timestamp != 0 {
 		n += 1 + sovSolomachine(uint64(m.Timestamp))
 	}
+	if m.SignerSet != nil {
+		l = m.SignerSet.Size()
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
+	return n
+}
+
+func (m *Signer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PublicKey != nil {
+		l = m.PublicKey.Size()
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovSolomachine(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *SignerSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for _, e := range m.Signers {
+			l = e.Size()
+			n += 1 + l + sovSolomachine(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovSolomachine(uint64(m.Threshold))
+	}
 	return n
 }
 
@@ -1697,6 +2174,14 @@ func (m *Header) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSolomachine(uint64(l))
 	}
+	if m.NewSignerSet != nil {
+		l = m.NewSignerSet.Size()
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
+	l = len(m.NewSignerSetSignature)
+	if l > 0 {
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
 	return n
 }
 
@@ -1803,6 +2288,10 @@ func (m *HeaderData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSolomachine(uint64(l))
 	}
+	if m.NewSignerSet != nil {
+		l = m.NewSignerSet.Size()
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
 	return n
 }
 
@@ -1937,6 +2426,39 @@ func (m *NextSequenceRecvData) Size() (n int) {
 	return n
 }
 
+func (m *NextSequenceAckData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
+	if m.NextSeqAck != 0 {
+		n += 1 + sovSolomachine(uint64(m.NextSeqAck))
+	}
+	return n
+}
+
+func (m *MembershipData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
+	return n
+}
+
 func sovSolomachine(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2067,6 +2589,25 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllowUpdateAfterProposal = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureWindow", wireType)
+			}
+			m.SignatureWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignatureWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSolomachine(dAtA[iNdEx:])
@@ -2204,6 +2745,250 @@ func (m *ConsensusState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignerSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SignerSet == nil {
+				m.SignerSet = &SignerSet{}
+			}
+			if err := m.SignerSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSolomachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Signer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSolomachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Signer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Signer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PublicKey == nil {
+				m.PublicKey = &types.Any{}
+			}
+			if err := m.PublicKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSolomachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignerSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSolomachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, Signer{})
+			if err := m.Signers[len(m.Signers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSolomachine(dAtA[iNdEx:])
@@ -2355,18 +3140,86 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NewPublicKey == nil {
-				m.NewPublicKey = &types.Any{}
+			if m.NewPublicKey == nil {
+				m.NewPublicKey = &types.Any{}
+			}
+			if err := m.NewPublicKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewDiversifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewDiversifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewSignerSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewSignerSet == nil {
+				m.NewSignerSet = &SignerSet{}
 			}
-			if err := m.NewPublicKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NewSignerSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewDiversifier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewSignerSetSignature", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSolomachine
@@ -2376,23 +3229,25 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthSolomachine
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSolomachine
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewDiversifier = string(dAtA[iNdEx:postIndex])
+			m.NewSignerSetSignature = append(m.NewSignerSetSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewSignerSetSignature == nil {
+				m.NewSignerSetSignature = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3117,6 +3972,42 @@ func (m *HeaderData) Unmarshal(dAtA []byte) error {
 			}
 			m.NewDiversifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewSignerSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewSignerSet == nil {
+				m.NewSignerSet = &SignerSet{}
+			}
+			if err := m.NewSignerSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSolomachine(dAtA[iNdEx:])
@@ -4041,6 +4932,227 @@ func (m *NextSequenceRecvData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *NextSequenceAckData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSolomachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NextSequenceAckData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NextSequenceAckData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = append(m.Path[:0], dAtA[iNdEx:postIndex]...)
+			if m.Path == nil {
+				m.Path = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSeqAck", wireType)
+			}
+			m.NextSeqAck = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSeqAck |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSolomachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MembershipData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSolomachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MembershipData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MembershipData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = append(m.Path[:0], dAtA[iNdEx:postIndex]...)
+			if m.Path == nil {
+				m.Path = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSolomachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSolomachine(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
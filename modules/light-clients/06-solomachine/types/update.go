@@ -14,7 +14,12 @@ import (
 // - the header provided is not parseable to a solo machine header
 // - the header sequence does not match the current sequence
 // - the header timestamp is less than the consensus state timestamp
-// - the currently registered public key did not provide the update signature
+// - the currently registered public key or signer set did not provide the update signature
+// - the new signer set did not approve the rotation to it
+// Clients with a signature window do not sign over the header sequence, which must still
+// match the current sequence as it is the height of the new consensus state. The header
+// timestamp must instead be greater than the consensus state timestamp and at most
+// MaxClockDrift ahead of the block time.
 func (cs ClientState) CheckHeaderAndUpdateState(
 	ctx sdk.Context, cdc codec.BinaryCodec, clientStore sdk.KVStore,
 	header exported.Header,
@@ -26,7 +31,7 @@ func (cs ClientState) CheckHeaderAndUpdateState(
 		)
 	}
 
-	if err := checkHeader(cdc, &cs, smHeader); err != nil {
+	if err := checkHeader(ctx, cdc, &cs, smHeader); err != nil {
 		return nil, nil, err
 	}
 
@@ -35,8 +40,9 @@ func (cs ClientState) CheckHeaderAndUpdateState(
 }
 
 // checkHeader checks if the Solo Machine update signature is valid.
-func checkHeader(cdc codec.BinaryCodec, clientState *ClientState, header *Header) error {
-	// assert update sequence is current sequence
+func checkHeader(ctx sdk.Context, cdc codec.BinaryCodec, clientState *ClientState, header *Header) error {
+	// assert update sequence is current sequence, the consensus state is stored at the
+	// header sequence
 	if header.Sequence != clientState.Sequence {
 		return sdkerrors.Wrapf(
 			clienttypes.ErrInvalidHeader,
@@ -44,16 +50,34 @@ func checkHeader(cdc codec.BinaryCodec, clientState *ClientState, header *Header
 		)
 	}
 
-	// assert update timestamp is not less than current consensus state timestamp
-	if header.Timestamp < clientState.ConsensusState.Timestamp {
+	sequence := header.Sequence
+	if clientState.SignatureWindow != 0 {
+		// the timestamp must strictly increase to prevent the replay of a previous rotation
+		if header.Timestamp <= clientState.ConsensusState.Timestamp {
+			return sdkerrors.Wrapf(
+				clienttypes.ErrInvalidHeader,
+				"header timestamp must be greater than the consensus state timestamp (%d <= %d)", header.Timestamp, clientState.ConsensusState.Timestamp,
+			)
+		}
+
+		if maxTimestamp := maxSignatureTimestamp(ctx.BlockTime()); header.Timestamp > maxTimestamp {
+			return sdkerrors.Wrapf(
+				clienttypes.ErrInvalidHeader,
+				"header timestamp is too far in the future (%d > %d)", header.Timestamp, maxTimestamp,
+			)
+		}
+
+		sequence = 0
+	} else if header.Timestamp < clientState.ConsensusState.Timestamp {
+		// assert update timestamp is not less than current consensus state timestamp
 		return sdkerrors.Wrapf(
 			clienttypes.ErrInvalidHeader,
 			"header timestamp is less than to the consensus state timestamp (%d < %d)", header.Timestamp, clientState.ConsensusState.Timestamp,
 		)
 	}
 
-	// assert currently registered public key signed over the new public key with correct sequence
-	data, err := HeaderSignBytes(cdc, header)
+	// assert currently registered signers signed over the new public key or signer set with correct sequence
+	data, err := HeaderSignBytes(cdc, sequence, header)
 	if err != nil {
 		return err
 	}
@@ -63,24 +87,32 @@ func checkHeader(cdc codec.BinaryCodec, clientState *ClientState, header *Header
 		return err
 	}
 
-	publicKey, err := clientState.ConsensusState.GetPubKey()
-	if err != nil {
-		return err
+	if err := clientState.ConsensusState.VerifySignature(data, sigData); err != nil {
+		return sdkerrors.Wrap(ErrInvalidHeader, err.Error())
 	}
 
-	if err := VerifySignature(publicKey, data, sigData); err != nil {
-		return sdkerrors.Wrap(ErrInvalidHeader, err.Error())
+	// assert the new signer set approved the rotation over the same sign bytes
+	if header.NewSignerSet != nil {
+		newSigData, err := UnmarshalSignatureData(cdc, header.NewSignerSetSignature)
+		if err != nil {
+			return err
+		}
+
+		if err := header.NewSignerSet.VerifySignature(data, newSigData); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidHeader, "new signer set failed to approve the rotation: %s", err)
+		}
 	}
 
 	return nil
 }
 
-// update the consensus state to the new public key and an incremented sequence
+// update the consensus state to the new public key or signer set and an incremented sequence
 func update(clientState *ClientState, header *Header) (*ClientState, *ConsensusState) {
 	consensusState := &ConsensusState{
 		PublicKey:   header.NewPublicKey,
 		Diversifier: header.NewDiversifier,
 		Timestamp:   header.Timestamp,
+		SignerSet:   header.NewSignerSet,
 	}
 
 	// increment sequence number
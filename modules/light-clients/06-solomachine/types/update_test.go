@@ -179,3 +179,177 @@ func (suite *SoloMachineTestSuite) TestCheckHeaderAndUpdateState() {
 		}
 	}
 }
+
+func (suite *SoloMachineTestSuite) TestCheckHeaderAndUpdateStateSignerSet() {
+	var (
+		solomachine *ibctesting.Solomachine
+		clientState *types.ClientState
+		header      *types.Header
+	)
+
+	testCases := []struct {
+		name    string
+		setup   func()
+		expPass bool
+	}{
+		{
+			"successful rotation approved by all signers",
+			func() {
+				header = solomachine.CreateHeader()
+			},
+			true,
+		},
+		{
+			"successful rotation approved by a quorum of the old and new signer sets",
+			func() {
+				header = solomachine.CreateRotationHeader([]uint64{1, 1, 1}, 2, []int{0, 3}, []int{0, 2})
+			},
+			true,
+		},
+		{
+			"successful rotation from a public key to a signer set",
+			func() {
+				solomachine = suite.solomachine
+				clientState = solomachine.ClientState()
+
+				weighted := ibctesting.NewWeightedSolomachine(suite.T(), suite.chainA.Codec, "solomachineweighted", "testing", []uint64{1, 1}, 2)
+
+				header = &types.Header{
+					Sequence:       solomachine.Sequence,
+					Timestamp:      solomachine.Time,
+					NewDiversifier: solomachine.Diversifier,
+					NewSignerSet:   weighted.SignerSet(),
+				}
+
+				signBytes, err := types.HeaderSignBytes(suite.chainA.Codec, solomachine.Sequence, header)
+				suite.Require().NoError(err)
+
+				header.Signature = solomachine.GenerateSignature(signBytes)
+				header.NewSignerSetSignature = weighted.GenerateSignature(signBytes)
+			},
+			true,
+		},
+		{
+			"successful rotation with a signature window",
+			func() {
+				solomachine.SignatureWindow = 100
+				clientState = solomachine.ClientState()
+
+				header = solomachine.CreateHeader()
+			},
+			true,
+		},
+		{
+			"signature window rejects a header at a different sequence",
+			func() {
+				solomachine.SignatureWindow = 100
+				clientState = solomachine.ClientState()
+
+				// the header sequence is not signed over with a signature window
+				header = solomachine.CreateHeader()
+				header.Sequence += 10
+			},
+			false,
+		},
+		{
+			"signature window rejects a header at a previous sequence",
+			func() {
+				solomachine.SignatureWindow = 100
+				clientState = solomachine.ClientState()
+				clientState.Sequence += 10
+
+				header = solomachine.CreateHeader()
+			},
+			false,
+		},
+		{
+			"old signer set does not reach the threshold",
+			func() {
+				header = solomachine.CreateRotationHeader([]uint64{1, 1, 1}, 2, []int{1, 2}, []int{0, 1, 2})
+			},
+			false,
+		},
+		{
+			"new signer set does not reach the threshold",
+			func() {
+				header = solomachine.CreateRotationHeader([]uint64{1, 1, 1}, 2, []int{0, 1, 2, 3}, []int{1})
+			},
+			false,
+		},
+		{
+			"new signer set signature is signed by the old signer set",
+			func() {
+				header = solomachine.CreateHeader()
+				header.NewSignerSetSignature = header.Signature
+			},
+			false,
+		},
+		{
+			"wrong sequence in header",
+			func() {
+				header = solomachine.CreateHeader()
+				header.Sequence++
+			},
+			false,
+		},
+		{
+			"signature window allows the header timestamp to be max clock drift ahead of the block time",
+			func() {
+				solomachine.SignatureWindow = 100
+				clientState = solomachine.ClientState()
+
+				// the timestamp is incremented by the header creation
+				solomachine.Time = uint64(suite.chainA.GetContext().BlockTime().Add(types.MaxClockDrift).UnixNano()) - 1
+				header = solomachine.CreateHeader()
+			},
+			true,
+		},
+		{
+			"signature window rejects a header timestamp more than max clock drift ahead of the block time",
+			func() {
+				solomachine.SignatureWindow = 100
+				clientState = solomachine.ClientState()
+
+				solomachine.Time = uint64(suite.chainA.GetContext().BlockTime().Add(types.MaxClockDrift).UnixNano())
+				header = solomachine.CreateHeader()
+			},
+			false,
+		},
+		{
+			"signature window requires the header timestamp to increase",
+			func() {
+				solomachine.SignatureWindow = 100
+				clientState = solomachine.ClientState()
+
+				solomachine.Time--
+				header = solomachine.CreateHeader()
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			solomachine = ibctesting.NewWeightedSolomachine(suite.T(), suite.chainA.Codec, "solomachineweighted", "testing", []uint64{2, 1, 1, 1}, 3)
+			clientState = solomachine.ClientState()
+
+			tc.setup()
+
+			cs, consensusState, err := clientState.CheckHeaderAndUpdateState(suite.chainA.GetContext(), suite.chainA.Codec, suite.store, header)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(header.NewSignerSet, cs.(*types.ClientState).ConsensusState.SignerSet)
+				suite.Require().Nil(cs.(*types.ClientState).ConsensusState.PublicKey)
+				suite.Require().Equal(header.Timestamp, consensusState.GetTimestamp())
+				suite.Require().NoError(consensusState.(*types.ConsensusState).ValidateBasic())
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(cs)
+				suite.Require().Nil(consensusState)
+			}
+		})
+	}
+}
@@ -878,3 +878,447 @@ func (suite *TendermintTestSuite) TestVerifyNextSeqRecv() {
 		})
 	}
 }
+
+func (suite *TendermintTestSuite) TestVerifyNextSeqAck() {
+	var (
+		clientState      *types.ClientState
+		proof            []byte
+		delayTimePeriod  uint64
+		delayBlockPeriod uint64
+		proofHeight      exported.Height
+		prefix           commitmenttypes.MerklePrefix
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"successful verification", func() {}, true,
+		},
+		{
+			name: "delay time period has passed",
+			malleate: func() {
+				delayTimePeriod = uint64(time.Second.Nanoseconds())
+			},
+			expPass: true,
+		},
+		{
+			name: "delay time period has not passed",
+			malleate: func() {
+				delayTimePeriod = uint64(time.Hour.Nanoseconds())
+			},
+			expPass: false,
+		},
+		{
+			name: "delay block period has passed",
+			malleate: func() {
+				delayBlockPeriod = 1
+			},
+			expPass: true,
+		},
+		{
+			name: "delay block period has not passed",
+			malleate: func() {
+				delayBlockPeriod = 10
+			},
+			expPass: false,
+		},
+
+		{
+			"ApplyPrefix failed", func() {
+				prefix = commitmenttypes.MerklePrefix{}
+			}, false,
+		},
+		{
+			"latest client height < height", func() {
+				proofHeight = clientState.LatestHeight.Increment()
+			}, false,
+		},
+		{
+			"proof verification failed", func() {
+				proof = invalidProof
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			// setup testing conditions
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetChannelOrdered()
+			suite.coordinator.Setup(path)
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+
+			// send packet
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+
+			// next seq ack incremented
+			err = path.RelayPacket(packet)
+			suite.Require().NoError(err)
+
+			err = path.EndpointB.UpdateClient()
+			suite.Require().NoError(err)
+
+			var ok bool
+			clientStateI := suite.chainB.GetClientState(path.EndpointB.ClientID)
+			clientState, ok = clientStateI.(*types.ClientState)
+			suite.Require().True(ok)
+
+			prefix = suite.chainA.GetPrefix()
+
+			// make next seq ack proof
+			nextSeqAckKey := host.NextSequenceAckKey(packet.GetSourcePort(), packet.GetSourceChannel())
+			proof, proofHeight = suite.chainA.QueryProof(nextSeqAckKey)
+
+			// reset time and block delays to 0, malleate may change to a specific non-zero value.
+			delayTimePeriod = 0
+			delayBlockPeriod = 0
+			tc.malleate() // make changes as necessary
+
+			ctx := suite.chainB.GetContext()
+			store := suite.chainB.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, path.EndpointB.ClientID)
+
+			err = clientState.VerifyNextSequenceAck(
+				ctx, store, suite.chainB.Codec, proofHeight, delayTimePeriod, delayBlockPeriod, &prefix, proof,
+				packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()+1,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TendermintTestSuite) TestVerifyMembership() {
+	var (
+		clientState      *types.ClientState
+		proof            []byte
+		delayTimePeriod  uint64
+		delayBlockPeriod uint64
+		proofHeight      exported.Height
+		merklePath       exported.Path
+		value            []byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"successful verification", func() {}, true,
+		},
+		{
+			"delay time period has passed", func() {
+				delayTimePeriod = uint64(time.Second.Nanoseconds())
+			}, true,
+		},
+		{
+			"delay time period has not passed", func() {
+				delayTimePeriod = uint64(time.Hour.Nanoseconds())
+			}, false,
+		},
+		{
+			"delay block period has passed", func() {
+				delayBlockPeriod = 1
+			}, true,
+		},
+		{
+			"delay block period has not passed", func() {
+				delayBlockPeriod = 10
+			}, false,
+		},
+		{
+			"latest client height < height", func() {
+				proofHeight = clientState.LatestHeight.Increment()
+			}, false,
+		},
+		{
+			"consensus state not found", func() {
+				proofHeight = clienttypes.ZeroHeight()
+			}, false,
+		},
+		{
+			"invalid path type", func() {
+				merklePath = ibcmock.KeyPath{}
+			}, false,
+		},
+		{
+			"empty proof", func() {
+				proof = nil
+			}, false,
+		},
+		{
+			"proof verification failed", func() {
+				proof = invalidProof
+			}, false,
+		},
+		{
+			"value does not match", func() {
+				value = []byte("invalid value")
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			var ok bool
+			clientStateI := suite.chainA.GetClientState(path.EndpointA.ClientID)
+			clientState, ok = clientStateI.(*types.ClientState)
+			suite.Require().True(ok)
+
+			// prove the connection end stored on the counterparty
+			key := host.ConnectionKey(path.EndpointB.ConnectionID)
+			proof, proofHeight = path.EndpointB.QueryProof(key)
+
+			var err error
+			merklePath, err = commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath(host.ConnectionPath(path.EndpointB.ConnectionID)))
+			suite.Require().NoError(err)
+
+			connection := path.EndpointB.GetConnection()
+			value, err = suite.chainB.Codec.Marshal(&connection)
+			suite.Require().NoError(err)
+
+			// reset time and block delays to 0, malleate may change to a specific non-zero value.
+			delayTimePeriod = 0
+			delayBlockPeriod = 0
+			tc.malleate() // make changes as necessary
+
+			ctx := suite.chainA.GetContext()
+			store := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, path.EndpointA.ClientID)
+
+			err = clientState.VerifyMembership(
+				ctx, store, suite.chainA.Codec, proofHeight, delayTimePeriod, delayBlockPeriod,
+				proof, merklePath, value,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TendermintTestSuite) TestVerifyNonMembership() {
+	var (
+		clientState      *types.ClientState
+		proof            []byte
+		delayTimePeriod  uint64
+		delayBlockPeriod uint64
+		proofHeight      exported.Height
+		merklePath       exported.Path
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"successful verification", func() {}, true,
+		},
+		{
+			"delay time period has not passed", func() {
+				delayTimePeriod = uint64(time.Hour.Nanoseconds())
+			}, false,
+		},
+		{
+			"delay block period has not passed", func() {
+				delayBlockPeriod = 10
+			}, false,
+		},
+		{
+			"latest client height < height", func() {
+				proofHeight = clientState.LatestHeight.Increment()
+			}, false,
+		},
+		{
+			"invalid path type", func() {
+				merklePath = ibcmock.KeyPath{}
+			}, false,
+		},
+		{
+			"proof verification failed", func() {
+				proof = invalidProof
+			}, false,
+		},
+		{
+			"key exists", func() {
+				// the proof of absence of the receipt key is not a proof of absence of an existing key
+				var err error
+				merklePath, err = commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath(host.FullClientStatePath(ibctesting.FirstClientID)))
+				suite.Require().NoError(err)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			var ok bool
+			clientStateI := suite.chainA.GetClientState(path.EndpointA.ClientID)
+			clientState, ok = clientStateI.(*types.ClientState)
+			suite.Require().True(ok)
+
+			// prove the absence of a packet receipt which was never written on chainB
+			receiptPath := host.PacketReceiptPath(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 1)
+			proof, proofHeight = path.EndpointB.QueryProof([]byte(receiptPath))
+
+			var err error
+			merklePath, err = commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath(receiptPath))
+			suite.Require().NoError(err)
+
+			// reset time and block delays to 0, malleate may change to a specific non-zero value.
+			delayTimePeriod = 0
+			delayBlockPeriod = 0
+			tc.malleate() // make changes as necessary
+
+			ctx := suite.chainA.GetContext()
+			store := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, path.EndpointA.ClientID)
+
+			err = clientState.VerifyNonMembership(
+				ctx, store, suite.chainA.Codec, proofHeight, delayTimePeriod, delayBlockPeriod,
+				proof, merklePath,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TendermintTestSuite) TestVerifyBatchMembership() {
+	var (
+		clientState      *types.ClientState
+		proof            []byte
+		delayTimePeriod  uint64
+		delayBlockPeriod uint64
+		proofHeight      exported.Height
+		paths            []exported.Path
+		values           [][]byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"successful verification", func() {}, true,
+		},
+		{
+			"delay time period has not passed", func() {
+				delayTimePeriod = uint64(time.Hour.Nanoseconds())
+			}, false,
+		},
+		{
+			"latest client height < height", func() {
+				proofHeight = clientState.LatestHeight.Increment()
+			}, false,
+		},
+		{
+			"no paths", func() {
+				paths = nil
+				values = nil
+			}, false,
+		},
+		{
+			"invalid path type", func() {
+				paths[0] = ibcmock.KeyPath{}
+			}, false,
+		},
+		{
+			"proof is not a batch proof", func() {
+				proof, _ = suite.chainB.QueryProof(host.ConnectionKey(ibctesting.FirstConnectionID))
+			}, false,
+		},
+		{
+			"value does not match", func() {
+				values[1] = []byte("invalid value")
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			var ok bool
+			clientStateI := suite.chainA.GetClientState(path.EndpointA.ClientID)
+			clientState, ok = clientStateI.(*types.ClientState)
+			suite.Require().True(ok)
+
+			// prove the connection and channel ends stored on the counterparty with a single proof
+			proof, proofHeight = path.EndpointB.QueryProofs(
+				host.ConnectionKey(path.EndpointB.ConnectionID),
+				host.ChannelKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID),
+			)
+
+			connectionPath, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath(host.ConnectionPath(path.EndpointB.ConnectionID)))
+			suite.Require().NoError(err)
+			channelPath, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath(host.ChannelPath(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)))
+			suite.Require().NoError(err)
+			paths = []exported.Path{connectionPath, channelPath}
+
+			connection := path.EndpointB.GetConnection()
+			connectionBz, err := suite.chainB.Codec.Marshal(&connection)
+			suite.Require().NoError(err)
+			channel := path.EndpointB.GetChannel()
+			channelBz, err := suite.chainB.Codec.Marshal(&channel)
+			suite.Require().NoError(err)
+			values = [][]byte{connectionBz, channelBz}
+
+			// reset time and block delays to 0, malleate may change to a specific non-zero value.
+			delayTimePeriod = 0
+			delayBlockPeriod = 0
+			tc.malleate() // make changes as necessary
+
+			ctx := suite.chainA.GetContext()
+			store := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, path.EndpointA.ClientID)
+
+			err = clientState.VerifyBatchMembership(
+				ctx, store, suite.chainA.Codec, proofHeight, delayTimePeriod, delayBlockPeriod,
+				proof, paths, values,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
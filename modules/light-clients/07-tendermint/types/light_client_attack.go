@@ -0,0 +1,189 @@
+package types
+
+import (
+	"bytes"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+var _ exported.Misbehaviour = &LightClientAttackMisbehaviour{}
+
+// TraceHeader defines the header fields used to link the trusted trace of a light client
+// attack. It is implemented by the headers of the 07-tendermint and 01-dymint clients.
+type TraceHeader interface {
+	GetHeight() exported.Height
+	GetTrustedHeight() clienttypes.Height
+}
+
+// NewLightClientAttackMisbehaviour creates a new LightClientAttackMisbehaviour instance.
+func NewLightClientAttackMisbehaviour(clientID string, conflictingHeader *Header, commonHeight clienttypes.Height, trustedTrace []*Header) *LightClientAttackMisbehaviour {
+	return &LightClientAttackMisbehaviour{
+		ClientId:          clientID,
+		ConflictingHeader: conflictingHeader,
+		CommonHeight:      commonHeight,
+		TrustedTrace:      trustedTrace,
+	}
+}
+
+// ClientType is Tendermint light client
+func (misbehaviour LightClientAttackMisbehaviour) ClientType() string {
+	return exported.Tendermint
+}
+
+// GetChainID returns the chain-id
+func (misbehaviour LightClientAttackMisbehaviour) GetChainID() string {
+	// assuming the chain-id of the conflicting header and the trusted trace are the same as checked in ValidateBasic
+	return misbehaviour.ConflictingHeader.Header.ChainID
+}
+
+// GetClientID returns the ID of the client that committed a misbehaviour.
+func (misbehaviour LightClientAttackMisbehaviour) GetClientID() string {
+	return misbehaviour.ClientId
+}
+
+// GetForkHeight returns the height at which the conflicting header forks from the
+// trusted trace. The client is frozen at this height.
+func (misbehaviour LightClientAttackMisbehaviour) GetForkHeight() clienttypes.Height {
+	return misbehaviour.ConflictingHeader.GetHeight().(clienttypes.Height)
+}
+
+// GetTime returns the timestamp at which misbehaviour occurred. It uses the
+// maximum value from the conflicting header and the last trusted header to prevent
+// producing an invalid header outside of the misbehaviour age range.
+func (misbehaviour LightClientAttackMisbehaviour) GetTime() time.Time {
+	t1 := misbehaviour.ConflictingHeader.GetTime()
+	t2 := misbehaviour.TrustedTrace[len(misbehaviour.TrustedTrace)-1].GetTime()
+	if t1.After(t2) {
+		return t1
+	}
+	return t2
+}
+
+// ValidateBasic implements Misbehaviour interface
+func (misbehaviour LightClientAttackMisbehaviour) ValidateBasic() error {
+	if err := host.ClientIdentifierValidator(misbehaviour.ClientId); err != nil {
+		return sdkerrors.Wrap(err, "misbehaviour client ID is invalid")
+	}
+
+	if misbehaviour.ConflictingHeader == nil {
+		return sdkerrors.Wrap(ErrInvalidHeader, "misbehaviour ConflictingHeader cannot be nil")
+	}
+	if misbehaviour.CommonHeight.RevisionHeight == 0 {
+		return sdkerrors.Wrap(ErrInvalidHeaderHeight, "misbehaviour common height cannot have zero revision height")
+	}
+	if !misbehaviour.ConflictingHeader.TrustedHeight.EQ(misbehaviour.CommonHeight) {
+		return sdkerrors.Wrapf(
+			clienttypes.ErrInvalidMisbehaviour,
+			"conflicting header must be trusted from the common height (%s != %s)", misbehaviour.ConflictingHeader.TrustedHeight, misbehaviour.CommonHeight,
+		)
+	}
+	if misbehaviour.ConflictingHeader.TrustedValidators == nil {
+		return sdkerrors.Wrap(ErrInvalidValidatorSet, "trusted validator set in ConflictingHeader cannot be empty")
+	}
+	if err := misbehaviour.ConflictingHeader.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(
+			clienttypes.ErrInvalidMisbehaviour,
+			sdkerrors.Wrap(err, "conflicting header failed validation").Error(),
+		)
+	}
+
+	if len(misbehaviour.TrustedTrace) == 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidMisbehaviour, "trusted trace cannot be empty")
+	}
+
+	trace := make([]TraceHeader, len(misbehaviour.TrustedTrace))
+	for i, header := range misbehaviour.TrustedTrace {
+		if header == nil {
+			return sdkerrors.Wrapf(ErrInvalidHeader, "header %d of the trusted trace cannot be nil", i)
+		}
+		if header.TrustedValidators == nil {
+			return sdkerrors.Wrapf(ErrInvalidValidatorSet, "trusted validator set in header %d of the trusted trace cannot be empty", i)
+		}
+		if err := header.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(
+				clienttypes.ErrInvalidMisbehaviour,
+				sdkerrors.Wrapf(err, "header %d of the trusted trace failed validation", i).Error(),
+			)
+		}
+		if header.Header.ChainID != misbehaviour.ConflictingHeader.Header.ChainID {
+			return sdkerrors.Wrap(clienttypes.ErrInvalidMisbehaviour, "headers must have identical chainIDs")
+		}
+
+		trace[i] = header
+	}
+
+	if err := ValidateTrustedTrace(misbehaviour.CommonHeight, misbehaviour.ConflictingHeader.GetHeight(), trace); err != nil {
+		return err
+	}
+
+	blockID, err := tmtypes.BlockIDFromProto(&misbehaviour.ConflictingHeader.SignedHeader.Commit.BlockID)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid block ID from conflicting header in misbehaviour")
+	}
+
+	return validCommit(misbehaviour.ConflictingHeader.Header.ChainID, *blockID,
+		misbehaviour.ConflictingHeader.Commit, misbehaviour.ConflictingHeader.ValidatorSet)
+}
+
+// ValidateTrustedTrace checks that the trusted trace of a light client attack starts at the
+// common height, that every header is trusted from the height of the previous header and
+// that the trace ends at the fork height.
+func ValidateTrustedTrace(commonHeight, forkHeight exported.Height, trace []TraceHeader) error {
+	if len(trace) == 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidMisbehaviour, "trusted trace cannot be empty")
+	}
+
+	trustedHeight := commonHeight
+	for i, header := range trace {
+		if !header.GetTrustedHeight().EQ(trustedHeight) {
+			return sdkerrors.Wrapf(
+				clienttypes.ErrInvalidMisbehaviour,
+				"header %d of the trusted trace must be trusted from height %s, got %s", i, trustedHeight, header.GetTrustedHeight(),
+			)
+		}
+
+		trustedHeight = header.GetHeight()
+	}
+
+	if !trustedHeight.EQ(forkHeight) {
+		return sdkerrors.Wrapf(
+			clienttypes.ErrInvalidMisbehaviour,
+			"trusted trace must end at the fork height %s, got %s", forkHeight, trustedHeight,
+		)
+	}
+
+	return nil
+}
+
+// CheckForkedSignedHeaders checks that the conflicting signed header is at the same height as
+// the trusted signed header and commits to a different block.
+func CheckForkedSignedHeaders(trusted, conflicting *tmproto.SignedHeader) error {
+	if trusted.Header.Height != conflicting.Header.Height {
+		return sdkerrors.Wrapf(
+			clienttypes.ErrInvalidMisbehaviour,
+			"conflicting header is not at the height of the trusted header (%d != %d)", conflicting.Header.Height, trusted.Header.Height,
+		)
+	}
+
+	trustedBlockID, err := tmtypes.BlockIDFromProto(&trusted.Commit.BlockID)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid block ID from trusted header in misbehaviour")
+	}
+	conflictingBlockID, err := tmtypes.BlockIDFromProto(&conflicting.Commit.BlockID)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid block ID from conflicting header in misbehaviour")
+	}
+
+	if bytes.Equal(trustedBlockID.Hash, conflictingBlockID.Hash) {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidMisbehaviour, "conflicting header block hash is equal to the trusted header block hash")
+	}
+
+	return nil
+}
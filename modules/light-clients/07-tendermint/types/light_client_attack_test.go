@@ -0,0 +1,174 @@
+package types_test
+
+import (
+	"time"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	ibctestingmock "github.com/cosmos/ibc-go/v3/testing/mock"
+)
+
+func (suite *TendermintTestSuite) TestLightClientAttackMisbehaviour() {
+	heightMinus1 := clienttypes.NewHeight(0, height.RevisionHeight-1)
+
+	chainATendermint := suite.chainA.TestChainClient.(*ibctesting.TestChainTendermint)
+
+	conflictingHeader := chainATendermint.CreateTMClientHeader(chainID, int64(height.RevisionHeight), heightMinus1, suite.now.Add(time.Minute), suite.valSet, suite.valSet, getSuiteSigners(suite))
+	attack := types.NewLightClientAttackMisbehaviour(clientID, conflictingHeader, heightMinus1, []*types.Header{suite.header})
+
+	suite.Require().Equal(exported.Tendermint, attack.ClientType())
+	suite.Require().Equal(clientID, attack.GetClientID())
+	suite.Require().Equal(chainID, attack.GetChainID())
+	suite.Require().Equal(height, attack.GetForkHeight())
+	suite.Require().Equal(suite.now.Add(time.Minute), attack.GetTime())
+}
+
+func (suite *TendermintTestSuite) TestLightClientAttackMisbehaviourValidateBasic() {
+	var attack *types.LightClientAttackMisbehaviour
+
+	altPrivVal := ibctestingmock.NewPV()
+	altPubKey, err := altPrivVal.GetPubKey()
+	suite.Require().NoError(err)
+
+	altVal := tmtypes.NewValidator(altPubKey, 6)
+	// Create alternative validator set with only altVal
+	altValSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{altVal})
+	altSigners := []tmtypes.PrivValidator{altPrivVal}
+
+	heightMinus1 := clienttypes.NewHeight(0, height.RevisionHeight-1)
+	heightMinus2 := clienttypes.NewHeight(0, height.RevisionHeight-2)
+
+	chainATendermint := suite.chainA.TestChainClient.(*ibctesting.TestChainTendermint)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"valid light client attack", func() {}, true,
+		},
+		{
+			"valid trusted trace with multiple headers", func() {
+				attack.CommonHeight = heightMinus2
+				attack.ConflictingHeader.TrustedHeight = heightMinus2
+				attack.TrustedTrace = []*types.Header{
+					chainATendermint.CreateTMClientHeader(chainID, int64(heightMinus1.RevisionHeight), heightMinus2, suite.now, suite.valSet, suite.valSet, getSuiteSigners(suite)),
+					suite.header,
+				}
+			}, true,
+		},
+		{
+			"invalid client ID", func() {
+				attack.ClientId = "GAIA"
+			}, false,
+		},
+		{
+			"conflicting header is nil", func() {
+				attack.ConflictingHeader = nil
+			}, false,
+		},
+		{
+			"common height is zero", func() {
+				attack.CommonHeight = clienttypes.ZeroHeight()
+			}, false,
+		},
+		{
+			"conflicting header is not trusted from the common height", func() {
+				attack.ConflictingHeader.TrustedHeight = heightMinus2
+			}, false,
+		},
+		{
+			"conflicting header trusted validators is nil", func() {
+				attack.ConflictingHeader.TrustedValidators = nil
+			}, false,
+		},
+		{
+			"conflicting header fails validation", func() {
+				attack.ConflictingHeader.ValidatorSet = nil
+			}, false,
+		},
+		{
+			"conflicting header is not committed by its validator set", func() {
+				wrongVoteSet := tmtypes.NewVoteSet(chainID, int64(height.RevisionHeight), 1, tmproto.PrecommitType, suite.valSet)
+				blockID, err := tmtypes.BlockIDFromProto(&attack.ConflictingHeader.Commit.BlockID)
+				suite.Require().NoError(err)
+
+				tmCommit, err := tmtypes.MakeCommit(*blockID, int64(height.RevisionHeight), attack.ConflictingHeader.Commit.Round, wrongVoteSet, getSuiteSigners(suite), suite.now)
+				suite.Require().NoError(err)
+
+				attack.ConflictingHeader.Commit = tmCommit.ToProto()
+			}, false,
+		},
+		{
+			"trusted trace is empty", func() {
+				attack.TrustedTrace = nil
+			}, false,
+		},
+		{
+			"trusted trace header is nil", func() {
+				attack.TrustedTrace = []*types.Header{nil}
+			}, false,
+		},
+		{
+			"trusted trace header trusted validators is nil", func() {
+				attack.TrustedTrace[0].TrustedValidators = nil
+			}, false,
+		},
+		{
+			"trusted trace header has a different chain-id", func() {
+				attack.TrustedTrace[0] = chainATendermint.CreateTMClientHeader("ethermint", int64(height.RevisionHeight), heightMinus1, suite.now, suite.valSet, suite.valSet, getSuiteSigners(suite))
+			}, false,
+		},
+		{
+			"trusted trace does not start at the common height", func() {
+				attack.TrustedTrace[0] = chainATendermint.CreateTMClientHeader(chainID, int64(height.RevisionHeight), heightMinus2, suite.now, suite.valSet, suite.valSet, getSuiteSigners(suite))
+			}, false,
+		},
+		{
+			"trusted trace headers are not linked", func() {
+				attack.CommonHeight = heightMinus2
+				attack.ConflictingHeader.TrustedHeight = heightMinus2
+				attack.TrustedTrace = []*types.Header{
+					chainATendermint.CreateTMClientHeader(chainID, int64(heightMinus1.RevisionHeight), heightMinus2, suite.now, suite.valSet, suite.valSet, getSuiteSigners(suite)),
+					chainATendermint.CreateTMClientHeader(chainID, int64(height.RevisionHeight), heightMinus2, suite.now, suite.valSet, suite.valSet, getSuiteSigners(suite)),
+				}
+			}, false,
+		},
+		{
+			"trusted trace does not end at the fork height", func() {
+				attack.ConflictingHeader = chainATendermint.CreateTMClientHeader(chainID, int64(height.RevisionHeight+1), heightMinus1, suite.now, altValSet, suite.valSet, altSigners)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			attack = types.NewLightClientAttackMisbehaviour(
+				clientID,
+				chainATendermint.CreateTMClientHeader(chainID, int64(height.RevisionHeight), heightMinus1, suite.now, altValSet, suite.valSet, altSigners),
+				heightMinus1,
+				[]*types.Header{suite.header},
+			)
+
+			tc.malleate()
+
+			err := attack.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -430,3 +430,136 @@ func (suite *TendermintTestSuite) TestCheckMisbehaviourAndUpdateState() {
 		})
 	}
 }
+
+func (suite *TendermintTestSuite) TestCheckLightClientAttackAndUpdateState() {
+	var (
+		clientState *types.ClientState
+		attack      *types.LightClientAttackMisbehaviour
+		timestamp   time.Time
+	)
+
+	altPrivVal := ibctestingmock.NewPV()
+	altPubKey, err := altPrivVal.GetPubKey()
+	suite.Require().NoError(err)
+
+	// altVal holds more than the default trust level but less than 2/3 of the voting power of bothValSet
+	altVal := tmtypes.NewValidator(altPubKey, 6)
+
+	// Create bothValSet with both suite validator and altVal
+	bothValSet := tmtypes.NewValidatorSet(append(suite.valSet.Validators, altVal))
+	bothValsHash := bothValSet.Hash()
+	// Create alternative validator set with only altVal
+	altValSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{altVal})
+
+	_, suiteVal := suite.valSet.GetByIndex(0)
+
+	// Create signer array and ensure it is in same order as bothValSet
+	bothSigners := ibctesting.CreateSortedSignerArray(altPrivVal, suite.privVal, altVal, suiteVal)
+
+	altSigners := []tmtypes.PrivValidator{altPrivVal}
+
+	// Create a validator set unknown to the client
+	unknownPrivVal := ibctestingmock.NewPV()
+	unknownPubKey, err := unknownPrivVal.GetPubKey()
+	suite.Require().NoError(err)
+
+	unknownValSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{tmtypes.NewValidator(unknownPubKey, 10)})
+	unknownSigners := []tmtypes.PrivValidator{unknownPrivVal}
+
+	heightPlus1 := clienttypes.NewHeight(height.RevisionNumber, height.RevisionHeight+1)
+	forkHeight := clienttypes.NewHeight(height.RevisionNumber, height.RevisionHeight+2)
+
+	chainATendermint := suite.chainA.TestChainClient.(*ibctesting.TestChainTendermint)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"valid lunatic attack signed by a minority of the trusted validators", func() {}, true,
+		},
+		{
+			"valid equivocation with the trusted validators", func() {
+				attack.ConflictingHeader = chainATendermint.CreateTMClientHeader(chainID, int64(forkHeight.RevisionHeight), height, suite.now.Add(2*time.Minute), bothValSet, bothValSet, bothSigners)
+			}, true,
+		},
+		{
+			"valid trusted trace with adjacent headers", func() {
+				attack.TrustedTrace = []*types.Header{
+					chainATendermint.CreateTMClientHeader(chainID, int64(heightPlus1.RevisionHeight), height, suite.now.Add(time.Minute), bothValSet, bothValSet, bothSigners),
+					chainATendermint.CreateTMClientHeader(chainID, int64(forkHeight.RevisionHeight), heightPlus1, suite.now.Add(2*time.Minute), bothValSet, bothValSet, bothSigners),
+				}
+			}, true,
+		},
+		{
+			"conflicting header is the trusted header", func() {
+				attack.ConflictingHeader = attack.TrustedTrace[0]
+			}, false,
+		},
+		{
+			"conflicting header is not signed by the trusted validators", func() {
+				attack.ConflictingHeader = chainATendermint.CreateTMClientHeader(chainID, int64(forkHeight.RevisionHeight), height, suite.now.Add(time.Minute), unknownValSet, bothValSet, unknownSigners)
+			}, false,
+		},
+		{
+			"trusted trace header is not signed by the trusted validators", func() {
+				attack.TrustedTrace[0] = chainATendermint.CreateTMClientHeader(chainID, int64(forkHeight.RevisionHeight), height, suite.now.Add(time.Minute), unknownValSet, bothValSet, unknownSigners)
+			}, false,
+		},
+		{
+			"consensus state at common height not found", func() {
+				attack.CommonHeight = heightPlus1
+				attack.ConflictingHeader.TrustedHeight = heightPlus1
+				attack.TrustedTrace[0].TrustedHeight = heightPlus1
+			}, false,
+		},
+		{
+			"trusting period has expired", func() {
+				timestamp = suite.now.Add(trustingPeriod)
+			}, false,
+		},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case: %s", tc.name), func() {
+			// reset suite to create fresh application state
+			suite.SetupTest()
+
+			clientState = types.NewClientState(chainID, types.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, height, commitmenttypes.GetSDKSpecs(), upgradePath, false, false)
+			attack = types.NewLightClientAttackMisbehaviour(
+				clientID,
+				chainATendermint.CreateTMClientHeader(chainID, int64(forkHeight.RevisionHeight), height, suite.now.Add(time.Minute), altValSet, bothValSet, altSigners),
+				height,
+				[]*types.Header{
+					chainATendermint.CreateTMClientHeader(chainID, int64(forkHeight.RevisionHeight), height, suite.now.Add(time.Minute), bothValSet, bothValSet, bothSigners),
+				},
+			)
+			timestamp = suite.now.Add(3 * time.Minute)
+
+			tc.malleate()
+
+			// Set current timestamp in context
+			ctx := suite.chainA.GetContext().WithBlockTime(timestamp)
+
+			// Set trusted consensus state at the common height in client store
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientConsensusState(ctx, clientID, height, types.NewConsensusState(suite.now, commitmenttypes.NewMerkleRoot(tmhash.Sum([]byte("app_hash"))), bothValsHash))
+
+			updatedClientState, err := clientState.CheckMisbehaviourAndUpdateState(
+				ctx,
+				suite.chainA.App.AppCodec(),
+				suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, clientID), // pass in clientID prefixed clientStore
+				attack,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.name)
+				suite.Require().Equal(forkHeight, updatedClientState.(*types.ClientState).FrozenHeight, "valid test case %d failed: %s", i, tc.name)
+			} else {
+				suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.name)
+				suite.Require().Nil(updatedClientState, "invalid test case %d passed: %s", i, tc.name)
+			}
+		})
+	}
+}
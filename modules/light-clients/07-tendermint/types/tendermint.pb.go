@@ -182,6 +182,55 @@ func (m *Misbehaviour) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Misbehaviour proto.InternalMessageInfo
 
+// LightClientAttackMisbehaviour is a wrapper over a conflicting Header and a
+// trace of trusted Headers that implements Misbehaviour interface expected by
+// ICS-02. It mirrors the Tendermint light client attack evidence: the
+// ConflictingHeader and the first Header of the TrustedTrace are both verified
+// from the trusted ConsensusState at the CommonHeight, and the TrustedTrace
+// links that ConsensusState to the height of the ConflictingHeader. This allows
+// detecting lunatic and amnesia attacks where the conflicting block is signed by
+// a minority of the validators at a height unrelated to the stored consensus
+// states.
+type LightClientAttackMisbehaviour struct {
+	ClientId          string       `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty" yaml:"client_id"`
+	ConflictingHeader *Header      `protobuf:"bytes,2,opt,name=conflicting_header,json=conflictingHeader,proto3" json:"conflicting_header,omitempty" yaml:"conflicting_header"`
+	CommonHeight      types.Height `protobuf:"bytes,3,opt,name=common_height,json=commonHeight,proto3" json:"common_height" yaml:"common_height"`
+	TrustedTrace      []*Header    `protobuf:"bytes,4,rep,name=trusted_trace,json=trustedTrace,proto3" json:"trusted_trace,omitempty" yaml:"trusted_trace"`
+}
+
+func (m *LightClientAttackMisbehaviour) Reset()         { *m = LightClientAttackMisbehaviour{} }
+func (m *LightClientAttackMisbehaviour) String() string { return proto.CompactTextString(m) }
+func (*LightClientAttackMisbehaviour) ProtoMessage()    {}
+func (*LightClientAttackMisbehaviour) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6d6cf2b288949be, []int{3}
+}
+func (m *LightClientAttackMisbehaviour) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LightClientAttackMisbehaviour) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LightClientAttackMisbehaviour.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LightClientAttackMisbehaviour) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightClientAttackMisbehaviour.Merge(m, src)
+}
+func (m *LightClientAttackMisbehaviour) XXX_Size() int {
+	return m.Size()
+}
+func (m *LightClientAttackMisbehaviour) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightClientAttackMisbehaviour.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LightClientAttackMisbehaviour proto.InternalMessageInfo
+
 // Header defines the Tendermint client consensus Header.
 // It encapsulates all the information necessary to update from a trusted
 // Tendermint ConsensusState. The inclusion of TrustedHeight and
@@ -205,7 +254,7 @@ func (m *Header) Reset()         { *m = Header{} }
 func (m *Header) String() string { return proto.CompactTextString(m) }
 func (*Header) ProtoMessage()    {}
 func (*Header) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6d6cf2b288949be, []int{3}
+	return fileDescriptor_c6d6cf2b288949be, []int{4}
 }
 func (m *Header) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -266,7 +315,7 @@ func (m *Fraction) Reset()         { *m = Fraction{} }
 func (m *Fraction) String() string { return proto.CompactTextString(m) }
 func (*Fraction) ProtoMessage()    {}
 func (*Fraction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6d6cf2b288949be, []int{4}
+	return fileDescriptor_c6d6cf2b288949be, []int{5}
 }
 func (m *Fraction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -313,6 +362,7 @@ func init() {
 	proto.RegisterType((*ClientState)(nil), "ibc.lightclients.tendermint.v1.ClientState")
 	proto.RegisterType((*ConsensusState)(nil), "ibc.lightclients.tendermint.v1.ConsensusState")
 	proto.RegisterType((*Misbehaviour)(nil), "ibc.lightclients.tendermint.v1.Misbehaviour")
+	proto.RegisterType((*LightClientAttackMisbehaviour)(nil), "ibc.lightclients.tendermint.v1.LightClientAttackMisbehaviour")
 	proto.RegisterType((*Header)(nil), "ibc.lightclients.tendermint.v1.Header")
 	proto.RegisterType((*Fraction)(nil), "ibc.lightclients.tendermint.v1.Fraction")
 }
@@ -322,75 +372,80 @@ func init() {
 }
 
 var fileDescriptor_c6d6cf2b288949be = []byte{
-	// 1080 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0x6e, 0xda, 0xb2, 0x4d, 0x26, 0xe9, 0x76, 0x31, 0xa5, 0x9b, 0x96, 0x6e, 0x1c, 0x19, 0xa9,
-	0xe4, 0x40, 0x6d, 0x92, 0x22, 0x21, 0x55, 0x5c, 0x70, 0x77, 0x51, 0x8b, 0x58, 0xa9, 0x72, 0xf9,
-	0x21, 0x21, 0x21, 0x33, 0xb1, 0x27, 0xc9, 0x68, 0x6d, 0x8f, 0xe5, 0x99, 0x84, 0x96, 0xbf, 0x00,
-	0x0e, 0x48, 0x7b, 0x44, 0x9c, 0x38, 0xf0, 0xc7, 0xec, 0xb1, 0x47, 0x4e, 0x06, 0xb5, 0x57, 0x4e,
-	0x39, 0x72, 0x42, 0xf3, 0xc3, 0xf6, 0x34, 0xdb, 0xa5, 0x5a, 0x2e, 0xd1, 0xbc, 0xf7, 0xbe, 0xf7,
-	0x7d, 0x99, 0x37, 0x6f, 0xde, 0x18, 0x38, 0x78, 0x18, 0x38, 0x11, 0x1e, 0x4f, 0x58, 0x10, 0x61,
-	0x94, 0x30, 0xea, 0x30, 0x94, 0x84, 0x28, 0x8b, 0x71, 0xc2, 0x9c, 0x59, 0x5f, 0xb3, 0xec, 0x34,
-	0x23, 0x8c, 0x18, 0x1d, 0x3c, 0x0c, 0x6c, 0x3d, 0xc1, 0xd6, 0x20, 0xb3, 0xfe, 0x4e, 0x57, 0xcb,
-	0x67, 0x17, 0x29, 0xa2, 0xce, 0x0c, 0x46, 0x38, 0x84, 0x8c, 0x64, 0x92, 0x61, 0x67, 0xf7, 0x25,
-	0x84, 0xf8, 0x55, 0xd1, 0x56, 0x9a, 0x11, 0x32, 0x2a, 0xac, 0xce, 0x98, 0x90, 0x71, 0x84, 0x1c,
-	0x61, 0x0d, 0xa7, 0x23, 0x27, 0x9c, 0x66, 0x90, 0x61, 0x92, 0xa8, 0xb8, 0xb9, 0x18, 0x67, 0x38,
-	0x46, 0x94, 0xc1, 0x38, 0x2d, 0x00, 0x7c, 0x7f, 0x01, 0xc9, 0x90, 0x23, 0xff, 0x2e, 0xdf, 0x93,
-	0x5c, 0x29, 0xc0, 0x7b, 0x15, 0x80, 0xc4, 0x31, 0x66, 0x71, 0x01, 0x2a, 0x2d, 0x05, 0xdc, 0x1c,
-	0x93, 0x31, 0x11, 0x4b, 0x87, 0xaf, 0xa4, 0xd7, 0xfa, 0x7b, 0x0d, 0x34, 0x8f, 0x04, 0xdf, 0x19,
-	0x83, 0x0c, 0x19, 0xdb, 0xa0, 0x1e, 0x4c, 0x20, 0x4e, 0x7c, 0x1c, 0xb6, 0x6b, 0xdd, 0x5a, 0xaf,
-	0xe1, 0xad, 0x09, 0xfb, 0x24, 0x34, 0x10, 0x68, 0xb2, 0x6c, 0x4a, 0x99, 0x1f, 0xa1, 0x19, 0x8a,
-	0xda, 0xcb, 0xdd, 0x5a, 0xaf, 0x39, 0xe8, 0xd9, 0xff, 0x5d, 0x4f, 0xfb, 0xd3, 0x0c, 0x06, 0x7c,
-	0xc3, 0xee, 0xce, 0x8b, 0xdc, 0x5c, 0x9a, 0xe7, 0xa6, 0x71, 0x01, 0xe3, 0xe8, 0xd0, 0xd2, 0xa8,
-	0x2c, 0x0f, 0x08, 0xeb, 0x73, 0x6e, 0x18, 0x23, 0xb0, 0x21, 0x2c, 0x9c, 0x8c, 0xfd, 0x14, 0x65,
-	0x98, 0x84, 0xed, 0x15, 0x21, 0xb5, 0x6d, 0xcb, 0x62, 0xd9, 0x45, 0xb1, 0xec, 0xc7, 0xaa, 0x98,
-	0xae, 0xa5, 0xb8, 0xb7, 0x34, 0xee, 0x2a, 0xdf, 0xfa, 0xe5, 0x4f, 0xb3, 0xe6, 0xdd, 0x2f, 0xbc,
-	0xa7, 0xc2, 0x69, 0x60, 0xf0, 0x60, 0x9a, 0x0c, 0x49, 0x12, 0x6a, 0x42, 0xab, 0x77, 0x09, 0xbd,
-	0xab, 0x84, 0x1e, 0x4a, 0xa1, 0x45, 0x02, 0xa9, 0xb4, 0x51, 0xba, 0x95, 0x14, 0x02, 0x1b, 0x31,
-	0x3c, 0xf7, 0x83, 0x88, 0x04, 0xcf, 0xfc, 0x30, 0xc3, 0x23, 0xd6, 0x7e, 0xe3, 0x35, 0xb7, 0xb4,
-	0x90, 0x2f, 0x85, 0xd6, 0x63, 0x78, 0x7e, 0xc4, 0x9d, 0x8f, 0xb9, 0xcf, 0xf8, 0x16, 0xac, 0x8f,
-	0x32, 0xf2, 0x03, 0x4a, 0xfc, 0x09, 0xe2, 0x07, 0xd2, 0xbe, 0x27, 0x44, 0x76, 0xc4, 0x11, 0xf1,
-	0x16, 0xb1, 0x55, 0xe7, 0xcc, 0xfa, 0xf6, 0xb1, 0x40, 0xb8, 0xbb, 0x4a, 0x65, 0x53, 0xaa, 0xdc,
-	0x48, 0xb7, 0xbc, 0x96, 0xb4, 0x25, 0x96, 0xd3, 0x47, 0x90, 0x21, 0xca, 0x0a, 0xfa, 0xb5, 0xd7,
-	0xa5, 0xbf, 0x91, 0x6e, 0x79, 0x2d, 0x69, 0x2b, 0xfa, 0x13, 0xd0, 0x14, 0x57, 0xc7, 0xa7, 0x29,
-	0x0a, 0x68, 0xbb, 0xde, 0x5d, 0xe9, 0x35, 0x07, 0x0f, 0x6c, 0x1c, 0xd0, 0xc1, 0x81, 0x7d, 0xca,
-	0x23, 0x67, 0x29, 0x0a, 0xdc, 0xad, 0xaa, 0x85, 0x34, 0xb8, 0xe5, 0x81, 0xb4, 0x80, 0x50, 0xe3,
-	0x10, 0xb4, 0xa6, 0xe9, 0x38, 0x83, 0x21, 0xf2, 0x53, 0xc8, 0x26, 0xed, 0x46, 0x77, 0xa5, 0xd7,
-	0x70, 0x1f, 0xce, 0x73, 0xf3, 0x2d, 0x75, 0x6e, 0x5a, 0xd4, 0xf2, 0x9a, 0xca, 0x3c, 0x85, 0x6c,
-	0x62, 0x40, 0xb0, 0x0d, 0xa3, 0x88, 0x7c, 0xef, 0x4f, 0xd3, 0x10, 0x32, 0xe4, 0xc3, 0x11, 0x43,
-	0x99, 0x8f, 0xce, 0x53, 0x9c, 0x5d, 0xb4, 0x41, 0xb7, 0xd6, 0xab, 0xbb, 0x7b, 0xf3, 0xdc, 0xec,
-	0x4a, 0xa2, 0x57, 0x42, 0xad, 0x76, 0xcd, 0xdb, 0x12, 0xd1, 0x2f, 0x45, 0xf0, 0x13, 0x1e, 0x7b,
-	0x22, 0x42, 0x06, 0x05, 0xe6, 0x2d, 0x79, 0x31, 0xa6, 0x43, 0x34, 0x81, 0x33, 0x4c, 0xa6, 0x59,
-	0xbb, 0x29, 0x84, 0xde, 0x9f, 0xe7, 0xe6, 0xde, 0x2b, 0x85, 0xf4, 0x04, 0x2e, 0xb7, 0xbb, 0x28,
-	0xf7, 0x54, 0x03, 0x1c, 0xae, 0xfe, 0xf8, 0x9b, 0xb9, 0x64, 0xfd, 0xbe, 0x0c, 0xee, 0x1f, 0x91,
-	0x84, 0xa2, 0x84, 0x4e, 0xa9, 0xbc, 0xf1, 0x2e, 0x68, 0x94, 0x43, 0x47, 0x5c, 0x79, 0x7e, 0xa4,
-	0x8b, 0x6d, 0xf9, 0x45, 0x81, 0x70, 0xeb, 0xfc, 0x48, 0x9f, 0xf3, 0xee, 0xab, 0xd2, 0x8c, 0x8f,
-	0xc1, 0x6a, 0x46, 0x08, 0x53, 0x33, 0xc1, 0xd2, 0x3a, 0xa2, 0x9a, 0x42, 0xb3, 0xbe, 0xfd, 0x14,
-	0x65, 0xcf, 0x22, 0xe4, 0x11, 0xc2, 0xdc, 0x55, 0x4e, 0xe3, 0x89, 0x2c, 0xe3, 0xa7, 0x1a, 0xd8,
-	0x4c, 0xd0, 0x39, 0xf3, 0xcb, 0x49, 0x4b, 0xfd, 0x09, 0xa4, 0x13, 0x71, 0xef, 0x5b, 0xee, 0xd7,
-	0xf3, 0xdc, 0x7c, 0x47, 0x56, 0xe1, 0x36, 0x94, 0xf5, 0x4f, 0x6e, 0x7e, 0x38, 0xc6, 0x6c, 0x32,
-	0x1d, 0x72, 0x39, 0x7d, 0xfe, 0x6b, 0xcb, 0x08, 0x0f, 0xa9, 0x33, 0xbc, 0x60, 0x88, 0xda, 0xc7,
-	0xe8, 0xdc, 0xe5, 0x0b, 0xcf, 0xe0, 0x74, 0x5f, 0x95, 0x6c, 0xc7, 0x90, 0x4e, 0x54, 0x99, 0x7e,
-	0x5e, 0x06, 0x2d, 0xbd, 0x7a, 0x46, 0x1f, 0x34, 0x64, 0x73, 0x97, 0x73, 0xd1, 0xdd, 0x9c, 0xe7,
-	0xe6, 0x03, 0xf9, 0xb7, 0xca, 0x90, 0xe5, 0xd5, 0xe5, 0xfa, 0x24, 0x34, 0x20, 0xa8, 0x4f, 0x10,
-	0x0c, 0x51, 0xe6, 0xf7, 0x55, 0x5d, 0xf6, 0xee, 0x9a, 0x95, 0xc7, 0x02, 0xef, 0x76, 0xae, 0x72,
-	0x73, 0x4d, 0xae, 0xfb, 0xf3, 0xdc, 0xdc, 0x90, 0x22, 0x05, 0x99, 0xe5, 0xad, 0xc9, 0x65, 0x5f,
-	0x93, 0x18, 0xa8, 0x19, 0xf9, 0x3f, 0x24, 0x06, 0x2f, 0x49, 0x0c, 0x4a, 0x89, 0x81, 0xaa, 0xc7,
-	0xaf, 0x2b, 0xe0, 0x9e, 0x44, 0x1b, 0x10, 0xac, 0x53, 0x3c, 0x4e, 0x50, 0xe8, 0x4b, 0x88, 0x6a,
-	0x99, 0x8e, 0xae, 0x23, 0xdf, 0xc3, 0x33, 0x01, 0x53, 0x82, 0xbb, 0x97, 0xb9, 0x59, 0xab, 0x26,
-	0xc1, 0x0d, 0x0a, 0xcb, 0x6b, 0x51, 0x0d, 0xcb, 0x07, 0x4d, 0x79, 0xc6, 0x3e, 0x45, 0x45, 0x5b,
-	0xdd, 0x22, 0x51, 0x1e, 0xde, 0x19, 0x62, 0x6e, 0xbb, 0xa2, 0xbf, 0x91, 0x6e, 0x79, 0xad, 0x99,
-	0x86, 0x33, 0xbe, 0x03, 0xf2, 0x29, 0x10, 0xfa, 0x62, 0x90, 0xad, 0xdc, 0x39, 0xc8, 0x1e, 0xa9,
-	0x41, 0xf6, 0xb6, 0xf6, 0xc0, 0x94, 0xf9, 0x96, 0xb7, 0xae, 0x1c, 0x6a, 0x94, 0x45, 0xc0, 0x28,
-	0x10, 0x55, 0xb3, 0xaa, 0xc7, 0xe5, 0xae, 0x5d, 0x3c, 0x9a, 0xe7, 0xe6, 0xf6, 0x4d, 0x95, 0x8a,
-	0xc3, 0xf2, 0xde, 0x54, 0xce, 0xaa, 0x6d, 0xad, 0xcf, 0x40, 0xbd, 0x78, 0x64, 0x8d, 0x5d, 0xd0,
-	0x48, 0xa6, 0x31, 0xca, 0x78, 0x44, 0x9c, 0xcc, 0xaa, 0x57, 0x39, 0x8c, 0x2e, 0x68, 0x86, 0x28,
-	0x21, 0x31, 0x4e, 0x44, 0x7c, 0x59, 0xc4, 0x75, 0x97, 0xeb, 0xbf, 0xb8, 0xea, 0xd4, 0x2e, 0xaf,
-	0x3a, 0xb5, 0xbf, 0xae, 0x3a, 0xb5, 0xe7, 0xd7, 0x9d, 0xa5, 0xcb, 0xeb, 0xce, 0xd2, 0x1f, 0xd7,
-	0x9d, 0xa5, 0x6f, 0x9e, 0x68, 0x57, 0x2c, 0x20, 0x34, 0x26, 0x94, 0x7f, 0x7a, 0xed, 0x8f, 0x89,
-	0x33, 0x3b, 0x70, 0x62, 0x12, 0x4e, 0x23, 0x44, 0xe5, 0x87, 0xd8, 0x7e, 0xf1, 0x25, 0xf6, 0xc1,
-	0x47, 0xfb, 0x8b, 0x9f, 0x4a, 0xc3, 0x7b, 0x62, 0xa4, 0x1c, 0xfc, 0x1b, 0x00, 0x00, 0xff, 0xff,
-	0x31, 0x4f, 0x07, 0x47, 0xb8, 0x09, 0x00, 0x00,
+	// 1162 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x8f, 0xdb, 0xc4,
+	0x17, 0x5f, 0x6f, 0xf2, 0xed, 0x26, 0x93, 0x6c, 0xb7, 0xf5, 0x77, 0x69, 0xbd, 0xcb, 0x36, 0x8e,
+	0x8c, 0x54, 0x72, 0xa0, 0x36, 0x49, 0x91, 0x90, 0x2a, 0x2e, 0x75, 0x5b, 0xb4, 0x45, 0xad, 0x54,
+	0x79, 0x4b, 0x91, 0x90, 0x90, 0x99, 0xd8, 0x93, 0x64, 0x54, 0xdb, 0x63, 0x79, 0x26, 0x61, 0x97,
+	0xbf, 0x00, 0x0e, 0x48, 0x3d, 0x70, 0x40, 0x9c, 0x38, 0xf0, 0xc7, 0xf4, 0xd8, 0x23, 0x27, 0x83,
+	0xb6, 0x57, 0x4e, 0x39, 0x72, 0x42, 0xf3, 0xc3, 0xc9, 0x24, 0xdb, 0xd2, 0x2e, 0x5c, 0xa2, 0x79,
+	0xef, 0x7d, 0xde, 0xe7, 0x93, 0x79, 0x6f, 0xe6, 0x79, 0x80, 0x87, 0x87, 0x91, 0x97, 0xe0, 0xf1,
+	0x84, 0x45, 0x09, 0x46, 0x19, 0xa3, 0x1e, 0x43, 0x59, 0x8c, 0x8a, 0x14, 0x67, 0xcc, 0x9b, 0xf5,
+	0x35, 0xcb, 0xcd, 0x0b, 0xc2, 0x88, 0xd9, 0xc1, 0xc3, 0xc8, 0xd5, 0x13, 0x5c, 0x0d, 0x32, 0xeb,
+	0xef, 0x77, 0xb5, 0x7c, 0x76, 0x92, 0x23, 0xea, 0xcd, 0x60, 0x82, 0x63, 0xc8, 0x48, 0x21, 0x19,
+	0xf6, 0x0f, 0xce, 0x20, 0xc4, 0xaf, 0x8a, 0xb6, 0xf3, 0x82, 0x90, 0x51, 0x65, 0x75, 0xc6, 0x84,
+	0x8c, 0x13, 0xe4, 0x09, 0x6b, 0x38, 0x1d, 0x79, 0xf1, 0xb4, 0x80, 0x0c, 0x93, 0x4c, 0xc5, 0xed,
+	0xf5, 0x38, 0xc3, 0x29, 0xa2, 0x0c, 0xa6, 0x79, 0x05, 0xe0, 0xfb, 0x8b, 0x48, 0x81, 0x3c, 0xf9,
+	0x77, 0xf9, 0x9e, 0xe4, 0x4a, 0x01, 0xde, 0x5f, 0x02, 0x48, 0x9a, 0x62, 0x96, 0x56, 0xa0, 0x85,
+	0xa5, 0x80, 0xbb, 0x63, 0x32, 0x26, 0x62, 0xe9, 0xf1, 0x95, 0xf4, 0x3a, 0x7f, 0x6e, 0x81, 0xd6,
+	0x1d, 0xc1, 0x77, 0xc4, 0x20, 0x43, 0xe6, 0x1e, 0x68, 0x44, 0x13, 0x88, 0xb3, 0x10, 0xc7, 0x96,
+	0xd1, 0x35, 0x7a, 0xcd, 0x60, 0x4b, 0xd8, 0xf7, 0x63, 0x13, 0x81, 0x16, 0x2b, 0xa6, 0x94, 0x85,
+	0x09, 0x9a, 0xa1, 0xc4, 0xda, 0xec, 0x1a, 0xbd, 0xd6, 0xa0, 0xe7, 0xfe, 0x73, 0x3d, 0xdd, 0x4f,
+	0x0b, 0x18, 0xf1, 0x0d, 0xfb, 0xfb, 0xcf, 0x4b, 0x7b, 0x63, 0x5e, 0xda, 0xe6, 0x09, 0x4c, 0x93,
+	0x5b, 0x8e, 0x46, 0xe5, 0x04, 0x40, 0x58, 0x0f, 0xb8, 0x61, 0x8e, 0xc0, 0x8e, 0xb0, 0x70, 0x36,
+	0x0e, 0x73, 0x54, 0x60, 0x12, 0x5b, 0x35, 0x21, 0xb5, 0xe7, 0xca, 0x62, 0xb9, 0x55, 0xb1, 0xdc,
+	0xbb, 0xaa, 0x98, 0xbe, 0xa3, 0xb8, 0xaf, 0x68, 0xdc, 0xcb, 0x7c, 0xe7, 0xa7, 0xdf, 0x6d, 0x23,
+	0xb8, 0x58, 0x79, 0x1f, 0x09, 0xa7, 0x89, 0xc1, 0xa5, 0x69, 0x36, 0x24, 0x59, 0xac, 0x09, 0xd5,
+	0xdf, 0x24, 0xf4, 0x9e, 0x12, 0xba, 0x2a, 0x85, 0xd6, 0x09, 0xa4, 0xd2, 0xce, 0xc2, 0xad, 0xa4,
+	0x10, 0xd8, 0x49, 0xe1, 0x71, 0x18, 0x25, 0x24, 0x7a, 0x1a, 0xc6, 0x05, 0x1e, 0x31, 0xeb, 0x7f,
+	0xe7, 0xdc, 0xd2, 0x5a, 0xbe, 0x14, 0xda, 0x4e, 0xe1, 0xf1, 0x1d, 0xee, 0xbc, 0xcb, 0x7d, 0xe6,
+	0x57, 0x60, 0x7b, 0x54, 0x90, 0x6f, 0x51, 0x16, 0x4e, 0x10, 0x6f, 0x88, 0x75, 0x41, 0x88, 0xec,
+	0x8b, 0x16, 0xf1, 0x23, 0xe2, 0xaa, 0x93, 0x33, 0xeb, 0xbb, 0x87, 0x02, 0xe1, 0x1f, 0x28, 0x95,
+	0x5d, 0xa9, 0xb2, 0x92, 0xee, 0x04, 0x6d, 0x69, 0x4b, 0x2c, 0xa7, 0x4f, 0x20, 0x43, 0x94, 0x55,
+	0xf4, 0x5b, 0xe7, 0xa5, 0x5f, 0x49, 0x77, 0x82, 0xb6, 0xb4, 0x15, 0xfd, 0x7d, 0xd0, 0x12, 0x57,
+	0x27, 0xa4, 0x39, 0x8a, 0xa8, 0xd5, 0xe8, 0xd6, 0x7a, 0xad, 0xc1, 0x25, 0x17, 0x47, 0x74, 0x70,
+	0xd3, 0x7d, 0xc4, 0x23, 0x47, 0x39, 0x8a, 0xfc, 0x2b, 0xcb, 0x23, 0xa4, 0xc1, 0x9d, 0x00, 0xe4,
+	0x15, 0x84, 0x9a, 0xb7, 0x40, 0x7b, 0x9a, 0x8f, 0x0b, 0x18, 0xa3, 0x30, 0x87, 0x6c, 0x62, 0x35,
+	0xbb, 0xb5, 0x5e, 0xd3, 0xbf, 0x3a, 0x2f, 0xed, 0xff, 0xab, 0xbe, 0x69, 0x51, 0x27, 0x68, 0x29,
+	0xf3, 0x11, 0x64, 0x13, 0x13, 0x82, 0x3d, 0x98, 0x24, 0xe4, 0x9b, 0x70, 0x9a, 0xc7, 0x90, 0xa1,
+	0x10, 0x8e, 0x18, 0x2a, 0x42, 0x74, 0x9c, 0xe3, 0xe2, 0xc4, 0x02, 0x5d, 0xa3, 0xd7, 0xf0, 0xaf,
+	0xcf, 0x4b, 0xbb, 0x2b, 0x89, 0x5e, 0x0b, 0x75, 0x2c, 0x23, 0xb8, 0x22, 0xa2, 0x9f, 0x8b, 0xe0,
+	0x6d, 0x1e, 0xbb, 0x27, 0x42, 0x26, 0x05, 0xf6, 0x2b, 0xf2, 0x52, 0x4c, 0x87, 0x68, 0x02, 0x67,
+	0x98, 0x4c, 0x0b, 0xab, 0x25, 0x84, 0x3e, 0x98, 0x97, 0xf6, 0xf5, 0xd7, 0x0a, 0xe9, 0x09, 0x5c,
+	0xee, 0x60, 0x5d, 0xee, 0xa1, 0x06, 0xb8, 0x55, 0xff, 0xee, 0x17, 0x7b, 0xc3, 0xf9, 0x75, 0x13,
+	0x5c, 0xbc, 0x43, 0x32, 0x8a, 0x32, 0x3a, 0xa5, 0xf2, 0xc6, 0xfb, 0xa0, 0xb9, 0x18, 0x3a, 0x96,
+	0xa1, 0x5a, 0xba, 0x7e, 0x2c, 0x1f, 0x57, 0x08, 0xbf, 0xc1, 0x5b, 0xfa, 0x8c, 0x9f, 0xbe, 0x65,
+	0x9a, 0xf9, 0x09, 0xa8, 0x17, 0x84, 0x30, 0x35, 0x13, 0x1c, 0xed, 0x44, 0x2c, 0xa7, 0xd0, 0xac,
+	0xef, 0x3e, 0x44, 0xc5, 0xd3, 0x04, 0x05, 0x84, 0x30, 0xbf, 0xce, 0x69, 0x02, 0x91, 0x65, 0x7e,
+	0x6f, 0x80, 0xdd, 0x0c, 0x1d, 0xb3, 0x70, 0x31, 0x69, 0x69, 0x38, 0x81, 0x74, 0x22, 0xee, 0x7d,
+	0xdb, 0xff, 0x62, 0x5e, 0xda, 0xef, 0xca, 0x2a, 0xbc, 0x0a, 0xe5, 0xfc, 0x55, 0xda, 0x1f, 0x8d,
+	0x31, 0x9b, 0x4c, 0x87, 0x5c, 0x4e, 0x9f, 0xff, 0xda, 0x32, 0xc1, 0x43, 0xea, 0x0d, 0x4f, 0x18,
+	0xa2, 0xee, 0x21, 0x3a, 0xf6, 0xf9, 0x22, 0x30, 0x39, 0xdd, 0x93, 0x05, 0xdb, 0x21, 0xa4, 0x13,
+	0x55, 0xa6, 0x1f, 0x36, 0x41, 0x5b, 0xaf, 0x9e, 0xd9, 0x07, 0x4d, 0x79, 0xb8, 0x17, 0x73, 0xd1,
+	0xdf, 0x9d, 0x97, 0xf6, 0x25, 0xf9, 0xb7, 0x16, 0x21, 0x27, 0x68, 0xc8, 0xf5, 0xfd, 0xd8, 0x84,
+	0xa0, 0x31, 0x41, 0x30, 0x46, 0x45, 0xd8, 0x57, 0x75, 0xb9, 0xfe, 0xa6, 0x59, 0x79, 0x28, 0xf0,
+	0x7e, 0xe7, 0xb4, 0xb4, 0xb7, 0xe4, 0xba, 0x3f, 0x2f, 0xed, 0x1d, 0x29, 0x52, 0x91, 0x39, 0xc1,
+	0x96, 0x5c, 0xf6, 0x35, 0x89, 0x81, 0x55, 0xfb, 0xb7, 0x12, 0x83, 0x33, 0x12, 0x83, 0x85, 0xc4,
+	0x40, 0xd5, 0xe3, 0xc7, 0x1a, 0xb8, 0xf6, 0x80, 0x93, 0xca, 0x4f, 0xc5, 0x6d, 0xc6, 0x60, 0xf4,
+	0xf4, 0xbf, 0x16, 0x88, 0x01, 0x33, 0x22, 0xd9, 0x28, 0xc1, 0x91, 0x98, 0xd5, 0x52, 0xf1, 0x9c,
+	0xa5, 0xba, 0x36, 0x2f, 0xed, 0x3d, 0xa5, 0x71, 0x86, 0xcb, 0x09, 0x2e, 0x6b, 0x4e, 0x99, 0xc1,
+	0xa7, 0x18, 0x3f, 0x94, 0x64, 0x31, 0x24, 0x6b, 0xe7, 0x9d, 0x62, 0x2b, 0xe9, 0x4e, 0xd0, 0x96,
+	0xb6, 0x9a, 0x62, 0x08, 0x6c, 0x8b, 0xef, 0x0c, 0x8a, 0x43, 0x56, 0xc0, 0x08, 0x59, 0xf5, 0x6e,
+	0xed, 0x1c, 0xfb, 0xb1, 0x96, 0x32, 0x2b, 0x34, 0x4e, 0xd0, 0x56, 0xf6, 0x63, 0x6e, 0xaa, 0xb6,
+	0xfc, 0x5c, 0x03, 0x17, 0xd4, 0xb6, 0x20, 0xd8, 0xa6, 0x78, 0x9c, 0xa1, 0xb8, 0xaa, 0xa3, 0xbc,
+	0xc9, 0x1d, 0x5d, 0x46, 0x3e, 0x53, 0x8e, 0x04, 0x4c, 0xe9, 0x1d, 0xbc, 0x28, 0x6d, 0x63, 0xa9,
+	0xb9, 0x42, 0xe1, 0x04, 0x6d, 0xaa, 0x61, 0x79, 0xe5, 0x16, 0x57, 0x2f, 0xa4, 0xa8, 0xba, 0xed,
+	0xaf, 0x90, 0x58, 0xdc, 0xa9, 0x23, 0xc4, 0xf4, 0x2d, 0xad, 0xa4, 0x3b, 0x41, 0x7b, 0xa6, 0xe1,
+	0xcc, 0xaf, 0xc1, 0xc5, 0x6a, 0xcb, 0x6f, 0xdd, 0x99, 0x6b, 0xaa, 0x33, 0xef, 0xac, 0x96, 0xac,
+	0x6a, 0x4d, 0xd5, 0x0a, 0xd5, 0x9b, 0x04, 0x98, 0x15, 0x62, 0x39, 0x43, 0xac, 0xfa, 0x5b, 0xed,
+	0x42, 0x3b, 0x68, 0x67, 0x39, 0x9c, 0xe0, 0xb2, 0x72, 0x3e, 0x59, 0xfa, 0x3e, 0x03, 0x8d, 0xea,
+	0xed, 0x63, 0x1e, 0x80, 0x66, 0x36, 0x4d, 0x51, 0xc1, 0x23, 0xa2, 0x33, 0xf5, 0x60, 0xe9, 0x30,
+	0xbb, 0xa0, 0x15, 0xa3, 0x8c, 0xa4, 0x38, 0x13, 0xf1, 0x4d, 0x11, 0xd7, 0x5d, 0x7e, 0xf8, 0xfc,
+	0xb4, 0x63, 0xbc, 0x38, 0xed, 0x18, 0x7f, 0x9c, 0x76, 0x8c, 0x67, 0x2f, 0x3b, 0x1b, 0x2f, 0x5e,
+	0x76, 0x36, 0x7e, 0x7b, 0xd9, 0xd9, 0xf8, 0xf2, 0x9e, 0x36, 0xf9, 0x22, 0x42, 0x53, 0x42, 0xf9,
+	0x8b, 0xf8, 0xc6, 0x98, 0x78, 0xb3, 0x9b, 0x5e, 0x4a, 0xe2, 0x69, 0x82, 0xa8, 0x7c, 0x1f, 0xdf,
+	0xa8, 0x1e, 0xc8, 0x1f, 0x7e, 0x7c, 0x63, 0xfd, 0x05, 0x3b, 0xbc, 0x20, 0x26, 0xfd, 0xcd, 0xbf,
+	0x07, 0x00, 0x76, 0x40, 0x60, 0xcf, 0x4f, 0x0b, 0x00, 0x00,
 }
 
 func (m *ClientState) Marshal() (dAtA []byte, err error) {
@@ -622,6 +677,72 @@ func (m *Misbehaviour) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LightClientAttackMisbehaviour) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LightClientAttackMisbehaviour) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LightClientAttackMisbehaviour) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TrustedTrace) > 0 {
+		for iNdEx := len(m.TrustedTrace) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TrustedTrace[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTendermint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.CommonHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTendermint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ConflictingHeader != nil {
+		{
+			size, err := m.ConflictingHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTendermint(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTendermint(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Header) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -816,6 +937,31 @@ func (m *Misbehaviour) Size() (n int) {
 	return n
 }
 
+func (m *LightClientAttackMisbehaviour) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTendermint(uint64(l))
+	}
+	if m.ConflictingHeader != nil {
+		l = m.ConflictingHeader.Size()
+		n += 1 + l + sovTendermint(uint64(l))
+	}
+	l = m.CommonHeight.Size()
+	n += 1 + l + sovTendermint(uint64(l))
+	if len(m.TrustedTrace) > 0 {
+		for _, e := range m.TrustedTrace {
+			l = e.Size()
+			n += 1 + l + sovTendermint(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Header) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1550,6 +1696,191 @@ func (m *Misbehaviour) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LightClientAttackMisbehaviour) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTendermint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LightClientAttackMisbehaviour: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LightClientAttackMisbehaviour: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictingHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConflictingHeader == nil {
+				m.ConflictingHeader = &Header{}
+			}
+			if err := m.ConflictingHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommonHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustedTrace", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrustedTrace = append(m.TrustedTrace, &Header{})
+			if err := m.TrustedTrace[len(m.TrustedTrace)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTendermint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Header) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
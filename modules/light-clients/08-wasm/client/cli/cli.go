@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the query commands for the 08-wasm light client
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        "ibc-wasm",
+		Short:                      "IBC wasm light client query subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdQueryChecksums(),
+		GetCmdQueryCode(),
+		GetCmdParams(),
+	)
+
+	return queryCmd
+}
+
+// NewTxCmd returns the transaction commands for the 08-wasm light client
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        "ibc-wasm",
+		Short:                      "IBC wasm light client transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewStoreCodeCmd(),
+	)
+
+	return txCmd
+}
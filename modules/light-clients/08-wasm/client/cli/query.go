@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/types"
+)
+
+// GetCmdQueryChecksums defines the command to query the checksums of all stored contract codes.
+func GetCmdQueryChecksums() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "checksums",
+		Short:   "Query the checksums of all stored wasm light client codes",
+		Long:    "Query the hex encoded checksums of all stored wasm light client codes",
+		Example: fmt.Sprintf("%s query ibc-wasm checksums", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryChecksumsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Checksums(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "checksums")
+
+	return cmd
+}
+
+// GetCmdQueryCode defines the command to query the contract code stored under a checksum.
+func GetCmdQueryCode() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "code [checksum]",
+		Short:   "Query the wasm light client code stored under a checksum",
+		Long:    "Query the wasm light client code stored under a hex encoded checksum",
+		Example: fmt.Sprintf("%s query ibc-wasm code 8e9d7f3b5c2a...", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryCodeRequest{
+				Checksum: args[0],
+			}
+
+			res, err := queryClient.Code(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdParams returns the command handler for 08-wasm parameter querying.
+func GetCmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query the current 08-wasm parameters",
+		Long:    "Query the current 08-wasm parameters",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc-wasm params", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/types"
+)
+
+// NewStoreCodeCmd defines the command to store a wasm light client code. The signer
+// must be the 08-wasm authority.
+func NewStoreCodeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "store-code [path/to/wasm-file]",
+		Short:   "Store a wasm light client code",
+		Long:    "Store a wasm light client code and allow new clients to use it. The signer must be the 08-wasm authority.",
+		Example: fmt.Sprintf("%s tx ibc-wasm store-code light_client.wasm --from authority", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			code, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgStoreCode(clientCtx.GetFromAddress().String(), code)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,14 @@
+/*
+Package wasm implements a concrete `ClientState`, `ConsensusState`, `Header` and
+`Misbehaviour` types for light clients whose verification, update, misbehaviour
+and upgrade logic is implemented by a stored Wasm contract executed by a
+`WasmEngine`.
+
+The module only defines the `WasmEngine` interface and does not provide an engine
+executing wasm code. Applications must pass a `WasmEngine` to the 08-wasm keeper and
+register the light client module returned by `NewLightClientModule` on the 02-client
+keeper, which wraps the client store of wasm clients with the engine of the keeper. The
+in-process `MockWasmEngine` of the testing package dispatches contract calls to Go
+callbacks and must only be used in tests.
+*/
+package wasm
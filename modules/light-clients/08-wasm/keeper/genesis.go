@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/types"
+)
+
+// InitGenesis initializes the 08-wasm state and stores the genesis contract codes in the
+// WasmEngine.
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	for _, contract := range state.Contracts {
+		if _, err := k.storeCode(ctx, contract.CodeBytes); err != nil {
+			panic(fmt.Sprintf("failed to store genesis contract: %v", err))
+		}
+	}
+
+	k.SetParams(ctx, state.Params)
+}
+
+// ExportGenesis exports the 08-wasm params and contract codes into its genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	contracts := []types.Contract{}
+	k.IterateCodes(ctx, func(_, code []byte) bool {
+		contracts = append(contracts, types.Contract{CodeBytes: code})
+		return false
+	})
+
+	return types.NewGenesisState(k.GetParams(ctx), contracts)
+}
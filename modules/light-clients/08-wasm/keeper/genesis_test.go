@@ -0,0 +1,39 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+
+	wasmtesting "github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/testing"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/types"
+)
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	checksum := suite.storeCode(wasmtesting.Code)
+	suite.storeCode([]byte("\x00asm\x02\x00\x00\x00"))
+
+	genesis := suite.keeper.ExportGenesis(suite.chainA.GetContext())
+	suite.Require().NoError(genesis.Validate())
+	suite.Require().Len(genesis.Contracts, 2)
+
+	// only the first code remains allowed
+	genesis.Params = types.NewParams(hex.EncodeToString(checksum))
+
+	suite.SetupTest()
+	ctx := suite.chainA.GetContext()
+
+	suite.Require().NotPanics(func() {
+		suite.keeper.InitGenesis(ctx, *genesis)
+	})
+
+	suite.Require().Equal(genesis, suite.keeper.ExportGenesis(ctx))
+	suite.Require().True(suite.keeper.IsAllowedChecksum(ctx, checksum))
+	suite.Require().False(suite.keeper.IsAllowedChecksum(ctx, types.Checksum([]byte("\x00asm\x02\x00\x00\x00"))))
+
+	// genesis contracts are stored in the wasm engine
+	_, err := suite.engine.Instantiate(checksum, types.NewEnv(ctx), nil, nil, nil)
+	suite.Require().NoError(err)
+
+	suite.Require().Panics(func() {
+		suite.keeper.InitGenesis(ctx, *genesis)
+	})
+}
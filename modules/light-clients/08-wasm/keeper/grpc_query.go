@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Checksums implements the Query/Checksums gRPC method
+func (k Keeper) Checksums(c context.Context, req *types.QueryChecksumsRequest) (*types.QueryChecksumsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	checksums := []string{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.KeyCodePrefix+"/"))
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		checksums = append(checksums, string(key))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryChecksumsResponse{
+		Checksums:  checksums,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Code implements the Query/Code gRPC method
+func (k Keeper) Code(c context.Context, req *types.QueryCodeRequest) (*types.QueryCodeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	checksum, err := hex.DecodeString(req.Checksum)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := types.ValidateChecksum(checksum); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	code, found := k.GetCode(ctx, checksum)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "%s: %s", req.Checksum, types.ErrCodeNotFound.Error())
+	}
+
+	return &types.QueryCodeResponse{
+		Data: code,
+	}, nil
+}
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{
+		Params: &params,
+	}, nil
+}
@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	wasmtesting "github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/testing"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryChecksums() {
+	codes := [][]byte{wasmtesting.Code, []byte("\x00asm\x02\x00\x00\x00"), []byte("\x00asm\x03\x00\x00\x00")}
+
+	var expChecksums []string
+	for _, code := range codes {
+		expChecksums = append(expChecksums, hex.EncodeToString(suite.storeCode(code)))
+	}
+
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+	res, err := suite.keeper.Checksums(ctx, &types.QueryChecksumsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch(expChecksums, res.Checksums)
+
+	res, err = suite.keeper.Checksums(ctx, &types.QueryChecksumsRequest{Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Checksums, 2)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+
+	_, err = suite.keeper.Checksums(ctx, nil)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryCode() {
+	checksum := hex.EncodeToString(suite.storeCode(wasmtesting.Code))
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+	res, err := suite.keeper.Code(ctx, &types.QueryCodeRequest{Checksum: checksum})
+	suite.Require().NoError(err)
+	suite.Require().Equal(wasmtesting.Code, res.Data)
+
+	_, err = suite.keeper.Code(ctx, &types.QueryCodeRequest{Checksum: hex.EncodeToString(types.Checksum([]byte("code")))})
+	suite.Require().Error(err)
+
+	_, err = suite.keeper.Code(ctx, &types.QueryCodeRequest{Checksum: "checksum"})
+	suite.Require().Error(err)
+
+	_, err = suite.keeper.Code(ctx, nil)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryParams() {
+	checksum := suite.storeCode(wasmtesting.Code)
+
+	res, err := suite.keeper.Params(sdk.WrapSDKContext(suite.chainA.GetContext()), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewParams(hex.EncodeToString(checksum)), *res.Params)
+}
@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/types"
+)
+
+// Keeper defines the 08-wasm keeper, which stores the light client contract codes
+type Keeper struct {
+	storeKey   sdk.StoreKey
+	cdc        codec.BinaryCodec
+	paramSpace paramtypes.Subspace
+
+	// authority is the address allowed to store contract codes
+	authority string
+	vm        types.WasmEngine
+}
+
+// NewKeeper creates a new 08-wasm Keeper instance. The WasmEngine executes the contracts
+// of the wasm light clients whose client store is wrapped by the keeper.
+func NewKeeper(
+	cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
+	authority string, vm types.WasmEngine,
+) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Errorf("invalid 08-wasm authority address: %w", err))
+	}
+
+	if vm == nil {
+		panic("08-wasm wasm engine cannot be nil")
+	}
+
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   key,
+		paramSpace: paramSpace,
+		authority:  authority,
+		vm:         vm,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+host.ModuleName+"-"+types.ModuleName)
+}
+
+// GetAuthority returns the address allowed to store contract codes.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetWasmEngine returns the WasmEngine executing the light client contracts.
+func (k Keeper) GetWasmEngine() types.WasmEngine {
+	return k.vm
+}
+
+// WrapClientStore wraps the client store of a wasm light client with the WasmEngine of the
+// keeper and its allowlist of checksums.
+func (k Keeper) WrapClientStore(clientStore sdk.KVStore) sdk.KVStore {
+	return types.NewClientStore(clientStore, k.vm, k.IsAllowedChecksum)
+}
+
+// storeCodeAndAllow stores the contract code in the WasmEngine and in state, and adds its
+// checksum to the allowed checksums. It returns the checksum of the code.
+func (k Keeper) storeCodeAndAllow(ctx sdk.Context, code []byte) ([]byte, error) {
+	checksum, err := k.storeCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	params := k.GetParams(ctx)
+	params.AllowedChecksums = append(params.AllowedChecksums, hex.EncodeToString(checksum))
+	k.SetParams(ctx, params)
+
+	return checksum, nil
+}
+
+// storeCode stores the contract code in the WasmEngine and in state.
+func (k Keeper) storeCode(ctx sdk.Context, code []byte) ([]byte, error) {
+	if err := types.ValidateCode(code); err != nil {
+		return nil, err
+	}
+
+	checksum := types.Checksum(code)
+	if _, found := k.GetCode(ctx, checksum); found {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidCode, "code with checksum %X has already been stored", checksum)
+	}
+
+	vmChecksum, err := k.vm.StoreCode(code)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidCode, err.Error())
+	}
+
+	if !bytes.Equal(vmChecksum, checksum) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidChecksum, "wasm engine checksum %X does not match code checksum %X", vmChecksum, checksum)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.CodeKey(checksum), code)
+
+	return checksum, nil
+}
+
+// GetCode returns the contract code stored under the given checksum.
+func (k Keeper) GetCode(ctx sdk.Context, checksum []byte) ([]byte, bool) {
+	code := ctx.KVStore(k.storeKey).Get(types.CodeKey(checksum))
+	if code == nil {
+		return nil, false
+	}
+
+	return code, true
+}
+
+// IterateCodes iterates over the stored contract codes in checksum order. Iteration
+// stops when the callback returns true.
+func (k Keeper) IterateCodes(ctx sdk.Context, cb func(checksum, code []byte) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.KeyCodePrefix+"/"))
+	iterator := store.Iterator(nil, nil)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		checksum, err := hex.DecodeString(string(iterator.Key()))
+		if err != nil {
+			panic(err)
+		}
+
+		if cb(checksum, iterator.Value()) {
+			break
+		}
+	}
+}
+
+// InitializeCodes stores all the contract codes kept in state in the WasmEngine. It must
+// be called on start up for engines which do not persist compiled codes across restarts.
+func (k Keeper) InitializeCodes(ctx sdk.Context) error {
+	var err error
+	k.IterateCodes(ctx, func(checksum, code []byte) bool {
+		var vmChecksum []byte
+		vmChecksum, err = k.vm.StoreCode(code)
+		if err == nil && !bytes.Equal(vmChecksum, checksum) {
+			err = sdkerrors.Wrapf(types.ErrInvalidChecksum, "wasm engine checksum %X does not match code checksum %X", vmChecksum, checksum)
+		}
+
+		return err != nil
+	})
+
+	return err
+}
+
+// IsAllowedChecksum returns whether new clients are allowed to use the contract with the
+// given checksum. The contract code must be stored and its checksum allowed in params.
+func (k Keeper) IsAllowedChecksum(ctx sdk.Context, checksum []byte) bool {
+	if _, found := k.GetCode(ctx, checksum); !found {
+		return false
+	}
+
+	return k.GetParams(ctx).IsAllowedChecksum(hex.EncodeToString(checksum))
+}
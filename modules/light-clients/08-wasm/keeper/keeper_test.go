@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/keeper"
+	wasmtesting "github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/testing"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chainA      *ibctesting.TestChain
+
+	engine *wasmtesting.MockWasmEngine
+	keeper keeper.Keeper
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+
+	suite.engine = wasmtesting.NewMockWasmEngine()
+	suite.keeper = suite.newKeeper(authtypes.NewModuleAddress(govtypes.ModuleName).String(), suite.engine)
+}
+
+func (suite *KeeperTestSuite) newKeeper(authority string, engine types.WasmEngine) keeper.Keeper {
+	app := suite.chainA.GetSimApp()
+	return keeper.NewKeeper(app.AppCodec(), app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName), authority, engine)
+}
+
+// storeCode stores the given code through the authority and returns its checksum.
+func (suite *KeeperTestSuite) storeCode(code []byte) []byte {
+	res, err := suite.keeper.StoreCode(sdk.WrapSDKContext(suite.chainA.GetContext()), types.NewMsgStoreCode(suite.keeper.GetAuthority(), code))
+	suite.Require().NoError(err)
+
+	return res.Checksum
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) TestNewKeeper() {
+	suite.Require().Panics(func() {
+		suite.newKeeper("authority", suite.engine)
+	})
+
+	suite.Require().Panics(func() {
+		suite.newKeeper(ibctesting.TestAccAddress, nil)
+	})
+
+	suite.Require().NotPanics(func() {
+		suite.newKeeper(ibctesting.TestAccAddress, suite.engine)
+	})
+}
+
+func (suite *KeeperTestSuite) TestInitializeCodes() {
+	checksum := suite.storeCode(wasmtesting.Code)
+
+	// a restarted engine does not know the stored codes
+	suite.engine = wasmtesting.NewMockWasmEngine()
+	suite.keeper = suite.newKeeper(suite.keeper.GetAuthority(), suite.engine)
+
+	ctx := suite.chainA.GetContext()
+	suite.Require().False(suite.keeper.IsAllowedChecksum(ctx, types.Checksum([]byte("\x00asm\x02\x00\x00\x00"))))
+	suite.Require().True(suite.keeper.IsAllowedChecksum(ctx, checksum))
+
+	_, err := suite.engine.Query(checksum, types.NewEnv(ctx), []byte("{}"), nil, nil)
+	suite.Require().Error(err)
+
+	suite.Require().NoError(suite.keeper.InitializeCodes(ctx))
+
+	_, err = suite.engine.Query(checksum, types.NewEnv(ctx), []byte(`{"status":{}}`), nil, nil)
+	suite.Require().ErrorContains(err, "no callback registered")
+
+	suite.engine.SetStoreCodeFailure(errors.New("compilation failed"))
+	suite.Require().Error(suite.keeper.InitializeCodes(ctx))
+}
+
+func (suite *KeeperTestSuite) TestWrapClientStore() {
+	checksum := suite.storeCode(wasmtesting.Code)
+	ctx := suite.chainA.GetContext()
+
+	clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, "08-wasm-0")
+	_, err := types.GetVM(clientStore)
+	suite.Require().ErrorIs(err, types.ErrVMNotSet)
+	suite.Require().False(types.IsAllowedChecksum(ctx, clientStore, checksum))
+
+	// the wrapped client store gives access to the engine and allowlist of the keeper
+	clientStore = suite.keeper.WrapClientStore(clientStore)
+	engine, err := types.GetVM(clientStore)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.engine, engine)
+	suite.Require().True(types.IsAllowedChecksum(ctx, clientStore, checksum))
+
+	clientStore.Set([]byte("key"), []byte("value"))
+	suite.Require().Equal([]byte("value"), suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, "08-wasm-0").Get([]byte("key")))
+}
+
+func (suite *KeeperTestSuite) TestIsAllowedChecksum() {
+	checksum := suite.storeCode(wasmtesting.Code)
+	ctx := suite.chainA.GetContext()
+
+	suite.Require().True(suite.keeper.IsAllowedChecksum(ctx, checksum))
+	suite.Require().Equal([]string{hex.EncodeToString(checksum)}, suite.keeper.GetAllowedChecksums(ctx))
+
+	// removing the checksum from the allowlist prevents its use by new clients
+	suite.keeper.SetParams(ctx, types.DefaultParams())
+	suite.Require().False(suite.keeper.IsAllowedChecksum(ctx, checksum))
+
+	code, found := suite.keeper.GetCode(ctx, checksum)
+	suite.Require().True(found)
+	suite.Require().Equal(wasmtesting.Code, code)
+}
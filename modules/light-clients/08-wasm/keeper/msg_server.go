@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/types"
+)
+
+var _ types.MsgServer = Keeper{}
+
+// StoreCode defines a rpc handler method for MsgStoreCode
+func (k Keeper) StoreCode(goCtx context.Context, msg *types.MsgStoreCode) (*types.MsgStoreCodeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Signer != k.authority {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	checksum, err := k.storeCodeAndAllow(ctx, msg.WasmByteCode)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to store wasm code")
+	}
+
+	k.Logger(ctx).Info("wasm code stored", "checksum", hex.EncodeToString(checksum))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeStoreCode,
+			sdk.NewAttribute(types.AttributeKeyChecksum, hex.EncodeToString(checksum)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+
+	return &types.MsgStoreCodeResponse{
+		Checksum: checksum,
+	}, nil
+}
@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	wasmtesting "github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/testing"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestMsgStoreCode() {
+	var msg *types.MsgStoreCode
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success", func() {}, nil,
+		},
+		{
+			"signer is not the authority", func() {
+				msg.Signer = ibctesting.TestAccAddress
+			}, types.ErrUnauthorized,
+		},
+		{
+			"code already stored", func() {
+				suite.storeCode(wasmtesting.Code)
+			}, types.ErrInvalidCode,
+		},
+		{
+			"invalid code", func() {
+				msg.WasmByteCode = []byte("code")
+			}, types.ErrInvalidCode,
+		},
+		{
+			"wasm engine fails to store code", func() {
+				suite.engine.SetStoreCodeFailure(errors.New("compilation failed"))
+			}, types.ErrInvalidCode,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			msg = types.NewMsgStoreCode(suite.keeper.GetAuthority(), wasmtesting.Code)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.keeper.StoreCode(sdk.WrapSDKContext(ctx), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(wasmtesting.Checksum, res.Checksum)
+				suite.Require().True(suite.keeper.IsAllowedChecksum(ctx, res.Checksum))
+
+				code, found := suite.keeper.GetCode(ctx, res.Checksum)
+				suite.Require().True(found)
+				suite.Require().Equal(wasmtesting.Code, code)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/types"
+)
+
+// GetAllowedChecksums retrieves the allowed checksums from the paramstore
+func (k Keeper) GetAllowedChecksums(ctx sdk.Context) []string {
+	var res []string
+	k.paramSpace.Get(ctx, types.KeyAllowedChecksums, &res)
+	return res
+}
+
+// GetParams returns the total set of 08-wasm parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	return types.NewParams(k.GetAllowedChecksums(ctx)...)
+}
+
+// SetParams sets the total set of 08-wasm parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
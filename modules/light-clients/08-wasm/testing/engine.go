@@ -0,0 +1,127 @@
+package testing
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/types"
+)
+
+var _ types.WasmEngine = &MockWasmEngine{}
+
+// EntryPointFn defines an in-process implementation of a contract entry point.
+type EntryPointFn func(checksum []byte, env types.Env, msg []byte, store sdk.KVStore, gasMeter sdk.GasMeter) ([]byte, error)
+
+// MockWasmEngine is an in-process WasmEngine used for testing. Stored codes are only
+// kept by checksum, contract calls are dispatched to the callbacks registered for the
+// name of the message, ie. the JSON key of the set field of QueryMsg and SudoMsg.
+type MockWasmEngine struct {
+	codes map[string][]byte
+
+	InstantiateFn    EntryPointFn
+	queryCallbacks   map[string]EntryPointFn
+	sudoCallbacks    map[string]EntryPointFn
+	storeCodeFailure error
+}
+
+// NewMockWasmEngine returns a MockWasmEngine without stored codes and callbacks.
+func NewMockWasmEngine() *MockWasmEngine {
+	return &MockWasmEngine{
+		codes:          make(map[string][]byte),
+		queryCallbacks: make(map[string]EntryPointFn),
+		sudoCallbacks:  make(map[string]EntryPointFn),
+	}
+}
+
+// RegisterQueryCallback registers the callback handling the query message with the given name.
+func (m *MockWasmEngine) RegisterQueryCallback(name string, fn EntryPointFn) {
+	m.queryCallbacks[name] = fn
+}
+
+// RegisterSudoCallback registers the callback handling the sudo message with the given name.
+func (m *MockWasmEngine) RegisterSudoCallback(name string, fn EntryPointFn) {
+	m.sudoCallbacks[name] = fn
+}
+
+// SetStoreCodeFailure makes StoreCode return the given error. A nil error resets it.
+func (m *MockWasmEngine) SetStoreCodeFailure(err error) {
+	m.storeCodeFailure = err
+}
+
+// StoreCode implements the WasmEngine interface. Storing the same code twice is a no-op.
+func (m *MockWasmEngine) StoreCode(code []byte) ([]byte, error) {
+	if m.storeCodeFailure != nil {
+		return nil, m.storeCodeFailure
+	}
+
+	checksum := types.Checksum(code)
+	m.codes[hex.EncodeToString(checksum)] = code
+
+	return checksum, nil
+}
+
+// Instantiate implements the WasmEngine interface. It succeeds without effect if no
+// InstantiateFn is set.
+func (m *MockWasmEngine) Instantiate(checksum []byte, env types.Env, msg []byte, store sdk.KVStore, gasMeter sdk.GasMeter) ([]byte, error) {
+	if err := m.hasCode(checksum); err != nil {
+		return nil, err
+	}
+
+	if m.InstantiateFn == nil {
+		return nil, nil
+	}
+
+	return m.InstantiateFn(checksum, env, msg, store, gasMeter)
+}
+
+// Query implements the WasmEngine interface.
+func (m *MockWasmEngine) Query(checksum []byte, env types.Env, msg []byte, store sdk.KVStore, gasMeter sdk.GasMeter) ([]byte, error) {
+	return m.dispatch(m.queryCallbacks, checksum, env, msg, store, gasMeter)
+}
+
+// Sudo implements the WasmEngine interface.
+func (m *MockWasmEngine) Sudo(checksum []byte, env types.Env, msg []byte, store sdk.KVStore, gasMeter sdk.GasMeter) ([]byte, error) {
+	return m.dispatch(m.sudoCallbacks, checksum, env, msg, store, gasMeter)
+}
+
+// dispatch calls the callback registered for the name of the message.
+func (m *MockWasmEngine) dispatch(
+	callbacks map[string]EntryPointFn, checksum []byte, env types.Env,
+	msg []byte, store sdk.KVStore, gasMeter sdk.GasMeter,
+) ([]byte, error) {
+	if err := m.hasCode(checksum); err != nil {
+		return nil, err
+	}
+
+	var payload map[string]json.RawMessage
+	if err := json.Unmarshal(msg, &payload); err != nil {
+		return nil, err
+	}
+
+	if len(payload) != 1 {
+		return nil, fmt.Errorf("expected exactly one message, got %d", len(payload))
+	}
+
+	var name string
+	for name = range payload {
+	}
+
+	fn, ok := callbacks[name]
+	if !ok {
+		return nil, fmt.Errorf("no callback registered for message %s", name)
+	}
+
+	return fn(checksum, env, msg, store, gasMeter)
+}
+
+// hasCode returns an error if no code is stored under the checksum.
+func (m *MockWasmEngine) hasCode(checksum []byte) error {
+	if _, ok := m.codes[hex.EncodeToString(checksum)]; !ok {
+		return fmt.Errorf("code with checksum %X not found", checksum)
+	}
+
+	return nil
+}
@@ -0,0 +1,11 @@
+package testing
+
+import (
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/types"
+)
+
+// Code is a minimal valid wasm module, consisting of the wasm magic number and version.
+var Code = []byte("\x00asm\x01\x00\x00\x00")
+
+// Checksum is the checksum of Code.
+var Checksum = types.Checksum(Code)
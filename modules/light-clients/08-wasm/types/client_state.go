@@ -0,0 +1,455 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+var _ exported.ClientState = (*ClientState)(nil)
+
+// NewClientState creates a new ClientState instance.
+func NewClientState(data []byte, checksum []byte, latestHeight clienttypes.Height) *ClientState {
+	return &ClientState{
+		Data:         data,
+		Checksum:     checksum,
+		LatestHeight: latestHeight,
+	}
+}
+
+// ClientType is Wasm.
+func (cs ClientState) ClientType() string {
+	return exported.Wasm
+}
+
+// GetChainID returns an empty string as the chain ID is part of the opaque client state
+// of the contract.
+func (cs ClientState) GetChainID() string {
+	return ""
+}
+
+// GetLatestHeight returns latest block height.
+func (cs ClientState) GetLatestHeight() exported.Height {
+	return cs.LatestHeight
+}
+
+// Validate performs a basic validation of the client state fields.
+func (cs ClientState) Validate() error {
+	if len(cs.Data) == 0 {
+		return sdkerrors.Wrap(ErrInvalidData, "data cannot be empty")
+	}
+
+	if err := ValidateChecksum(cs.Checksum); err != nil {
+		return err
+	}
+
+	if cs.LatestHeight.RevisionHeight == 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidHeight, "wasm client's latest height revision height cannot be zero")
+	}
+
+	return nil
+}
+
+// ZeroCustomFields returns a copy of the client state. The client state of the contract
+// is opaque and its custom fields cannot be zeroed.
+func (cs ClientState) ZeroCustomFields() exported.ClientState {
+	return NewClientState(cs.Data, cs.Checksum, cs.LatestHeight)
+}
+
+// Initialize checks that the contract of the client state is allowed and calls its
+// instantiate entry point with the initial client and consensus states.
+func (cs ClientState) Initialize(ctx sdk.Context, _ codec.BinaryCodec, clientStore sdk.KVStore, consState exported.ConsensusState) error {
+	consensusState, ok := consState.(*ConsensusState)
+	if !ok {
+		return sdkerrors.Wrapf(clienttypes.ErrInvalidConsensus, "invalid initial consensus state. expected type: %T, got: %T",
+			&ConsensusState{}, consState)
+	}
+
+	if !IsAllowedChecksum(ctx, clientStore, cs.Checksum) {
+		return sdkerrors.Wrapf(ErrChecksumNotAllowed, "checksum %X", cs.Checksum)
+	}
+
+	payload := InstantiateMessage{
+		ClientState:    cs.Data,
+		ConsensusState: consensusState.Data,
+		Checksum:       cs.Checksum,
+	}
+
+	return wasmInstantiate(ctx, clientStore, &cs, payload)
+}
+
+// Status returns the status of the client as reported by the contract. Unknown is returned
+// if the contract call fails or returns an invalid status.
+func (cs ClientState) Status(ctx sdk.Context, clientStore sdk.KVStore, _ codec.BinaryCodec) exported.Status {
+	payload := QueryMsg{
+		Status: &StatusMsg{ClientState: cs.Data},
+	}
+
+	var result StatusResult
+	if err := wasmQuery(ctx, clientStore, &cs, payload, &result); err != nil {
+		return exported.Unknown
+	}
+
+	switch result.Status {
+	case exported.Active, exported.Frozen, exported.Expired:
+		return result.Status
+	default:
+		return exported.Unknown
+	}
+}
+
+// ExportMetadata exports the client metadata reported by the contract. It panics if the
+// contract call fails.
+func (cs ClientState) ExportMetadata(clientStore sdk.KVStore) []exported.GenesisMetadata {
+	payload := QueryMsg{
+		ExportMetadata: &ExportMetadataMsg{ClientState: cs.Data},
+	}
+
+	// genesis export is not executed within a block, the contract is called with an empty context
+	ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	var result ExportMetadataResult
+	if err := wasmQuery(ctx, clientStore, &cs, payload, &result); err != nil {
+		panic(fmt.Errorf("failed to export wasm client metadata: %w", err))
+	}
+
+	genesisMetadata := make([]exported.GenesisMetadata, len(result.GenesisMetadata))
+	for i, metadata := range result.GenesisMetadata {
+		genesisMetadata[i] = metadata
+	}
+
+	return genesisMetadata
+}
+
+// CheckHeaderAndUpdateState calls the contract to verify the header and returns the updated
+// client state and the consensus state at the header height.
+func (cs ClientState) CheckHeaderAndUpdateState(
+	ctx sdk.Context, _ codec.BinaryCodec, clientStore sdk.KVStore, header exported.Header,
+) (exported.ClientState, exported.ConsensusState, error) {
+	wasmHeader, ok := header.(*Header)
+	if !ok {
+		return nil, nil, sdkerrors.Wrapf(clienttypes.ErrInvalidHeader, "expected type %T, got %T", &Header{}, header)
+	}
+
+	payload := SudoMsg{
+		UpdateState: &UpdateStateMsg{
+			ClientState:   cs.Data,
+			ClientMessage: wasmHeader.Data,
+		},
+	}
+
+	var result UpdateStateResult
+	if err := wasmSudo(ctx, clientStore, &cs, payload, &result); err != nil {
+		return nil, nil, err
+	}
+
+	if !result.Height.EQ(wasmHeader.Height) {
+		return nil, nil, sdkerrors.Wrapf(ErrInvalidContractResult, "updated height %s does not match header height %s", result.Height, wasmHeader.Height)
+	}
+
+	if len(result.ClientState) == 0 {
+		return nil, nil, sdkerrors.Wrap(ErrInvalidContractResult, "updated client state cannot be empty")
+	}
+
+	latestHeight := cs.LatestHeight
+	if result.Height.GT(latestHeight) {
+		latestHeight = result.Height
+	}
+
+	newClientState := NewClientState(result.ClientState, cs.Checksum, latestHeight)
+	newConsensusState := NewConsensusState(result.ConsensusState, result.Timestamp)
+
+	return newClientState, newConsensusState, nil
+}
+
+// CheckMisbehaviourAndUpdateState calls the contract to verify the misbehaviour and returns
+// the client state updated by the contract, which is expected to be frozen.
+func (cs ClientState) CheckMisbehaviourAndUpdateState(
+	ctx sdk.Context, _ codec.BinaryCodec, clientStore sdk.KVStore, misbehaviour exported.Misbehaviour,
+) (exported.ClientState, error) {
+	wasmMisbehaviour, ok := misbehaviour.(*Misbehaviour)
+	if !ok {
+		return nil, sdkerrors.Wrapf(clienttypes.ErrInvalidClientType, "expected type %T, got %T", &Misbehaviour{}, misbehaviour)
+	}
+
+	payload := SudoMsg{
+		UpdateStateOnMisbehaviour: &UpdateStateOnMisbehaviourMsg{
+			ClientState:   cs.Data,
+			ClientMessage: wasmMisbehaviour.Data,
+		},
+	}
+
+	var result ClientStateResult
+	if err := wasmSudo(ctx, clientStore, &cs, payload, &result); err != nil {
+		return nil, err
+	}
+
+	if len(result.ClientState) == 0 {
+		return nil, sdkerrors.Wrap(ErrInvalidContractResult, "updated client state cannot be empty")
+	}
+
+	return NewClientState(result.ClientState, cs.Checksum, cs.LatestHeight), nil
+}
+
+// CheckSubstituteAndUpdateState calls the contract to check that the substitute client can
+// replace the subject client. The substitute must use the same contract. The consensus state
+// at the latest height of the substitute is copied to the subject client store.
+func (cs ClientState) CheckSubstituteAndUpdateState(
+	ctx sdk.Context, cdc codec.BinaryCodec, subjectClientStore,
+	substituteClientStore sdk.KVStore, substituteClient exported.ClientState,
+) (exported.ClientState, error) {
+	substituteClientState, ok := substituteClient.(*ClientState)
+	if !ok {
+		return nil, sdkerrors.Wrapf(
+			clienttypes.ErrInvalidClient, "expected type %T, got %T", &ClientState{}, substituteClient,
+		)
+	}
+
+	if string(substituteClientState.Checksum) != string(cs.Checksum) {
+		return nil, sdkerrors.Wrapf(
+			clienttypes.ErrInvalidSubstitute, "substitute checksum %X does not match subject checksum %X", substituteClientState.Checksum, cs.Checksum,
+		)
+	}
+
+	consensusState, err := GetConsensusState(substituteClientStore, cdc, substituteClientState.LatestHeight)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "unable to retrieve latest consensus state for substitute client")
+	}
+
+	payload := SudoMsg{
+		CheckSubstituteAndUpdateState: &CheckSubstituteAndUpdateStateMsg{
+			ClientState:           cs.Data,
+			SubstituteClientState: substituteClientState.Data,
+		},
+	}
+
+	var result ClientStateResult
+	if err := wasmSudo(ctx, subjectClientStore, &cs, payload, &result); err != nil {
+		return nil, err
+	}
+
+	if len(result.ClientState) == 0 {
+		return nil, sdkerrors.Wrap(ErrInvalidContractResult, "updated client state cannot be empty")
+	}
+
+	SetConsensusState(subjectClientStore, cdc, consensusState, substituteClientState.LatestHeight)
+
+	return NewClientState(result.ClientState, cs.Checksum, substituteClientState.LatestHeight), nil
+}
+
+// VerifyUpgradeAndUpdateState calls the contract to verify the upgraded client and consensus
+// states committed to by the counterparty. The upgraded client keeps the checksum of the client.
+func (cs ClientState) VerifyUpgradeAndUpdateState(
+	ctx sdk.Context, _ codec.BinaryCodec, clientStore sdk.KVStore,
+	upgradedClient exported.ClientState, upgradedConsState exported.ConsensusState,
+	proofUpgradeClient, proofUpgradeConsState []byte,
+) (exported.ClientState, exported.ConsensusState, error) {
+	wasmUpgradedClient, ok := upgradedClient.(*ClientState)
+	if !ok {
+		return nil, nil, sdkerrors.Wrapf(clienttypes.ErrInvalidClientType, "upgraded client must be Wasm client. expected: %T got: %T",
+			&ClientState{}, upgradedClient)
+	}
+
+	wasmUpgradedConsState, ok := upgradedConsState.(*ConsensusState)
+	if !ok {
+		return nil, nil, sdkerrors.Wrapf(clienttypes.ErrInvalidConsensus, "upgraded consensus state must be Wasm consensus state. expected %T, got: %T",
+			&ConsensusState{}, upgradedConsState)
+	}
+
+	payload := SudoMsg{
+		VerifyUpgradeAndUpdateState: &VerifyUpgradeAndUpdateStateMsg{
+			ClientState:                cs.Data,
+			UpgradeClientState:         wasmUpgradedClient.Data,
+			UpgradeConsensusState:      wasmUpgradedConsState.Data,
+			ProofUpgradeClient:         proofUpgradeClient,
+			ProofUpgradeConsensusState: proofUpgradeConsState,
+		},
+	}
+
+	var result UpdateStateResult
+	if err := wasmSudo(ctx, clientStore, &cs, payload, &result); err != nil {
+		return nil, nil, err
+	}
+
+	if len(result.ClientState) == 0 || result.Height.IsZero() {
+		return nil, nil, sdkerrors.Wrap(ErrInvalidContractResult, "upgraded client state and height cannot be empty")
+	}
+
+	newClientState := NewClientState(result.ClientState, cs.Checksum, result.Height)
+	newConsensusState := NewConsensusState(result.ConsensusState, result.Timestamp)
+
+	return newClientState, newConsensusState, nil
+}
+
+// VerifyMembership calls the contract to verify a proof of the existence of a value at the
+// given CommitmentPath at the specified height.
+func (cs ClientState) VerifyMembership(
+	ctx sdk.Context,
+	clientStore sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+	value []byte,
+) error {
+	merklePath, consensusState, err := produceVerificationArgs(clientStore, cdc, cs, height, proof, path)
+	if err != nil {
+		return err
+	}
+
+	payload := SudoMsg{
+		VerifyMembership: &VerifyMembershipMsg{
+			ClientState:      cs.Data,
+			ConsensusState:   consensusState.Data,
+			Height:           height.(clienttypes.Height),
+			DelayTimePeriod:  delayTimePeriod,
+			DelayBlockPeriod: delayBlockPeriod,
+			Proof:            proof,
+			MerklePath:       merklePath,
+			Value:            value,
+		},
+	}
+
+	return wasmSudo(ctx, clientStore, &cs, payload, nil)
+}
+
+// VerifyNonMembership calls the contract to verify a proof of the absence of the given
+// CommitmentPath at the specified height.
+func (cs ClientState) VerifyNonMembership(
+	ctx sdk.Context,
+	clientStore sdk.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+) error {
+	merklePath, consensusState, err := produceVerificationArgs(clientStore, cdc, cs, height, proof, path)
+	if err != nil {
+		return err
+	}
+
+	payload := SudoMsg{
+		VerifyNonMembership: &VerifyNonMembershipMsg{
+			ClientState:      cs.Data,
+			ConsensusState:   consensusState.Data,
+			Height:           height.(clienttypes.Height),
+			DelayTimePeriod:  delayTimePeriod,
+			DelayBlockPeriod: delayBlockPeriod,
+			Proof:            proof,
+			MerklePath:       merklePath,
+		},
+	}
+
+	return wasmSudo(ctx, clientStore, &cs, payload, nil)
+}
+
+// VerifyClientState is not supported, the counterparty state is verified through VerifyMembership.
+func (cs ClientState) VerifyClientState(
+	_ sdk.KVStore, _ codec.BinaryCodec, _ exported.Height, _ exported.Prefix, _ string, _ []byte, _ exported.ClientState,
+) error {
+	return sdkerrors.Wrap(ErrNotSupported, "use VerifyMembership")
+}
+
+// VerifyClientConsensusState is not supported, the counterparty state is verified through VerifyMembership.
+func (cs ClientState) VerifyClientConsensusState(
+	_ sdk.KVStore, _ codec.BinaryCodec, _ exported.Height, _ string, _ exported.Height, _ exported.Prefix, _ []byte, _ exported.ConsensusState,
+) error {
+	return sdkerrors.Wrap(ErrNotSupported, "use VerifyMembership")
+}
+
+// VerifyConnectionState is not supported, the counterparty state is verified through VerifyMembership.
+func (cs ClientState) VerifyConnectionState(
+	_ sdk.KVStore, _ codec.BinaryCodec, _ exported.Height, _ exported.Prefix, _ []byte, _ string, _ exported.ConnectionI,
+) error {
+	return sdkerrors.Wrap(ErrNotSupported, "use VerifyMembership")
+}
+
+// VerifyChannelState is not supported, the counterparty state is verified through VerifyMembership.
+func (cs ClientState) VerifyChannelState(
+	_ sdk.KVStore, _ codec.BinaryCodec, _ exported.Height, _ exported.Prefix, _ []byte, _, _ string, _ exported.ChannelI,
+) error {
+	return sdkerrors.Wrap(ErrNotSupported, "use VerifyMembership")
+}
+
+// VerifyPacketCommitment is not supported, the counterparty state is verified through VerifyMembership.
+func (cs ClientState) VerifyPacketCommitment(
+	_ sdk.Context, _ sdk.KVStore, _ codec.BinaryCodec, _ exported.Height, _, _ uint64, _ exported.Prefix, _ []byte, _, _ string, _ uint64, _ []byte,
+) error {
+	return sdkerrors.Wrap(ErrNotSupported, "use VerifyMembership")
+}
+
+// VerifyPacketAcknowledgement is not supported, the counterparty state is verified through VerifyMembership.
+func (cs ClientState) VerifyPacketAcknowledgement(
+	_ sdk.Context, _ sdk.KVStore, _ codec.BinaryCodec, _ exported.Height, _, _ uint64, _ exported.Prefix, _ []byte, _, _ string, _ uint64, _ []byte,
+) error {
+	return sdkerrors.Wrap(ErrNotSupported, "use VerifyMembership")
+}
+
+// VerifyPacketReceiptAbsence is not supported, the counterparty state is verified through VerifyNonMembership.
+func (cs ClientState) VerifyPacketReceiptAbsence(
+	_ sdk.Context, _ sdk.KVStore, _ codec.BinaryCodec, _ exported.Height, _, _ uint64, _ exported.Prefix, _ []byte, _, _ string, _ uint64,
+) error {
+	return sdkerrors.Wrap(ErrNotSupported, "use VerifyNonMembership")
+}
+
+// VerifyNextSequenceRecv is not supported, the counterparty state is verified through VerifyMembership.
+func (cs ClientState) VerifyNextSequenceRecv(
+	_ sdk.Context, _ sdk.KVStore, _ codec.BinaryCodec, _ exported.Height, _, _ uint64, _ exported.Prefix, _ []byte, _, _ string, _ uint64,
+) error {
+	return sdkerrors.Wrap(ErrNotSupported, "use VerifyMembership")
+}
+
+// VerifyNextSequenceAck is not supported, the counterparty state is verified through VerifyMembership.
+func (cs ClientState) VerifyNextSequenceAck(
+	_ sdk.Context, _ sdk.KVStore, _ codec.BinaryCodec, _ exported.Height, _, _ uint64, _ exported.Prefix, _ []byte, _, _ string, _ uint64,
+) error {
+	return sdkerrors.Wrap(ErrNotSupported, "use VerifyMembership")
+}
+
+// produceVerificationArgs performs the basic checks on the verification arguments and
+// returns the MerklePath and the consensus state at the proof height.
+func produceVerificationArgs(
+	clientStore sdk.KVStore,
+	cdc codec.BinaryCodec,
+	cs ClientState,
+	height exported.Height,
+	proof []byte,
+	path exported.Path,
+) (commitmenttypes.MerklePath, *ConsensusState, error) {
+	if cs.GetLatestHeight().LT(height) {
+		return commitmenttypes.MerklePath{}, nil, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidHeight,
+			"client state height < proof height (%d < %d), please ensure the client has been updated", cs.GetLatestHeight(), height,
+		)
+	}
+
+	if _, ok := height.(clienttypes.Height); !ok {
+		return commitmenttypes.MerklePath{}, nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", clienttypes.Height{}, height)
+	}
+
+	merklePath, ok := path.(commitmenttypes.MerklePath)
+	if !ok {
+		return commitmenttypes.MerklePath{}, nil, sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "expected %T, got %T", commitmenttypes.MerklePath{}, path)
+	}
+
+	if len(proof) == 0 {
+		return commitmenttypes.MerklePath{}, nil, sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "proof cannot be empty")
+	}
+
+	consensusState, err := GetConsensusState(clientStore, cdc, height)
+	if err != nil {
+		return commitmenttypes.MerklePath{}, nil, sdkerrors.Wrap(err, "please ensure the proof was constructed against a height that exists on the client")
+	}
+
+	return merklePath, consensusState, nil
+}
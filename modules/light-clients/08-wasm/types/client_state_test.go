@@ -0,0 +1,419 @@
+package types_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+	wasmtesting "github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/testing"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/types"
+	ibcmock "github.com/cosmos/ibc-go/v3/testing/mock"
+)
+
+func (suite *WasmTestSuite) TestValidate() {
+	testCases := []struct {
+		name        string
+		clientState *types.ClientState
+		expPass     bool
+	}{
+		{
+			name:        "valid client",
+			clientState: types.NewClientState([]byte("data"), wasmtesting.Checksum, height),
+			expPass:     true,
+		},
+		{
+			name:        "empty data",
+			clientState: types.NewClientState(nil, wasmtesting.Checksum, height),
+			expPass:     false,
+		},
+		{
+			name:        "invalid checksum",
+			clientState: types.NewClientState([]byte("data"), []byte("checksum"), height),
+			expPass:     false,
+		},
+		{
+			name:        "zero latest height",
+			clientState: types.NewClientState([]byte("data"), wasmtesting.Checksum, clienttypes.ZeroHeight()),
+			expPass:     false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.clientState.Validate()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *WasmTestSuite) TestInitialize() {
+	var (
+		clientState    *types.ClientState
+		consensusState exported.ConsensusState
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"invalid consensus state type", func() {
+				consensusState = &ibctmtypes.ConsensusState{}
+			}, false,
+		},
+		{
+			"checksum not allowed", func() {
+				suite.keeper.SetParams(suite.chainA.GetContext(), types.DefaultParams())
+			}, false,
+		},
+		{
+			"code not stored", func() {
+				clientState.Checksum = types.Checksum([]byte("\x00asm\x02\x00\x00\x00"))
+			}, false,
+		},
+		{
+			"contract instantiation fails", func() {
+				suite.engine.InstantiateFn = func(_ []byte, _ types.Env, _ []byte, _ sdk.KVStore, _ sdk.GasMeter) ([]byte, error) {
+					return nil, errors.New("instantiation failed")
+				}
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			clientState = types.NewClientState([]byte("client state"), wasmtesting.Checksum, height)
+			consensusState = types.NewConsensusState([]byte("consensus state"), 1)
+
+			var instantiated types.InstantiateMessage
+			suite.engine.InstantiateFn = func(_ []byte, _ types.Env, msg []byte, _ sdk.KVStore, _ sdk.GasMeter) ([]byte, error) {
+				return nil, json.Unmarshal(msg, &instantiated)
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			err := clientState.Initialize(ctx, suite.chainA.Codec, suite.clientStore(ctx, "08-wasm-0"), consensusState)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(clientState.Data, instantiated.ClientState)
+				suite.Require().Equal([]byte("consensus state"), instantiated.ConsensusState)
+				suite.Require().Equal(wasmtesting.Checksum, instantiated.Checksum)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *WasmTestSuite) TestStatus() {
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expStatus exported.Status
+	}{
+		{
+			"active", func() {}, exported.Active,
+		},
+		{
+			"frozen", func() {
+				suite.registerStatus(exported.Frozen)
+			}, exported.Frozen,
+		},
+		{
+			"invalid status returned by contract", func() {
+				suite.registerStatus("Halted")
+			}, exported.Unknown,
+		},
+		{
+			"contract call fails", func() {
+				suite.engine.RegisterQueryCallback("status", func(_ []byte, _ types.Env, _ []byte, _ sdk.KVStore, _ sdk.GasMeter) ([]byte, error) {
+					return nil, errors.New("query failed")
+				})
+			}, exported.Unknown,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			clientID, clientState := suite.createClient()
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			clientStore := suite.clientStore(ctx, clientID)
+			suite.Require().Equal(tc.expStatus, clientState.Status(ctx, clientStore, suite.chainA.Codec))
+		})
+	}
+}
+
+func (suite *WasmTestSuite) TestUpdateClient() {
+	var (
+		header       *types.Header
+		updateResult types.UpdateStateResult
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"updated height does not match header height", func() {
+				updateResult.Height = height.Increment().(clienttypes.Height).Increment().(clienttypes.Height)
+			}, false,
+		},
+		{
+			"empty updated client state", func() {
+				updateResult.ClientState = nil
+			}, false,
+		},
+		{
+			"contract rejects header", func() {
+				suite.engine.RegisterSudoCallback("update_state", func(_ []byte, _ types.Env, _ []byte, _ sdk.KVStore, _ sdk.GasMeter) ([]byte, error) {
+					return nil, errors.New("invalid header")
+				})
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			clientID, _ := suite.createClient()
+
+			newHeight := height.Increment().(clienttypes.Height)
+			header = types.NewHeader([]byte("header"), newHeight)
+			updateResult = types.UpdateStateResult{
+				ClientState:    []byte("updated client state"),
+				ConsensusState: []byte("updated consensus state"),
+				Timestamp:      2,
+				Height:         newHeight,
+			}
+
+			suite.engine.RegisterSudoCallback("update_state", func(_ []byte, _ types.Env, msg []byte, _ sdk.KVStore, _ sdk.GasMeter) ([]byte, error) {
+				var payload types.SudoMsg
+				if err := json.Unmarshal(msg, &payload); err != nil {
+					return nil, err
+				}
+
+				if string(payload.UpdateState.ClientMessage) != "header" {
+					return nil, errors.New("unexpected client message")
+				}
+
+				return json.Marshal(updateResult)
+			})
+
+			tc.malleate()
+
+			clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+			err := clientKeeper.UpdateClient(suite.chainA.GetContext(), clientID, header)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				clientState, found := clientKeeper.GetClientState(suite.chainA.GetContext(), clientID)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewClientState([]byte("updated client state"), wasmtesting.Checksum, newHeight), clientState)
+
+				consensusState, found := clientKeeper.GetClientConsensusState(suite.chainA.GetContext(), clientID, newHeight)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewConsensusState([]byte("updated consensus state"), 2), consensusState)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *WasmTestSuite) TestCheckMisbehaviourAndUpdateState() {
+	clientID, clientState := suite.createClient()
+
+	suite.engine.RegisterSudoCallback("update_state_on_misbehaviour", func(_ []byte, _ types.Env, _ []byte, _ sdk.KVStore, _ sdk.GasMeter) ([]byte, error) {
+		return json.Marshal(types.ClientStateResult{ClientState: []byte("frozen client state")})
+	})
+
+	ctx := suite.chainA.GetContext()
+	clientStore := suite.clientStore(ctx, clientID)
+
+	newClientState, err := clientState.CheckMisbehaviourAndUpdateState(ctx, suite.chainA.Codec, clientStore, types.NewMisbehaviour(clientID, []byte("misbehaviour")))
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewClientState([]byte("frozen client state"), wasmtesting.Checksum, height), newClientState)
+
+	_, err = clientState.CheckMisbehaviourAndUpdateState(ctx, suite.chainA.Codec, clientStore, &ibctmtypes.Misbehaviour{})
+	suite.Require().Error(err)
+}
+
+func (suite *WasmTestSuite) TestVerifyMembership() {
+	var (
+		proofHeight exported.Height
+		proof       []byte
+		path        exported.Path
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"contract rejects proof", func() {
+				proof = []byte("invalid proof")
+			}, false,
+		},
+		{
+			"proof height greater than client latest height", func() {
+				proofHeight = height.Increment()
+			}, false,
+		},
+		{
+			"consensus state not found", func() {
+				proofHeight = clienttypes.NewHeight(0, 1)
+			}, false,
+		},
+		{
+			"empty proof", func() {
+				proof = nil
+			}, false,
+		},
+		{
+			"invalid path type", func() {
+				path = ibcmock.KeyPath{}
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			clientID, clientState := suite.createClient()
+
+			proofHeight = height
+			proof = []byte("proof")
+			path = commitmenttypes.NewMerklePath(host.StoreKey, "key")
+
+			suite.engine.RegisterSudoCallback("verify_membership", func(_ []byte, _ types.Env, msg []byte, _ sdk.KVStore, _ sdk.GasMeter) ([]byte, error) {
+				var payload types.SudoMsg
+				if err := json.Unmarshal(msg, &payload); err != nil {
+					return nil, err
+				}
+
+				if string(payload.VerifyMembership.Proof) != "proof" || string(payload.VerifyMembership.Value) != "value" {
+					return nil, errors.New("membership verification failed")
+				}
+
+				return nil, nil
+			})
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			clientStore := suite.clientStore(ctx, clientID)
+			err := clientState.VerifyMembership(ctx, clientStore, suite.chainA.Codec, proofHeight, 0, 0, proof, path, []byte("value"))
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *WasmTestSuite) TestVerifyNonMembership() {
+	clientID, clientState := suite.createClient()
+
+	suite.engine.RegisterSudoCallback("verify_non_membership", func(_ []byte, _ types.Env, msg []byte, _ sdk.KVStore, _ sdk.GasMeter) ([]byte, error) {
+		var payload types.SudoMsg
+		if err := json.Unmarshal(msg, &payload); err != nil {
+			return nil, err
+		}
+
+		if len(payload.VerifyNonMembership.MerklePath.KeyPath) != 2 {
+			return nil, errors.New("unexpected merkle path")
+		}
+
+		return nil, nil
+	})
+
+	ctx := suite.chainA.GetContext()
+	clientStore := suite.clientStore(ctx, clientID)
+	path := commitmenttypes.NewMerklePath(host.StoreKey, "key")
+
+	err := clientState.VerifyNonMembership(ctx, clientStore, suite.chainA.Codec, height, 0, 0, []byte("proof"), path)
+	suite.Require().NoError(err)
+
+	err = clientState.VerifyPacketReceiptAbsence(ctx, clientStore, suite.chainA.Codec, height, 0, 0, nil, []byte("proof"), "port", "channel", 1)
+	suite.Require().ErrorIs(err, types.ErrNotSupported)
+}
+
+func (suite *WasmTestSuite) TestClientStoreWrites() {
+	clientID, _ := suite.createClient()
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+
+	writeStore := func(_ []byte, _ types.Env, _ []byte, store sdk.KVStore, _ sdk.GasMeter) ([]byte, error) {
+		store.Set([]byte("contract/key"), []byte("value"))
+		return nil, nil
+	}
+
+	// a contract writing on verification is rejected
+	suite.engine.RegisterSudoCallback("verify_membership", writeStore)
+	suite.engine.RegisterSudoCallback("verify_non_membership", writeStore)
+
+	ctx := suite.chainA.GetContext()
+	path, err := commitmenttypes.ApplyPrefix(commitmenttypes.NewMerklePrefix([]byte(host.StoreKey)), commitmenttypes.NewMerklePath("key"))
+	suite.Require().NoError(err)
+
+	err = clientKeeper.VerifyMembership(ctx, clientID, height, 0, 0, []byte("proof"), path, []byte("value"))
+	suite.Require().ErrorIs(err, clienttypes.ErrClientStoreWrite)
+
+	err = clientKeeper.VerifyNonMembership(ctx, clientID, height, 0, 0, []byte("proof"), path)
+	suite.Require().ErrorIs(err, clienttypes.ErrClientStoreWrite)
+
+	clientStore := clientKeeper.ClientStore(ctx, clientID)
+	suite.Require().False(clientStore.Has([]byte("contract/key")))
+
+	// a contract writing on update writes to its client store
+	newHeight := height.Increment().(clienttypes.Height)
+	suite.engine.RegisterSudoCallback("update_state", func(checksum []byte, env types.Env, msg []byte, store sdk.KVStore, gasMeter sdk.GasMeter) ([]byte, error) {
+		if _, err := writeStore(checksum, env, msg, store, gasMeter); err != nil {
+			return nil, err
+		}
+
+		return json.Marshal(types.UpdateStateResult{
+			ClientState:    []byte("updated client state"),
+			ConsensusState: []byte("updated consensus state"),
+			Timestamp:      2,
+			Height:         newHeight,
+		})
+	})
+
+	err = clientKeeper.UpdateClient(ctx, clientID, types.NewHeader([]byte("header"), newHeight))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]byte("value"), clientStore.Get([]byte("contract/key")))
+}
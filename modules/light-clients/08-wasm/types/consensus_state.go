@@ -0,0 +1,48 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+var _ exported.ConsensusState = (*ConsensusState)(nil)
+
+// NewConsensusState creates a new ConsensusState instance.
+func NewConsensusState(data []byte, timestamp uint64) *ConsensusState {
+	return &ConsensusState{
+		Data:      data,
+		Timestamp: timestamp,
+	}
+}
+
+// ClientType returns Wasm.
+func (ConsensusState) ClientType() string {
+	return exported.Wasm
+}
+
+// GetRoot returns an empty commitment root. The commitment root is part of the opaque
+// consensus state of the contract, which verifies proofs itself.
+func (ConsensusState) GetRoot() exported.Root {
+	return commitmenttypes.MerkleRoot{}
+}
+
+// GetTimestamp returns the timestamp (in nanoseconds) of the consensus state.
+func (cs ConsensusState) GetTimestamp() uint64 {
+	return cs.Timestamp
+}
+
+// ValidateBasic defines a basic validation for the wasm consensus state.
+func (cs ConsensusState) ValidateBasic() error {
+	if len(cs.Data) == 0 {
+		return sdkerrors.Wrap(ErrInvalidData, "data cannot be empty")
+	}
+
+	if cs.Timestamp == 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidConsensus, "timestamp cannot be zero")
+	}
+
+	return nil
+}
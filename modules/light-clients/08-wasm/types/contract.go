@@ -0,0 +1,194 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// InstantiateMessage is the payload of the instantiate entry point of a contract. It
+// is called when a client is created.
+type InstantiateMessage struct {
+	ClientState    []byte `json:"client_state"`
+	ConsensusState []byte `json:"consensus_state"`
+	Checksum       []byte `json:"checksum"`
+}
+
+// QueryMsg is the payload of the query entry point of a contract. Exactly one field is set.
+type QueryMsg struct {
+	Status         *StatusMsg         `json:"status,omitempty"`
+	ExportMetadata *ExportMetadataMsg `json:"export_metadata,omitempty"`
+}
+
+// StatusMsg queries the status of the client.
+type StatusMsg struct {
+	ClientState []byte `json:"client_state"`
+}
+
+// ExportMetadataMsg queries the client metadata exported in genesis.
+type ExportMetadataMsg struct {
+	ClientState []byte `json:"client_state"`
+}
+
+// SudoMsg is the payload of the sudo entry point of a contract. Exactly one field is set.
+type SudoMsg struct {
+	UpdateState                   *UpdateStateMsg                   `json:"update_state,omitempty"`
+	UpdateStateOnMisbehaviour     *UpdateStateOnMisbehaviourMsg     `json:"update_state_on_misbehaviour,omitempty"`
+	VerifyMembership              *VerifyMembershipMsg              `json:"verify_membership,omitempty"`
+	VerifyNonMembership           *VerifyNonMembershipMsg           `json:"verify_non_membership,omitempty"`
+	VerifyUpgradeAndUpdateState   *VerifyUpgradeAndUpdateStateMsg   `json:"verify_upgrade_and_update_state,omitempty"`
+	CheckSubstituteAndUpdateState *CheckSubstituteAndUpdateStateMsg `json:"check_substitute_and_update_state,omitempty"`
+}
+
+// UpdateStateMsg verifies a header and returns the updated client and consensus states.
+type UpdateStateMsg struct {
+	ClientState   []byte `json:"client_state"`
+	ClientMessage []byte `json:"client_message"`
+}
+
+// UpdateStateOnMisbehaviourMsg verifies a misbehaviour and returns the frozen client state.
+type UpdateStateOnMisbehaviourMsg struct {
+	ClientState   []byte `json:"client_state"`
+	ClientMessage []byte `json:"client_message"`
+}
+
+// VerifyMembershipMsg verifies a proof of the existence of a value at a path.
+type VerifyMembershipMsg struct {
+	ClientState      []byte                     `json:"client_state"`
+	ConsensusState   []byte                     `json:"consensus_state"`
+	Height           clienttypes.Height         `json:"height"`
+	DelayTimePeriod  uint64                     `json:"delay_time_period"`
+	DelayBlockPeriod uint64                     `json:"delay_block_period"`
+	Proof            []byte                     `json:"proof"`
+	MerklePath       commitmenttypes.MerklePath `json:"merkle_path"`
+	Value            []byte                     `json:"value"`
+}
+
+// VerifyNonMembershipMsg verifies a proof of the absence of a path.
+type VerifyNonMembershipMsg struct {
+	ClientState      []byte                     `json:"client_state"`
+	ConsensusState   []byte                     `json:"consensus_state"`
+	Height           clienttypes.Height         `json:"height"`
+	DelayTimePeriod  uint64                     `json:"delay_time_period"`
+	DelayBlockPeriod uint64                     `json:"delay_block_period"`
+	Proof            []byte                     `json:"proof"`
+	MerklePath       commitmenttypes.MerklePath `json:"merkle_path"`
+}
+
+// VerifyUpgradeAndUpdateStateMsg verifies the upgraded client and consensus states committed
+// to by the counterparty and returns the upgraded client and consensus states.
+type VerifyUpgradeAndUpdateStateMsg struct {
+	ClientState                []byte `json:"client_state"`
+	UpgradeClientState         []byte `json:"upgrade_client_state"`
+	UpgradeConsensusState      []byte `json:"upgrade_consensus_state"`
+	ProofUpgradeClient         []byte `json:"proof_upgrade_client"`
+	ProofUpgradeConsensusState []byte `json:"proof_upgrade_consensus_state"`
+}
+
+// CheckSubstituteAndUpdateStateMsg checks that the substitute client state can replace the
+// client state and returns the updated client state.
+type CheckSubstituteAndUpdateStateMsg struct {
+	ClientState           []byte `json:"client_state"`
+	SubstituteClientState []byte `json:"substitute_client_state"`
+}
+
+// StatusResult is the result of the status query.
+type StatusResult struct {
+	Status exported.Status `json:"status"`
+}
+
+// ExportMetadataResult is the result of the export metadata query.
+type ExportMetadataResult struct {
+	GenesisMetadata []clienttypes.GenesisMetadata `json:"genesis_metadata"`
+}
+
+// UpdateStateResult is the result of the update state and verify upgrade sudo calls.
+type UpdateStateResult struct {
+	ClientState    []byte             `json:"client_state"`
+	ConsensusState []byte             `json:"consensus_state"`
+	Timestamp      uint64             `json:"timestamp"`
+	Height         clienttypes.Height `json:"height"`
+}
+
+// ClientStateResult is the result of the sudo calls which only update the client state.
+type ClientStateResult struct {
+	ClientState []byte `json:"client_state"`
+}
+
+// wasmInstantiate calls the instantiate entry point of the contract of the client state.
+func wasmInstantiate(ctx sdk.Context, clientStore sdk.KVStore, cs *ClientState, payload InstantiateMessage) error {
+	engine, err := GetVM(clientStore)
+	if err != nil {
+		return err
+	}
+
+	msg, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	if _, err := engine.Instantiate(cs.Checksum, NewEnv(ctx), msg, clientStore, ctx.GasMeter()); err != nil {
+		return sdkerrors.Wrap(ErrWasmContractCallFailed, err.Error())
+	}
+
+	return nil
+}
+
+// wasmQuery calls the query entry point of the contract of the client state and
+// unmarshals the result into the provided result.
+func wasmQuery(ctx sdk.Context, clientStore sdk.KVStore, cs *ClientState, payload QueryMsg, result interface{}) error {
+	engine, err := GetVM(clientStore)
+	if err != nil {
+		return err
+	}
+
+	msg, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	bz, err := engine.Query(cs.Checksum, NewEnv(ctx), msg, clientStore, ctx.GasMeter())
+	if err != nil {
+		return sdkerrors.Wrap(ErrWasmContractCallFailed, err.Error())
+	}
+
+	if err := json.Unmarshal(bz, result); err != nil {
+		return sdkerrors.Wrap(ErrInvalidContractResult, err.Error())
+	}
+
+	return nil
+}
+
+// wasmSudo calls the sudo entry point of the contract of the client state and unmarshals
+// the result into the provided result, unless it is nil.
+func wasmSudo(ctx sdk.Context, clientStore sdk.KVStore, cs *ClientState, payload SudoMsg, result interface{}) error {
+	engine, err := GetVM(clientStore)
+	if err != nil {
+		return err
+	}
+
+	msg, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	bz, err := engine.Sudo(cs.Checksum, NewEnv(ctx), msg, clientStore, ctx.GasMeter())
+	if err != nil {
+		return sdkerrors.Wrap(ErrWasmContractCallFailed, err.Error())
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	if err := json.Unmarshal(bz, result); err != nil {
+		return sdkerrors.Wrap(ErrInvalidContractResult, err.Error())
+	}
+
+	return nil
+}
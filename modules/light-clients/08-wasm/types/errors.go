@@ -0,0 +1,19 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// IBC wasm client sentinel errors
+var (
+	ErrInvalidData            = sdkerrors.Register(SubModuleName, 2, "invalid data")
+	ErrInvalidChecksum        = sdkerrors.Register(SubModuleName, 3, "invalid checksum")
+	ErrInvalidCode            = sdkerrors.Register(SubModuleName, 4, "invalid contract code")
+	ErrCodeNotFound           = sdkerrors.Register(SubModuleName, 5, "contract code not found")
+	ErrChecksumNotAllowed     = sdkerrors.Register(SubModuleName, 6, "checksum is not in the allowed checksums")
+	ErrVMNotSet               = sdkerrors.Register(SubModuleName, 7, "wasm engine has not been set")
+	ErrWasmContractCallFailed = sdkerrors.Register(SubModuleName, 8, "wasm contract call failed")
+	ErrInvalidContractResult  = sdkerrors.Register(SubModuleName, 9, "invalid wasm contract result")
+	ErrUnauthorized           = sdkerrors.Register(SubModuleName, 10, "signer is not the 08-wasm authority")
+	ErrNotSupported           = sdkerrors.Register(SubModuleName, 11, "operation is not supported by wasm light clients")
+)
@@ -0,0 +1,8 @@
+package types
+
+// 08-wasm events
+const (
+	EventTypeStoreCode = "store_wasm_code"
+
+	AttributeKeyChecksum = "checksum"
+)
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+// NewGenesisState creates a new 08-wasm GenesisState instance.
+func NewGenesisState(params Params, contracts []Contract) *GenesisState {
+	return &GenesisState{
+		Params:    params,
+		Contracts: contracts,
+	}
+}
+
+// DefaultGenesisState returns the default 08-wasm GenesisState, without any contracts.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), []Contract{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure. Every allowed checksum must be the checksum of a contract.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	checksums := make(map[string]bool)
+	for i, contract := range gs.Contracts {
+		if err := ValidateCode(contract.CodeBytes); err != nil {
+			return fmt.Errorf("invalid contract %d: %w", i, err)
+		}
+
+		checksum := hex.EncodeToString(Checksum(contract.CodeBytes))
+		if checksums[checksum] {
+			return fmt.Errorf("duplicate contract with checksum %s", checksum)
+		}
+		checksums[checksum] = true
+	}
+
+	for _, checksum := range gs.Params.AllowedChecksums {
+		if !checksums[checksum] {
+			return fmt.Errorf("allowed checksum %s has no contract", checksum)
+		}
+	}
+
+	return nil
+}
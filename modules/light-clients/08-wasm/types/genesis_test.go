@@ -0,0 +1,39 @@
+package types_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	wasmtesting "github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/testing"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	checksum := hex.EncodeToString(wasmtesting.Checksum)
+	contract := types.Contract{CodeBytes: wasmtesting.Code}
+
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expPass  bool
+	}{
+		{"default", types.DefaultGenesisState(), true},
+		{"allowed contract", types.NewGenesisState(types.NewParams(checksum), []types.Contract{contract}), true},
+		{"contract not allowed", types.NewGenesisState(types.DefaultParams(), []types.Contract{contract}), true},
+		{"invalid params", types.NewGenesisState(types.NewParams("checksum"), []types.Contract{contract}), false},
+		{"invalid contract code", types.NewGenesisState(types.DefaultParams(), []types.Contract{{CodeBytes: []byte("code")}}), false},
+		{"duplicate contract", types.NewGenesisState(types.DefaultParams(), []types.Contract{contract, contract}), false},
+		{"allowed checksum without contract", types.NewGenesisState(types.NewParams(checksum), nil), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
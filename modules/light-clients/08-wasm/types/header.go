@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+var _ exported.Header = (*Header)(nil)
+
+// NewHeader creates a new Header instance.
+func NewHeader(data []byte, height clienttypes.Height) *Header {
+	return &Header{
+		Data:   data,
+		Height: height,
+	}
+}
+
+// ClientType defines that the Header is a Wasm client header.
+func (Header) ClientType() string {
+	return exported.Wasm
+}
+
+// GetChainID returns an empty string as the chain ID is part of the opaque header of the contract.
+func (Header) GetChainID() string {
+	return ""
+}
+
+// GetHeight returns the height of the consensus state added by the header.
+func (h Header) GetHeight() exported.Height {
+	return h.Height
+}
+
+// ValidateBasic defines a basic validation for the wasm header.
+func (h Header) ValidateBasic() error {
+	if len(h.Data) == 0 {
+		return sdkerrors.Wrap(ErrInvalidData, "data cannot be empty")
+	}
+
+	if h.Height.IsZero() {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidHeader, "header height cannot be zero")
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+const (
+	// SubModuleName defines the 08-wasm light client name
+	SubModuleName = "08-wasm"
+
+	// ModuleName defines the 08-wasm module name
+	ModuleName = SubModuleName
+
+	// StoreKey is the store key string for 08-wasm
+	StoreKey = ModuleName
+
+	// KeyCodePrefix is the key prefix under which contract codes are stored
+	KeyCodePrefix = "codes"
+)
+
+// CodeKey returns the store key under which the code with the given checksum is stored
+func CodeKey(checksum []byte) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyCodePrefix, hex.EncodeToString(checksum)))
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+var _ exported.Misbehaviour = (*Misbehaviour)(nil)
+
+// NewMisbehaviour creates a new Misbehaviour instance.
+func NewMisbehaviour(clientID string, data []byte) *Misbehaviour {
+	return &Misbehaviour{
+		ClientId: clientID,
+		Data:     data,
+	}
+}
+
+// ClientType is Wasm light client.
+func (Misbehaviour) ClientType() string {
+	return exported.Wasm
+}
+
+// GetClientID returns the ID of the client that committed a misbehaviour.
+func (misbehaviour Misbehaviour) GetClientID() string {
+	return misbehaviour.ClientId
+}
+
+// GetChainID returns an empty string as the chain ID is part of the opaque misbehaviour of the contract.
+func (Misbehaviour) GetChainID() string {
+	return ""
+}
+
+// ValidateBasic defines a basic validation for the wasm misbehaviour.
+func (misbehaviour Misbehaviour) ValidateBasic() error {
+	if err := host.ClientIdentifierValidator(misbehaviour.ClientId); err != nil {
+		return sdkerrors.Wrap(err, "misbehaviour client ID is invalid")
+	}
+
+	if len(misbehaviour.Data) == 0 {
+		return sdkerrors.Wrap(ErrInvalidData, "data cannot be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxWasmSize is the maximum size in bytes of a contract code
+const MaxWasmSize = 3 * 1024 * 1024
+
+// wasmMagicNumber is the magic number every Wasm binary starts with
+var wasmMagicNumber = []byte("\x00asm")
+
+var _ sdk.Msg = &MsgStoreCode{}
+
+// NewMsgStoreCode creates a new MsgStoreCode instance
+//
+//nolint:interfacer
+func NewMsgStoreCode(signer string, code []byte) *MsgStoreCode {
+	return &MsgStoreCode{
+		Signer:       signer,
+		WasmByteCode: code,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgStoreCode) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return ValidateCode(msg.WasmByteCode)
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgStoreCode) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr}
+}
+
+// ValidateCode returns an error if the code is empty, exceeds MaxWasmSize or is not a
+// Wasm binary.
+func ValidateCode(code []byte) error {
+	if len(code) == 0 {
+		return sdkerrors.Wrap(ErrInvalidCode, "code cannot be empty")
+	}
+
+	if len(code) > MaxWasmSize {
+		return sdkerrors.Wrapf(ErrInvalidCode, "code size %d exceeds the maximum size %d", len(code), MaxWasmSize)
+	}
+
+	if !bytes.HasPrefix(code, wasmMagicNumber) {
+		return sdkerrors.Wrap(ErrInvalidCode, "code is not a Wasm binary")
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	wasmtesting "github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/testing"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func TestMsgStoreCodeValidateBasic(t *testing.T) {
+	signer := ibctesting.TestAccAddress
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgStoreCode
+		expPass bool
+	}{
+		{"valid msg", types.NewMsgStoreCode(signer, wasmtesting.Code), true},
+		{"invalid signer", types.NewMsgStoreCode("signer", wasmtesting.Code), false},
+		{"empty code", types.NewMsgStoreCode(signer, nil), false},
+		{"code is not a wasm binary", types.NewMsgStoreCode(signer, []byte("code")), false},
+		{"code exceeds maximum size", types.NewMsgStoreCode(signer, append(wasmtesting.Code, bytes.Repeat([]byte{0}, types.MaxWasmSize)...)), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
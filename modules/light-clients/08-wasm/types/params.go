@@ -0,0 +1,82 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// KeyAllowedChecksums is store's key for AllowedChecksums Params
+var KeyAllowedChecksums = []byte("AllowedChecksums")
+
+// ParamKeyTable type declaration for parameters
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new parameter configuration for the 08-wasm module
+func NewParams(allowedChecksums ...string) Params {
+	return Params{
+		AllowedChecksums: allowedChecksums,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the 08-wasm module.
+// No checksums are allowed by default.
+func DefaultParams() Params {
+	return NewParams()
+}
+
+// Validate all 08-wasm module parameters
+func (p Params) Validate() error {
+	return validateChecksums(p.AllowedChecksums)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyAllowedChecksums, p.AllowedChecksums, validateChecksums),
+	}
+}
+
+// IsAllowedChecksum checks if the given hex encoded checksum is allowed
+func (p Params) IsAllowedChecksum(checksum string) bool {
+	for _, allowedChecksum := range p.AllowedChecksums {
+		if allowedChecksum == checksum {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validateChecksums(i interface{}) error {
+	checksums, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for i, checksum := range checksums {
+		bz, err := hex.DecodeString(checksum)
+		if err != nil {
+			return fmt.Errorf("allowed checksum %d is not hex encoded: %w", i, err)
+		}
+
+		if err := ValidateChecksum(bz); err != nil {
+			return fmt.Errorf("allowed checksum %d is invalid: %w", i, err)
+		}
+
+		if hex.EncodeToString(bz) != checksum {
+			return fmt.Errorf("allowed checksum %d must be lower case hex encoded", i)
+		}
+
+		if seen[checksum] {
+			return fmt.Errorf("allowed checksum %s is duplicated", checksum)
+		}
+		seen[checksum] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package types_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	wasmtesting "github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/testing"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/types"
+)
+
+func TestValidateParams(t *testing.T) {
+	checksum := hex.EncodeToString(wasmtesting.Checksum)
+
+	testCases := []struct {
+		name    string
+		params  types.Params
+		expPass bool
+	}{
+		{"default params", types.DefaultParams(), true},
+		{"allowed checksum", types.NewParams(checksum), true},
+		{"not hex encoded", types.NewParams("checksum"), false},
+		{"invalid checksum length", types.NewParams(checksum[2:]), false},
+		{"upper case hex encoded", types.NewParams(strings.ToUpper(checksum)), false},
+		{"duplicate checksum", types.NewParams(checksum, checksum), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.params.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
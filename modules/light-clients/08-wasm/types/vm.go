@@ -0,0 +1,102 @@
+package types
+
+import (
+	"crypto/sha256"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// WasmEngine defines the virtual machine executing light client contracts. Contracts
+// are identified by the checksum of their code and have read and write access to the
+// client store of the client they are called for. The client store is read-only during
+// proof verification.
+type WasmEngine interface {
+	// StoreCode compiles and stores the given contract code and returns its checksum.
+	StoreCode(code []byte) ([]byte, error)
+
+	// Instantiate calls the instantiate entry point of the contract.
+	Instantiate(checksum []byte, env Env, msg []byte, store sdk.KVStore, gasMeter sdk.GasMeter) ([]byte, error)
+
+	// Query calls the query entry point of the contract. The contract must not write to the store.
+	Query(checksum []byte, env Env, msg []byte, store sdk.KVStore, gasMeter sdk.GasMeter) ([]byte, error)
+
+	// Sudo calls the sudo entry point of the contract.
+	Sudo(checksum []byte, env Env, msg []byte, store sdk.KVStore, gasMeter sdk.GasMeter) ([]byte, error)
+}
+
+// Env defines the block environment a contract is executed in.
+type Env struct {
+	ChainID string    `json:"chain_id"`
+	Height  int64     `json:"height"`
+	Time    time.Time `json:"time"`
+}
+
+// NewEnv returns the environment of the block being executed.
+func NewEnv(ctx sdk.Context) Env {
+	return Env{
+		ChainID: ctx.ChainID(),
+		Height:  ctx.BlockHeight(),
+		Time:    ctx.BlockTime(),
+	}
+}
+
+// ChecksumAllowlist returns whether new clients are allowed to use the contract with
+// the given checksum.
+type ChecksumAllowlist func(ctx sdk.Context, checksum []byte) bool
+
+var _ sdk.KVStore = (*clientStore)(nil)
+
+// clientStore is the client store given to wasm light clients by the 08-wasm light client
+// module. It holds the WasmEngine executing the contracts and the allowlist of checksums
+// new clients may use, as ClientState has no access to the 08-wasm keeper.
+type clientStore struct {
+	sdk.KVStore
+
+	vm                WasmEngine
+	checksumAllowlist ChecksumAllowlist
+}
+
+// NewClientStore wraps the client store of a wasm light client with the WasmEngine executing
+// its contract and the allowlist of checksums new clients may use.
+func NewClientStore(store sdk.KVStore, vm WasmEngine, allowlist ChecksumAllowlist) sdk.KVStore {
+	return &clientStore{
+		KVStore:           store,
+		vm:                vm,
+		checksumAllowlist: allowlist,
+	}
+}
+
+// GetVM returns the WasmEngine of the given client store. The client store must have been
+// wrapped by NewClientStore.
+func GetVM(store sdk.KVStore) (WasmEngine, error) {
+	clientStore, ok := store.(*clientStore)
+	if !ok || clientStore.vm == nil {
+		return nil, ErrVMNotSet
+	}
+
+	return clientStore.vm, nil
+}
+
+// IsAllowedChecksum returns whether new clients are allowed to use the contract with the
+// given checksum, according to the allowlist of the given client store.
+func IsAllowedChecksum(ctx sdk.Context, store sdk.KVStore, checksum []byte) bool {
+	clientStore, ok := store.(*clientStore)
+	return ok && clientStore.checksumAllowlist != nil && clientStore.checksumAllowlist(ctx, checksum)
+}
+
+// Checksum returns the checksum of the given contract code.
+func Checksum(code []byte) []byte {
+	hash := sha256.Sum256(code)
+	return hash[:]
+}
+
+// ValidateChecksum returns an error if the checksum is not a sha256 hash.
+func ValidateChecksum(checksum []byte) error {
+	if len(checksum) != sha256.Size {
+		return sdkerrors.Wrapf(ErrInvalidChecksum, "expected %d bytes, got %d", sha256.Size, len(checksum))
+	}
+
+	return nil
+}
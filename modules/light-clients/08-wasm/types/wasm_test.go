@@ -0,0 +1,74 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/keeper"
+	wasmtesting "github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/testing"
+	"github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+var height = clienttypes.NewHeight(0, 10)
+
+type WasmTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chainA      *ibctesting.TestChain
+
+	engine *wasmtesting.MockWasmEngine
+	keeper keeper.Keeper
+}
+
+func (suite *WasmTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+
+	// the wasm clients of each test chain are executed by its own mock wasm engine
+	app := suite.chainA.GetSimApp()
+	suite.keeper = app.WasmClientKeeper
+	suite.engine = suite.keeper.GetWasmEngine().(*wasmtesting.MockWasmEngine)
+
+	_, err := suite.keeper.StoreCode(sdk.WrapSDKContext(suite.chainA.GetContext()), types.NewMsgStoreCode(suite.keeper.GetAuthority(), wasmtesting.Code))
+	suite.Require().NoError(err)
+
+	params := app.IBCKeeper.ClientKeeper.GetParams(suite.chainA.GetContext())
+	params.AllowedClients = append(params.AllowedClients, exported.Wasm)
+	app.IBCKeeper.ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+}
+
+// createClient creates a wasm client on chainA whose contract reports it as active.
+func (suite *WasmTestSuite) createClient() (string, *types.ClientState) {
+	suite.registerStatus(exported.Active)
+
+	clientState := types.NewClientState([]byte("client state"), wasmtesting.Checksum, height)
+	consensusState := types.NewConsensusState([]byte("consensus state"), uint64(suite.chainA.GetContext().BlockTime().UnixNano()))
+
+	clientID, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.CreateClient(suite.chainA.GetContext(), clientState, consensusState)
+	suite.Require().NoError(err)
+
+	return clientID, clientState
+}
+
+// clientStore returns the client store of the given client as given to wasm light clients.
+func (suite *WasmTestSuite) clientStore(ctx sdk.Context, clientID string) sdk.KVStore {
+	return suite.keeper.WrapClientStore(suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, clientID))
+}
+
+// registerStatus makes the contract report the given client status.
+func (suite *WasmTestSuite) registerStatus(status exported.Status) {
+	suite.engine.RegisterQueryCallback("status", func(_ []byte, _ types.Env, _ []byte, _ sdk.KVStore, _ sdk.GasMeter) ([]byte, error) {
+		return json.Marshal(types.StatusResult{Status: status})
+	})
+}
+
+func TestWasmTestSuite(t *testing.T) {
+	suite.Run(t, new(WasmTestSuite))
+}
@@ -13,6 +13,7 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
@@ -77,15 +78,12 @@ func (cs ClientState) ExportMetadata(_ sdk.KVStore) []exported.GenesisMetadata {
 	return nil
 }
 
-// CheckHeaderAndUpdateState updates the localhost client. It only needs access to the context
-func (cs *ClientState) CheckHeaderAndUpdateState(
-	ctx sdk.Context, _ codec.BinaryCodec, _ sdk.KVStore, _ exported.Header,
+// CheckHeaderAndUpdateState returns an error. The localhost client is updated to the latest
+// height of the running chain on every BeginBlock.
+func (cs ClientState) CheckHeaderAndUpdateState(
+	_ sdk.Context, _ codec.BinaryCodec, _ sdk.KVStore, _ exported.Header,
 ) (exported.ClientState, exported.ConsensusState, error) {
-	// use the chain ID from context since the localhost client is from the running chain (i.e self).
-	cs.ChainId = ctx.ChainID()
-	revision := clienttypes.ParseChainID(cs.ChainId)
-	cs.Height = clienttypes.NewHeight(revision, uint64(ctx.BlockHeight()))
-	return cs, nil, nil
+	return nil, nil, sdkerrors.Wrap(clienttypes.ErrUpdateClientFailed, "localhost client is only updated on BeginBlock")
 }
 
 // CheckMisbehaviourAndUpdateState implements ClientState
@@ -114,6 +112,65 @@ func (cs ClientState) VerifyUpgradeAndUpdateState(
 	return nil, nil, sdkerrors.Wrap(clienttypes.ErrInvalidUpgradeClient, "cannot upgrade localhost client")
 }
 
+// VerifyMembership verifies that the value is stored in the local IBC store under the key
+// of the path. The commitment prefix of the path, the height and the proof are ignored.
+// The store must be the local IBC store.
+func (cs ClientState) VerifyMembership(
+	_ sdk.Context,
+	store sdk.KVStore,
+	_ codec.BinaryCodec,
+	_ exported.Height,
+	_ uint64,
+	_ uint64,
+	_ []byte,
+	path exported.Path,
+	value []byte,
+) error {
+	key, err := localKey(path)
+	if err != nil {
+		return err
+	}
+
+	data := store.Get(key)
+	if len(data) == 0 {
+		return sdkerrors.Wrapf(clienttypes.ErrFailedMembershipVerification, "not found for path %s", key)
+	}
+
+	if !bytes.Equal(data, value) {
+		return sdkerrors.Wrapf(
+			clienttypes.ErrFailedMembershipVerification,
+			"value ≠ stored value: \n%X\n≠\n%X", value, data,
+		)
+	}
+
+	return nil
+}
+
+// VerifyNonMembership verifies that no value is stored in the local IBC store under the key
+// of the path. The commitment prefix of the path, the height and the proof are ignored.
+// The store must be the local IBC store.
+func (cs ClientState) VerifyNonMembership(
+	_ sdk.Context,
+	store sdk.KVStore,
+	_ codec.BinaryCodec,
+	_ exported.Height,
+	_ uint64,
+	_ uint64,
+	_ []byte,
+	path exported.Path,
+) error {
+	key, err := localKey(path)
+	if err != nil {
+		return err
+	}
+
+	if store.Has(key) {
+		return sdkerrors.Wrapf(clienttypes.ErrFailedNonMembershipVerification, "value found for path %s", key)
+	}
+
+	return nil
+}
+
 // VerifyClientState verifies that the localhost client state is stored locally
 func (cs ClientState) VerifyClientState(
 	store sdk.KVStore, cdc codec.BinaryCodec,
@@ -338,3 +395,50 @@ func (cs ClientState) VerifyNextSequenceRecv(
 
 	return nil
 }
+
+// VerifyNextSequenceAck verifies a proof of the next sequence number to be
+// acknowledged of the specified channel at the specified port.
+func (cs ClientState) VerifyNextSequenceAck(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	_ codec.BinaryCodec,
+	_ exported.Height,
+	_ uint64,
+	_ uint64,
+	_ exported.Prefix,
+	_ []byte,
+	portID,
+	channelID string,
+	nextSequenceAck uint64,
+) error {
+	path := host.NextSequenceAckKey(portID, channelID)
+
+	data := store.Get(path)
+	if len(data) == 0 {
+		return sdkerrors.Wrapf(clienttypes.ErrFailedNextSeqAckVerification, "not found for path %s", path)
+	}
+
+	prevSequenceAck := binary.BigEndian.Uint64(data)
+	if prevSequenceAck != nextSequenceAck {
+		return sdkerrors.Wrapf(
+			clienttypes.ErrFailedNextSeqAckVerification,
+			"next sequence acknowledgement ≠ previous stored sequence (%d ≠ %d)", nextSequenceAck, prevSequenceAck,
+		)
+	}
+
+	return nil
+}
+
+// localKey returns the local store key of a path, which is the last key of the merkle path.
+func localKey(path exported.Path) ([]byte, error) {
+	merklePath, ok := path.(commitmenttypes.MerklePath)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", commitmenttypes.MerklePath{}, path)
+	}
+
+	if len(merklePath.KeyPath) == 0 {
+		return nil, sdkerrors.Wrap(commitmenttypes.ErrInvalidMerkleProof, "path cannot be empty")
+	}
+
+	return []byte(merklePath.KeyPath[len(merklePath.KeyPath)-1]), nil
+}
@@ -162,11 +162,10 @@ func (suite *LocalhostTestSuite) TestVerifyClientConsensusState() {
 
 func (suite *LocalhostTestSuite) TestCheckHeaderAndUpdateState() {
 	clientState := types.NewClientState("chainID", clientHeight)
-	cs, _, err := clientState.CheckHeaderAndUpdateState(suite.ctx, nil, nil, nil)
-	suite.Require().NoError(err)
-	suite.Require().Equal(uint64(0), cs.GetLatestHeight().GetRevisionNumber())
-	suite.Require().Equal(suite.ctx.BlockHeight(), int64(cs.GetLatestHeight().GetRevisionHeight()))
-	suite.Require().Equal(suite.ctx.BlockHeader().ChainID, clientState.ChainId)
+	cs, consState, err := clientState.CheckHeaderAndUpdateState(suite.ctx, nil, nil, nil)
+	suite.Require().Error(err)
+	suite.Require().Nil(cs)
+	suite.Require().Nil(consState)
 }
 
 func (suite *LocalhostTestSuite) TestMisbehaviourAndUpdateState() {
@@ -524,3 +523,172 @@ func (suite *LocalhostTestSuite) TestVerifyNextSeqRecv() {
 		})
 	}
 }
+
+func (suite *LocalhostTestSuite) TestVerifyNextSeqAck() {
+	nextSeqAck := uint64(5)
+
+	testCases := []struct {
+		name        string
+		clientState *types.ClientState
+		malleate    func()
+		nextSeqAck  uint64
+		expPass     bool
+	}{
+		{
+			name:        "proof verification success",
+			clientState: types.NewClientState("chainID", clientHeight),
+			malleate: func() {
+				suite.store.Set(
+					host.NextSequenceAckKey(testPortID, testChannelID),
+					sdk.Uint64ToBigEndian(nextSeqAck),
+				)
+			},
+			nextSeqAck: nextSeqAck,
+			expPass:    true,
+		},
+		{
+			name:        "proof verification failed: different nextSeqAck stored",
+			clientState: types.NewClientState("chainID", clientHeight),
+			malleate: func() {
+				suite.store.Set(
+					host.NextSequenceAckKey(testPortID, testChannelID),
+					sdk.Uint64ToBigEndian(3),
+				)
+			},
+			nextSeqAck: nextSeqAck,
+			expPass:    false,
+		},
+		{
+			name:        "proof verification failed: no nextSeqAck stored",
+			clientState: types.NewClientState("chainID", clientHeight),
+			malleate:    func() {},
+			nextSeqAck:  nextSeqAck,
+			expPass:     false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			err := tc.clientState.VerifyNextSequenceAck(
+				suite.ctx, suite.store, suite.cdc, clientHeight, 0, 0, nil, []byte{}, testPortID, testChannelID, nextSeqAck,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *LocalhostTestSuite) TestVerifyMembership() {
+	commitment := []byte("commitment")
+	path := commitmenttypes.NewMerklePath("ibc", host.PacketCommitmentPath(testPortID, testChannelID, testSequence))
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		path     exported.Path
+		expPass  bool
+	}{
+		{
+			name: "verification success",
+			malleate: func() {
+				suite.store.Set(host.PacketCommitmentKey(testPortID, testChannelID, testSequence), commitment)
+			},
+			path:    path,
+			expPass: true,
+		},
+		{
+			name: "verification failed: different value stored",
+			malleate: func() {
+				suite.store.Set(host.PacketCommitmentKey(testPortID, testChannelID, testSequence), []byte("different"))
+			},
+			path:    path,
+			expPass: false,
+		},
+		{
+			name:     "verification failed: no value stored",
+			malleate: func() {},
+			path:     path,
+			expPass:  false,
+		},
+		{
+			name: "verification failed: empty path",
+			malleate: func() {
+				suite.store.Set(host.PacketCommitmentKey(testPortID, testChannelID, testSequence), commitment)
+			},
+			path:    commitmenttypes.MerklePath{},
+			expPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			clientState := types.NewClientState("chainID", clientHeight)
+			err := clientState.VerifyMembership(
+				suite.ctx, suite.store, suite.cdc, clientHeight, 0, 0, []byte{}, tc.path, commitment,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *LocalhostTestSuite) TestVerifyNonMembership() {
+	path := commitmenttypes.NewMerklePath(host.PacketReceiptPath(testPortID, testChannelID, testSequence))
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			name:     "verification success",
+			malleate: func() {},
+			expPass:  true,
+		},
+		{
+			name: "verification failed: value stored",
+			malleate: func() {
+				suite.store.Set(host.PacketReceiptKey(testPortID, testChannelID, testSequence), []byte{byte(1)})
+			},
+			expPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			clientState := types.NewClientState("chainID", clientHeight)
+			err := clientState.VerifyNonMembership(
+				suite.ctx, suite.store, suite.cdc, clientHeight, 0, 0, []byte{}, path,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
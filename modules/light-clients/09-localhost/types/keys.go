@@ -4,3 +4,8 @@ const (
 	// SubModuleName for the localhost (loopback) client
 	SubModuleName = "localhost"
 )
+
+// SentinelProof is the proof submitted in the messages verified by the localhost client.
+// The localhost client reads the local IBC store directly and ignores proofs, but messages
+// require them to be non-empty.
+var SentinelProof = []byte{0x01}
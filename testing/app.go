@@ -24,6 +24,7 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/ibc-go/v3/modules/core/keeper"
+	wasmtesting "github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/testing"
 	"github.com/cosmos/ibc-go/v3/testing/simapp"
 )
 
@@ -50,7 +51,9 @@ type TestingApp interface {
 func SetupTestingApp(chainConsensusType string) (TestingApp, map[string]json.RawMessage) {
 	db := dbm.NewMemDB()
 	encCdc := simapp.MakeTestEncodingConfig()
-	app := simapp.NewSimAppWithConsensusType(log.NewNopLogger(), db, nil, true, map[int64]bool{}, simapp.DefaultNodeHome, 5, chainConsensusType, encCdc, simapp.EmptyAppOptions{})
+	// the in-process mock wasm engine executes the 08-wasm light client contracts of the test chains
+	appOpts := simapp.AppOptionsMap{simapp.WasmEngineAppOption: wasmtesting.NewMockWasmEngine()}
+	app := simapp.NewSimAppWithConsensusType(log.NewNopLogger(), db, nil, true, map[int64]bool{}, simapp.DefaultNodeHome, 5, chainConsensusType, encCdc, appOpts)
 	return app, simapp.NewDefaultGenesisState(encCdc.Marshaler)
 }
 
@@ -241,6 +241,50 @@ func (chain *TestChain) QueryProofAtHeight(key []byte, height int64) ([]byte, cl
 	return proof, clienttypes.NewHeight(revision, uint64(res.Height)+1)
 }
 
+// QueryProofs performs an abci query for each of the given keys and returns the proto encoded
+// merkle proof combining them into a compressed ics23 batch proof, along with the height at
+// which the proof will succeed on a tendermint verifier.
+func (chain *TestChain) QueryProofs(keys ...[]byte) ([]byte, clienttypes.Height) {
+	return chain.QueryProofsAtHeight(chain.App.LastBlockHeight(), keys...)
+}
+
+// QueryProofsAtHeight performs an abci query for each of the given keys at the given height and
+// returns the proto encoded merkle proof combining them into a compressed ics23 batch proof, along
+// with the height at which the proof will succeed on a tendermint verifier.
+func (chain *TestChain) QueryProofsAtHeight(height int64, keys ...[]byte) ([]byte, clienttypes.Height) {
+	var (
+		proofs    = make([]commitmenttypes.MerkleProof, len(keys))
+		resHeight int64
+	)
+	for i, key := range keys {
+		res := chain.App.Query(abci.RequestQuery{
+			Path:   fmt.Sprintf("store/%s/key", host.StoreKey),
+			Height: height - 1,
+			Data:   key,
+			Prove:  true,
+		})
+
+		merkleProof, err := commitmenttypes.ConvertProofs(res.ProofOps)
+		require.NoError(chain.T, err)
+
+		proofs[i] = merkleProof
+		resHeight = res.Height
+	}
+
+	batchProof, err := commitmenttypes.CombineMerkleProofs(proofs)
+	require.NoError(chain.T, err)
+
+	proof, err := chain.App.AppCodec().Marshal(&batchProof)
+	require.NoError(chain.T, err)
+
+	revision := clienttypes.ParseChainID(chain.ChainID)
+
+	// proof height + 1 is returned as the proof created corresponds to the height the proof
+	// was created in the IAVL tree. Tendermint and subsequently the clients that rely on it
+	// have heights 1 above the IAVL tree. Thus we return proof height + 1
+	return proof, clienttypes.NewHeight(revision, uint64(resHeight)+1)
+}
+
 // QueryUpgradeProof performs an abci query with the given key and returns the proto encoded merkle proof
 // for the query and the height at which the proof will succeed on a tendermint verifier.
 func (chain *TestChain) QueryUpgradeProof(key []byte, height uint64) ([]byte, clienttypes.Height) {
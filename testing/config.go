@@ -23,9 +23,10 @@ func NewConnectionConfig() *ConnectionConfig {
 }
 
 type ChannelConfig struct {
-	PortID  string
-	Version string
-	Order   channeltypes.Order
+	PortID      string
+	Version     string
+	Order       channeltypes.Order
+	PacketDelay channeltypes.PacketDelay
 }
 
 func NewChannelConfig() *ChannelConfig {
@@ -71,6 +71,16 @@ func (endpoint *Endpoint) QueryProofAtHeight(key []byte, height uint64) ([]byte,
 	return endpoint.Chain.QueryProofAtHeight(key, int64(height))
 }
 
+// QueryProofs queries a compressed batch proof of the given keys associated with this endpoint
+// using the lastest client state height on the counterparty chain.
+func (endpoint *Endpoint) QueryProofs(keys ...[]byte) ([]byte, clienttypes.Height) {
+	// obtain the counterparty client representing the chain associated with the endpoint
+	clientState := endpoint.Counterparty.Chain.GetClientState(endpoint.Counterparty.ClientID)
+
+	// query proof on the counterparty using the latest height of the IBC client
+	return endpoint.Chain.QueryProofsAtHeight(int64(clientState.GetLatestHeight().GetRevisionHeight()), keys...)
+}
+
 // CreateClient creates an IBC client on the endpoint. It will update the
 // clientID for the endpoint if the message is successfully executed.
 // NOTE: a solo machine client will be created with an empty diversifier.
@@ -101,8 +111,13 @@ func (endpoint *Endpoint) CreateClient() (err error) {
 	return nil
 }
 
-// UpdateClient updates the IBC client associated with the endpoint.
+// UpdateClient updates the IBC client associated with the endpoint. It is a no-op for
+// the localhost client, which is updated on BeginBlock.
 func (endpoint *Endpoint) UpdateClient() (err error) {
+	if endpoint.ClientID == exported.Localhost {
+		return nil
+	}
+
 	// ensure counterparty has committed state
 	endpoint.Chain.Coordinator.CommitBlock(endpoint.Counterparty.Chain)
 
@@ -238,6 +253,7 @@ func (endpoint *Endpoint) ChanOpenInit() error {
 		endpoint.Counterparty.ChannelConfig.PortID,
 		endpoint.Chain.SenderAccount.GetAddress().String(),
 	)
+	msg.PacketDelay = endpoint.ChannelConfig.PacketDelay
 	res, err := endpoint.Chain.SendMsgs(msg)
 	if err != nil {
 		return err
@@ -264,6 +280,7 @@ func (endpoint *Endpoint) ChanOpenTry() error {
 		proof, height,
 		endpoint.Chain.SenderAccount.GetAddress().String(),
 	)
+	msg.PacketDelay = endpoint.ChannelConfig.PacketDelay
 	res, err := endpoint.Chain.SendMsgs(msg)
 	if err != nil {
 		return err
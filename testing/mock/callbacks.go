@@ -0,0 +1,89 @@
+package mock
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+
+	callbackstypes "github.com/cosmos/ibc-go/v3/modules/apps/callbacks/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+const (
+	// MockCallbackAddress is the callback address of a successful mock callback.
+	MockCallbackAddress = "mockcallback"
+	// MockFailureCallbackAddress is the callback address of a mock callback returning an error.
+	MockFailureCallbackAddress = "mockcallbackfailure"
+	// MockPanicCallbackAddress is the callback address of a panicking mock callback.
+	MockPanicCallbackAddress = "mockcallbackpanic"
+	// MockOutOfGasCallbackAddress is the callback address of a mock callback running out of gas.
+	MockOutOfGasCallbackAddress = "mockcallbackoutofgas"
+)
+
+// MockCallbackCanaryCapabilityName is the prefix of the capabilities created by the mock callback handler.
+var MockCallbackCanaryCapabilityName = "mock callback canary capability name"
+
+var _ callbackstypes.CallbackHandler = CallbackHandler{}
+
+// CallbackHandler is a callback handler used for testing the callbacks middleware.
+// Each executed callback creates a canary capability before behaving according to
+// the callback address, which allows tests to verify that state changes of failed
+// callbacks are discarded.
+type CallbackHandler struct {
+	scopedKeeper capabilitykeeper.ScopedKeeper
+}
+
+// NewCallbackHandler returns a mock callback handler using the provided scoped keeper
+// to create canary capabilities.
+func NewCallbackHandler(scopedKeeper capabilitykeeper.ScopedKeeper) CallbackHandler {
+	return CallbackHandler{
+		scopedKeeper: scopedKeeper,
+	}
+}
+
+// IBCSendPacketCallback implements the CallbackHandler interface.
+func (h CallbackHandler) IBCSendPacketCallback(ctx sdk.Context, packet exported.PacketI, callbackAddress, packetSenderAddress string) error {
+	return h.handleCallback(ctx, callbackstypes.CallbackTypeSendPacket, packet, callbackAddress)
+}
+
+// IBCOnAcknowledgementPacketCallback implements the CallbackHandler interface.
+func (h CallbackHandler) IBCOnAcknowledgementPacketCallback(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress, callbackAddress, packetSenderAddress string) error {
+	return h.handleCallback(ctx, callbackstypes.CallbackTypeAcknowledgementPacket, packet, callbackAddress)
+}
+
+// IBCOnTimeoutPacketCallback implements the CallbackHandler interface.
+func (h CallbackHandler) IBCOnTimeoutPacketCallback(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress, callbackAddress, packetSenderAddress string) error {
+	return h.handleCallback(ctx, callbackstypes.CallbackTypeTimeoutPacket, packet, callbackAddress)
+}
+
+// IBCReceivePacketCallback implements the CallbackHandler interface.
+func (h CallbackHandler) IBCReceivePacketCallback(ctx sdk.Context, packet exported.PacketI, ack exported.Acknowledgement, callbackAddress string) error {
+	return h.handleCallback(ctx, callbackstypes.CallbackTypeReceivePacket, packet, callbackAddress)
+}
+
+// handleCallback creates the canary capability of the callback and fails, panics
+// or runs out of gas if requested by the callback address.
+func (h CallbackHandler) handleCallback(ctx sdk.Context, callbackType callbackstypes.CallbackType, packet exported.PacketI, callbackAddress string) error {
+	if _, err := h.scopedKeeper.NewCapability(ctx, GetMockCallbackCanaryCapabilityName(callbackType, packet)); err != nil {
+		return err
+	}
+
+	switch callbackAddress {
+	case MockFailureCallbackAddress:
+		return errors.New("mock callback failure")
+	case MockPanicCallbackAddress:
+		panic("mock callback panic")
+	case MockOutOfGasCallbackAddress:
+		ctx.GasMeter().ConsumeGas(ctx.GasMeter().Limit()+1, "mock callback out of gas")
+	}
+
+	return nil
+}
+
+// GetMockCallbackCanaryCapabilityName generates a capability name for testing callback functionality.
+func GetMockCallbackCanaryCapabilityName(callbackType callbackstypes.CallbackType, packet exported.PacketI) string {
+	return fmt.Sprintf("%s%s%s%s%d", MockCallbackCanaryCapabilityName, callbackType, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+}
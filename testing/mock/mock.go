@@ -150,3 +150,16 @@ func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
 	return []abci.ValidatorUpdate{}
 }
+
+// KeyPath defines a placeholder struct which implements the exported.Path interface
+type KeyPath struct{}
+
+// String implements the exported.Path interface
+func (KeyPath) String() string {
+	return ""
+}
+
+// Empty implements the exported.Path interface
+func (KeyPath) Empty() bool {
+	return false
+}
@@ -0,0 +1,357 @@
+package ibctesting
+
+import (
+	"fmt"
+
+	"github.com/stretchr/testify/require"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	multihoptypes "github.com/cosmos/ibc-go/v3/modules/core/33-multihop/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// MultihopPath contains the paths connecting a sequence of chains over IBC and
+// the two endpoints of a multi-hop channel opened between the first and the last
+// chain of the sequence, over the connections of every path.
+type MultihopPath struct {
+	Paths     []*Path
+	EndpointA *MultihopEndpoint
+	EndpointZ *MultihopEndpoint
+}
+
+// MultihopEndpoint represents a multi-hop channel endpoint. It contains the
+// endpoints of the connections along the channel path, each of them tracking
+// the next chain of the path.
+type MultihopEndpoint struct {
+	Chain         *TestChain
+	Counterparty  *MultihopEndpoint
+	ChannelID     string
+	ChannelConfig *ChannelConfig
+
+	Hops []*Endpoint
+}
+
+// NewMultihopPath constructs a path between every pair of consecutive chains and
+// the endpoints of a multi-hop channel between the first and the last chain.
+func NewMultihopPath(chains ...*TestChain) *MultihopPath {
+	if len(chains) < 3 {
+		panic(fmt.Sprintf("multi-hop path requires at least 3 chains, got %d", len(chains)))
+	}
+
+	paths := make([]*Path, len(chains)-1)
+	for i := range paths {
+		paths[i] = NewPath(chains[i], chains[i+1])
+	}
+
+	hopsA := make([]*Endpoint, len(paths))
+	hopsZ := make([]*Endpoint, len(paths))
+	for i, path := range paths {
+		hopsA[i] = path.EndpointA
+		hopsZ[len(paths)-1-i] = path.EndpointB
+	}
+
+	endpointA := &MultihopEndpoint{
+		Chain:         chains[0],
+		ChannelConfig: NewChannelConfig(),
+		Hops:          hopsA,
+	}
+	endpointZ := &MultihopEndpoint{
+		Chain:         chains[len(chains)-1],
+		ChannelConfig: NewChannelConfig(),
+		Hops:          hopsZ,
+	}
+
+	endpointA.Counterparty = endpointZ
+	endpointZ.Counterparty = endpointA
+
+	return &MultihopPath{
+		Paths:     paths,
+		EndpointA: endpointA,
+		EndpointZ: endpointZ,
+	}
+}
+
+// SetChannelOrdered sets the channel order for both endpoints to ORDERED.
+func (path *MultihopPath) SetChannelOrdered() {
+	path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
+	path.EndpointZ.ChannelConfig.Order = channeltypes.ORDERED
+}
+
+// SetupMultihop constructs the clients and connections of every path and opens
+// the multi-hop channel between the first and the last chain.
+func (coord *Coordinator) SetupMultihop(path *MultihopPath) {
+	for _, p := range path.Paths {
+		coord.SetupConnections(p)
+	}
+
+	coord.CreateMultihopChannels(path)
+}
+
+// CreateMultihopChannels opens the multi-hop channel of the path. The
+// connections of every path must already be open.
+func (coord *Coordinator) CreateMultihopChannels(path *MultihopPath) {
+	err := path.EndpointA.ChanOpenInit()
+	require.NoError(coord.T, err)
+
+	err = path.EndpointZ.ChanOpenTry()
+	require.NoError(coord.T, err)
+
+	err = path.EndpointA.ChanOpenAck()
+	require.NoError(coord.T, err)
+
+	err = path.EndpointZ.ChanOpenConfirm()
+	require.NoError(coord.T, err)
+}
+
+// ConnectionHops returns the connection hops of the channel end.
+func (endpoint *MultihopEndpoint) ConnectionHops() []string {
+	connectionHops := make([]string, len(endpoint.Hops))
+	for i, hop := range endpoint.Hops {
+		connectionHops[i] = hop.ConnectionID
+	}
+	return connectionHops
+}
+
+// GetChannel retrieves the multi-hop channel of this endpoint. The channel is
+// expected to exist otherwise testing will fail.
+func (endpoint *MultihopEndpoint) GetChannel() channeltypes.Channel {
+	channel, found := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.GetChannel(endpoint.Chain.GetContext(), endpoint.ChannelConfig.PortID, endpoint.ChannelID)
+	require.True(endpoint.Chain.T, found)
+
+	return channel
+}
+
+// QueryMultihopProof returns the multi-hop proof of the key stored on the
+// counterparty chain and the proof height of the first hop. The clients along
+// the path are updated to construct the proof.
+func (endpoint *MultihopEndpoint) QueryMultihopProof(key []byte) ([]byte, clienttypes.Height) {
+	proofs, height := QueryMultihopProofs(endpoint.Hops, key)
+
+	proof, err := endpoint.Chain.App.AppCodec().Marshal(&proofs[0])
+	require.NoError(endpoint.Chain.T, err)
+
+	return proof, height
+}
+
+// QueryMultihopProofs returns the multi-hop proofs of the keys stored on the chain
+// reached by the given connection hop endpoints and the proof height of the first
+// hop. Every proof shares the same hop proofs. The clients along the path are
+// updated to construct the proofs.
+func QueryMultihopProofs(hops []*Endpoint, keys ...[]byte) ([]multihoptypes.MultihopProof, clienttypes.Height) {
+	last := hops[len(hops)-1]
+	require.NoError(last.Chain.T, last.UpdateClient())
+
+	height := last.GetClientState().GetLatestHeight().(clienttypes.Height)
+	keyProofs := make([][]byte, len(keys))
+	for i, key := range keys {
+		keyProofs[i], _ = last.Counterparty.Chain.QueryProofAtHeight(key, int64(height.GetRevisionHeight()))
+	}
+
+	// the hop proofs are constructed backwards, from the last chain to the
+	// first chain of the path
+	hopProofs := make([]multihoptypes.HopProof, len(hops)-1)
+	for i := len(hops) - 2; i >= 0; i-- {
+		next := hops[i+1]
+		consensusHeight := height
+
+		require.NoError(hops[i].Chain.T, hops[i].UpdateClient())
+		height = hops[i].GetClientState().GetLatestHeight().(clienttypes.Height)
+
+		proofConnection, _ := next.Chain.QueryProofAtHeight(host.ConnectionKey(next.ConnectionID), int64(height.GetRevisionHeight()))
+		proofConsensus, _ := next.Chain.QueryProofAtHeight(host.FullConsensusStateKey(next.ClientID, consensusHeight), int64(height.GetRevisionHeight()))
+
+		consensusState, err := clienttypes.PackConsensusState(next.GetConsensusState(consensusHeight))
+		require.NoError(next.Chain.T, err)
+
+		hopProofs[i] = multihoptypes.HopProof{
+			Connection:      next.GetConnection(),
+			ProofConnection: proofConnection,
+			ConsensusState:  consensusState,
+			ConsensusHeight: consensusHeight,
+			ProofConsensus:  proofConsensus,
+		}
+	}
+
+	proofs := make([]multihoptypes.MultihopProof, len(keys))
+	for i := range keys {
+		proofs[i] = multihoptypes.MultihopProof{
+			Hops:     hopProofs,
+			KeyProof: keyProofs[i],
+		}
+	}
+
+	return proofs, height
+}
+
+// QueryClientFrozenProofs returns the multi-hop proofs of the connection end and
+// the client state of the connection hop at the given index, along with the
+// proof height of the first hop.
+func (endpoint *MultihopEndpoint) QueryClientFrozenProofs(index int) ([]byte, []byte, clienttypes.Height) {
+	hop := endpoint.Hops[index]
+	proofs, height := QueryMultihopProofs(
+		endpoint.Hops[:index],
+		host.ConnectionKey(hop.ConnectionID), host.FullClientStateKey(hop.ClientID),
+	)
+
+	cdc := endpoint.Chain.App.AppCodec()
+
+	connection := hop.GetConnection()
+	proofs[0].Value = cdc.MustMarshal(&connection)
+	proofs[1].Value = clienttypes.MustMarshalClientState(cdc, hop.GetClientState())
+
+	proofConnection, err := cdc.Marshal(&proofs[0])
+	require.NoError(endpoint.Chain.T, err)
+
+	proofClientState, err := cdc.Marshal(&proofs[1])
+	require.NoError(endpoint.Chain.T, err)
+
+	return proofConnection, proofClientState, height
+}
+
+// ChanOpenInit will construct and execute a MsgChannelOpenInit on the associated endpoint.
+func (endpoint *MultihopEndpoint) ChanOpenInit() error {
+	msg := channeltypes.NewMsgChannelOpenInit(
+		endpoint.ChannelConfig.PortID,
+		endpoint.ChannelConfig.Version, endpoint.ChannelConfig.Order, endpoint.ConnectionHops(),
+		endpoint.Counterparty.ChannelConfig.PortID,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+	res, err := endpoint.Chain.SendMsgs(msg)
+	if err != nil {
+		return err
+	}
+
+	endpoint.ChannelID, err = ParseChannelIDFromEvents(res.GetEvents())
+	require.NoError(endpoint.Chain.T, err)
+
+	return nil
+}
+
+// ChanOpenTry will construct and execute a MsgChannelOpenTry on the associated endpoint.
+func (endpoint *MultihopEndpoint) ChanOpenTry() error {
+	channelKey := host.ChannelKey(endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID)
+	proof, height := endpoint.QueryMultihopProof(channelKey)
+
+	msg := channeltypes.NewMsgChannelOpenTry(
+		endpoint.ChannelConfig.PortID, "", // does not support handshake continuation
+		endpoint.ChannelConfig.Version, endpoint.ChannelConfig.Order, endpoint.ConnectionHops(),
+		endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID, endpoint.Counterparty.ChannelConfig.Version,
+		proof, height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+	res, err := endpoint.Chain.SendMsgs(msg)
+	if err != nil {
+		return err
+	}
+
+	endpoint.ChannelID, err = ParseChannelIDFromEvents(res.GetEvents())
+	require.NoError(endpoint.Chain.T, err)
+
+	// update version to selected app version
+	endpoint.ChannelConfig.Version = endpoint.GetChannel().Version
+
+	return nil
+}
+
+// ChanOpenAck will construct and execute a MsgChannelOpenAck on the associated endpoint.
+func (endpoint *MultihopEndpoint) ChanOpenAck() error {
+	channelKey := host.ChannelKey(endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID)
+	proof, height := endpoint.QueryMultihopProof(channelKey)
+
+	msg := channeltypes.NewMsgChannelOpenAck(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID,
+		endpoint.Counterparty.ChannelID, endpoint.Counterparty.ChannelConfig.Version,
+		proof, height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// ChanOpenConfirm will construct and execute a MsgChannelOpenConfirm on the associated endpoint.
+func (endpoint *MultihopEndpoint) ChanOpenConfirm() error {
+	channelKey := host.ChannelKey(endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID)
+	proof, height := endpoint.QueryMultihopProof(channelKey)
+
+	msg := channeltypes.NewMsgChannelOpenConfirm(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID,
+		proof, height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// ChanCloseFrozen will construct and execute a MsgChannelCloseFrozen on the associated
+// endpoint, proving the client of the connection hop at the given index to be frozen.
+func (endpoint *MultihopEndpoint) ChanCloseFrozen(index int) error {
+	proofConnection, proofClientState, height := endpoint.QueryClientFrozenProofs(index)
+
+	msg := channeltypes.NewMsgChannelCloseFrozen(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID,
+		proofConnection, proofClientState, height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// SendPacket sends a packet through the channel keeper using the associated endpoint.
+func (endpoint *MultihopEndpoint) SendPacket(packet exported.PacketI) error {
+	channelCap := endpoint.Chain.GetChannelCapability(packet.GetSourcePort(), packet.GetSourceChannel())
+
+	// no need to send message, acting as a module
+	err := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.SendPacket(endpoint.Chain.GetContext(), channelCap, packet)
+	if err != nil {
+		return err
+	}
+
+	// commit changes since no message was sent
+	endpoint.Chain.Coordinator.CommitBlock(endpoint.Chain)
+
+	return nil
+}
+
+// RecvPacket receives a packet on the associated endpoint.
+func (endpoint *MultihopEndpoint) RecvPacket(packet channeltypes.Packet) error {
+	packetKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	proof, proofHeight := endpoint.QueryMultihopProof(packetKey)
+
+	recvMsg := channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String())
+
+	return endpoint.Chain.sendMsgs(recvMsg)
+}
+
+// AcknowledgePacket sends a MsgAcknowledgement to the channel associated with the endpoint.
+func (endpoint *MultihopEndpoint) AcknowledgePacket(packet channeltypes.Packet, ack []byte) error {
+	packetKey := host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	proof, proofHeight := endpoint.QueryMultihopProof(packetKey)
+
+	ackMsg := channeltypes.NewMsgAcknowledgement(packet, ack, proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String())
+
+	return endpoint.Chain.sendMsgs(ackMsg)
+}
+
+// TimeoutPacket sends a MsgTimeout to the channel associated with the endpoint.
+func (endpoint *MultihopEndpoint) TimeoutPacket(packet channeltypes.Packet) error {
+	var packetKey []byte
+
+	switch endpoint.ChannelConfig.Order {
+	case channeltypes.ORDERED:
+		packetKey = host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
+	case channeltypes.UNORDERED:
+		packetKey = host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	default:
+		return fmt.Errorf("unsupported order type %s", endpoint.ChannelConfig.Order)
+	}
+
+	proof, proofHeight := endpoint.QueryMultihopProof(packetKey)
+	nextSeqRecv, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(endpoint.Counterparty.Chain.GetContext(), packet.GetDestPort(), packet.GetDestChannel())
+	require.True(endpoint.Chain.T, found)
+
+	timeoutMsg := channeltypes.NewMsgTimeout(
+		packet, nextSeqRecv,
+		proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+
+	return endpoint.Chain.sendMsgs(timeoutMsg)
+}
@@ -4,7 +4,10 @@ import (
 	"bytes"
 	"fmt"
 
+	"github.com/stretchr/testify/require"
+
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
 
 // Path contains two endpoints representing two chains connected over IBC
@@ -29,6 +32,30 @@ func NewPath(chainA, chainB *TestChain) *Path {
 	}
 }
 
+// NewLocalhostPath constructs a path whose endpoints are both on the given chain and use
+// the localhost client and its sentinel connection, which are created if they do not exist.
+func NewLocalhostPath(chain *TestChain) *Path {
+	ctx := chain.GetContext()
+	if _, found := chain.App.GetIBCKeeper().ClientKeeper.GetClientState(ctx, exported.Localhost); !found {
+		params := chain.App.GetIBCKeeper().ClientKeeper.GetParams(ctx)
+		params.AllowedClients = append(params.AllowedClients, exported.Localhost)
+		chain.App.GetIBCKeeper().ClientKeeper.SetParams(ctx, params)
+
+		require.NoError(chain.T, chain.App.GetIBCKeeper().ClientKeeper.CreateLocalhostClient(ctx))
+		chain.App.GetIBCKeeper().ConnectionKeeper.CreateSentinelLocalhostConnection(ctx)
+
+		chain.Coordinator.CommitBlock(chain)
+	}
+
+	path := NewPath(chain, chain)
+	for _, endpoint := range []*Endpoint{path.EndpointA, path.EndpointB} {
+		endpoint.ClientID = exported.Localhost
+		endpoint.ConnectionID = exported.LocalhostConnectionID
+	}
+
+	return path
+}
+
 // SetChannelOrdered sets the channel order for both endpoints to ORDERED.
 func (path *Path) SetChannelOrdered() {
 	path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
@@ -2,7 +2,6 @@ package simapp
 
 import (
 	"encoding/json"
-	"fmt"
 	"io"
 	"net/http"
 	"os"
@@ -14,6 +13,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -90,6 +90,8 @@ import (
 	icahostkeeper "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/keeper"
 	icahosttypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	ibccallbacks "github.com/cosmos/ibc-go/v3/modules/apps/callbacks"
+	ibccallbackstypes "github.com/cosmos/ibc-go/v3/modules/apps/callbacks/types"
 	transfer "github.com/cosmos/ibc-go/v3/modules/apps/transfer"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v3/modules/apps/transfer/keeper"
 	ibctransfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
@@ -97,11 +99,19 @@ import (
 	ibcclient "github.com/cosmos/ibc-go/v3/modules/core/02-client"
 	ibcclientclient "github.com/cosmos/ibc-go/v3/modules/core/02-client/client"
 	ibcclienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	ibcconnection "github.com/cosmos/ibc-go/v3/modules/core/03-connection"
+	ibcconnectionclient "github.com/cosmos/ibc-go/v3/modules/core/03-connection/client"
+	ibcconnectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	ibcchannel "github.com/cosmos/ibc-go/v3/modules/core/04-channel"
+	ibcchannelclient "github.com/cosmos/ibc-go/v3/modules/core/04-channel/client"
+	ibcchanneltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v3/modules/core/05-port/types"
 	ibchost "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	ibckeeper "github.com/cosmos/ibc-go/v3/modules/core/keeper"
-	ibcdmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/01-dymint/types"
-	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
+	ibccoretypes "github.com/cosmos/ibc-go/v3/modules/core/types"
+	ibcwasm "github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm"
+	ibcwasmkeeper "github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/keeper"
+	ibcwasmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/types"
 	ibcmock "github.com/cosmos/ibc-go/v3/testing/mock"
 
 	authz "github.com/cosmos/cosmos-sdk/x/authz"
@@ -116,6 +126,9 @@ import (
 
 const appName = "SimApp"
 
+// DefaultMaxCallbackGas is the maximum gas a single callback of the callbacks middleware may consume.
+const DefaultMaxCallbackGas = uint64(400_000)
+
 var (
 	// DefaultNodeHome default home directories for the application daemon
 	DefaultNodeHome string
@@ -133,7 +146,9 @@ var (
 		distr.AppModuleBasic{},
 		gov.NewAppModuleBasic(
 			paramsclient.ProposalHandler, distrclient.ProposalHandler, upgradeclient.ProposalHandler, upgradeclient.CancelProposalHandler,
-			ibcclientclient.UpdateClientProposalHandler, ibcclientclient.UpgradeProposalHandler,
+			ibcclientclient.UpdateClientProposalHandler, ibcclientclient.UpgradeProposalHandler, ibcclientclient.ClientMigrationProposalHandler,
+			ibcconnectionclient.ConnectionCloseProposalHandler,
+			ibcchannelclient.ChannelHaltProposalHandler, ibcchannelclient.ChannelResumeProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -147,6 +162,7 @@ var (
 		ica.AppModuleBasic{},
 		authzmodule.AppModuleBasic{},
 		vesting.AppModuleBasic{},
+		ibcwasm.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -202,6 +218,7 @@ type SimApp struct {
 	EvidenceKeeper      evidencekeeper.Keeper
 	TransferKeeper      ibctransferkeeper.Keeper
 	FeeGrantKeeper      feegrantkeeper.Keeper
+	WasmClientKeeper    ibcwasmkeeper.Keeper
 
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper           capabilitykeeper.ScopedKeeper
@@ -267,7 +284,7 @@ func NewSimAppWithConsensusType(
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, paramstypes.StoreKey, ibchost.StoreKey, upgradetypes.StoreKey, feegrant.StoreKey,
 		evidencetypes.StoreKey, ibctransfertypes.StoreKey, icacontrollertypes.StoreKey, icahosttypes.StoreKey, capabilitytypes.StoreKey,
-		authzkeeper.StoreKey,
+		authzkeeper.StoreKey, ibcwasmtypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -337,20 +354,21 @@ func NewSimAppWithConsensusType(
 		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks()),
 	)
 
-	var selfClient exported.SelfClient
-	switch chainConsensusType {
-	case exported.Tendermint:
-		selfClient = ibctmtypes.NewSelfClient()
-	case exported.Dymint:
-		selfClient = ibcdmtypes.NewSelfClient()
-	default:
-		panic(fmt.Sprintf("client type %s is not supported", chainConsensusType))
-	}
-	// Create IBC Keeper
-	app.IBCKeeper = ibckeeper.NewKeeperWithSelfClient(
-		appCodec, keys[ibchost.StoreKey], app.GetSubspace(ibchost.ModuleName), app.StakingKeeper, app.UpgradeKeeper, scopedIBCKeeper, selfClient, nil,
+	// NOTE: the wasm engine executing the light client contracts must be set explicitly in
+	// the app options, the in-process mock wasm engine is only set by tests.
+	app.WasmClientKeeper = ibcwasmkeeper.NewKeeper(
+		appCodec, keys[ibcwasmtypes.StoreKey], app.GetSubspace(ibcwasmtypes.ModuleName),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(), wasmEngine(appOpts),
 	)
 
+	// Create IBC Keeper, the 08-wasm light client module executes the wasm clients with the
+	// wasm engine of the 08-wasm keeper
+	app.IBCKeeper = ibckeeper.NewKeeperWithLightClients(
+		appCodec, keys[ibchost.StoreKey], app.GetSubspace(ibchost.ModuleName), app.StakingKeeper, app.UpgradeKeeper, scopedIBCKeeper,
+		ibccoretypes.NewLightClientRegistry(ibcwasm.NewLightClientModule(app.WasmClientKeeper)), chainConsensusType, nil,
+	)
+	app.IBCKeeper.SetCapabilityKeeper(app.CapabilityKeeper, memKeys[capabilitytypes.MemStoreKey])
+
 	app.AuthzKeeper = authzkeeper.NewKeeper(keys[authzkeeper.StoreKey], appCodec, app.BaseApp.MsgServiceRouter())
 
 	// register the proposal types
@@ -359,7 +377,9 @@ func NewSimAppWithConsensusType(
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
-		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper))
+		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
+		AddRoute(ibcconnectiontypes.RouterKey, ibcconnection.NewConnectionProposalHandler(app.IBCKeeper.ConnectionKeeper, app.IBCKeeper.ChannelKeeper)).
+		AddRoute(ibcchanneltypes.RouterKey, ibcchannel.NewChannelProposalHandler(app.IBCKeeper.ChannelKeeper))
 	app.GovKeeper = govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
 		&stakingKeeper, govRouter,
@@ -371,7 +391,6 @@ func NewSimAppWithConsensusType(
 		app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
 		app.AccountKeeper, app.BankKeeper, scopedTransferKeeper,
 	)
-	transferModule := transfer.NewAppModule(app.TransferKeeper)
 	transferIBCModule := transfer.NewIBCModule(app.TransferKeeper)
 
 	// NOTE: the IBC mock keeper and application module is used only for testing core IBC. Do
@@ -379,6 +398,27 @@ func NewSimAppWithConsensusType(
 	mockModule := ibcmock.NewAppModule(&app.IBCKeeper.PortKeeper)
 	mockIBCModule := ibcmock.NewIBCModule(&mockModule, ibcmock.NewMockIBCApp(ibcmock.ModuleName, scopedIBCMockKeeper))
 
+	// Create the transfer stack with the callbacks middleware on top of the transfer application
+	// and set the bottom of the stack as the ICS4Wrapper of the transfer keeper so that callbacks
+	// are executed for sent packets.
+	// NOTE: the mock callback handler is used only for testing the callbacks middleware.
+	mockCallbackHandler := ibcmock.NewCallbackHandler(scopedIBCMockKeeper)
+	callbacksRouter := ibccallbackstypes.NewRouter()
+	callbacksRouter.AddRoute(ibcmock.MockCallbackAddress, mockCallbackHandler).
+		AddRoute(ibcmock.MockFailureCallbackAddress, mockCallbackHandler).
+		AddRoute(ibcmock.MockPanicCallbackAddress, mockCallbackHandler).
+		AddRoute(ibcmock.MockOutOfGasCallbackAddress, mockCallbackHandler)
+	callbacksRouter.Seal()
+
+	transferStack := porttypes.NewStackBuilder(app.IBCKeeper.ChannelKeeper).
+		Base(ibctransfertypes.ModuleName, transferIBCModule).
+		Use(ibccallbackstypes.ModuleName, func(ibcModule porttypes.IBCModule, ics4Wrapper porttypes.ICS4Wrapper) porttypes.Middleware {
+			return ibccallbacks.NewIBCMiddleware(ibcModule, ics4Wrapper, callbacksRouter, DefaultMaxCallbackGas)
+		}).
+		Build()
+	app.TransferKeeper.SetICS4Wrapper(transferStack.ICS4Wrapper())
+	transferModule := transfer.NewAppModule(app.TransferKeeper)
+
 	app.ICAControllerKeeper = icacontrollerkeeper.NewKeeper(
 		appCodec, keys[icacontrollertypes.StoreKey], app.GetSubspace(icacontrollertypes.SubModuleName),
 		app.IBCKeeper.ChannelKeeper, // may be replaced with middleware such as ics29 fee
@@ -389,7 +429,7 @@ func NewSimAppWithConsensusType(
 	app.ICAHostKeeper = icahostkeeper.NewKeeper(
 		appCodec, keys[icahosttypes.StoreKey], app.GetSubspace(icahosttypes.SubModuleName),
 		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
-		app.AccountKeeper, scopedICAHostKeeper, app.MsgServiceRouter(),
+		app.AccountKeeper, app.BankKeeper, scopedICAHostKeeper, app.MsgServiceRouter(),
 	)
 
 	icaModule := ica.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper)
@@ -406,7 +446,7 @@ func NewSimAppWithConsensusType(
 	ibcRouter.AddRoute(icacontrollertypes.SubModuleName, icaControllerIBCModule).
 		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule).
 		AddRoute(ibcmock.ModuleName+icacontrollertypes.SubModuleName, icaControllerIBCModule). // ica with mock auth module stack route to ica (top level of middleware stack)
-		AddRoute(ibctransfertypes.ModuleName, transferIBCModule).
+		AddRoute(ibctransfertypes.ModuleName, transferStack).
 		AddRoute(ibcmock.ModuleName, mockIBCModule)
 	app.IBCKeeper.SetRouter(ibcRouter)
 
@@ -449,6 +489,7 @@ func NewSimAppWithConsensusType(
 		transferModule,
 		icaModule,
 		mockModule,
+		ibcwasm.NewAppModule(app.WasmClientKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -460,13 +501,13 @@ func NewSimAppWithConsensusType(
 		upgradetypes.ModuleName, capabilitytypes.ModuleName, minttypes.ModuleName, distrtypes.ModuleName, slashingtypes.ModuleName,
 		evidencetypes.ModuleName, stakingtypes.ModuleName, ibchost.ModuleName, ibctransfertypes.ModuleName, authtypes.ModuleName,
 		banktypes.ModuleName, govtypes.ModuleName, crisistypes.ModuleName, genutiltypes.ModuleName, authz.ModuleName, feegrant.ModuleName,
-		paramstypes.ModuleName, vestingtypes.ModuleName, icatypes.ModuleName, ibcmock.ModuleName,
+		paramstypes.ModuleName, vestingtypes.ModuleName, icatypes.ModuleName, ibcmock.ModuleName, ibcwasmtypes.ModuleName,
 	)
 	app.mm.SetOrderEndBlockers(
 		crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName, ibchost.ModuleName, ibctransfertypes.ModuleName,
 		capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName, slashingtypes.ModuleName,
 		minttypes.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName, feegrant.ModuleName, paramstypes.ModuleName,
-		upgradetypes.ModuleName, vestingtypes.ModuleName, icatypes.ModuleName, ibcmock.ModuleName,
+		upgradetypes.ModuleName, vestingtypes.ModuleName, icatypes.ModuleName, ibcmock.ModuleName, ibcwasmtypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -479,6 +520,7 @@ func NewSimAppWithConsensusType(
 		slashingtypes.ModuleName, govtypes.ModuleName, minttypes.ModuleName, crisistypes.ModuleName,
 		ibchost.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName, ibctransfertypes.ModuleName,
 		icatypes.ModuleName, ibcmock.ModuleName, feegrant.ModuleName, paramstypes.ModuleName, upgradetypes.ModuleName, vestingtypes.ModuleName,
+		ibcwasmtypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
@@ -544,6 +586,12 @@ func NewSimAppWithConsensusType(
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(err.Error())
 		}
+
+		// the wasm engine does not persist the light client codes across restarts
+		ctx := app.BaseApp.NewUncachedContext(true, tmproto.Header{})
+		if err := app.WasmClientKeeper.InitializeCodes(ctx); err != nil {
+			tmos.Exit(err.Error())
+		}
 	}
 
 	app.ScopedIBCKeeper = scopedIBCKeeper
@@ -753,6 +801,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(ibchost.ModuleName)
 	paramsKeeper.Subspace(icacontrollertypes.SubModuleName)
 	paramsKeeper.Subspace(icahosttypes.SubModuleName)
+	paramsKeeper.Subspace(ibcwasmtypes.ModuleName)
 
 	return paramsKeeper
 }
@@ -338,6 +338,14 @@ func (ao EmptyAppOptions) Get(o string) interface{} {
 	return nil
 }
 
+// AppOptionsMap is a stub implementing AppOptions with the options of the map
+type AppOptionsMap map[string]interface{}
+
+// Get implements AppOptions
+func (ao AppOptionsMap) Get(o string) interface{} {
+	return ao[o]
+}
+
 // FundAccount is a utility function that funds an account by minting and sending the coins to the address
 // TODO(fdymylja): instead of using the mint module account, which has the permission of minting, create a "faucet" account
 func FundAccount(app *SimApp, ctx sdk.Context, addr sdk.AccAddress, amounts sdk.Coins) error {
@@ -0,0 +1,49 @@
+package simapp
+
+import (
+	"errors"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcwasmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/08-wasm/types"
+)
+
+// WasmEngineAppOption is the app option holding the WasmEngine executing the 08-wasm light
+// client contracts. This repository does not provide a WasmEngine executing wasm code, the
+// 08-wasm codes cannot be stored and the wasm clients cannot be used if the option is not
+// set. The in-process mock wasm engine must only be set by tests.
+const WasmEngineAppOption = "ibc-wasm-engine"
+
+var errWasmEngineDisabled = errors.New("no wasm engine is set in the app options")
+
+var _ ibcwasmtypes.WasmEngine = disabledWasmEngine{}
+
+// wasmEngine returns the WasmEngine set in the app options, or a WasmEngine failing every
+// call if it is not set.
+func wasmEngine(appOpts servertypes.AppOptions) ibcwasmtypes.WasmEngine {
+	if engine, ok := appOpts.Get(WasmEngineAppOption).(ibcwasmtypes.WasmEngine); ok {
+		return engine
+	}
+
+	return disabledWasmEngine{}
+}
+
+// disabledWasmEngine is the WasmEngine of apps created without a WasmEngine.
+type disabledWasmEngine struct{}
+
+func (disabledWasmEngine) StoreCode([]byte) ([]byte, error) {
+	return nil, errWasmEngineDisabled
+}
+
+func (disabledWasmEngine) Instantiate([]byte, ibcwasmtypes.Env, []byte, sdk.KVStore, sdk.GasMeter) ([]byte, error) {
+	return nil, errWasmEngineDisabled
+}
+
+func (disabledWasmEngine) Query([]byte, ibcwasmtypes.Env, []byte, sdk.KVStore, sdk.GasMeter) ([]byte, error) {
+	return nil, errWasmEngineDisabled
+}
+
+func (disabledWasmEngine) Sudo([]byte, ibcwasmtypes.Env, []byte, sdk.KVStore, sdk.GasMeter) ([]byte, error) {
+	return nil, errWasmEngineDisabled
+}
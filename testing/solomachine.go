@@ -32,6 +32,10 @@ type Solomachine struct {
 	Sequence    uint64
 	Time        uint64
 	Diversifier string
+
+	Weights         []uint64 // weights of the signer set used for verification instead of the public key
+	Threshold       uint64   // threshold of the signer set
+	SignatureWindow uint64   // signature window of the client state, proofs are sequence bound if 0
 }
 
 // NewSolomachine returns a new solomachine instance with an `nKeys` amount of
@@ -53,6 +57,27 @@ func NewSolomachine(t *testing.T, cdc codec.BinaryCodec, clientID, diversifier s
 	}
 }
 
+// NewWeightedSolomachine returns a new solomachine instance whose consensus state uses a
+// weighted signer set with a generated key for each of the given weights and a sequence
+// starting at 1. Signatures are made by all the signers unless the signers are specified
+// with the multi-party signing helpers.
+func NewWeightedSolomachine(t *testing.T, cdc codec.BinaryCodec, clientID, diversifier string, weights []uint64, threshold uint64) *Solomachine {
+	privKeys, pubKeys, _ := GenerateKeys(t, uint64(len(weights)))
+
+	return &Solomachine{
+		t:           t,
+		cdc:         cdc,
+		ClientID:    clientID,
+		PrivateKeys: privKeys,
+		PublicKeys:  pubKeys,
+		Sequence:    1,
+		Time:        10,
+		Diversifier: diversifier,
+		Weights:     weights,
+		Threshold:   threshold,
+	}
+}
+
 // GenerateKeys generates a new set of secp256k1 private keys and public keys.
 // If the number of keys is greater than one then the public key returned represents
 // a multisig public key. The private keys are used for signing, the public
@@ -85,11 +110,22 @@ func GenerateKeys(t *testing.T, n uint64) ([]cryptotypes.PrivKey, []cryptotypes.
 // ClientState returns a new solo machine ClientState instance. Default usage does not allow update
 // after governance proposal
 func (solo *Solomachine) ClientState() *solomachinetypes.ClientState {
-	return solomachinetypes.NewClientState(solo.Sequence, solo.ConsensusState(), false)
+	clientState := solomachinetypes.NewClientState(solo.Sequence, solo.ConsensusState(), false)
+	clientState.SignatureWindow = solo.SignatureWindow
+
+	return clientState
 }
 
 // ConsensusState returns a new solo machine ConsensusState instance
 func (solo *Solomachine) ConsensusState() *solomachinetypes.ConsensusState {
+	if solo.Weights != nil {
+		return &solomachinetypes.ConsensusState{
+			SignerSet:   solo.SignerSet(),
+			Diversifier: solo.Diversifier,
+			Timestamp:   solo.Time,
+		}
+	}
+
 	publicKey, err := codectypes.NewAnyWithValue(solo.PublicKey)
 	require.NoError(solo.t, err)
 
@@ -100,14 +136,54 @@ func (solo *Solomachine) ConsensusState() *solomachinetypes.ConsensusState {
 	}
 }
 
+// SignerSet returns the weighted signer set of the solo machine.
+func (solo *Solomachine) SignerSet() *solomachinetypes.SignerSet {
+	return newSignerSet(solo.t, solo.PublicKeys, solo.Weights, solo.Threshold)
+}
+
+// newSignerSet returns a signer set of the given public keys and weights.
+func newSignerSet(t *testing.T, pubKeys []cryptotypes.PubKey, weights []uint64, threshold uint64) *solomachinetypes.SignerSet {
+	require.Equal(t, len(pubKeys), len(weights), "a weight is required for each public key")
+
+	signers := make([]solomachinetypes.Signer, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		publicKey, err := codectypes.NewAnyWithValue(pubKey)
+		require.NoError(t, err)
+
+		signers[i] = solomachinetypes.NewSigner(publicKey, weights[i])
+	}
+
+	return solomachinetypes.NewSignerSet(signers, threshold)
+}
+
 // GetHeight returns an exported.Height with Sequence as RevisionHeight
 func (solo *Solomachine) GetHeight() exported.Height {
 	return clienttypes.NewHeight(0, solo.Sequence)
 }
 
+// SignSequence returns the sequence signed over by the solo machine. It is 0 if the
+// solo machine uses a signature window, proofs are then independent of the sequence.
+func (solo *Solomachine) SignSequence() uint64 {
+	if solo.SignatureWindow != 0 {
+		return 0
+	}
+
+	return solo.Sequence
+}
+
 // CreateHeader generates a new private/public key pair and creates the
-// necessary signature to construct a valid solo machine header.
+// necessary signature to construct a valid solo machine header. A weighted
+// solo machine rotates to a new signer set with the same weights and threshold.
 func (solo *Solomachine) CreateHeader() *solomachinetypes.Header {
+	if solo.Weights != nil {
+		return solo.CreateRotationHeader(solo.Weights, solo.Threshold, allSigners(len(solo.Weights)), allSigners(len(solo.Weights)))
+	}
+
+	// clients with a signature window require the header timestamp to increase
+	if solo.SignatureWindow != 0 {
+		solo.Time++
+	}
+
 	// generate new private keys and signature for header
 	newPrivKeys, newPubKeys, newPubKey := GenerateKeys(solo.t, uint64(len(solo.PrivateKeys)))
 
@@ -123,7 +199,7 @@ func (solo *Solomachine) CreateHeader() *solomachinetypes.Header {
 	require.NoError(solo.t, err)
 
 	signBytes := &solomachinetypes.SignBytes{
-		Sequence:    solo.Sequence,
+		Sequence:    solo.SignSequence(),
 		Timestamp:   solo.Time,
 		Diversifier: solo.Diversifier,
 		DataType:    solomachinetypes.HEADER,
@@ -152,9 +228,60 @@ func (solo *Solomachine) CreateHeader() *solomachinetypes.Header {
 	return header
 }
 
+// CreateRotationHeader generates a new weighted signer set with the given weights and
+// threshold and creates a header rotating the solo machine to it. The header is signed
+// by the given signers of the current signer set and the rotation is approved by the
+// given signers of the new signer set. Clients with a signature window require the
+// header timestamp to increase, the solo machine time is incremented before signing
+// if a signature window is used.
+func (solo *Solomachine) CreateRotationHeader(weights []uint64, threshold uint64, oldSigners, newSigners []int) *solomachinetypes.Header {
+	require.NotNil(solo.t, solo.Weights, "rotation requires a weighted solo machine")
+
+	if solo.SignatureWindow != 0 {
+		solo.Time++
+	}
+
+	newPrivKeys, newPubKeys, _ := GenerateKeys(solo.t, uint64(len(weights)))
+	newSignerSet := newSignerSet(solo.t, newPubKeys, weights, threshold)
+
+	header := &solomachinetypes.Header{
+		Sequence:       solo.Sequence,
+		Timestamp:      solo.Time,
+		NewDiversifier: solo.Diversifier,
+		NewSignerSet:   newSignerSet,
+	}
+
+	signBytes, err := solomachinetypes.HeaderSignBytes(solo.cdc, solo.SignSequence(), header)
+	require.NoError(solo.t, err)
+
+	header.Signature = solo.GenerateSignerSetSignature(signBytes, oldSigners...)
+
+	partialSigs := make(map[int][]byte, len(newSigners))
+	for _, signer := range newSigners {
+		sig, err := newPrivKeys[signer].Sign(signBytes)
+		require.NoError(solo.t, err)
+
+		partialSigs[signer] = sig
+	}
+	header.NewSignerSetSignature = aggregateSignatures(solo.t, solo.cdc, len(newPrivKeys), partialSigs)
+
+	// assumes successful header update
+	solo.Sequence++
+	solo.PrivateKeys = newPrivKeys
+	solo.PublicKeys = newPubKeys
+	solo.Weights = weights
+	solo.Threshold = threshold
+
+	return header
+}
+
 // CreateMisbehaviour constructs testing misbehaviour for the solo machine client
 // by signing over two different data bytes at the same sequence.
 func (solo *Solomachine) CreateMisbehaviour() *solomachinetypes.Misbehaviour {
+	if solo.SignatureWindow != 0 {
+		return solo.createConflictingMisbehaviour()
+	}
+
 	path := solo.GetClientStatePath("counterparty")
 	dataOne, err := solomachinetypes.ClientStateDataBytes(solo.cdc, path, solo.ClientState())
 	require.NoError(solo.t, err)
@@ -212,10 +339,45 @@ func (solo *Solomachine) CreateMisbehaviour() *solomachinetypes.Misbehaviour {
 	}
 }
 
+// createConflictingMisbehaviour constructs testing misbehaviour for a solo machine client
+// with a signature window by signing over two different values for the same path at the
+// same timestamp.
+func (solo *Solomachine) createConflictingMisbehaviour() *solomachinetypes.Misbehaviour {
+	path := solo.GetClientStatePath("counterparty")
+
+	signatures := make([]*solomachinetypes.SignatureAndData, 2)
+	for i, value := range [][]byte{[]byte("value one"), []byte("value two")} {
+		data, err := solomachinetypes.MembershipDataBytes(solo.cdc, path, value)
+		require.NoError(solo.t, err)
+
+		signBytes, err := solomachinetypes.MisbehaviourSignBytes(solo.cdc, solo.SignSequence(), solo.Time, solo.Diversifier, solomachinetypes.MEMBERSHIP, data)
+		require.NoError(solo.t, err)
+
+		signatures[i] = &solomachinetypes.SignatureAndData{
+			Signature: solo.GenerateSignature(signBytes),
+			DataType:  solomachinetypes.MEMBERSHIP,
+			Data:      data,
+			Timestamp: solo.Time,
+		}
+	}
+
+	return &solomachinetypes.Misbehaviour{
+		ClientId:     solo.ClientID,
+		Sequence:     solo.Sequence,
+		SignatureOne: signatures[0],
+		SignatureTwo: signatures[1],
+	}
+}
+
 // GenerateSignature uses the stored private keys to generate a signature
 // over the sign bytes with each key. If the amount of keys is greater than
-// 1 then a multisig data type is returned.
+// 1 then a multisig data type is returned. A weighted solo machine returns
+// the signature of all the signers of its signer set.
 func (solo *Solomachine) GenerateSignature(signBytes []byte) []byte {
+	if solo.Weights != nil {
+		return solo.GenerateSignerSetSignature(signBytes, allSigners(len(solo.Weights))...)
+	}
+
 	sigs := make([]signing.SignatureData, len(solo.PrivateKeys))
 	for i, key := range solo.PrivateKeys {
 		sig, err := key.Sign(signBytes)
@@ -247,6 +409,94 @@ func (solo *Solomachine) GenerateSignature(signBytes []byte) []byte {
 	return bz
 }
 
+// PartialSignature returns the signature of a single party, the signer at the given
+// index of the signer set, over the sign bytes. Partial signatures are created
+// independently by each party and combined with AggregateSignatures.
+func (solo *Solomachine) PartialSignature(signer int, signBytes []byte) []byte {
+	require.Less(solo.t, signer, len(solo.PrivateKeys), "signer index out of range")
+
+	sig, err := solo.PrivateKeys[signer].Sign(signBytes)
+	require.NoError(solo.t, err)
+
+	return sig
+}
+
+// AggregateSignatures combines the partial signatures of the signer set, keyed by the
+// index of their signer, into the signature data verified against the signer set.
+func (solo *Solomachine) AggregateSignatures(partialSigs map[int][]byte) []byte {
+	return aggregateSignatures(solo.t, solo.cdc, len(solo.PrivateKeys), partialSigs)
+}
+
+// aggregateSignatures returns the marshaled multi signature data of a signer set of the
+// given size marking the signers of the partial signatures.
+func aggregateSignatures(t *testing.T, cdc codec.BinaryCodec, size int, partialSigs map[int][]byte) []byte {
+	multiSigData := multisig.NewMultisig(size)
+	for signer, sig := range partialSigs {
+		require.Less(t, signer, size, "signer index out of range")
+
+		multisig.AddSignature(multiSigData, &signing.SingleSignatureData{Signature: sig}, signer)
+	}
+
+	protoSigData := signing.SignatureDataToProto(multiSigData)
+	bz, err := cdc.Marshal(protoSigData)
+	require.NoError(t, err)
+
+	return bz
+}
+
+// GenerateSignerSetSignature returns the aggregated signature of the given signers of
+// the signer set over the sign bytes.
+func (solo *Solomachine) GenerateSignerSetSignature(signBytes []byte, signers ...int) []byte {
+	partialSigs := make(map[int][]byte, len(signers))
+	for _, signer := range signers {
+		partialSigs[signer] = solo.PartialSignature(signer, signBytes)
+	}
+
+	return solo.AggregateSignatures(partialSigs)
+}
+
+// allSigners returns the indices of all the signers of a signer set of the given size.
+func allSigners(size int) []int {
+	signers := make([]int, size)
+	for i := range signers {
+		signers[i] = i
+	}
+
+	return signers
+}
+
+// GenerateProof generates a proof over the given path and value using the generic
+// MEMBERSHIP or NONMEMBERSHIP sign bytes at the current sequence and timestamp. The
+// value must be nil for NONMEMBERSHIP proofs.
+func (solo *Solomachine) GenerateProof(dataType solomachinetypes.DataType, path commitmenttypes.MerklePath, value []byte) []byte {
+	signBytes, err := solomachinetypes.MembershipSignBytes(solo.cdc, solo.SignSequence(), solo.Time, solo.Diversifier, dataType, path, value)
+	require.NoError(solo.t, err)
+
+	return solo.timestampedProof(solo.GenerateSignature(signBytes))
+}
+
+// GenerateProofWithSigners generates a proof like GenerateProof signed only by the given
+// signers of the signer set of a weighted solo machine.
+func (solo *Solomachine) GenerateProofWithSigners(dataType solomachinetypes.DataType, path commitmenttypes.MerklePath, value []byte, signers ...int) []byte {
+	signBytes, err := solomachinetypes.MembershipSignBytes(solo.cdc, solo.SignSequence(), solo.Time, solo.Diversifier, dataType, path, value)
+	require.NoError(solo.t, err)
+
+	return solo.timestampedProof(solo.GenerateSignerSetSignature(signBytes, signers...))
+}
+
+// timestampedProof returns the marshaled proof of the signature at the current timestamp.
+func (solo *Solomachine) timestampedProof(sig []byte) []byte {
+	signatureDoc := &solomachinetypes.TimestampedSignatureData{
+		SignatureData: sig,
+		Timestamp:     solo.Time,
+	}
+
+	proof, err := solo.cdc.Marshal(signatureDoc)
+	require.NoError(solo.t, err)
+
+	return proof
+}
+
 // GetClientStatePath returns the commitment path for the client state.
 func (solo *Solomachine) GetClientStatePath(counterpartyClientIdentifier string) commitmenttypes.MerklePath {
 	path, err := commitmenttypes.ApplyPrefix(prefix, commitmenttypes.NewMerklePath(host.FullClientStatePath(counterpartyClientIdentifier)))
@@ -317,3 +567,12 @@ func (solo *Solomachine) GetNextSequenceRecvPath(portID, channelID string) commi
 
 	return path
 }
+
+// GetNextSequenceAckPath returns the commitment path for the next sequence ack counter.
+func (solo *Solomachine) GetNextSequenceAckPath(portID, channelID string) commitmenttypes.MerklePath {
+	nextSequenceAckPath := commitmenttypes.NewMerklePath(host.NextSequenceAckPath(portID, channelID))
+	path, err := commitmenttypes.ApplyPrefix(prefix, nextSequenceAckPath)
+	require.NoError(solo.t, err)
+
+	return path
+}